@@ -0,0 +1,350 @@
+// Package analysis implements static checks over Cherri parse trees.
+//
+// Every check takes a tree produced by the Cherri grammar together with the
+// source it was parsed from and reports Diagnostics anchored to nodes.
+package analysis
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_cherri "github.com/tree-sitter/tree-sitter-cherri/bindings/go"
+)
+
+// Severity is the importance of a Diagnostic.
+type Severity int
+
+const (
+	SeverityError Severity = iota + 1
+	SeverityWarning
+	SeverityInformation
+	SeverityHint
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	case SeverityInformation:
+		return "information"
+	case SeverityHint:
+		return "hint"
+	}
+	return "unknown"
+}
+
+// A Diagnostic is a problem found by a check.
+type Diagnostic struct {
+	Range    tree_sitter.Range
+	Severity Severity
+	// Code identifies the check that produced the diagnostic.
+	Code    string
+	Message string
+	// Suggestions are replacement texts for Range, best first.
+	Suggestions []string
+	// Fixes are code actions that resolve the diagnostic.
+	Fixes []CodeAction
+}
+
+// A TextEdit replaces the source bytes in Range with NewText.
+type TextEdit struct {
+	Range   tree_sitter.Range
+	NewText string
+}
+
+// A CodeAction is a titled set of edits offered to the user.
+type CodeAction struct {
+	Title string
+	Edits []TextEdit
+}
+
+// ApplyEdits returns source with edits applied. Edits must not overlap.
+func ApplyEdits(source []byte, edits []TextEdit) []byte {
+	sorted := slices.Clone(edits)
+	slices.SortStableFunc(sorted, func(a, b TextEdit) int {
+		return int(a.Range.StartByte) - int(b.Range.StartByte)
+	})
+	var out []byte
+	last := uint(0)
+	for _, edit := range sorted {
+		out = append(out, source[last:edit.Range.StartByte]...)
+		out = append(out, edit.NewText...)
+		last = edit.Range.EndByte
+	}
+	return append(out, source[last:]...)
+}
+
+// InsertionAt returns an empty range at offset, for inserting text.
+func InsertionAt(node *tree_sitter.Node, source []byte, offset uint) tree_sitter.Range {
+	point := PointAt(node, source, offset)
+	return tree_sitter.Range{StartByte: offset, EndByte: offset, StartPoint: point, EndPoint: point}
+}
+
+// VariableReference returns the text referring to @name in place of a node
+// ending at end. A space is added when the next character would otherwise
+// be lexed as part of the variable name.
+func VariableReference(name string, source []byte, end uint) string {
+	if int(end) < len(source) && strings.IndexByte(",)}]", source[end]) >= 0 {
+		return "@" + name + " "
+	}
+	return "@" + name
+}
+
+// LineIndent returns the leading whitespace of the line containing offset.
+func LineIndent(source []byte, offset uint) string {
+	start := offset
+	for start > 0 && source[start-1] != '\n' {
+		start--
+	}
+	end := start
+	for end < uint(len(source)) && (source[end] == ' ' || source[end] == '\t') {
+		end++
+	}
+	return string(source[start:end])
+}
+
+// Parse parses source with the Cherri grammar. The caller owns the
+// returned tree and must close it.
+func Parse(source []byte) *tree_sitter.Tree {
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	// SetLanguage only fails on an ABI mismatch, which the binding test
+	// already guards against.
+	_ = parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_cherri.Language()))
+	return parser.Parse(source, nil)
+}
+
+// Walk calls fn for node and each of its descendants in document order.
+// Children are skipped when fn returns false.
+func Walk(node *tree_sitter.Node, fn func(*tree_sitter.Node) bool) {
+	if !fn(node) {
+		return
+	}
+	for i := uint(0); i < node.ChildCount(); i++ {
+		Walk(node.Child(i), fn)
+	}
+}
+
+// VariableName returns the name of an at_variable node without the
+// leading @. The at_variable token also swallows punctuation written
+// directly after it, as in `@var,` or `@var)`, so that is trimmed too.
+func VariableName(node *tree_sitter.Node, source []byte) string {
+	return strings.TrimRight(strings.TrimPrefix(node.Utf8Text(source), "@"), ",)}]")
+}
+
+// InterpolationNames returns the names an interpolation node refers to, in
+// order. Interpolations hold a variable name, optionally followed by an
+// accessor such as `{dict['key']}` or `{list[index]}`; names used in the
+// accessor are returned too, but quoted keys and numbers are not.
+func InterpolationNames(node *tree_sitter.Node, source []byte) []string {
+	names, _ := interpolationNames(node, source)
+	return names
+}
+
+// interpolationNames returns the names an interpolation node refers to
+// and the byte offset in source of each.
+func interpolationNames(node *tree_sitter.Node, source []byte) ([]string, []uint) {
+	text := node.Utf8Text(source)
+	inner := strings.TrimLeft(text, "{")
+	base := node.StartByte() + uint(len(text)-len(inner))
+	inner = strings.TrimRight(inner, "}")
+	var names []string
+	var offsets []uint
+	var word strings.Builder
+	wordStart := 0
+	quote := rune(0)
+	end := func() {
+		if w := word.String(); w != "" && !unicode.IsDigit(rune(w[0])) {
+			names = append(names, w)
+			offsets = append(offsets, base+uint(wordStart))
+		}
+		word.Reset()
+	}
+	for i, r := range inner {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			end()
+			quote = r
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_':
+			if word.Len() == 0 {
+				wordStart = i
+			}
+			word.WriteRune(r)
+		default:
+			end()
+		}
+	}
+	end()
+	return names, offsets
+}
+
+// CallName returns the name of the function a call node invokes.
+func CallName(call *tree_sitter.Node, source []byte) string {
+	if fn := call.ChildByFieldName("function"); fn != nil {
+		return fn.Utf8Text(source)
+	}
+	return ""
+}
+
+// Arguments returns the argument expressions of a call node in order.
+//
+// An at_variable directly followed by a comma is lexed as a single token
+// and lands in an ERROR node, so at_variables are recovered from those.
+func Arguments(call *tree_sitter.Node) []*tree_sitter.Node {
+	var args []*tree_sitter.Node
+	for i := uint(0); i < call.NamedChildCount(); i++ {
+		child := call.NamedChild(i)
+		switch {
+		case child.IsError():
+			for j := uint(0); j < child.NamedChildCount(); j++ {
+				if inner := child.NamedChild(j); inner.Kind() == "at_variable" {
+					args = append(args, inner)
+				}
+			}
+		case call.FieldNameForNamedChild(uint32(i)) == "arguments":
+			args = append(args, child)
+		}
+	}
+	return args
+}
+
+// StringValue returns the decoded value of a string or single_quoted_string
+// node. It reports false for other nodes and for strings that contain
+// interpolations, whose value is not known statically.
+func StringValue(node *tree_sitter.Node, source []byte) (string, bool) {
+	decoded, ok := DecodeString(node, source)
+	return decoded.Value, ok
+}
+
+// A DecodedString is the value of a string literal along with where each
+// of its bytes came from in the source.
+type DecodedString struct {
+	Value string
+	// Offsets holds the source byte offset each byte of Value was decoded
+	// from, followed by the offset of the closing quote.
+	Offsets []uint
+}
+
+// DecodeString decodes a string or single_quoted_string node like
+// StringValue, also recording source offsets.
+func DecodeString(node *tree_sitter.Node, source []byte) (DecodedString, bool) {
+	var b strings.Builder
+	var offsets []uint
+	emit := func(s string, at uint) {
+		b.WriteString(s)
+		for range len(s) {
+			offsets = append(offsets, at)
+		}
+	}
+	switch node.Kind() {
+	case "string":
+		for i := uint(0); i < node.NamedChildCount(); i++ {
+			child := node.NamedChild(i)
+			switch child.Kind() {
+			case "string_content":
+				text := child.Utf8Text(source)
+				for j := range len(text) {
+					emit(text[j:j+1], child.StartByte()+uint(j))
+				}
+			case "escape_sequence":
+				emit(unescape(child.Utf8Text(source), '"'), child.StartByte())
+			default:
+				return DecodedString{}, false
+			}
+		}
+	case "single_quoted_string":
+		start := node.StartByte() + 1
+		text := string(source[start : node.EndByte()-1])
+		for i := 0; i < len(text); i++ {
+			if text[i] == '\\' && i+1 < len(text) {
+				emit(unescape(text[i:i+2], '\''), start+uint(i))
+				i++
+				continue
+			}
+			emit(text[i:i+1], start+uint(i))
+		}
+	default:
+		return DecodedString{}, false
+	}
+	offsets = append(offsets, node.EndByte()-1)
+	return DecodedString{Value: b.String(), Offsets: offsets}, true
+}
+
+// A StringPart is a piece of a double-quoted string: either decoded text
+// or an interpolation node.
+type StringPart struct {
+	Text          string
+	Interpolation *tree_sitter.Node
+}
+
+// StringParts splits a string node into decoded text and interpolations,
+// merging adjacent text.
+func StringParts(node *tree_sitter.Node, source []byte) []StringPart {
+	var parts []StringPart
+	text := func(s string) {
+		if n := len(parts); n > 0 && parts[n-1].Interpolation == nil {
+			parts[n-1].Text += s
+		} else {
+			parts = append(parts, StringPart{Text: s})
+		}
+	}
+	for i := uint(0); i < node.NamedChildCount(); i++ {
+		child := node.NamedChild(i)
+		switch child.Kind() {
+		case "string_content":
+			text(child.Utf8Text(source))
+		case "escape_sequence":
+			text(unescape(child.Utf8Text(source), '"'))
+		case "interpolation":
+			parts = append(parts, StringPart{Interpolation: child})
+		}
+	}
+	return parts
+}
+
+// PointAt returns the position of the source byte at offset, which must
+// lie within node.
+func PointAt(node *tree_sitter.Node, source []byte, offset uint) tree_sitter.Point {
+	point := node.StartPosition()
+	for _, c := range source[node.StartByte():offset] {
+		if c == '\n' {
+			point.Row++
+			point.Column = 0
+		} else {
+			point.Column++
+		}
+	}
+	return point
+}
+
+// unescape decodes a two-byte escape sequence. Double-quoted strings
+// understand the usual control escapes; single-quoted strings are raw and
+// only escape their quote and the backslash.
+func unescape(seq string, quote byte) string {
+	c := seq[1]
+	if c == quote || c == '\\' {
+		return string(c)
+	}
+	if quote == '\'' {
+		return seq
+	}
+	switch c {
+	case 'n':
+		return "\n"
+	case 't':
+		return "\t"
+	case 'r':
+		return "\r"
+	case '{':
+		return "{"
+	}
+	return seq
+}
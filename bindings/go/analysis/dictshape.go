@@ -0,0 +1,313 @@
+package analysis
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// A Shape is the set of keys, and the type of each key's value, that a
+// dictionary variable is known to hold.
+type Shape struct {
+	// Keys maps each key to the type of its value: one of the Cherri type
+	// keywords, or "variable" when the type is not known.
+	Keys map[string]string
+	// Definitions are the dictionary literals the shape was inferred from.
+	Definitions []*tree_sitter.Node
+}
+
+// Has reports whether key is part of the shape.
+func (s *Shape) Has(key string) bool {
+	_, ok := s.Keys[key]
+	return ok
+}
+
+// SortedKeys returns the keys of the shape in lexical order.
+func (s *Shape) SortedKeys() []string {
+	keys := make([]string, 0, len(s.Keys))
+	for key := range s.Keys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// dictionaryKeyActions maps the actions that read or write a dictionary
+// key to the index of their key argument. The dictionary is always the
+// first argument.
+var dictionaryKeyActions = map[string]int{
+	"getValue":    1,
+	"setValue":    1,
+	"removeValue": 1,
+}
+
+// InferShapes infers the shape of every @variable assigned a dictionary
+// literal, keyed by variable name without the @.
+//
+// Shapes are flow-insensitive: assignments of different literals to the
+// same variable are merged, and copying one variable into another copies
+// its shape. A variable that is ever assigned something whose shape is not
+// known (a call result, a parameter, ...) has no shape, as it could hold
+// any keys.
+func InferShapes(tree *tree_sitter.Tree, source []byte) map[string]*Shape {
+	shapes := map[string]*Shape{}
+	unknown := map[string]bool{}
+	var copies [][2]string
+
+	Walk(tree.RootNode(), func(node *tree_sitter.Node) bool {
+		if node.Kind() != "variable_assignment" {
+			return true
+		}
+		name := VariableName(node.ChildByFieldName("name"), source)
+		value := node.ChildByFieldName("value")
+		if value == nil {
+			return true
+		}
+		switch value.Kind() {
+		case "dictionary":
+			shape := shapes[name]
+			if shape == nil {
+				shape = &Shape{Keys: map[string]string{}}
+				shapes[name] = shape
+			}
+			mergeLiteral(shape, value, source)
+		case "at_variable":
+			copies = append(copies, [2]string{name, VariableName(value, source)})
+		default:
+			unknown[name] = true
+		}
+		return true
+	})
+
+	// Propagate copies until nothing changes, so that chains of copies
+	// resolve regardless of the order they appear in.
+	for changed := true; changed; {
+		changed = false
+		for _, c := range copies {
+			dst, src := c[0], c[1]
+			if unknown[src] || shapes[src] == nil {
+				if !unknown[dst] {
+					unknown[dst] = true
+					changed = true
+				}
+				continue
+			}
+			shape := shapes[dst]
+			if shape == nil {
+				shape = &Shape{Keys: map[string]string{}}
+				shapes[dst] = shape
+			}
+			for key, typ := range shapes[src].Keys {
+				if old, ok := shape.Keys[key]; !ok || old != typ && old != "variable" {
+					shape.Keys[key] = mergeTypes(old, ok, typ)
+					changed = true
+				}
+			}
+		}
+	}
+	for name := range unknown {
+		delete(shapes, name)
+	}
+	return shapes
+}
+
+func mergeLiteral(shape *Shape, dict *tree_sitter.Node, source []byte) {
+	shape.Definitions = append(shape.Definitions, dict)
+	for i := uint(0); i < dict.NamedChildCount(); i++ {
+		pair := dict.NamedChild(i)
+		if pair.Kind() != "dictionary_pair" {
+			continue
+		}
+		key, ok := dictionaryKey(pair, source)
+		if !ok {
+			continue
+		}
+		old, exists := shape.Keys[key]
+		shape.Keys[key] = mergeTypes(old, exists, LiteralType(pair.ChildByFieldName("value")))
+	}
+}
+
+func mergeTypes(old string, exists bool, typ string) string {
+	if exists && old != typ {
+		return "variable"
+	}
+	return typ
+}
+
+// dictionaryKey returns the literal key of a dictionary_pair.
+func dictionaryKey(pair *tree_sitter.Node, source []byte) (string, bool) {
+	key := pair.ChildByFieldName("key")
+	if key == nil {
+		return "", false
+	}
+	if key.Kind() == "identifier" {
+		return key.Utf8Text(source), true
+	}
+	return StringValue(key, source)
+}
+
+// LiteralType returns the Cherri type of a literal expression.
+func LiteralType(value *tree_sitter.Node) string {
+	if value == nil {
+		return "variable"
+	}
+	switch value.Kind() {
+	case "string", "single_quoted_string":
+		return "text"
+	case "number":
+		return "number"
+	case "boolean":
+		return "bool"
+	case "dictionary":
+		return "dictionary"
+	}
+	return "variable"
+}
+
+// CheckDictionaryKeys reports literal keys that are not in the inferred
+// shape of the dictionary variable they are read from or written to,
+// suggesting the closest known keys. Keys are checked in getValue,
+// setValue and removeValue calls and in accessors, as in `@d['key']` and
+// `"{d['key']}"`.
+func CheckDictionaryKeys(tree *tree_sitter.Tree, source []byte) []Diagnostic {
+	shapes := InferShapes(tree, source)
+	var diags []Diagnostic
+	check := func(name, key string, r tree_sitter.Range, quote string) {
+		shape := shapes[name]
+		if shape == nil || shape.Has(key) {
+			return
+		}
+		suggestions := closestKeys(key, shape.SortedKeys())
+		message := fmt.Sprintf("key %q is not in the dictionary assigned to @%s", key, name)
+		if len(suggestions) > 0 {
+			message += fmt.Sprintf("; did you mean %q?", suggestions[0])
+		}
+		quoted := make([]string, len(suggestions))
+		for i, s := range suggestions {
+			quoted[i] = fmt.Sprintf("%q", s)
+			if quote == "'" {
+				quoted[i] = quote + s + quote
+			}
+		}
+		diags = append(diags, Diagnostic{
+			Range:       r,
+			Severity:    SeverityWarning,
+			Code:        "dictionary-key",
+			Message:     message,
+			Suggestions: quoted,
+		})
+	}
+	Walk(tree.RootNode(), func(node *tree_sitter.Node) bool {
+		switch node.Kind() {
+		case "call":
+			keyIndex, ok := dictionaryKeyActions[CallName(node, source)]
+			if !ok {
+				return true
+			}
+			args := Arguments(node)
+			if len(args) <= keyIndex || args[0].Kind() != "at_variable" {
+				return true
+			}
+			keyNode := args[keyIndex]
+			if key, ok := StringValue(keyNode, source); ok {
+				check(VariableName(args[0], source), key, keyNode.Range(), `"`)
+			}
+		case "at_variable", "interpolation":
+			text := node.Utf8Text(source)
+			prefix := len(text) - len(strings.TrimLeft(text, "@{"))
+			name, key, start, end, ok := keyAccessor(text[prefix:])
+			if !ok {
+				return true
+			}
+			from, to := node.StartByte()+uint(prefix+start), node.StartByte()+uint(prefix+end)
+			check(name, key, tree_sitter.Range{
+				StartByte:  from,
+				EndByte:    to,
+				StartPoint: PointAt(node, source, from),
+				EndPoint:   PointAt(node, source, to),
+			}, text[prefix+start:prefix+start+1])
+		}
+		return true
+	})
+	return diags
+}
+
+// keyAccessor parses a variable name followed by a quoted key accessor, as
+// in `d['key']`. It returns the name, the key, and the byte offsets in
+// text of the key including its quotes.
+func keyAccessor(text string) (name, key string, start, end int, ok bool) {
+	i := strings.IndexFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	})
+	if i <= 0 || text[i] != '[' || i+1 >= len(text) || text[i+1] != '\'' && text[i+1] != '"' {
+		return "", "", 0, 0, false
+	}
+	start = i + 1
+	n := strings.IndexByte(text[start+1:], text[start])
+	if n < 0 {
+		return "", "", 0, 0, false
+	}
+	end = start + n + 2
+	if end >= len(text) || text[end] != ']' {
+		return "", "", 0, 0, false
+	}
+	return text[:i], text[start+1 : end-1], start, end, true
+}
+
+// closestKeys returns the keys within a small edit distance of key,
+// closest first.
+func closestKeys(key string, keys []string) []string {
+	limit := len(key) / 3
+	if limit < 1 {
+		limit = 1
+	}
+	type candidate struct {
+		key      string
+		distance int
+	}
+	var candidates []candidate
+	for _, k := range keys {
+		d := editDistance(strings.ToLower(key), strings.ToLower(k))
+		if d <= limit {
+			candidates = append(candidates, candidate{k, d})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].distance < candidates[j].distance
+	})
+	out := make([]string, len(candidates))
+	for i, c := range candidates {
+		out[i] = c.key
+	}
+	return out
+}
+
+// editDistance returns the Damerau-Levenshtein (optimal string alignment)
+// distance between a and b, so that transposed letters count as one edit.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(rb)]
+}
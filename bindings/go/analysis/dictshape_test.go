@@ -0,0 +1,101 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestInferShapes(t *testing.T) {
+	source := []byte(`@person = {"name": "Ada", "age": 36, "admin": false}
+@person = {"name": "Grace", "email": "grace@example.com"}
+@copy = @person
+@other = getDictionary(ShortcutInput)
+`)
+	tree := Parse(source)
+	defer tree.Close()
+
+	shapes := InferShapes(tree, source)
+	want := map[string]string{"name": "text", "age": "number", "admin": "bool", "email": "text"}
+	for _, name := range []string{"person", "copy"} {
+		shape := shapes[name]
+		if shape == nil {
+			t.Fatalf("no shape inferred for @%s", name)
+		}
+		if !reflect.DeepEqual(shape.Keys, want) {
+			t.Errorf("@%s keys = %v, want %v", name, shape.Keys, want)
+		}
+	}
+	if shapes["other"] != nil {
+		t.Errorf("@other has a shape, but is assigned a call result")
+	}
+}
+
+func TestCheckDictionaryKeys(t *testing.T) {
+	source := []byte(`@person = {"name": "Ada", "email": "ada@example.com"}
+@a = getValue(@person , "name")
+@b = getValue(@person, "nmae")
+setValue(@person , "emial", "x")
+@c = getValue(@person , "phone")
+`)
+	tree := Parse(source)
+	defer tree.Close()
+
+	diags := CheckDictionaryKeys(tree, source)
+	if len(diags) != 3 {
+		t.Fatalf("got %d diagnostics, want 3: %v", len(diags), diags)
+	}
+	tests := []struct {
+		row         uint
+		suggestions []string
+	}{
+		{2, []string{`"name"`}},
+		{3, []string{`"email"`}},
+		{4, nil},
+	}
+	for i, tt := range tests {
+		d := diags[i]
+		if d.Range.StartPoint.Row != tt.row {
+			t.Errorf("diagnostic %d on row %d, want %d", i, d.Range.StartPoint.Row, tt.row)
+		}
+		if len(d.Suggestions) != len(tt.suggestions) || len(tt.suggestions) > 0 && d.Suggestions[0] != tt.suggestions[0] {
+			t.Errorf("diagnostic %d suggestions = %v, want %v", i, d.Suggestions, tt.suggestions)
+		}
+	}
+}
+
+func TestCheckDictionaryKeyAccessors(t *testing.T) {
+	source := []byte(`@person = {"name": "Ada", "email": "ada@example.com"}
+@a = @person['nmae']
+@b = "Hi {person['emial']}!"
+@c = @person["name"]
+@d = "{person['name']} {person}"
+@e = @other['nmae']
+`)
+	tree := Parse(source)
+	defer tree.Close()
+
+	diags := CheckDictionaryKeys(tree, source)
+	tests := []struct {
+		text        string
+		row         uint
+		suggestions []string
+	}{
+		{`'nmae'`, 1, []string{`'name'`}},
+		{`'emial'`, 2, []string{`'email'`}},
+	}
+	if len(diags) != len(tests) {
+		t.Fatalf("got %d diagnostics, want %d: %v", len(diags), len(tests), diags)
+	}
+	for i, tt := range tests {
+		d := diags[i]
+		if got := string(source[d.Range.StartByte:d.Range.EndByte]); got != tt.text {
+			t.Errorf("diagnostic %d range = %q, want %q", i, got, tt.text)
+		}
+		if d.Range.StartPoint.Row != tt.row {
+			t.Errorf("diagnostic %d on row %d, want %d", i, d.Range.StartPoint.Row, tt.row)
+		}
+		if !reflect.DeepEqual(d.Suggestions, tt.suggestions) {
+			t.Errorf("diagnostic %d suggestions = %v, want %v", i, d.Suggestions, tt.suggestions)
+		}
+	}
+}
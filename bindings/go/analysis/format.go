@@ -0,0 +1,603 @@
+package analysis
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/catalog"
+)
+
+// Sample values rendered in format hovers.
+var (
+	SampleDate   = time.Date(2024, time.March, 14, 15, 9, 26, 535_000_000, time.UTC)
+	SampleNumber = 1234567.891
+)
+
+// A Hover is documentation shown for a range of the source.
+type Hover struct {
+	Range    tree_sitter.Range
+	Contents string
+}
+
+// A FormatProblem is a mistake in a date or number format pattern.
+type FormatProblem struct {
+	// Offset is the byte offset of the problem in the pattern.
+	Offset   int
+	Length   int
+	Severity Severity
+	Message  string
+	// Replacement is the suggested fix for the problem span, if any.
+	Replacement string
+}
+
+// CheckFormatArguments validates literal arguments to the date and number
+// format parameters of catalog actions.
+func CheckFormatArguments(tree *tree_sitter.Tree, source []byte) []Diagnostic {
+	var diags []Diagnostic
+	eachFormatArgument(tree, source, func(arg *tree_sitter.Node, typ catalog.ParamType, decoded DecodedString) {
+		var problems []FormatProblem
+		if typ == catalog.DateFormat {
+			problems = ValidateDateFormat(decoded.Value)
+		} else {
+			problems = ValidateNumberFormat(decoded.Value)
+		}
+		for _, p := range problems {
+			start := decoded.Offsets[p.Offset]
+			end := decoded.Offsets[min(p.Offset+max(p.Length, 1), len(decoded.Offsets)-1)]
+			if end <= start {
+				end = start + 1
+			}
+			d := Diagnostic{
+				Range: tree_sitter.Range{
+					StartByte:  start,
+					EndByte:    end,
+					StartPoint: PointAt(arg, source, start),
+					EndPoint:   PointAt(arg, source, end),
+				},
+				Severity: p.Severity,
+				Code:     "format",
+				Message:  p.Message,
+			}
+			if p.Replacement != "" {
+				d.Suggestions = []string{p.Replacement}
+			}
+			diags = append(diags, d)
+		}
+	})
+	return diags
+}
+
+// FormatHover returns a hover rendering SampleDate or SampleNumber with the
+// format pattern literal at point.
+func FormatHover(tree *tree_sitter.Tree, source []byte, point tree_sitter.Point) (Hover, bool) {
+	var hover Hover
+	found := false
+	eachFormatArgument(tree, source, func(arg *tree_sitter.Node, typ catalog.ParamType, decoded DecodedString) {
+		if found || !containsPoint(arg.Range(), point) {
+			return
+		}
+		var sample string
+		if typ == catalog.DateFormat {
+			sample = FormatSampleDate(decoded.Value)
+		} else {
+			sample = FormatSampleNumber(decoded.Value)
+		}
+		hover = Hover{Range: arg.Range(), Contents: "Sample: `" + sample + "`"}
+		found = true
+	})
+	return hover, found
+}
+
+func containsPoint(r tree_sitter.Range, p tree_sitter.Point) bool {
+	after := p.Row > r.StartPoint.Row || p.Row == r.StartPoint.Row && p.Column >= r.StartPoint.Column
+	before := p.Row < r.EndPoint.Row || p.Row == r.EndPoint.Row && p.Column <= r.EndPoint.Column
+	return after && before
+}
+
+func eachFormatArgument(tree *tree_sitter.Tree, source []byte, fn func(*tree_sitter.Node, catalog.ParamType, DecodedString)) {
+	Walk(tree.RootNode(), func(node *tree_sitter.Node) bool {
+		if node.Kind() != "call" {
+			return true
+		}
+		action, ok := catalog.Lookup(CallName(node, source))
+		if !ok {
+			return true
+		}
+		for i, arg := range Arguments(node) {
+			param := action.Param(i)
+			if param == nil || param.Type != catalog.DateFormat && param.Type != catalog.NumberFormat {
+				continue
+			}
+			if decoded, ok := DecodeString(arg, source); ok {
+				fn(arg, param.Type, decoded)
+			}
+		}
+		return true
+	})
+}
+
+// A formatField is a run of one repeated pattern letter, or literal text.
+type formatField struct {
+	offset  int
+	letter  byte
+	count   int
+	literal string
+}
+
+// dateFieldWidths gives the largest meaningful run of each date pattern
+// letter; zero means any width.
+var dateFieldWidths = map[byte]int{
+	'G': 5, 'y': 0, 'Y': 0, 'u': 0, 'U': 5, 'r': 0,
+	'Q': 5, 'q': 5, 'M': 5, 'L': 5, 'w': 2, 'W': 1,
+	'd': 2, 'D': 3, 'F': 1, 'g': 0,
+	'E': 6, 'e': 6, 'c': 6, 'a': 5, 'b': 5, 'B': 5,
+	'h': 2, 'H': 2, 'K': 2, 'k': 2, 'j': 6, 'J': 2, 'C': 6,
+	'm': 2, 's': 2, 'S': 0, 'A': 0,
+	'z': 4, 'Z': 5, 'O': 4, 'v': 4, 'V': 4, 'X': 5, 'x': 5,
+}
+
+// splitDateFormat splits a date pattern into fields and quoted literals.
+func splitDateFormat(pattern string) ([]formatField, *FormatProblem) {
+	var fields []formatField
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+		switch {
+		case c == '\'':
+			if i+1 < len(pattern) && pattern[i+1] == '\'' {
+				fields = append(fields, formatField{offset: i, literal: "'"})
+				i += 2
+				continue
+			}
+			end := strings.IndexByte(pattern[i+1:], '\'')
+			if end < 0 {
+				return fields, &FormatProblem{Offset: i, Length: 1, Severity: SeverityError, Message: "unterminated quoted text"}
+			}
+			fields = append(fields, formatField{offset: i, literal: pattern[i+1 : i+1+end]})
+			i += end + 2
+		case c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z':
+			n := 1
+			for i+n < len(pattern) && pattern[i+n] == c {
+				n++
+			}
+			fields = append(fields, formatField{offset: i, letter: c, count: n})
+			i += n
+		default:
+			fields = append(fields, formatField{offset: i, literal: pattern[i : i+1]})
+			i++
+		}
+	}
+	return fields, nil
+}
+
+// ValidateDateFormat checks a Unicode (TR35) date format pattern and warns
+// about letters that are valid but rarely what was meant.
+func ValidateDateFormat(pattern string) []FormatProblem {
+	fields, problem := splitDateFormat(pattern)
+	var problems []FormatProblem
+	if problem != nil {
+		problems = append(problems, *problem)
+	}
+	has := map[byte]bool{}
+	for _, f := range fields {
+		has[f.letter] = true
+	}
+	hasHour := has['H'] || has['h'] || has['k'] || has['K']
+	hasDate := has['y'] || has['d'] || has['Y']
+	for _, f := range fields {
+		if f.letter == 0 {
+			continue
+		}
+		text := strings.Repeat(string(f.letter), f.count)
+		width, known := dateFieldWidths[f.letter]
+		switch {
+		case !known:
+			problems = append(problems, FormatProblem{Offset: f.offset, Length: f.count, Severity: SeverityError,
+				Message: fmt.Sprintf("%q is not a date field; quote literal text like '%s'", text, text)})
+		case width > 0 && f.count > width:
+			problems = append(problems, FormatProblem{Offset: f.offset, Length: f.count, Severity: SeverityError,
+				Message: fmt.Sprintf("%q is too wide; %q takes at most %d letters", text, f.letter, width)})
+		case f.letter == 'Y' && !has['w']:
+			fix := strings.Repeat("y", f.count)
+			problems = append(problems, FormatProblem{Offset: f.offset, Length: f.count, Severity: SeverityWarning,
+				Message: fmt.Sprintf("%q is the week-based year, which differs from the calendar year around New Year; did you mean %q?", text, fix), Replacement: fix})
+		case f.letter == 'm' && !hasHour && hasDate:
+			fix := strings.Repeat("M", f.count)
+			problems = append(problems, FormatProblem{Offset: f.offset, Length: f.count, Severity: SeverityWarning,
+				Message: fmt.Sprintf("%q is minutes; did you mean the month %q?", text, fix), Replacement: fix})
+		case f.letter == 'D' && has['M']:
+			fix := strings.Repeat("d", min(f.count, 2))
+			problems = append(problems, FormatProblem{Offset: f.offset, Length: f.count, Severity: SeverityWarning,
+				Message: fmt.Sprintf("%q is the day of the year; did you mean the day of the month %q?", text, fix), Replacement: fix})
+		case f.letter == 'h' && !has['a'] && !has['b'] && !has['B']:
+			fix := strings.Repeat("H", f.count)
+			problems = append(problems, FormatProblem{Offset: f.offset, Length: f.count, Severity: SeverityWarning,
+				Message: fmt.Sprintf("%q is the 12-hour clock but the pattern has no AM/PM marker; did you mean %q?", text, fix), Replacement: fix})
+		case f.letter == 'S' && f.count > 9:
+			problems = append(problems, FormatProblem{Offset: f.offset, Length: f.count, Severity: SeverityWarning,
+				Message: "fractional seconds beyond 9 digits are always zero"})
+		}
+	}
+	return problems
+}
+
+// FormatSampleDate renders SampleDate with a date pattern. Fields it
+// cannot render are shown as their pattern letters.
+func FormatSampleDate(pattern string) string {
+	fields, _ := splitDateFormat(pattern)
+	t := SampleDate
+	var b strings.Builder
+	pad := func(n, width int) string { return fmt.Sprintf("%0*d", width, n) }
+	for _, f := range fields {
+		if f.letter == 0 {
+			b.WriteString(f.literal)
+			continue
+		}
+		n := f.count
+		switch f.letter {
+		case 'G':
+			b.WriteString(pick(n, "AD", "Anno Domini", "A"))
+		case 'y', 'Y', 'u', 'r':
+			if n == 2 {
+				b.WriteString(pad(t.Year()%100, 2))
+			} else {
+				b.WriteString(pad(t.Year(), n))
+			}
+		case 'Q', 'q':
+			q := (int(t.Month())-1)/3 + 1
+			b.WriteString(pickNumeric(n, q, fmt.Sprintf("Q%d", q), ordinal(q)+" quarter", strconv.Itoa(q)))
+		case 'M', 'L':
+			m := int(t.Month())
+			b.WriteString(pickNumeric(n, m, t.Month().String()[:3], t.Month().String(), t.Month().String()[:1]))
+		case 'd':
+			b.WriteString(pad(t.Day(), n))
+		case 'D':
+			b.WriteString(pad(t.YearDay(), n))
+		case 'F':
+			b.WriteString(strconv.Itoa((t.Day()-1)/7 + 1))
+		case 'w':
+			_, week := t.ISOWeek()
+			b.WriteString(pad(week, n))
+		case 'W':
+			b.WriteString(strconv.Itoa((t.Day()+int(t.AddDate(0, 0, 1-t.Day()).Weekday())-1)/7 + 1))
+		case 'E', 'e', 'c':
+			day := t.Weekday().String()
+			if f.letter != 'E' && n <= 2 {
+				b.WriteString(pad(int(t.Weekday())+1, n))
+				continue
+			}
+			switch {
+			case n <= 3:
+				b.WriteString(day[:3])
+			case n == 4:
+				b.WriteString(day)
+			case n == 5:
+				b.WriteString(day[:1])
+			default:
+				b.WriteString(day[:2])
+			}
+		case 'a', 'b', 'B':
+			b.WriteString(t.Format("PM"))
+		case 'h':
+			b.WriteString(pad((t.Hour()+11)%12+1, n))
+		case 'H':
+			b.WriteString(pad(t.Hour(), n))
+		case 'K':
+			b.WriteString(pad(t.Hour()%12, n))
+		case 'k':
+			b.WriteString(pad((t.Hour()+23)%24+1, n))
+		case 'm':
+			b.WriteString(pad(t.Minute(), n))
+		case 's':
+			b.WriteString(pad(t.Second(), n))
+		case 'S':
+			digits := pad(t.Nanosecond(), 9)
+			if n <= 9 {
+				b.WriteString(digits[:n])
+			} else {
+				b.WriteString(digits + strings.Repeat("0", n-9))
+			}
+		case 'z', 'v', 'V':
+			b.WriteString(pick(n, "UTC", "Coordinated Universal Time", "UTC"))
+		case 'Z':
+			b.WriteString(pick(n, "+0000", "GMT", "Z"))
+		case 'O':
+			b.WriteString("GMT")
+		case 'X':
+			b.WriteString("Z")
+		case 'x':
+			b.WriteString(pick(n, "+00", "+0000", "+00:00"))
+		default:
+			b.WriteString(strings.Repeat(string(f.letter), n))
+		}
+	}
+	return b.String()
+}
+
+// pick chooses between the abbreviated (1-3 letters), wide (4) and narrow
+// (5) forms of a field.
+func pick(n int, short, wide, narrow string) string {
+	switch {
+	case n <= 3:
+		return short
+	case n == 4:
+		return wide
+	}
+	return narrow
+}
+
+// pickNumeric is pick for fields with numeric forms at widths 1 and 2.
+func pickNumeric(n, value int, short, wide, narrow string) string {
+	switch n {
+	case 1:
+		return strconv.Itoa(value)
+	case 2:
+		return fmt.Sprintf("%02d", value)
+	}
+	return pick(n, short, wide, narrow)
+}
+
+func ordinal(n int) string {
+	switch n {
+	case 1:
+		return "1st"
+	case 2:
+		return "2nd"
+	case 3:
+		return "3rd"
+	}
+	return strconv.Itoa(n) + "th"
+}
+
+// A numberPattern is one subpattern of a number format.
+type numberPattern struct {
+	prefix, suffix    string
+	minInt            int
+	grouping          int
+	minFrac, maxFrac  int
+	exponentDigits    int
+	percent, permille bool
+}
+
+// ValidateNumberFormat checks a Unicode number format pattern such as
+// "#,##0.00;(#,##0.00)".
+func ValidateNumberFormat(pattern string) []FormatProblem {
+	var problems []FormatProblem
+	parts, problem := splitNumberFormat(pattern)
+	if problem != nil {
+		return []FormatProblem{*problem}
+	}
+	if len(parts) > 2 {
+		problems = append(problems, FormatProblem{Offset: parts[2].offset - 1, Length: 1, Severity: SeverityError,
+			Message: "a number format has at most a positive and a negative subpattern"})
+	}
+	for _, part := range parts {
+		if _, p := parseNumberPattern(part); p != nil {
+			problems = append(problems, *p)
+		}
+	}
+	return problems
+}
+
+type numberPart struct {
+	offset int
+	text   string
+}
+
+// splitNumberFormat splits a pattern on unquoted semicolons.
+func splitNumberFormat(pattern string) ([]numberPart, *FormatProblem) {
+	var parts []numberPart
+	start := 0
+	quoted := -1
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '\'':
+			if quoted >= 0 {
+				quoted = -1
+			} else {
+				quoted = i
+			}
+		case ';':
+			if quoted < 0 {
+				parts = append(parts, numberPart{start, pattern[start:i]})
+				start = i + 1
+			}
+		}
+	}
+	if quoted >= 0 {
+		return nil, &FormatProblem{Offset: quoted, Length: 1, Severity: SeverityError, Message: "unterminated quoted text"}
+	}
+	return append(parts, numberPart{start, pattern[start:]}), nil
+}
+
+// parseNumberPattern parses one subpattern: a literal prefix, the digit
+// pattern and a literal suffix.
+func parseNumberPattern(part numberPart) (numberPattern, *FormatProblem) {
+	var np numberPattern
+	text := part.text
+	errAt := func(i int, format string, args ...any) *FormatProblem {
+		return &FormatProblem{Offset: part.offset + i, Length: 1, Severity: SeverityError, Message: fmt.Sprintf(format, args...)}
+	}
+	isDigitChar := func(c byte) bool {
+		return c == '#' || c == '0' || c >= '1' && c <= '9' || c == ',' || c == '.'
+	}
+	// Split off the prefix and suffix, honouring quotes.
+	var prefix, suffix strings.Builder
+	i := 0
+	literal := func(b *strings.Builder) {
+		c := text[i]
+		switch {
+		case c == '\'':
+			end := strings.IndexByte(text[i+1:], '\'')
+			if end == 0 {
+				b.WriteByte('\'')
+			} else {
+				b.WriteString(text[i+1 : i+1+end])
+			}
+			i += end + 2
+			return
+		case c == '%':
+			np.percent = true
+		case strings.HasPrefix(text[i:], "‰"):
+			np.permille = true
+			b.WriteString("‰")
+			i += len("‰")
+			return
+		case strings.HasPrefix(text[i:], "¤"):
+			b.WriteString("$")
+			i += len("¤")
+			return
+		}
+		b.WriteByte(c)
+		i++
+	}
+	for i < len(text) && !isDigitChar(text[i]) {
+		literal(&prefix)
+	}
+	start := i
+	// E starts the exponent only after a digit of the mantissa, so a
+	// prefix such as EUR stays literal.
+	isExponent := func(j int) bool {
+		digits := text[start:j]
+		return text[j] == 'E' && strings.ContainsAny(digits, "#0123456789") && !strings.Contains(digits, "E")
+	}
+	for i < len(text) && (isDigitChar(text[i]) || isExponent(i)) {
+		if text[i] == 'E' && i+1 < len(text) && text[i+1] == '+' {
+			i++
+		}
+		i++
+	}
+	digits := text[start:i]
+	for i < len(text) {
+		if isDigitChar(text[i]) {
+			return np, errAt(i, "digit %q after the suffix has started", text[i])
+		}
+		literal(&suffix)
+	}
+	np.prefix, np.suffix = prefix.String(), suffix.String()
+	if np.percent && np.permille {
+		return np, errAt(0, "a pattern cannot have both a percent and a per-mille sign")
+	}
+	if digits == "" {
+		return np, errAt(0, "pattern has no digits")
+	}
+	mantissa, exponent, hasExponent := strings.Cut(digits, "E")
+	if hasExponent {
+		exponent = strings.TrimPrefix(exponent, "+")
+		if exponent == "" || strings.Trim(exponent, "0") != "" {
+			return np, errAt(start+len(mantissa), "an exponent must be followed by one or more 0 digits")
+		}
+		np.exponentDigits = len(exponent)
+	}
+	integer, fraction, _ := strings.Cut(mantissa, ".")
+	if strings.Contains(fraction, ".") {
+		return np, errAt(start+len(integer)+1+strings.IndexByte(fraction, '.'), "more than one decimal separator")
+	}
+	if idx := strings.IndexByte(fraction, ','); idx >= 0 {
+		return np, errAt(start+len(integer)+1+idx, "grouping separator after the decimal separator")
+	}
+	seenZero := false
+	for j := 0; j < len(integer); j++ {
+		switch c := integer[j]; {
+		case c == '#' && seenZero:
+			return np, errAt(start+j, "'#' after '0' in the integer part; optional digits must come first")
+		case c >= '0' && c <= '9':
+			seenZero = true
+			np.minInt++
+		}
+	}
+	if last := strings.LastIndexByte(integer, ','); last >= 0 {
+		np.grouping = len(integer) - last - 1
+		if np.grouping == 0 {
+			return np, errAt(start+last, "grouping separator must be followed by digits")
+		}
+	}
+	seenHash := false
+	for j := 0; j < len(fraction); j++ {
+		switch c := fraction[j]; {
+		case c == '#':
+			seenHash = true
+			np.maxFrac++
+		case seenHash:
+			return np, errAt(start+len(integer)+1+j, "'0' after '#' in the fraction part; required digits must come first")
+		default:
+			np.minFrac++
+			np.maxFrac++
+		}
+	}
+	return np, nil
+}
+
+// FormatSampleNumber renders SampleNumber, and its negation when the
+// pattern has a negative subpattern, with a number pattern.
+func FormatSampleNumber(pattern string) string {
+	parts, problem := splitNumberFormat(pattern)
+	if problem != nil {
+		return pattern
+	}
+	positive, p := parseNumberPattern(parts[0])
+	if p != nil {
+		return pattern
+	}
+	sample := positive.format(SampleNumber)
+	if len(parts) > 1 {
+		if negative, p := parseNumberPattern(parts[1]); p == nil {
+			// The negative subpattern only contributes its prefix and
+			// suffix; digits always follow the positive subpattern.
+			negative.minInt, negative.grouping = positive.minInt, positive.grouping
+			negative.minFrac, negative.maxFrac = positive.minFrac, positive.maxFrac
+			negative.exponentDigits = positive.exponentDigits
+			sample += ", " + negative.format(SampleNumber)
+		}
+	}
+	return sample
+}
+
+func (np numberPattern) format(value float64) string {
+	switch {
+	case np.percent:
+		value *= 100
+	case np.permille:
+		value *= 1000
+	}
+	exponent := ""
+	if np.exponentDigits > 0 && value != 0 {
+		intDigits := max(np.minInt, 1)
+		e := int(math.Floor(math.Log10(math.Abs(value)))) - (intDigits - 1)
+		value /= math.Pow(10, float64(e))
+		sign := ""
+		if e < 0 {
+			sign = "-"
+			e = -e
+		}
+		exponent = fmt.Sprintf("E%s%0*d", sign, np.exponentDigits, e)
+	}
+	s := strconv.FormatFloat(value, 'f', np.maxFrac, 64)
+	integer, fraction, _ := strings.Cut(s, ".")
+	for len(fraction) > np.minFrac && strings.HasSuffix(fraction, "0") {
+		fraction = fraction[:len(fraction)-1]
+	}
+	if integer == "0" && np.minInt == 0 {
+		integer = ""
+	}
+	for len(integer) < np.minInt {
+		integer = "0" + integer
+	}
+	if np.grouping > 0 && np.exponentDigits == 0 {
+		var grouped []string
+		for len(integer) > np.grouping {
+			grouped = append([]string{integer[len(integer)-np.grouping:]}, grouped...)
+			integer = integer[:len(integer)-np.grouping]
+		}
+		integer = strings.Join(append([]string{integer}, grouped...), ",")
+	}
+	out := integer
+	if fraction != "" {
+		out += "." + fraction
+	}
+	return np.prefix + out + exponent + np.suffix
+}
@@ -0,0 +1,121 @@
+package analysis
+
+import (
+	"strings"
+	"testing"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+func TestValidateDateFormat(t *testing.T) {
+	tests := []struct {
+		pattern     string
+		severity    Severity
+		replacement string
+	}{
+		{"yyyy-MM-dd HH:mm:ss", 0, ""},
+		{"EEEE, MMMM d 'at' h:mm a", 0, ""},
+		{"YYYY-MM-dd", SeverityWarning, "yyyy"},
+		{"yyyy-mm-dd", SeverityWarning, "MM"},
+		{"yyyy-MM-DD", SeverityWarning, "dd"},
+		{"hh:mm", SeverityWarning, "HH"},
+		{"yyyy-MM-dd T HH", SeverityError, ""},
+		{"MMMMMM", SeverityError, ""},
+		{"'unterminated", SeverityError, ""},
+	}
+	for _, tt := range tests {
+		problems := ValidateDateFormat(tt.pattern)
+		if tt.severity == 0 {
+			if len(problems) != 0 {
+				t.Errorf("ValidateDateFormat(%q) = %v, want no problems", tt.pattern, problems)
+			}
+			continue
+		}
+		if len(problems) != 1 || problems[0].Severity != tt.severity || problems[0].Replacement != tt.replacement {
+			t.Errorf("ValidateDateFormat(%q) = %+v, want one %v suggesting %q", tt.pattern, problems, tt.severity, tt.replacement)
+		}
+	}
+}
+
+func TestFormatSampleDate(t *testing.T) {
+	tests := map[string]string{
+		"yyyy-MM-dd":               "2024-03-14",
+		"EEEE, MMMM d 'at' h:mm a": "Thursday, March 14 at 3:09 PM",
+		"yy/M/d HH:mm:ss.SSS":      "24/3/14 15:09:26.535",
+		"QQQ ''yy":                 "Q1 '24",
+	}
+	for pattern, want := range tests {
+		if got := FormatSampleDate(pattern); got != want {
+			t.Errorf("FormatSampleDate(%q) = %q, want %q", pattern, got, want)
+		}
+	}
+}
+
+func TestValidateNumberFormat(t *testing.T) {
+	valid := []string{"#,##0.00", "0.###E0", "#,##0.00;(#,##0.00)", "0%", "'#'0", "EUR #,##0", "0.0E+00"}
+	for _, pattern := range valid {
+		if problems := ValidateNumberFormat(pattern); len(problems) != 0 {
+			t.Errorf("ValidateNumberFormat(%q) = %v, want no problems", pattern, problems)
+		}
+	}
+	invalid := map[string]int{
+		"0#":        1,
+		"0.#0":      3,
+		"0.0.0":     3,
+		"#,##0.0,0": 7,
+		"0E":        1,
+		"E0E":       2,
+		"0;0;0":     3,
+		"abc":       0,
+	}
+	for pattern, offset := range invalid {
+		problems := ValidateNumberFormat(pattern)
+		if len(problems) != 1 || problems[0].Offset != offset {
+			t.Errorf("ValidateNumberFormat(%q) = %+v, want one error at %d", pattern, problems, offset)
+		}
+	}
+}
+
+func TestFormatSampleNumber(t *testing.T) {
+	tests := map[string]string{
+		"#,##0.00":            "1,234,567.89",
+		"0.###E0":             "1.235E6",
+		"#,##0.00;(#,##0.00)": "1,234,567.89, (1,234,567.89)",
+		"0":                   "1234568",
+		"#0.0%":               "123456789.1%",
+		"EUR #,##0":           "EUR 1,234,568",
+		"0E0 EUR":             "1E6 EUR",
+	}
+	for pattern, want := range tests {
+		if got := FormatSampleNumber(pattern); got != want {
+			t.Errorf("FormatSampleNumber(%q) = %q, want %q", pattern, got, want)
+		}
+	}
+}
+
+func TestCheckFormatArguments(t *testing.T) {
+	source := []byte(`@a = formatDate(CurrentDate, "YYYY-MM-dd")
+@b = formatNumber(3, "0.#0")
+`)
+	tree := Parse(source)
+	defer tree.Close()
+
+	diags := CheckFormatArguments(tree, source)
+	if len(diags) != 2 {
+		t.Fatalf("got %d diagnostics, want 2: %v", len(diags), diags)
+	}
+	if r := diags[0].Range; r.StartPoint.Column != 30 || r.EndPoint.Column != 34 {
+		t.Errorf("YYYY diagnostic spans columns %d-%d, want 30-34", r.StartPoint.Column, r.EndPoint.Column)
+	}
+	if diags[1].Severity != SeverityError || diags[1].Range.StartPoint.Row != 1 {
+		t.Errorf("number diagnostic = %+v", diags[1])
+	}
+
+	hover, ok := FormatHover(tree, source, tree_sitter.NewPoint(0, 33))
+	if !ok || !strings.Contains(hover.Contents, "2024-03-14") {
+		t.Errorf("FormatHover = %+v, %v; want a sample date", hover, ok)
+	}
+	if _, ok := FormatHover(tree, source, tree_sitter.NewPoint(0, 2)); ok {
+		t.Error("FormatHover returned a hover outside a format argument")
+	}
+}
@@ -0,0 +1,206 @@
+package analysis
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/catalog"
+)
+
+// CheckLoopInvariantCalls reports calls to pure actions inside repeat and
+// for loops whose arguments do not change between iterations. Each
+// diagnostic offers a fix that assigns the result to a new @variable
+// before the loop and refers to it in every identical call in the loop.
+//
+// An argument changes between iterations if it refers to RepeatItem,
+// RepeatIndex, the loop variable, or a variable assigned anywhere in the
+// loop body. Only calls every iteration makes are reported: a call in a
+// branch of an if, a menu item or a nested loop may not run at all.
+func CheckLoopInvariantCalls(tree *tree_sitter.Tree, source []byte) []Diagnostic {
+	names := NamesInUse(tree, source)
+	claimed := map[uintptr]bool{}
+	var diags []Diagnostic
+	Walk(tree.RootNode(), func(node *tree_sitter.Node) bool {
+		if node.Kind() != "repeat_statement" && node.Kind() != "for_statement" {
+			return true
+		}
+		body := node.ChildByFieldName("body")
+		if body == nil {
+			return true
+		}
+		variant := loopVariantNames(node, body, source)
+
+		// Group identical invariant calls so a single hoist covers them.
+		var order []string
+		groups := map[string][]*tree_sitter.Node{}
+		Walk(body, func(n *tree_sitter.Node) bool {
+			if n.Kind() != "call" || claimed[n.Id()] {
+				return true
+			}
+			if !alwaysRuns(n, body) {
+				return false
+			}
+			if !isInvariantCall(n, source, variant) || isStatement(n) {
+				return true
+			}
+			text := n.Utf8Text(source)
+			if groups[text] == nil {
+				order = append(order, text)
+			}
+			groups[text] = append(groups[text], n)
+			// Nested calls move along with the outermost invariant call.
+			Walk(n, func(inner *tree_sitter.Node) bool {
+				claimed[inner.Id()] = true
+				return true
+			})
+			return false
+		})
+
+		for _, text := range order {
+			calls := groups[text]
+			name := UniqueName(hoistName(CallName(calls[0], source)), names)
+			names[name] = true
+			indent := LineIndent(source, node.StartByte())
+			edits := []TextEdit{{
+				Range:   InsertionAt(node, source, node.StartByte()),
+				NewText: fmt.Sprintf("@%s = %s\n%s", name, text, indent),
+			}}
+			for _, call := range calls {
+				edits = append(edits, TextEdit{
+					Range:   call.Range(),
+					NewText: VariableReference(name, source, call.EndByte()),
+				})
+			}
+			message := fmt.Sprintf("%s is called with the same arguments on every iteration", CallName(calls[0], source))
+			if len(calls) > 1 {
+				message = fmt.Sprintf("%s is called %d times with the same arguments on every iteration", CallName(calls[0], source), len(calls))
+			}
+			diags = append(diags, Diagnostic{
+				Range:    calls[0].Range(),
+				Severity: SeverityInformation,
+				Code:     "loop-invariant",
+				Message:  message,
+				Fixes: []CodeAction{{
+					Title: fmt.Sprintf("Hoist into @%s before the loop", name),
+					Edits: edits,
+				}},
+			})
+		}
+		return true
+	})
+	return diags
+}
+
+// loopVariantNames returns the names that may change between iterations of
+// a loop: its loop variable and every variable written in its body.
+func loopVariantNames(loop, body *tree_sitter.Node, source []byte) map[string]bool {
+	variant := map[string]bool{}
+	if v := loop.ChildByFieldName("variable"); v != nil {
+		variant[v.Utf8Text(source)] = true
+	}
+	for _, occ := range Occurrences(body, source) {
+		if occ.Write {
+			variant[occ.Name] = true
+		}
+	}
+	return variant
+}
+
+// isInvariantCall reports whether call and every call nested in its
+// arguments are pure and refer to nothing in variant.
+func isInvariantCall(call *tree_sitter.Node, source []byte, variant map[string]bool) bool {
+	invariant := true
+	Walk(call, func(n *tree_sitter.Node) bool {
+		if !invariant {
+			return false
+		}
+		switch n.Kind() {
+		case "call":
+			action, ok := catalog.Lookup(CallName(n, source))
+			invariant = ok && action.Pure
+		case "builtin_constant":
+			text := n.Utf8Text(source)
+			invariant = text != "RepeatItem" && text != "RepeatIndex" && text != "Ask"
+		case "at_variable":
+			invariant = !variant[VariableName(n, source)]
+		case "identifier":
+			if parent := n.Parent(); parent.Kind() != "call" || parent.ChildByFieldName("function").Id() != n.Id() {
+				invariant = !variant[n.Utf8Text(source)]
+			}
+		case "interpolation":
+			invariant = !interpolationRefers(n.Utf8Text(source), variant)
+		case "ERROR":
+			invariant = false
+		}
+		return invariant
+	})
+	return invariant
+}
+
+// interpolationRefers reports whether the text of an interpolation such as
+// `{RepeatItem}` or `{name}` names anything in variant.
+func interpolationRefers(text string, variant map[string]bool) bool {
+	inner := strings.Trim(text, "{}")
+	words := strings.FieldsFunc(inner, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	})
+	for _, w := range words {
+		if variant[w] || w == "RepeatItem" || w == "RepeatIndex" {
+			return true
+		}
+	}
+	return false
+}
+
+// alwaysRuns reports whether node is evaluated whenever body runs, that is,
+// it is not inside the body or a branch of a statement in body.
+func alwaysRuns(node, body *tree_sitter.Node) bool {
+	for child, parent := node, node.Parent(); parent != nil && child.Id() != body.Id(); child, parent = parent, parent.Parent() {
+		switch parent.FieldNameForChild(uint32(childIndex(parent, child))) {
+		case "body", "consequence", "alternative":
+			return false
+		}
+	}
+	return true
+}
+
+// isStatement reports whether node is used as a statement, so its value is
+// discarded.
+func isStatement(node *tree_sitter.Node) bool {
+	parent := node.Parent()
+	if parent == nil {
+		return false
+	}
+	switch parent.Kind() {
+	case "source_file", "block":
+		return true
+	case "if_statement", "for_statement", "repeat_statement", "item_statement":
+		field := parent.FieldNameForChild(uint32(childIndex(parent, node)))
+		return field == "consequence" || field == "alternative" || field == "body"
+	}
+	return false
+}
+
+func childIndex(parent, child *tree_sitter.Node) int {
+	for i := uint(0); i < parent.ChildCount(); i++ {
+		if parent.Child(i).Id() == child.Id() {
+			return int(i)
+		}
+	}
+	return -1
+}
+
+// hoistName derives a variable name from an action name, e.g. getValue
+// becomes value and matchText becomes matchTextResult.
+func hoistName(action string) string {
+	name := action
+	if rest, ok := strings.CutPrefix(action, "get"); ok && rest != "" && unicode.IsUpper(rune(rest[0])) {
+		name = rest
+	} else {
+		name += "Result"
+	}
+	return strings.ToLower(name[:1]) + name[1:]
+}
@@ -0,0 +1,113 @@
+package analysis
+
+import "testing"
+
+func TestCheckLoopInvariantCalls(t *testing.T) {
+	source := []byte(`@url = "https://example.com"
+repeat 5 {
+  @page = urlEncode(@url )
+  @again = trimWhitespace(urlEncode(@url ))
+  @item = getValue(RepeatItem, "name")
+  @count = count(@page )
+  show(count(@list ))
+  getName(@url )
+  @n = randomNumber(1, 10)
+}
+`)
+	tree := Parse(source)
+	defer tree.Close()
+
+	diags := CheckLoopInvariantCalls(tree, source)
+	if len(diags) != 3 {
+		t.Fatalf("got %d diagnostics, want 3: %v", len(diags), diags)
+	}
+	for i, row := range []uint{2, 3, 6} {
+		if diags[i].Range.StartPoint.Row != row {
+			t.Errorf("diagnostic %d on row %d, want %d", i, diags[i].Range.StartPoint.Row, row)
+		}
+	}
+
+	fixed := ApplyEdits(source, diags[0].Fixes[0].Edits)
+	want := `@url = "https://example.com"
+@urlEncodeResult = urlEncode(@url )
+repeat 5 {
+  @page = @urlEncodeResult
+  @again = trimWhitespace(urlEncode(@url ))
+  @item = getValue(RepeatItem, "name")
+  @count = count(@page )
+  show(count(@list ))
+  getName(@url )
+  @n = randomNumber(1, 10)
+}
+`
+	if string(fixed) != want {
+		t.Errorf("hoisted source:\n%s\nwant:\n%s", fixed, want)
+	}
+
+	fixed = ApplyEdits(source, diags[2].Fixes[0].Edits)
+	tree = Parse(fixed)
+	defer tree.Close()
+	if tree.RootNode().HasError() {
+		t.Errorf("hoisting produced a syntax error:\n%s", fixed)
+	}
+}
+
+func TestCheckLoopInvariantCallsConditional(t *testing.T) {
+	for _, source := range []string{
+		// Only some iterations run the branch.
+		`@url = "https://example.com"
+repeat 3 {
+  if RepeatIndex > 5 {
+    @p = urlEncode(@url )
+  } else {
+    @q = urlEncode(@url )
+  }
+}
+`,
+		`repeat 3 {
+  menu "Pick" {
+    item "Encode": {
+      @p = urlEncode("https://example.com")
+    }
+  }
+}
+`,
+		// Fetching a page is not pure.
+		`repeat 3 {
+  @p = getWebPageContents("https://example.com")
+}
+`,
+		// The index makes the interpolation change.
+		`repeat 3 {
+  show(changeCase("{list[RepeatIndex]}", "UPPERCASE"))
+}
+`,
+	} {
+		tree := Parse([]byte(source))
+		if diags := CheckLoopInvariantCalls(tree, []byte(source)); len(diags) != 0 {
+			t.Errorf("got %d diagnostics for\n%s", len(diags), source)
+		}
+		tree.Close()
+	}
+}
+
+func TestCheckLoopInvariantCallsNested(t *testing.T) {
+	// The inner loop may run no times, so the call is hoisted out of it
+	// but not out of the outer loop.
+	source := []byte(`repeat 3 {
+  for x in getclipboard {
+    @p = urlEncode("https://example.com")
+  }
+}
+`)
+	tree := Parse(source)
+	defer tree.Close()
+
+	diags := CheckLoopInvariantCalls(tree, source)
+	if len(diags) != 1 {
+		t.Fatalf("got %d diagnostics, want 1: %v", len(diags), diags)
+	}
+	if row := diags[0].Fixes[0].Edits[0].Range.StartPoint.Row; row != 1 {
+		t.Errorf("hoisted to row %d, want 1", row)
+	}
+}
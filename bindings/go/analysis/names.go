@@ -0,0 +1,83 @@
+package analysis
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Words splits a name into words at spaces, punctuation and case changes,
+// so "getContentsOfURL", "get contents of URL" and "get_contents-of.URL"
+// all give get, contents, of and URL. A run of capitals is one word, as
+// in "IPAddress".
+func Words(name string) []string {
+	var words []string
+	var word []rune
+	runes := []rune(name)
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if len(word) > 0 {
+				words, word = append(words, string(word)), nil
+			}
+			continue
+		}
+		if len(word) > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			if unicode.IsLower(prev) || unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+				words, word = append(words, string(word)), nil
+			}
+		}
+		word = append(word, r)
+	}
+	if len(word) > 0 {
+		words = append(words, string(word))
+	}
+	return words
+}
+
+// Identifier joins the Words of name into a camelCase identifier, e.g.
+// "Get Contents of URL" into getContentsOfURL and "URL Encode" into
+// urlEncode. An identifier cannot start with a digit or hold anything but
+// ASCII letters, digits and underscores, so leading words that start with
+// a digit and other characters are dropped. It returns "" if nothing is
+// left.
+func Identifier(name string) string {
+	var b strings.Builder
+	for _, w := range Words(name) {
+		w = strings.Map(func(r rune) rune {
+			if r > unicode.MaxASCII {
+				return -1
+			}
+			return r
+		}, w)
+		switch {
+		case w == "":
+		case b.Len() > 0:
+			b.WriteString(strings.ToUpper(w[:1]) + w[1:])
+		case unicode.IsDigit(rune(w[0])):
+		case strings.ToUpper(w) == w:
+			b.WriteString(strings.ToLower(w))
+		default:
+			b.WriteString(strings.ToLower(w[:1]) + w[1:])
+		}
+	}
+	return b.String()
+}
+
+// keywords are the words of the grammar that cannot name a variable.
+var keywords = map[string]bool{}
+
+func init() {
+	for _, w := range strings.Fields(`if else for in repeat menu item const true false
+		name glyph from mac inputs noinput askfor getclipboard list nil action stop
+		makeVCard rawAction embedFile nothing
+		CurrentDate Device RepeatIndex RepeatItem ShortcutInput Ask
+		text number bool dictionary array variable color float`) {
+		keywords[w] = true
+	}
+}
+
+// IsKeyword reports whether word is a keyword, builtin constant or type
+// keyword of the grammar.
+func IsKeyword(word string) bool {
+	return keywords[word]
+}
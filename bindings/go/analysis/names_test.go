@@ -0,0 +1,42 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWords(t *testing.T) {
+	for in, want := range map[string][]string{
+		"getContentsOfURL":       {"get", "Contents", "Of", "URL"},
+		"IPAddressSource":        {"IP", "Address", "Source"},
+		"X-Auth-Token":           {"X", "Auth", "Token"},
+		"api_key":                {"api", "key"},
+		"is.workflow.text.match": {"is", "workflow", "text", "match"},
+		"":                       nil,
+	} {
+		if got := Words(in); !reflect.DeepEqual(got, want) {
+			t.Errorf("Words(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestIdentifier(t *testing.T) {
+	for in, want := range map[string]string{
+		"Get Contents of URL": "getContentsOfURL",
+		"URL Encode":          "urlEncode",
+		"AlertActionTitle":    "alertActionTitle",
+		"IPAddressSource":     "ipAddressSource",
+		"text.match":          "textMatch",
+		"list-pets":           "listPets",
+		"GET /pets/{id}":      "getPetsId",
+		"Repeat Results":      "repeatResults",
+		"3D Touch":            "touch",
+		"2024":                "",
+		"café au lait":        "cafAuLait",
+		"":                    "",
+	} {
+		if got := Identifier(in); got != want {
+			t.Errorf("Identifier(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,479 @@
+package analysis
+
+import (
+	"fmt"
+	"strings"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/catalog"
+)
+
+// CheckRegexArguments reports literal arguments to regex parameters of
+// catalog actions that are not valid ICU regular expressions. Each
+// diagnostic points at the offending character inside the string.
+func CheckRegexArguments(tree *tree_sitter.Tree, source []byte) []Diagnostic {
+	var diags []Diagnostic
+	Walk(tree.RootNode(), func(node *tree_sitter.Node) bool {
+		if node.Kind() != "call" {
+			return true
+		}
+		action, ok := catalog.Lookup(CallName(node, source))
+		if !ok {
+			return true
+		}
+		for i, arg := range Arguments(node) {
+			param := action.Param(i)
+			if param == nil || param.Type != catalog.Regex {
+				continue
+			}
+			decoded, ok := DecodeString(arg, source)
+			if !ok {
+				continue
+			}
+			err := ValidateICURegex(decoded.Value)
+			if err == nil {
+				continue
+			}
+			start := decoded.Offsets[err.Offset]
+			end := start + 1
+			if err.Offset+1 < len(decoded.Offsets) {
+				end = max(end, decoded.Offsets[err.Offset+1])
+			}
+			diags = append(diags, Diagnostic{
+				Range: tree_sitter.Range{
+					StartByte:  start,
+					EndByte:    end,
+					StartPoint: PointAt(arg, source, start),
+					EndPoint:   PointAt(arg, source, end),
+				},
+				Severity: SeverityError,
+				Code:     "regex",
+				Message:  fmt.Sprintf("invalid regular expression for %s: %s", param.Name, err.Message),
+			})
+		}
+		return true
+	})
+	return diags
+}
+
+// A RegexError is a syntax error in a regular expression.
+type RegexError struct {
+	// Offset is the byte offset in the pattern the error was found at. It
+	// equals the pattern length for errors at the end of the pattern.
+	Offset  int
+	Message string
+}
+
+func (e *RegexError) Error() string {
+	return fmt.Sprintf("%s at offset %d", e.Message, e.Offset)
+}
+
+// ValidateICURegex checks pattern against the ICU regular expression
+// syntax used by Shortcuts. Unlike Go's regexp it accepts lookaround,
+// backreferences, atomic groups and possessive quantifiers.
+func ValidateICURegex(pattern string) *RegexError {
+	r := &icuRegex{pattern: pattern, names: map[string]bool{}}
+	if err := r.alternation(-1); err != nil {
+		return err
+	}
+	for _, ref := range r.refs {
+		if ref.name != "" && !r.names[ref.name] {
+			return &RegexError{ref.offset, fmt.Sprintf("back reference to undefined group %q", ref.name)}
+		}
+		if ref.name == "" && ref.group > r.groups {
+			return &RegexError{ref.offset, fmt.Sprintf("back reference to nonexistent group %d", ref.group)}
+		}
+	}
+	return nil
+}
+
+type icuRegex struct {
+	pattern string
+	i       int
+	groups  int
+	names   map[string]bool
+	refs    []backref
+	// lookbehind is the nesting depth of lookbehind assertions, which must
+	// have a bounded length.
+	lookbehind int
+}
+
+type backref struct {
+	offset int
+	group  int
+	name   string
+}
+
+func (r *icuRegex) errorf(offset int, format string, args ...any) *RegexError {
+	return &RegexError{offset, fmt.Sprintf(format, args...)}
+}
+
+func (r *icuRegex) more() bool { return r.i < len(r.pattern) }
+
+func (r *icuRegex) peek() byte { return r.pattern[r.i] }
+
+// alternation parses a sequence of alternatives up to the closing
+// parenthesis of the group opened at open, or to the end of the pattern
+// when open is -1.
+func (r *icuRegex) alternation(open int) *RegexError {
+	quantifiable := false
+	for r.more() {
+		c := r.peek()
+		switch c {
+		case '|':
+			r.i++
+			quantifiable = false
+		case ')':
+			if open < 0 {
+				return r.errorf(r.i, "unmatched closing parenthesis")
+			}
+			r.i++
+			return nil
+		case '(':
+			q, err := r.group()
+			if err != nil {
+				return err
+			}
+			quantifiable = q
+		case '[':
+			if err := r.class(); err != nil {
+				return err
+			}
+			quantifiable = true
+		case '\\':
+			if err := r.escape(false); err != nil {
+				return err
+			}
+			quantifiable = true
+		case '*', '+', '?':
+			if !quantifiable {
+				return r.errorf(r.i, "nothing to repeat before %q", c)
+			}
+			if c != '?' && r.lookbehind > 0 {
+				return r.errorf(r.i, "lookbehind pattern must have a bounded length")
+			}
+			r.i++
+			r.quantifierSuffix()
+			quantifiable = false
+		case '{':
+			if !quantifiable {
+				return r.errorf(r.i, "nothing to repeat before '{'")
+			}
+			if err := r.interval(); err != nil {
+				return err
+			}
+			quantifiable = false
+		default:
+			r.i++
+			quantifiable = true
+		}
+	}
+	if open >= 0 {
+		return r.errorf(open, "missing closing parenthesis")
+	}
+	return nil
+}
+
+// quantifierSuffix consumes the lazy (?) or possessive (+) marker.
+func (r *icuRegex) quantifierSuffix() {
+	if r.more() && (r.peek() == '?' || r.peek() == '+') {
+		r.i++
+	}
+}
+
+// interval parses a {n}, {n,} or {n,m} quantifier.
+func (r *icuRegex) interval() *RegexError {
+	open := r.i
+	end := strings.IndexByte(r.pattern[open:], '}')
+	if end < 0 {
+		return r.errorf(open, "missing closing brace in interval")
+	}
+	body := r.pattern[open+1 : open+end]
+	lo, hi, ok := strings.Cut(body, ",")
+	if !isDigits(lo) || ok && hi != "" && !isDigits(hi) {
+		return r.errorf(open, "invalid interval {%s}", body)
+	}
+	if ok && hi == "" && r.lookbehind > 0 {
+		return r.errorf(open, "lookbehind pattern must have a bounded length")
+	}
+	if ok && hi != "" && atoi(lo) > atoi(hi) {
+		return r.errorf(open, "interval minimum %s is greater than maximum %s", lo, hi)
+	}
+	r.i = open + end + 1
+	r.quantifierSuffix()
+	return nil
+}
+
+// group parses a parenthesized construct and reports whether it can be
+// quantified. Inline flag settings such as (?i) cannot.
+func (r *icuRegex) group() (bool, *RegexError) {
+	open := r.i
+	r.i++
+	if !r.more() || r.peek() != '?' {
+		r.groups++
+		return true, r.alternation(open)
+	}
+	r.i++
+	if !r.more() {
+		return false, r.errorf(open, "missing closing parenthesis")
+	}
+	switch c := r.peek(); {
+	case c == ':' || c == '=' || c == '!' || c == '>':
+		r.i++
+		return true, r.alternation(open)
+	case c == '#':
+		end := strings.IndexByte(r.pattern[r.i:], ')')
+		if end < 0 {
+			return false, r.errorf(open, "missing closing parenthesis in comment")
+		}
+		r.i += end + 1
+		return false, nil
+	case c == '<':
+		r.i++
+		if r.more() && (r.peek() == '=' || r.peek() == '!') {
+			r.i++
+			r.lookbehind++
+			err := r.alternation(open)
+			r.lookbehind--
+			return true, err
+		}
+		start := r.i
+		name, err := r.groupName('>')
+		if err != nil {
+			return false, err
+		}
+		if r.names[name] {
+			return false, r.errorf(start, "duplicate group name %q", name)
+		}
+		r.names[name] = true
+		r.groups++
+		return true, r.alternation(open)
+	case strings.IndexByte("ismwx-", c) >= 0:
+		for r.more() && strings.IndexByte("ismwx-", r.peek()) >= 0 {
+			r.i++
+		}
+		if !r.more() {
+			return false, r.errorf(open, "missing closing parenthesis")
+		}
+		switch r.peek() {
+		case ')':
+			r.i++
+			return false, nil
+		case ':':
+			r.i++
+			return true, r.alternation(open)
+		}
+		return false, r.errorf(r.i, "invalid flag %q", r.peek())
+	default:
+		return false, r.errorf(r.i, "invalid group syntax (?%c", c)
+	}
+}
+
+// groupName parses a group name terminated by term.
+func (r *icuRegex) groupName(term byte) (string, *RegexError) {
+	start := r.i
+	for r.more() && isWordByte(r.peek()) {
+		r.i++
+	}
+	name := r.pattern[start:r.i]
+	if name == "" || name[0] >= '0' && name[0] <= '9' {
+		return "", r.errorf(start, "invalid group name")
+	}
+	if !r.more() || r.peek() != term {
+		return "", r.errorf(r.i, "group name must end with %q", term)
+	}
+	r.i++
+	return name, nil
+}
+
+// class parses a bracketed set, including nested sets and POSIX classes.
+func (r *icuRegex) class() *RegexError {
+	open := r.i
+	r.i++
+	if r.more() && r.peek() == '^' {
+		r.i++
+	}
+	if r.more() && r.peek() == ':' {
+		end := strings.Index(r.pattern[r.i:], ":]")
+		if end < 0 {
+			return r.errorf(open, "missing closing bracket")
+		}
+		r.i += end + 2
+		return nil
+	}
+	first := true
+	prev := -1
+	for r.more() {
+		c := r.peek()
+		switch {
+		case c == ']' && !first:
+			r.i++
+			return nil
+		case c == '[':
+			if err := r.class(); err != nil {
+				return err
+			}
+			prev = -1
+		case c == '\\':
+			start := r.i
+			if err := r.escape(true); err != nil {
+				return err
+			}
+			prev = -1
+			if r.i-start == 2 && !isWordByte(r.pattern[start+1]) {
+				prev = int(r.pattern[start+1])
+			}
+		case c == '-' && prev >= 0 && r.i+1 < len(r.pattern) && r.pattern[r.i+1] != ']' && r.pattern[r.i+1] != '[':
+			dash := r.i
+			r.i++
+			hi := int(r.peek())
+			if r.peek() == '\\' {
+				start := r.i
+				if err := r.escape(true); err != nil {
+					return err
+				}
+				if r.i-start != 2 {
+					prev = -1
+					continue
+				}
+				hi = int(r.pattern[start+1])
+			} else {
+				r.i++
+			}
+			if hi < prev {
+				return r.errorf(dash-1, "invalid range %c-%c in set", prev, hi)
+			}
+			prev = -1
+		default:
+			r.i++
+			prev = int(c)
+		}
+		first = false
+	}
+	return r.errorf(open, "missing closing bracket")
+}
+
+// escape parses a backslash escape. Backreferences are not allowed in
+// sets.
+func (r *icuRegex) escape(inClass bool) *RegexError {
+	start := r.i
+	r.i++
+	if !r.more() {
+		return r.errorf(start, "trailing backslash")
+	}
+	c := r.peek()
+	r.i++
+	switch {
+	case c >= '1' && c <= '9' && !inClass:
+		r.refs = append(r.refs, backref{offset: start, group: int(c - '0')})
+	case c == '0':
+		for n := 0; n < 3 && r.more() && r.peek() >= '0' && r.peek() <= '7'; n++ {
+			r.i++
+		}
+	case c == 'k' && !inClass:
+		if !r.more() || r.peek() != '<' {
+			return r.errorf(start, "\\k must be followed by <name>")
+		}
+		r.i++
+		name, err := r.groupName('>')
+		if err != nil {
+			return err
+		}
+		r.refs = append(r.refs, backref{offset: start, name: name})
+	case c == 'x':
+		if r.more() && r.peek() == '{' {
+			return r.braced(start, "hex escape", isHexDigits)
+		}
+		return r.fixedHex(start, 2)
+	case c == 'u':
+		return r.fixedHex(start, 4)
+	case c == 'U':
+		return r.fixedHex(start, 8)
+	case c == 'N':
+		if !r.more() || r.peek() != '{' {
+			return r.errorf(start, "\\N must be followed by {name}")
+		}
+		return r.braced(start, "character name", func(s string) bool { return s != "" })
+	case c == 'p' || c == 'P':
+		if r.more() && r.peek() == '{' {
+			return r.braced(start, "property", func(s string) bool { return s != "" })
+		}
+		if !r.more() {
+			return r.errorf(start, "\\%c must be followed by a property", c)
+		}
+		r.i++
+	case c == 'c':
+		if !r.more() {
+			return r.errorf(start, "\\c must be followed by a control character")
+		}
+		r.i++
+	case c == 'Q':
+		end := strings.Index(r.pattern[r.i:], `\E`)
+		if end < 0 {
+			r.i = len(r.pattern)
+		} else {
+			r.i += end + 2
+		}
+	case strings.IndexByte("dDwWsShHvVRXbBAzZGaefnrtE", c) >= 0:
+	case c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9':
+		return r.errorf(start, "unknown escape \\%c", c)
+	}
+	return nil
+}
+
+func (r *icuRegex) braced(start int, what string, valid func(string) bool) *RegexError {
+	end := strings.IndexByte(r.pattern[r.i:], '}')
+	if end < 0 || !valid(r.pattern[r.i+1:r.i+end]) {
+		return r.errorf(start, "invalid %s", what)
+	}
+	r.i += end + 1
+	return nil
+}
+
+func (r *icuRegex) fixedHex(start, n int) *RegexError {
+	if r.i+n > len(r.pattern) || !isHexDigits(r.pattern[r.i:r.i+n]) {
+		return r.errorf(start, "invalid hex escape, expected %d hex digits", n)
+	}
+	r.i += n
+	return nil
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func isHexDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", rune(s[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func atoi(s string) int {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		n = n*10 + int(s[i]-'0')
+		if n > 1<<20 {
+			return n
+		}
+	}
+	return n
+}
@@ -0,0 +1,77 @@
+package analysis
+
+import "testing"
+
+func TestValidateICURegex(t *testing.T) {
+	valid := []string{
+		`\d{4}-\d{2}-\d{2}`,
+		`(?<year>\d+)-\k<year>`,
+		`(?<=\$)\d+(?!px)`,
+		`(a)(b)\2\1`,
+		`[[a-z]&&[^aeiou]]`,
+		`[[:alpha:]_-]+`,
+		`(?i)hello|(?x: world )`,
+		`a++b*+c?+`,
+		`\x{1F600}é\p{L}\N{LATIN SMALL LETTER A}`,
+		`\Q(literal)\E`,
+		`(?#comment)x`,
+		`[]a]`,
+	}
+	for _, pattern := range valid {
+		if err := ValidateICURegex(pattern); err != nil {
+			t.Errorf("ValidateICURegex(%q) = %v, want nil", pattern, err)
+		}
+	}
+
+	invalid := []struct {
+		pattern string
+		offset  int
+	}{
+		{`(abc`, 0},
+		{`abc)`, 3},
+		{`*abc`, 0},
+		{`a{3,1}`, 1},
+		{`[z-a]`, 1},
+		{`[abc`, 0},
+		{`\q`, 0},
+		{`(a)\2`, 3},
+		{`\k<nope>`, 0},
+		{`(?<=a+)b`, 5},
+		{`(?P<n>a)`, 2},
+		{`\x4`, 0},
+		{`abc\`, 3},
+	}
+	for _, tt := range invalid {
+		err := ValidateICURegex(tt.pattern)
+		if err == nil {
+			t.Errorf("ValidateICURegex(%q) = nil, want an error", tt.pattern)
+			continue
+		}
+		if err.Offset != tt.offset {
+			t.Errorf("ValidateICURegex(%q) error at %d (%s), want %d", tt.pattern, err.Offset, err.Message, tt.offset)
+		}
+	}
+}
+
+func TestCheckRegexArguments(t *testing.T) {
+	source := []byte(`@ok = matchText("\\d+", "a1")
+@bad = matchText("ab\\q", "a1")
+@raw = regReplaceText('x(y', "", ShortcutInput)
+@dynamic = matchText("{pattern}(", "a1")
+`)
+	tree := Parse(source)
+	defer tree.Close()
+
+	diags := CheckRegexArguments(tree, source)
+	if len(diags) != 2 {
+		t.Fatalf("got %d diagnostics, want 2: %v", len(diags), diags)
+	}
+	// `\\q` in a double-quoted string decodes to `\q`, which starts at
+	// column 20 of the second line.
+	if p := diags[0].Range.StartPoint; p.Row != 1 || p.Column != 20 {
+		t.Errorf("first diagnostic at %v, want 1:20", p)
+	}
+	if p := diags[1].Range.StartPoint; p.Row != 2 || p.Column != 24 {
+		t.Errorf("second diagnostic at %v, want 2:24", p)
+	}
+}
@@ -0,0 +1,339 @@
+package analysis
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// SchemaDirective is the comment prefix that binds a dictionary literal to
+// a JSON Schema file:
+//
+//	// cherri:schema schemas/user.json
+//	@body = {"name": @name, "age": 36}
+//
+// The directive applies to the first dictionary literal in the statement
+// that follows it. Relative paths are resolved against the directory of
+// the source file.
+const SchemaDirective = "cherri:schema"
+
+// A Schema is the subset of JSON Schema that can be checked against
+// dictionary literals.
+type Schema struct {
+	Ref                  string             `json:"$ref"`
+	Type                 schemaTypes        `json:"type"`
+	Properties           map[string]*Schema `json:"properties"`
+	Required             []string           `json:"required"`
+	AdditionalProperties *schemaOrBool      `json:"additionalProperties"`
+	Enum                 []any              `json:"enum"`
+	Definitions          map[string]*Schema `json:"definitions"`
+	Defs                 map[string]*Schema `json:"$defs"`
+}
+
+// schemaTypes accepts both a single type name and a list of them.
+type schemaTypes []string
+
+func (t *schemaTypes) UnmarshalJSON(data []byte) error {
+	var one string
+	if err := json.Unmarshal(data, &one); err == nil {
+		*t = schemaTypes{one}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return err
+	}
+	*t = many
+	return nil
+}
+
+// schemaOrBool is the value of additionalProperties, which is either a
+// boolean or a schema for the extra properties.
+type schemaOrBool struct {
+	Allowed bool
+	Schema  *Schema
+}
+
+func (s *schemaOrBool) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &s.Allowed); err == nil {
+		return nil
+	}
+	s.Allowed = true
+	return json.Unmarshal(data, &s.Schema)
+}
+
+// CheckSchemas validates every dictionary literal annotated with a
+// SchemaDirective against its schema. dir is the directory of the source
+// file. Each schema file is read once, however many dictionaries use it.
+func CheckSchemas(tree *tree_sitter.Tree, source []byte, dir string) []Diagnostic {
+	var diags []Diagnostic
+	schemas := schemaCache{}
+	Walk(tree.RootNode(), func(node *tree_sitter.Node) bool {
+		if node.Kind() != "comment" {
+			return true
+		}
+		path, ok := schemaPath(node.Utf8Text(source))
+		if !ok {
+			return false
+		}
+		dict := annotatedDictionary(node)
+		if dict == nil {
+			diags = append(diags, Diagnostic{
+				Range:    node.Range(),
+				Severity: SeverityWarning,
+				Code:     "schema",
+				Message:  "schema directive is not followed by a dictionary literal",
+			})
+			return false
+		}
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+		root, err := schemas.load(path)
+		if err != nil {
+			diags = append(diags, Diagnostic{
+				Range:    node.Range(),
+				Severity: SeverityError,
+				Code:     "schema",
+				Message:  err.Error(),
+			})
+			return false
+		}
+		v := schemaValidator{root: root, source: source}
+		v.object(dict, root, "")
+		diags = append(diags, v.diags...)
+		return false
+	})
+	return diags
+}
+
+func schemaPath(comment string) (string, bool) {
+	text := strings.TrimSpace(strings.TrimPrefix(comment, "//"))
+	if !strings.HasPrefix(text, SchemaDirective) {
+		return "", false
+	}
+	path := strings.TrimSpace(strings.TrimPrefix(text, SchemaDirective))
+	return path, path != ""
+}
+
+// annotatedDictionary returns the first dictionary literal in the
+// statement following a directive comment.
+func annotatedDictionary(comment *tree_sitter.Node) *tree_sitter.Node {
+	next := comment.NextNamedSibling()
+	for next != nil && next.Kind() == "comment" {
+		next = next.NextNamedSibling()
+	}
+	if next == nil {
+		return nil
+	}
+	var dict *tree_sitter.Node
+	Walk(next, func(node *tree_sitter.Node) bool {
+		if dict != nil {
+			return false
+		}
+		if node.Kind() == "dictionary" {
+			dict = node
+			return false
+		}
+		return true
+	})
+	return dict
+}
+
+// A schemaCache holds the schemas loaded by path, along with the error
+// loading each failed with.
+type schemaCache map[string]loadedSchema
+
+type loadedSchema struct {
+	schema *Schema
+	err    error
+}
+
+func (c schemaCache) load(path string) (*Schema, error) {
+	path = filepath.Clean(path)
+	loaded, ok := c[path]
+	if !ok {
+		loaded.schema, loaded.err = loadSchema(path)
+		c[path] = loaded
+	}
+	return loaded.schema, loaded.err
+}
+
+func loadSchema(path string) (*Schema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading schema: %w", err)
+	}
+	var schema Schema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("parsing schema %s: %w", path, err)
+	}
+	return &schema, nil
+}
+
+type schemaValidator struct {
+	root   *Schema
+	source []byte
+	diags  []Diagnostic
+}
+
+func (v *schemaValidator) report(node *tree_sitter.Node, format string, args ...any) {
+	v.diags = append(v.diags, Diagnostic{
+		Range:    node.Range(),
+		Severity: SeverityError,
+		Code:     "schema",
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// resolve follows local $refs of the form #/definitions/name or
+// #/$defs/name.
+func (v *schemaValidator) resolve(schema *Schema) *Schema {
+	for seen := 0; schema != nil && schema.Ref != "" && seen < 32; seen++ {
+		var defs map[string]*Schema
+		name, ok := strings.CutPrefix(schema.Ref, "#/definitions/")
+		if ok {
+			defs = v.root.Definitions
+		} else if name, ok = strings.CutPrefix(schema.Ref, "#/$defs/"); ok {
+			defs = v.root.Defs
+		}
+		if defs[name] == nil {
+			return nil
+		}
+		schema = defs[name]
+	}
+	return schema
+}
+
+// object validates a dictionary literal. path is the dotted key path of
+// the dictionary, used in messages for nested dictionaries.
+func (v *schemaValidator) object(dict *tree_sitter.Node, schema *Schema, path string) {
+	schema = v.resolve(schema)
+	if schema == nil {
+		return
+	}
+	if len(schema.Type) > 0 && !slices.Contains(schema.Type, "object") {
+		v.report(dict, "%s must be %s, not a dictionary", describePath(path), strings.Join(schema.Type, " or "))
+		return
+	}
+	seen := map[string]bool{}
+	for i := uint(0); i < dict.NamedChildCount(); i++ {
+		pair := dict.NamedChild(i)
+		if pair.Kind() != "dictionary_pair" {
+			continue
+		}
+		key, ok := dictionaryKey(pair, v.source)
+		if !ok {
+			continue
+		}
+		seen[key] = true
+		keyPath := key
+		if path != "" {
+			keyPath = path + "." + key
+		}
+		prop, declared := schema.Properties[key]
+		if !declared {
+			extra := schema.AdditionalProperties
+			if extra != nil && !extra.Allowed {
+				v.report(pair, "key %q is not allowed by the schema", keyPath)
+				continue
+			}
+			if extra == nil || extra.Schema == nil {
+				continue
+			}
+			prop = extra.Schema
+		}
+		v.value(pair, pair.ChildByFieldName("value"), prop, keyPath)
+	}
+	var missing []string
+	for _, key := range schema.Required {
+		if !seen[key] {
+			missing = append(missing, key)
+		}
+	}
+	sort.Strings(missing)
+	for _, key := range missing {
+		v.report(dict, "%s is missing required key %q", describePath(path), key)
+	}
+}
+
+// value validates the value of a dictionary_pair. Values whose type is not
+// known statically, such as variables and call results, are accepted.
+func (v *schemaValidator) value(pair, value *tree_sitter.Node, schema *Schema, path string) {
+	schema = v.resolve(schema)
+	if schema == nil || value == nil {
+		return
+	}
+	if value.Kind() == "dictionary" {
+		v.object(value, schema, path)
+		return
+	}
+	typ, literal, ok := jsonLiteral(value, v.source)
+	if !ok {
+		return
+	}
+	if len(schema.Type) > 0 && !slices.Contains(schema.Type, typ) &&
+		!(typ == "integer" && slices.Contains(schema.Type, "number")) {
+		v.report(pair, "%q must be %s, not %s", path, strings.Join(schema.Type, " or "), typ)
+		return
+	}
+	if literal != nil && len(schema.Enum) > 0 {
+		for _, allowed := range schema.Enum {
+			if allowed == literal {
+				return
+			}
+		}
+		v.report(pair, "%q must be one of %s", path, formatEnum(schema.Enum))
+	}
+}
+
+// jsonLiteral returns the JSON type of a literal expression and, when it
+// is known statically, its value as decoded by encoding/json.
+func jsonLiteral(value *tree_sitter.Node, source []byte) (string, any, bool) {
+	switch value.Kind() {
+	case "string", "single_quoted_string":
+		if s, ok := StringValue(value, source); ok {
+			return "string", s, true
+		}
+		return "string", nil, true
+	case "number":
+		var n float64
+		text := value.Utf8Text(source)
+		if err := json.Unmarshal([]byte(text), &n); err != nil {
+			return "", nil, false
+		}
+		if strings.Contains(text, ".") {
+			return "number", n, true
+		}
+		return "integer", n, true
+	case "boolean":
+		return "boolean", value.Utf8Text(source) == "true", true
+	case "builtin_keyword":
+		if value.Utf8Text(source) == "nil" {
+			return "null", nil, true
+		}
+	}
+	return "", nil, false
+}
+
+func describePath(path string) string {
+	if path == "" {
+		return "dictionary"
+	}
+	return fmt.Sprintf("%q", path)
+}
+
+func formatEnum(values []any) string {
+	parts := make([]string, len(values))
+	for i, value := range values {
+		data, _ := json.Marshal(value)
+		parts[i] = string(data)
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,101 @@
+package analysis
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const userSchema = `{
+  "type": "object",
+  "properties": {
+    "name": {"type": "string"},
+    "age": {"type": "integer"},
+    "role": {"enum": ["admin", "member"]},
+    "address": {"$ref": "#/$defs/address"}
+  },
+  "required": ["name", "age"],
+  "additionalProperties": false,
+  "$defs": {
+    "address": {
+      "type": "object",
+      "properties": {"city": {"type": "string"}},
+      "required": ["city"]
+    }
+  }
+}`
+
+func TestCheckSchemas(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "user.json"), []byte(userSchema), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	source := []byte(`// cherri:schema user.json
+@ok = {"name": "Ada", "age": 36, "role": "admin", "address": {"city": @city }}
+
+// cherri:schema user.json
+@bad = {"name": 1, "age": 3.5, "role": "owner", "nickname": "x", "address": {"zip": "1"}}
+
+@unchecked = {"anything": true}
+`)
+	tree := Parse(source)
+	defer tree.Close()
+
+	diags := CheckSchemas(tree, source, dir)
+	want := []struct {
+		row     uint
+		message string
+	}{
+		{4, `"name" must be string, not integer`},
+		{4, `"age" must be integer, not number`},
+		{4, `"role" must be one of "admin", "member"`},
+		{4, `key "nickname" is not allowed`},
+		{4, `"address" is missing required key "city"`},
+	}
+	if len(diags) != len(want) {
+		t.Fatalf("got %d diagnostics, want %d: %v", len(diags), len(want), diags)
+	}
+	for i, w := range want {
+		if diags[i].Range.StartPoint.Row != w.row || !strings.Contains(diags[i].Message, w.message) {
+			t.Errorf("diagnostic %d = %d:%q, want %d:%q", i, diags[i].Range.StartPoint.Row, diags[i].Message, w.row, w.message)
+		}
+	}
+}
+
+func TestCheckSchemasMissingFile(t *testing.T) {
+	source := []byte("// cherri:schema missing.json\n@body = {}\n")
+	tree := Parse(source)
+	defer tree.Close()
+
+	diags := CheckSchemas(tree, source, t.TempDir())
+	if len(diags) != 1 || diags[0].Severity != SeverityError {
+		t.Fatalf("got %v, want one error for the missing schema", diags)
+	}
+}
+
+func TestSchemaCache(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "user.json")
+	if err := os.WriteFile(path, []byte(userSchema), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	schemas := schemaCache{}
+	first, err := schemas.load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Later loads of the same file, however the path is written, do not
+	// read it again.
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []string{path, dir + filepath.FromSlash("/./sub/../user.json")} {
+		if got, err := schemas.load(p); got != first || err != nil {
+			t.Errorf("load(%q) = %p, %v, want the cached %p", p, got, err, first)
+		}
+	}
+	if _, err := schemas.load(filepath.Join(dir, "missing.json")); err == nil {
+		t.Error("loading a missing schema succeeded")
+	}
+}
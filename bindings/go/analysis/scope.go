@@ -0,0 +1,155 @@
+package analysis
+
+import (
+	"fmt"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// mutatingActions maps actions that modify a variable passed to them in
+// place to the index of that argument.
+var mutatingActions = map[string]int{
+	"setValue": 0,
+}
+
+// An Occurrence is a read or write of a variable.
+//
+// Cherri has a single namespace for variables: `@name`, `name` and
+// `{name}` in a string all refer to the same variable, and every variable
+// is global to the shortcut (or to the action that defines it).
+type Occurrence struct {
+	Name string
+	// Node is the at_variable, identifier, interpolation or
+	// builtin_constant node the name appears in.
+	Node *tree_sitter.Node
+	// Start is the byte offset of the name itself, which is inside Node
+	// for at_variables and interpolations.
+	Start uint
+	// Write is set for assignments, declarations, loop variables and
+	// parameters of action definitions.
+	Write bool
+	// Mutation is set, along with Write, for variables modified in place
+	// by an action such as setValue. These are also reads.
+	Mutation bool
+}
+
+// Occurrences returns every variable occurrence under node in document
+// order. The RepeatItem and RepeatIndex constants are reported as reads,
+// as they are implicitly written by the innermost repeat loop.
+func Occurrences(node *tree_sitter.Node, source []byte) []Occurrence {
+	var out []Occurrence
+	Walk(node, func(n *tree_sitter.Node) bool {
+		switch n.Kind() {
+		case "at_variable":
+			out = append(out, Occurrence{Name: VariableName(n, source), Node: n, Start: n.StartByte() + 1, Write: isAssignmentName(n)})
+		case "identifier":
+			if isCallee(n) || isDictionaryKey(n) || isSignatureName(n) {
+				return true
+			}
+			write := isAssignmentName(n) || isLoopVariable(n) || isParameterName(n)
+			if !write && n.Parent() != nil && n.Parent().Kind() == "pragma" {
+				return true
+			}
+			out = append(out, Occurrence{Name: n.Utf8Text(source), Node: n, Start: n.StartByte(), Write: write})
+		case "interpolation":
+			names, offsets := interpolationNames(n, source)
+			for i, name := range names {
+				out = append(out, Occurrence{Name: name, Node: n, Start: offsets[i]})
+			}
+		case "builtin_constant":
+			if text := n.Utf8Text(source); text == "RepeatItem" || text == "RepeatIndex" {
+				out = append(out, Occurrence{Name: text, Node: n, Start: n.StartByte()})
+			}
+		case "call":
+			if i, ok := mutatingActions[CallName(n, source)]; ok {
+				if args := Arguments(n); len(args) > i && args[i].Kind() == "at_variable" {
+					// Report the mutation before the read the at_variable
+					// case adds, so callers can tell them apart by order.
+					out = append(out, Occurrence{Name: VariableName(args[i], source), Node: args[i], Start: args[i].StartByte() + 1, Write: true, Mutation: true})
+				}
+			}
+		}
+		return true
+	})
+	return out
+}
+
+// isLoopVariable reports whether node is the variable of a for or repeat
+// loop.
+func isLoopVariable(node *tree_sitter.Node) bool {
+	parent := node.Parent()
+	if parent == nil || parent.Kind() != "for_statement" && parent.Kind() != "repeat_statement" {
+		return false
+	}
+	v := parent.ChildByFieldName("variable")
+	return v != nil && v.Id() == node.Id()
+}
+
+// isSignatureName reports whether node is the name or return type of an
+// action_definition, or the type of one of its parameters, which are not
+// variables.
+func isSignatureName(node *tree_sitter.Node) bool {
+	parent := node.Parent()
+	if parent == nil {
+		return false
+	}
+	switch parent.Kind() {
+	case "action_definition":
+		return true
+	case "parameter":
+		typ := parent.ChildByFieldName("type")
+		return typ != nil && typ.Id() == node.Id()
+	}
+	return false
+}
+
+// isParameterName reports whether node is the name of a parameter of an
+// action_definition.
+func isParameterName(node *tree_sitter.Node) bool {
+	parent := node.Parent()
+	if parent == nil || parent.Kind() != "parameter" {
+		return false
+	}
+	name := parent.ChildByFieldName("name")
+	return name != nil && name.Id() == node.Id()
+}
+
+// isDictionaryKey reports whether node is a bare dictionary key, which
+// names a key rather than a variable.
+func isDictionaryKey(node *tree_sitter.Node) bool {
+	parent := node.Parent()
+	if parent == nil || parent.Kind() != "dictionary_pair" {
+		return false
+	}
+	key := parent.ChildByFieldName("key")
+	return key != nil && key.Id() == node.Id()
+}
+
+// NamesInUse returns every @variable, identifier and loop variable name
+// in use, to avoid collisions when introducing new variables.
+func NamesInUse(tree *tree_sitter.Tree, source []byte) map[string]bool {
+	names := map[string]bool{}
+	Walk(tree.RootNode(), func(n *tree_sitter.Node) bool {
+		switch n.Kind() {
+		case "at_variable":
+			names[VariableName(n, source)] = true
+		case "identifier":
+			names[n.Utf8Text(source)] = true
+		}
+		return true
+	})
+	return names
+}
+
+// UniqueName returns base, or base with the smallest numeric suffix that is
+// not in names.
+func UniqueName(base string, names map[string]bool) string {
+	if !names[base] {
+		return base
+	}
+	for i := 2; ; i++ {
+		if name := fmt.Sprintf("%s%d", base, i); !names[name] {
+			return name
+		}
+	}
+}
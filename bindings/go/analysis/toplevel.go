@@ -0,0 +1,84 @@
+package analysis
+
+import (
+	"strings"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// Parameters returns the parameter nodes of an action_definition in
+// order.
+func Parameters(def *tree_sitter.Node) []*tree_sitter.Node {
+	params := def.ChildByFieldName("parameters")
+	if params == nil {
+		return nil
+	}
+	var out []*tree_sitter.Node
+	for i := uint(0); i < params.NamedChildCount(); i++ {
+		if p := params.NamedChild(i); p.Kind() == "parameter" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// ParameterNames returns the names of the parameters of an
+// action_definition.
+func ParameterNames(def *tree_sitter.Node, source []byte) []string {
+	var names []string
+	for _, p := range Parameters(def) {
+		if name := p.ChildByFieldName("name"); name != nil {
+			names = append(names, name.Utf8Text(source))
+		}
+	}
+	return names
+}
+
+// PragmaArguments returns the nodes on the rest of the line of a top-level
+// pragma, such as the value of `#define name "..."`, which parse as
+// separate statements after it.
+func PragmaArguments(pragma *tree_sitter.Node) []*tree_sitter.Node {
+	var rest []*tree_sitter.Node
+	for next := pragma.NextNamedSibling(); next != nil && next.StartPosition().Row == pragma.StartPosition().Row; next = next.NextNamedSibling() {
+		rest = append(rest, next)
+	}
+	return rest
+}
+
+// An Include is an `#include` pragma.
+type Include struct {
+	// Path is the included path as written, relative to the including
+	// file.
+	Path string
+	Node *tree_sitter.Node
+}
+
+// Includes returns the `#include` pragmas of a file.
+func Includes(root *tree_sitter.Node, source []byte) []Include {
+	var includes []Include
+	for i := uint(0); i < root.NamedChildCount(); i++ {
+		pragma := root.NamedChild(i)
+		if pragma.Kind() != "pragma" || pragma.NamedChild(0).Utf8Text(source) != "#include" {
+			continue
+		}
+		if path, ok := StringValue(pragma.ChildByFieldName("value"), source); ok {
+			includes = append(includes, Include{Path: path, Node: pragma})
+		}
+	}
+	return includes
+}
+
+// CommentText returns the text of a comment without its markers: the
+// leading `//` of a line comment, or the `/*`, `*/` and the `*` starting
+// each line of a block comment. Lines are trimmed, and so are blank lines
+// at either end.
+func CommentText(text string) string {
+	if strings.HasPrefix(text, "//") {
+		return strings.TrimSpace(strings.TrimPrefix(text, "//"))
+	}
+	lines := strings.Split(strings.TrimSuffix(strings.TrimPrefix(text, "/*"), "*/"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "*"))
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,88 @@
+package analysis
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestIncludesAndParameters(t *testing.T) {
+	source := []byte(`#include "lib/strings.cherri"
+#include 'lib/math.cherri'
+action greet(text name, number times): text {
+  output("Hello {name}")
+}
+action wave() {
+  show("wave")
+}
+`)
+	tree := Parse(source)
+	defer tree.Close()
+	root := tree.RootNode()
+
+	greet, wave := root.NamedChild(2), root.NamedChild(3)
+	if greet.Kind() != "action_definition" || wave.Kind() != "action_definition" {
+		t.Fatalf("tree = %s", root.ToSexp())
+	}
+	if params := ParameterNames(greet, source); !reflect.DeepEqual(params, []string{"name", "times"}) {
+		t.Errorf("greet parameters = %v", params)
+	}
+	if params := Parameters(wave); len(params) != 0 {
+		t.Errorf("wave has %d parameters", len(params))
+	}
+	// Parameters are written by the call; the action name and the types
+	// are not variables.
+	var occs []string
+	for _, occ := range Occurrences(greet, source) {
+		occs = append(occs, fmt.Sprintf("%s %v", occ.Name, occ.Write))
+	}
+	if want := []string{"name true", "times true", "name false"}; !reflect.DeepEqual(occs, want) {
+		t.Errorf("greet occurrences = %q, want %q", occs, want)
+	}
+
+	var paths []string
+	for _, inc := range Includes(root, source) {
+		paths = append(paths, inc.Path)
+	}
+	if want := []string{"lib/strings.cherri", "lib/math.cherri"}; !reflect.DeepEqual(paths, want) {
+		t.Errorf("includes = %v, want %v", paths, want)
+	}
+}
+
+func TestPragmaArguments(t *testing.T) {
+	source := []byte("#define name \"Demo\"\n#question key \"Key?\" \"\"\nshow(1)\n")
+	tree := Parse(source)
+	defer tree.Close()
+	root := tree.RootNode()
+
+	var got [][]string
+	for i := uint(0); i < root.NamedChildCount(); i++ {
+		n := root.NamedChild(i)
+		if n.Kind() != "pragma" {
+			continue
+		}
+		var texts []string
+		for _, arg := range PragmaArguments(n) {
+			texts = append(texts, arg.Utf8Text(source))
+		}
+		got = append(got, texts)
+	}
+	want := [][]string{{`"Demo"`}, {`"Key?"`, `""`}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("pragma arguments = %q, want %q", got, want)
+	}
+}
+
+func TestCommentText(t *testing.T) {
+	for text, want := range map[string]string{
+		"// Doubles a number. ":                  "Doubles a number.",
+		"//":                                     "",
+		"/* One line */":                         "One line",
+		"/*\n * First\n *\n * Second\n */":       "First\n\nSecond",
+		"/**\n   Indented\n   without stars\n*/": "Indented\nwithout stars",
+	} {
+		if got := CommentText(text); got != want {
+			t.Errorf("CommentText(%q) = %q, want %q", text, got, want)
+		}
+	}
+}
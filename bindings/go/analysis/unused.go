@@ -0,0 +1,121 @@
+package analysis
+
+import (
+	"fmt"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/catalog"
+)
+
+// CheckUnusedResults reports calls to pure actions whose result is thrown
+// away: calls used as statements, and calls assigned to an @variable that
+// is never read. Since pure actions have no side effects, such calls do
+// nothing but slow the shortcut down.
+func CheckUnusedResults(tree *tree_sitter.Tree, source []byte) []Diagnostic {
+	reads := variableReads(tree, source)
+	var diags []Diagnostic
+	Walk(tree.RootNode(), func(node *tree_sitter.Node) bool {
+		switch node.Kind() {
+		case "call":
+			name := CallName(node, source)
+			if action, ok := catalog.Lookup(name); ok && action.Pure && isStatement(node) {
+				diags = append(diags, Diagnostic{
+					Range:    node.Range(),
+					Severity: SeverityWarning,
+					Code:     "unused-result",
+					Message:  fmt.Sprintf("result of %s is discarded; it has no other effect", name),
+					Fixes: []CodeAction{{
+						Title: "Remove call",
+						Edits: []TextEdit{StatementDeletion(node, source)},
+					}},
+				})
+			}
+		case "variable_assignment":
+			value := node.ChildByFieldName("value")
+			if value == nil || value.Kind() != "call" {
+				return true
+			}
+			name := CallName(value, source)
+			action, ok := catalog.Lookup(name)
+			variable := VariableName(node.ChildByFieldName("name"), source)
+			if !ok || !action.Pure || reads[variable] {
+				return true
+			}
+			diags = append(diags, Diagnostic{
+				Range:    node.ChildByFieldName("name").Range(),
+				Severity: SeverityWarning,
+				Code:     "unused-result",
+				Message:  fmt.Sprintf("@%s is never read, so the result of %s is unused", variable, name),
+				Fixes: []CodeAction{{
+					Title: "Remove assignment",
+					Edits: []TextEdit{StatementDeletion(node, source)},
+				}},
+			})
+		}
+		return true
+	})
+	return diags
+}
+
+// variableReads returns the names of variables that are read anywhere.
+func variableReads(tree *tree_sitter.Tree, source []byte) map[string]bool {
+	reads := map[string]bool{}
+	for _, occ := range Occurrences(tree.RootNode(), source) {
+		if !occ.Write {
+			reads[occ.Name] = true
+		}
+	}
+	return reads
+}
+
+// isAssignmentName reports whether node is the name being assigned or
+// declared by its parent.
+func isAssignmentName(node *tree_sitter.Node) bool {
+	parent := node.Parent()
+	if parent == nil {
+		return false
+	}
+	switch parent.Kind() {
+	case "variable_assignment", "constant_assignment", "identifier_assignment", "declaration":
+		name := parent.ChildByFieldName("name")
+		return name != nil && name.Id() == node.Id()
+	}
+	return false
+}
+
+// isCallee reports whether node is the function name of a call.
+func isCallee(node *tree_sitter.Node) bool {
+	parent := node.Parent()
+	if parent == nil || parent.Kind() != "call" {
+		return false
+	}
+	fn := parent.ChildByFieldName("function")
+	return fn != nil && fn.Id() == node.Id()
+}
+
+// StatementDeletion returns an edit removing a statement. When the
+// statement is alone on its lines, the lines are removed entirely.
+func StatementDeletion(node *tree_sitter.Node, source []byte) TextEdit {
+	r := node.Range()
+	lineStart := r.StartByte
+	for lineStart > 0 && (source[lineStart-1] == ' ' || source[lineStart-1] == '\t') {
+		lineStart--
+	}
+	lineEnd := r.EndByte
+	for int(lineEnd) < len(source) && (source[lineEnd] == ' ' || source[lineEnd] == '\t') {
+		lineEnd++
+	}
+	if (lineStart == 0 || source[lineStart-1] == '\n') && (int(lineEnd) == len(source) || source[lineEnd] == '\n') {
+		r.StartByte = lineStart
+		r.StartPoint.Column = 0
+		if int(lineEnd) < len(source) {
+			r.EndByte = lineEnd + 1
+			r.EndPoint = tree_sitter.Point{Row: r.EndPoint.Row + 1}
+		} else {
+			r.EndPoint.Column += lineEnd - r.EndByte
+			r.EndByte = lineEnd
+		}
+	}
+	return TextEdit{Range: r}
+}
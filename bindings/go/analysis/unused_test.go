@@ -0,0 +1,43 @@
+package analysis
+
+import "testing"
+
+func TestCheckUnusedResults(t *testing.T) {
+	source := []byte(`@text = "  hello  "
+trimWhitespace(@text )
+alert(@text )
+@trimmed = trimWhitespace(@text )
+@used = count(@text )
+@hash = hash(@text )
+show("{used}")
+@name = prompt("Name?")
+@key = trimWhitespace("a")
+show("{list[key]} {list['hash']}")
+`)
+	tree := Parse(source)
+	defer tree.Close()
+
+	diags := CheckUnusedResults(tree, source)
+	if len(diags) != 3 {
+		t.Fatalf("got %d diagnostics, want 3: %v", len(diags), diags)
+	}
+	for i, row := range []uint{1, 3, 5} {
+		if diags[i].Range.StartPoint.Row != row {
+			t.Errorf("diagnostic %d on row %d, want %d", i, diags[i].Range.StartPoint.Row, row)
+		}
+	}
+
+	fixed := ApplyEdits(source, append(diags[0].Fixes[0].Edits, diags[1].Fixes[0].Edits...))
+	want := `@text = "  hello  "
+alert(@text )
+@used = count(@text )
+@hash = hash(@text )
+show("{used}")
+@name = prompt("Name?")
+@key = trimWhitespace("a")
+show("{list[key]} {list['hash']}")
+`
+	if string(fixed) != want {
+		t.Errorf("fixed source:\n%s\nwant:\n%s", fixed, want)
+	}
+}
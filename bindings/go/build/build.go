@@ -0,0 +1,422 @@
+// Package build builds Cherri projects: it compiles every entry-point
+// shortcut for every target variant, in parallel, skipping targets whose
+// sources have not changed since the last build.
+package build
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/analysis"
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/compiler"
+)
+
+// ConfigFile is the name of the project configuration file, at the root
+// of a project.
+const ConfigFile = "cherri.json"
+
+// cacheFile is the name of the build cache, in the output directory.
+const cacheFile = ".cherri-cache.json"
+
+// A Config is a project configuration.
+type Config struct {
+	// Entries are glob patterns of entry-point shortcuts, relative to the
+	// project root. When empty, every .cherri file no other file includes
+	// is an entry point.
+	Entries []string `json:"entries,omitempty"`
+	// Output is the directory compiled shortcuts are written to, relative
+	// to the project root. It defaults to "build".
+	Output string `json:"output,omitempty"`
+	// Variants are the targets each entry point is compiled for. When
+	// empty, each entry point is compiled once for all platforms.
+	Variants []Variant `json:"variants,omitempty"`
+}
+
+// A Variant is a target to compile entry points for, such as iOS or
+// macOS.
+type Variant struct {
+	// Name is appended to the names of output files.
+	Name     string            `json:"name"`
+	Platform compiler.Platform `json:"platform,omitempty"`
+	// Defines override `#define` values, e.g. to give the macOS build of
+	// a shortcut a different name.
+	Defines map[string]string `json:"defines,omitempty"`
+}
+
+// LoadConfig reads the configuration of the project at dir. A project
+// without a configuration file uses the defaults.
+func LoadConfig(dir string) (*Config, error) {
+	config := &Config{}
+	data, err := os.ReadFile(filepath.Join(dir, ConfigFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return config, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("%s: %w", ConfigFile, err)
+	}
+	names := map[string]bool{}
+	for _, v := range config.Variants {
+		if names[v.Name] {
+			return nil, fmt.Errorf("%s: variant %q is defined twice", ConfigFile, v.Name)
+		}
+		names[v.Name] = true
+		if v.Platform != "" && v.Platform != compiler.IOS && v.Platform != compiler.MacOS {
+			return nil, fmt.Errorf("%s: variant %q has unknown platform %q", ConfigFile, v.Name, v.Platform)
+		}
+	}
+	return config, nil
+}
+
+// Options configure Build.
+type Options struct {
+	// Jobs is the number of targets compiled at once. It defaults to the
+	// number of CPUs.
+	Jobs int
+	// Force rebuilds targets that are up to date.
+	Force bool
+}
+
+// A Target is an entry point compiled for a variant.
+type Target struct {
+	Entry   string
+	Variant string
+	// Output is the path of the compiled shortcut, relative to the
+	// project root.
+	Output string
+	// Skipped is set when the target was up to date.
+	Skipped     bool
+	Diagnostics []compiler.Diagnostic
+
+	hash    string
+	variant Variant
+}
+
+// Failed reports whether the target failed to compile.
+func (t *Target) Failed() bool {
+	return hasErrors(t.Diagnostics)
+}
+
+// A Report is the outcome of a build.
+type Report struct {
+	Targets []*Target
+}
+
+// HasErrors reports whether any target failed to compile.
+func (r *Report) HasErrors() bool {
+	return hasErrors(r.Diagnostics())
+}
+
+// Diagnostics returns the diagnostics of every target, sorted by position.
+// A diagnostic reported for several variants, as is usual for problems in
+// shared files, is only returned once.
+func (r *Report) Diagnostics() []compiler.Diagnostic {
+	seen := map[string]bool{}
+	var out []compiler.Diagnostic
+	for _, t := range r.Targets {
+		for _, d := range t.Diagnostics {
+			if key := d.String(); !seen[key] {
+				seen[key] = true
+				out = append(out, d)
+			}
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		a, b := out[i], out[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Range.StartByte != b.Range.StartByte {
+			return a.Range.StartByte < b.Range.StartByte
+		}
+		return a.Message < b.Message
+	})
+	return out
+}
+
+// A Project is the sources of a project.
+type Project struct {
+	Config *Config
+	// Files maps the slash-separated paths of the .cherri files of the
+	// project, relative to its root, to their contents.
+	Files map[string][]byte
+	// Includes maps each file to the files it includes that exist.
+	Includes map[string][]string
+	// Entries are the sorted entry points.
+	Entries []string
+}
+
+// LoadProject reads the configuration and sources of the project at dir.
+func LoadProject(dir string) (*Project, error) {
+	config, err := LoadConfig(dir)
+	if err != nil {
+		return nil, err
+	}
+	files, err := readSources(dir)
+	if err != nil {
+		return nil, err
+	}
+	graph := includeGraph(files)
+	entries, err := entryPoints(config, files, graph)
+	if err != nil {
+		return nil, err
+	}
+	return &Project{Config: config, Files: files, Includes: graph, Entries: entries}, nil
+}
+
+// Closure returns the sorted paths of a file and the files it includes,
+// directly or not.
+func (p *Project) Closure(file string) []string {
+	seen := map[string]bool{}
+	var visit func(string)
+	visit = func(name string) {
+		if seen[name] {
+			return
+		}
+		seen[name] = true
+		for _, inc := range p.Includes[name] {
+			visit(inc)
+		}
+	}
+	visit(file)
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Build compiles the project at dir and writes the compiled shortcuts to
+// its output directory.
+func Build(dir string, opts Options) (*Report, error) {
+	project, err := LoadProject(dir)
+	if err != nil {
+		return nil, err
+	}
+	output := project.Config.Output
+	if output == "" {
+		output = "build"
+	}
+	variants := project.Config.Variants
+	if len(variants) == 0 {
+		variants = []Variant{{}}
+	}
+
+	cachePath := filepath.Join(dir, filepath.FromSlash(output), cacheFile)
+	cache := readCache(cachePath)
+	report := &Report{}
+	var pending []*Target
+	for _, entry := range project.Entries {
+		for _, v := range variants {
+			name := strings.TrimSuffix(entry, path.Ext(entry))
+			if v.Name != "" {
+				name += "-" + v.Name
+			}
+			t := &Target{Entry: entry, Variant: v.Name, Output: path.Join(output, name+".plist"), variant: v}
+			t.hash = targetHash(project, t)
+			report.Targets = append(report.Targets, t)
+			if !opts.Force && cache[t.Output] == t.hash && exists(filepath.Join(dir, filepath.FromSlash(t.Output))) {
+				t.Skipped = true
+				continue
+			}
+			pending = append(pending, t)
+		}
+	}
+
+	jobs := opts.Jobs
+	if jobs <= 0 {
+		jobs = runtime.NumCPU()
+	}
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		writeErr error
+		queue    = make(chan *Target)
+	)
+	for range min(jobs, max(len(pending), 1)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for t := range queue {
+				err := compileTarget(dir, project.Files, t)
+				mu.Lock()
+				if err != nil && writeErr == nil {
+					writeErr = err
+				}
+				if err == nil && !t.Failed() {
+					cache[t.Output] = t.hash
+				} else {
+					delete(cache, t.Output)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	for _, t := range pending {
+		queue <- t
+	}
+	close(queue)
+	wg.Wait()
+	if writeErr != nil {
+		return report, writeErr
+	}
+	if len(pending) > 0 {
+		if err := writeCache(cachePath, cache); err != nil {
+			return report, err
+		}
+	}
+	return report, nil
+}
+
+func compileTarget(dir string, files map[string][]byte, t *Target) error {
+	result := compiler.Compile(files, t.Entry, compiler.Options{Platform: t.variant.Platform, Defines: t.variant.Defines})
+	t.Diagnostics = result.Diagnostics
+	out := filepath.Join(dir, filepath.FromSlash(t.Output))
+	if result.Plist == nil {
+		// Leaving the output of an earlier build would pass it off as
+		// the result of this one.
+		if err := os.Remove(out); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(out), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(out, result.Plist, 0o644)
+}
+
+func hasErrors(diagnostics []compiler.Diagnostic) bool {
+	for _, d := range diagnostics {
+		if d.Severity == analysis.SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// readSources reads every .cherri file under dir, keyed by slash-separated
+// path relative to dir.
+func readSources(dir string) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || filepath.Ext(p) != ".cherri" {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)], err = os.ReadFile(p)
+		return err
+	})
+	return files, err
+}
+
+// includeGraph maps each file to the files it includes that exist.
+func includeGraph(files map[string][]byte) map[string][]string {
+	graph := map[string][]string{}
+	for name, source := range files {
+		tree := analysis.Parse(source)
+		for _, inc := range analysis.Includes(tree.RootNode(), source) {
+			target := path.Join(path.Dir(name), inc.Path)
+			if _, ok := files[target]; ok {
+				graph[name] = append(graph[name], target)
+			}
+		}
+		tree.Close()
+	}
+	return graph
+}
+
+// entryPoints returns the sorted entry points of a project.
+func entryPoints(config *Config, files map[string][]byte, graph map[string][]string) ([]string, error) {
+	var entries []string
+	if len(config.Entries) == 0 {
+		included := map[string]bool{}
+		for _, targets := range graph {
+			for _, t := range targets {
+				included[t] = true
+			}
+		}
+		for name := range files {
+			if !included[name] {
+				entries = append(entries, name)
+			}
+		}
+	} else {
+		seen := map[string]bool{}
+		for _, pattern := range config.Entries {
+			matched := false
+			for name := range files {
+				ok, err := path.Match(pattern, name)
+				if err != nil {
+					return nil, fmt.Errorf("%s: invalid entry pattern %q: %w", ConfigFile, pattern, err)
+				}
+				if ok && !seen[name] {
+					seen[name] = true
+					entries = append(entries, name)
+				}
+				matched = matched || ok
+			}
+			if !matched {
+				return nil, fmt.Errorf("%s: entry pattern %q matches no files", ConfigFile, pattern)
+			}
+		}
+	}
+	sort.Strings(entries)
+	return entries, nil
+}
+
+// targetHash hashes everything a target's output depends on: the
+// compiler, the variant, and the entry point with the files it includes.
+func targetHash(project *Project, t *Target) string {
+	h := sha256.New()
+	variant, _ := json.Marshal(t.variant)
+	fmt.Fprintf(h, "compiler %s\nvariant %s\nentry %s\n", compiler.Version, variant, t.Entry)
+	for _, name := range project.Closure(t.Entry) {
+		fmt.Fprintf(h, "file %s %d\n", name, len(project.Files[name]))
+		h.Write(project.Files[name])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func exists(p string) bool {
+	_, err := os.Stat(p)
+	return err == nil
+}
+
+// readCache returns the hashes of the targets built last time, keyed by
+// output path. A missing or unreadable cache is empty.
+func readCache(p string) map[string]string {
+	cache := map[string]string{}
+	if data, err := os.ReadFile(p); err == nil {
+		_ = json.Unmarshal(data, &cache)
+	}
+	return cache
+}
+
+func writeCache(p string, cache map[string]string) error {
+	data, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(p, append(data, '\n'), 0o644)
+}
@@ -0,0 +1,166 @@
+package build
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// built returns the output paths of the targets that were compiled rather
+// than skipped.
+func built(report *Report) []string {
+	var out []string
+	for _, t := range report.Targets {
+		if !t.Skipped {
+			out = append(out, t.Output)
+		}
+	}
+	return out
+}
+
+func TestBuild(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		ConfigFile: `{
+  "output": "out",
+  "variants": [
+    {"name": "ios", "platform": "ios"},
+    {"name": "mac", "platform": "macos", "defines": {"name": "Tool (Mac)"}}
+  ]
+}`,
+		"main.cherri":       "#include \"lib/shared.cherri\"\nshow(greeting)\n",
+		"tool.cherri":       "#define from menubar\nalert(\"tool\")\n",
+		"lib/shared.cherri": "const greeting = \"Hello\"\n",
+	})
+
+	report, err := Build(dir, Options{Jobs: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"out/main-ios.plist", "out/main-mac.plist", "out/tool-ios.plist", "out/tool-mac.plist"}
+	if got := built(report); !reflect.DeepEqual(got, want) {
+		t.Fatalf("built %v, want %v", got, want)
+	}
+	for _, name := range want {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Error(err)
+		}
+	}
+	// menubar is left out of the iOS build of tool.cherri.
+	if d := report.Diagnostics(); len(d) != 1 || d[0].File != "tool.cherri" || report.HasErrors() {
+		t.Errorf("diagnostics = %v", d)
+	}
+
+	report, err = Build(dir, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := built(report); got != nil {
+		t.Errorf("rebuilt %v with no changes", got)
+	}
+
+	writeFiles(t, dir, map[string]string{"lib/shared.cherri": "const greeting = \"Hi\"\n"})
+	report, err = Build(dir, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := built(report), []string{"out/main-ios.plist", "out/main-mac.plist"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("after changing an include, built %v, want %v", got, want)
+	}
+
+	report, err = Build(dir, Options{Force: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(built(report)) != 4 {
+		t.Errorf("forced build built %v", built(report))
+	}
+}
+
+func TestBuildErrors(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		ConfigFile:                `{"entries": ["shortcuts/*.cherri"], "variants": [{"name": "a"}, {"name": "b"}]}`,
+		"shortcuts/broken.cherri": "#include \"../lib.cherri\"\nshow(\"ok\")\n",
+		"lib.cherri":              "frobnicate()\n",
+	})
+	report, err := Build(dir, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.HasErrors() {
+		t.Fatal("build with an unknown action has no errors")
+	}
+	// The error in the shared include is reported once, not per variant.
+	d := report.Diagnostics()
+	if len(d) != 1 || d[0].String() != "lib.cherri:1:1: error: unknown action frobnicate" {
+		t.Errorf("diagnostics = %v", d)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "build", "shortcuts", "broken-a.plist")); err == nil {
+		t.Error("a target with errors was written")
+	}
+	// Failed targets are not cached.
+	if report, _ = Build(dir, Options{}); len(built(report)) != 2 {
+		t.Errorf("failed targets were skipped on the next build")
+	}
+
+	// A target that fails leaves no output of an earlier build behind.
+	writeFiles(t, dir, map[string]string{"lib.cherri": "const ok = 1\n"})
+	if report, err := Build(dir, Options{}); err != nil || report.HasErrors() {
+		t.Fatalf("fixed build: %v %v", err, report.Diagnostics())
+	}
+	output := filepath.Join(dir, "build", "shortcuts", "broken-a.plist")
+	if _, err := os.Stat(output); err != nil {
+		t.Fatal(err)
+	}
+	writeFiles(t, dir, map[string]string{"lib.cherri": "frobnicate()\n"})
+	if report, _ = Build(dir, Options{}); !report.HasErrors() {
+		t.Fatal("broken build has no errors")
+	}
+	if _, err := os.Stat(output); err == nil {
+		t.Error("the output of the earlier build was left behind")
+	}
+
+	writeFiles(t, dir, map[string]string{ConfigFile: `{"entries": ["missing/*.cherri"]}`})
+	if _, err := Build(dir, Options{}); err == nil {
+		t.Error("an entry pattern matching nothing was accepted")
+	}
+	writeFiles(t, dir, map[string]string{ConfigFile: `{"variants": [{"name": "x", "platform": "watchos"}]}`})
+	if _, err := LoadConfig(dir); err == nil {
+		t.Error("an unknown platform was accepted")
+	}
+}
+
+func TestLoadProject(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"a.cherri":          "#include \"lib/b.cherri\"\n",
+		"lib/b.cherri":      "#include \"c.cherri\"\n#include \"missing.cherri\"\n",
+		"lib/c.cherri":      "#include \"b.cherri\"\n",
+		"standalone.cherri": "alert(\"hi\")\n",
+	})
+	project, err := LoadProject(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"a.cherri", "standalone.cherri"}; !reflect.DeepEqual(project.Entries, want) {
+		t.Errorf("Entries = %v, want %v", project.Entries, want)
+	}
+	if got, want := project.Closure("a.cherri"), []string{"a.cherri", "lib/b.cherri", "lib/c.cherri"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Closure = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,135 @@
+// Package catalog describes the Shortcuts actions that Cherri code calls.
+//
+// The catalog is embedded from actions.json and maps each Cherri action
+// name to its Shortcuts identifier and parameters.
+package catalog
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"sort"
+)
+
+// ParamType is the kind of value a parameter accepts.
+type ParamType string
+
+const (
+	Text       ParamType = "text"
+	Number     ParamType = "number"
+	Bool       ParamType = "bool"
+	Dictionary ParamType = "dictionary"
+	Array      ParamType = "array"
+	Variable   ParamType = "variable"
+	Enum       ParamType = "enum"
+	// Regex is a text parameter holding an ICU regular expression.
+	Regex ParamType = "regex"
+	// DateFormat is a text parameter holding a Unicode date format
+	// pattern such as "yyyy-MM-dd".
+	DateFormat ParamType = "dateFormat"
+	// NumberFormat is a text parameter holding a Unicode number format
+	// pattern such as "#,##0.00".
+	NumberFormat ParamType = "numberFormat"
+)
+
+// A Parameter is one argument of an action, in call order.
+type Parameter struct {
+	// Name is the Cherri parameter name.
+	Name string `json:"name"`
+	// Key is the WorkflowAction parameter key it compiles to.
+	Key      string    `json:"key"`
+	Type     ParamType `json:"type"`
+	Optional bool      `json:"optional,omitempty"`
+	// Enum lists the accepted values of an Enum parameter.
+	Enum []string `json:"enum,omitempty"`
+}
+
+// An Action is a Shortcuts action callable from Cherri.
+type Action struct {
+	// Name is the Cherri function name, e.g. "matchText".
+	Name string `json:"name"`
+	// Identifier is the WorkflowAction identifier, e.g.
+	// "is.workflow.actions.text.match".
+	Identifier string `json:"identifier"`
+	// Pure actions have no side effects and return the same result for
+	// the same arguments within a run, so they are only worth calling for
+	// their result.
+	Pure bool `json:"pure,omitempty"`
+	// Platforms lists the platforms the action is available on, "ios"
+	// and "macos". It is empty for actions available everywhere.
+	Platforms []string `json:"platforms,omitempty"`
+	// Fixed holds parameter values the action always compiles with,
+	// keyed by WorkflowAction parameter key. They tell apart actions
+	// sharing an identifier, such as replaceText and regReplaceText.
+	Fixed      map[string]any `json:"fixed,omitempty"`
+	Parameters []Parameter    `json:"parameters"`
+}
+
+// Param returns the parameter at index i of a call, or nil.
+func (a *Action) Param(i int) *Parameter {
+	if i < 0 || i >= len(a.Parameters) {
+		return nil
+	}
+	return &a.Parameters[i]
+}
+
+//go:embed actions.json
+var actionsJSON []byte
+
+var actions = func() map[string]*Action {
+	list, err := Decode(actionsJSON)
+	if err != nil {
+		panic("catalog: invalid actions.json: " + err.Error())
+	}
+	m := make(map[string]*Action, len(list))
+	for _, a := range list {
+		m[a.Name] = a
+	}
+	return m
+}()
+
+// Lookup returns the action called name.
+func Lookup(name string) (*Action, bool) {
+	a, ok := actions[name]
+	return a, ok
+}
+
+// Actions returns every action in the catalog, sorted by name.
+func Actions() []*Action {
+	list := make([]*Action, 0, len(actions))
+	for _, a := range actions {
+		list = append(list, a)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+// Decode parses a catalog in the format of actions.json.
+func Decode(data []byte) ([]*Action, error) {
+	var list []*Action
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// Encode formats a catalog like actions.json.
+func Encode(list []*Action) ([]byte, error) {
+	// Actions without parameters list none rather than null. The actions
+	// are copied so the caller's are left as they are.
+	out := make([]Action, len(list))
+	for i, a := range list {
+		out[i] = *a
+		if out[i].Parameters == nil {
+			out[i].Parameters = []Parameter{}
+		}
+	}
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(out); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
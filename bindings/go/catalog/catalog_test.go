@@ -0,0 +1,59 @@
+package catalog
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	action, ok := Lookup("matchText")
+	if !ok {
+		t.Fatal("matchText is missing from the catalog")
+	}
+	if action.Identifier != "is.workflow.actions.text.match" {
+		t.Errorf("matchText identifier = %q", action.Identifier)
+	}
+	if p := action.Param(0); p == nil || p.Type != Regex {
+		t.Errorf("matchText first parameter = %+v, want a regex", p)
+	}
+	if action.Param(len(action.Parameters)) != nil {
+		t.Error("Param past the end returned a parameter")
+	}
+	if _, ok := Lookup("noSuchAction"); ok {
+		t.Error("Lookup found an unknown action")
+	}
+}
+
+func TestActionsSorted(t *testing.T) {
+	list := Actions()
+	for i := 1; i < len(list); i++ {
+		if list[i-1].Name >= list[i].Name {
+			t.Fatalf("Actions not sorted: %q before %q", list[i-1].Name, list[i].Name)
+		}
+	}
+}
+
+func TestEncode(t *testing.T) {
+	list, err := Decode(actionsJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := Encode(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, actionsJSON) {
+		t.Error("Encode does not reproduce actions.json")
+	}
+	nothing := &Action{Name: "nothing", Identifier: "is.workflow.actions.nothing"}
+	data, err = Encode([]*Action{nothing})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(data, []byte(`"parameters": []`)) {
+		t.Errorf("an action without parameters encodes as\n%s", data)
+	}
+	if nothing.Parameters != nil {
+		t.Error("Encode changed the parameters of the action")
+	}
+}
@@ -0,0 +1,187 @@
+package wfactions
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/catalog"
+)
+
+// A Change is a difference in an action between two catalogs.
+type Change struct {
+	// Action is the name of the action in the new catalog.
+	Action string
+	Detail string
+}
+
+func (c Change) String() string {
+	return c.Action + ": " + c.Detail
+}
+
+// A Diff lists the differences between two catalogs. Actions are matched
+// by identifier and name, so each of several actions sharing an
+// identifier is compared with its own previous version. An action whose
+// identifier has exactly one unmatched action in each catalog was renamed.
+type Diff struct {
+	// Added and Removed hold the names of the actions only in the new and
+	// the old catalog.
+	Added, Removed []string
+	Changed        []Change
+}
+
+// actionKey identifies an action across catalogs.
+type actionKey struct{ identifier, name string }
+
+// Compare returns the differences between an old and a new catalog.
+func Compare(before, after []*catalog.Action) *Diff {
+	d := &Diff{}
+	old := map[actionKey]*catalog.Action{}
+	for _, a := range before {
+		old[actionKey{a.Identifier, a.Name}] = a
+	}
+	// Actions without a counterpart of the same name, by identifier.
+	added := map[string][]*catalog.Action{}
+	matched := map[*catalog.Action]bool{}
+	for _, a := range after {
+		o, ok := old[actionKey{a.Identifier, a.Name}]
+		if !ok {
+			added[a.Identifier] = append(added[a.Identifier], a)
+			continue
+		}
+		matched[o] = true
+		d.Changed = append(d.Changed, changes(o, a)...)
+	}
+	removed := map[string][]*catalog.Action{}
+	for _, a := range before {
+		if !matched[a] {
+			removed[a.Identifier] = append(removed[a.Identifier], a)
+		}
+	}
+	for id, list := range added {
+		if len(list) == 1 && len(removed[id]) == 1 {
+			d.Changed = append(d.Changed, changes(removed[id][0], list[0])...)
+			delete(removed, id)
+			continue
+		}
+		for _, a := range list {
+			d.Added = append(d.Added, a.Name)
+		}
+	}
+	for _, list := range removed {
+		for _, a := range list {
+			d.Removed = append(d.Removed, a.Name)
+		}
+	}
+	sort.Strings(d.Added)
+	sort.Strings(d.Removed)
+	sort.SliceStable(d.Changed, func(i, j int) bool { return d.Changed[i].Action < d.Changed[j].Action })
+	return d
+}
+
+// changes lists the differences between two versions of an action.
+func changes(old, updated *catalog.Action) []Change {
+	var out []Change
+	add := func(format string, args ...any) {
+		out = append(out, Change{Action: updated.Name, Detail: fmt.Sprintf(format, args...)})
+	}
+	if old.Name != updated.Name {
+		add("renamed from %s", old.Name)
+	}
+	if old.Pure != updated.Pure {
+		add("pure %t -> %t", old.Pure, updated.Pure)
+	}
+	if !slices.Equal(old.Platforms, updated.Platforms) {
+		add("platforms %s -> %s", platformList(old.Platforms), platformList(updated.Platforms))
+	}
+	if !reflect.DeepEqual(old.Fixed, updated.Fixed) {
+		add("fixed parameters %v -> %v", old.Fixed, updated.Fixed)
+	}
+
+	params := map[string]catalog.Parameter{}
+	for _, p := range old.Parameters {
+		params[p.Key] = p
+	}
+	seen := map[string]bool{}
+	for _, p := range updated.Parameters {
+		seen[p.Key] = true
+		o, ok := params[p.Key]
+		if !ok {
+			add("parameter %s (%s) added", p.Name, p.Key)
+			continue
+		}
+		if o.Name != p.Name {
+			add("parameter %s (%s) renamed from %s", p.Name, p.Key, o.Name)
+		}
+		if o.Type != p.Type {
+			add("parameter %s type %s -> %s", p.Name, o.Type, p.Type)
+		}
+		if o.Optional != p.Optional {
+			add("parameter %s optional %t -> %t", p.Name, o.Optional, p.Optional)
+		}
+		if added, removed := difference(p.Enum, o.Enum), difference(o.Enum, p.Enum); len(added)+len(removed) > 0 {
+			var parts []string
+			if len(added) > 0 {
+				parts = append(parts, "added "+quote(added))
+			}
+			if len(removed) > 0 {
+				parts = append(parts, "removed "+quote(removed))
+			}
+			add("parameter %s values %s", p.Name, strings.Join(parts, ", "))
+		}
+	}
+	for _, p := range old.Parameters {
+		if !seen[p.Key] {
+			add("parameter %s (%s) removed", p.Name, p.Key)
+		}
+	}
+	return out
+}
+
+func platformList(platforms []string) string {
+	if len(platforms) == 0 {
+		return "all"
+	}
+	return strings.Join(platforms, ",")
+}
+
+// difference returns the values of a not in b.
+func difference(a, b []string) []string {
+	var out []string
+	for _, v := range a {
+		if !slices.Contains(b, v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func quote(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// Empty reports whether the catalogs are the same.
+func (d *Diff) Empty() bool {
+	return len(d.Added)+len(d.Removed)+len(d.Changed) == 0
+}
+
+func (d *Diff) String() string {
+	var b strings.Builder
+	for _, name := range d.Added {
+		b.WriteString("+ " + name + "\n")
+	}
+	for _, name := range d.Removed {
+		b.WriteString("- " + name + "\n")
+	}
+	for _, c := range d.Changed {
+		b.WriteString("~ " + c.String() + "\n")
+	}
+	fmt.Fprintf(&b, "%d added, %d removed, %d changes\n", len(d.Added), len(d.Removed), len(d.Changed))
+	return b.String()
+}
@@ -0,0 +1,66 @@
+package wfactions
+
+import (
+	"testing"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/catalog"
+)
+
+func TestCompare(t *testing.T) {
+	old := []*catalog.Action{
+		{Name: "alert", Identifier: "is.workflow.actions.alert", Parameters: []catalog.Parameter{
+			{Name: "alert", Key: "WFAlertActionMessage", Type: catalog.Text},
+			{Name: "title", Key: "WFAlertActionTitle", Type: catalog.Text, Optional: true},
+		}},
+		{Name: "changeCase", Identifier: "is.workflow.actions.text.changecase", Pure: true, Parameters: []catalog.Parameter{
+			{Name: "case", Key: "WFCaseType", Type: catalog.Enum, Enum: []string{"UPPERCASE", "lowercase", "Title"}},
+		}},
+		{Name: "vibrate", Identifier: "is.workflow.actions.vibrate"},
+	}
+	after := []*catalog.Action{
+		{Name: "alert", Identifier: "is.workflow.actions.alert", Platforms: []string{"ios"}, Parameters: []catalog.Parameter{
+			{Name: "message", Key: "WFAlertActionMessage", Type: catalog.Variable},
+			{Name: "cancelButton", Key: "WFAlertActionCancelButtonShown", Type: catalog.Bool, Optional: true},
+		}},
+		{Name: "changeCase", Identifier: "is.workflow.actions.text.changecase", Pure: true, Parameters: []catalog.Parameter{
+			{Name: "case", Key: "WFCaseType", Type: catalog.Enum, Enum: []string{"UPPERCASE", "lowercase", "Sentence"}},
+		}},
+		{Name: "showNote", Identifier: "is.workflow.actions.shownote"},
+	}
+	got := Compare(old, after).String()
+	want := `+ showNote
+- vibrate
+~ alert: platforms all -> ios
+~ alert: parameter message (WFAlertActionMessage) renamed from alert
+~ alert: parameter message type text -> variable
+~ alert: parameter cancelButton (WFAlertActionCancelButtonShown) added
+~ alert: parameter title (WFAlertActionTitle) removed
+~ changeCase: parameter case values added "Sentence", removed "Title"
+1 added, 1 removed, 6 changes
+`
+	if got != want {
+		t.Errorf("Compare =\n%s\nwant\n%s", got, want)
+	}
+	if !Compare(after, after).Empty() {
+		t.Error("a catalog differs from itself")
+	}
+}
+
+func TestCompareSharedIdentifier(t *testing.T) {
+	replace := func(name string) *catalog.Action {
+		return &catalog.Action{Name: name, Identifier: "is.workflow.actions.text.replace"}
+	}
+	old := []*catalog.Action{replace("replaceText"), replace("regReplaceText"), replace("iRegReplaceText"), replace("hash")}
+	old[3].Identifier = "is.workflow.actions.hash"
+	after := []*catalog.Action{replace("replaceText"), replace("hashText")}
+	after[1].Identifier = "is.workflow.actions.hash"
+	got := Compare(old, after).String()
+	want := `- iRegReplaceText
+- regReplaceText
+~ hashText: renamed from hash
+0 added, 2 removed, 1 changes
+`
+	if got != want {
+		t.Errorf("Compare =\n%s\nwant\n%s", got, want)
+	}
+}
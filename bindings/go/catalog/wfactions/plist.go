@@ -0,0 +1,152 @@
+package wfactions
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// decode parses a dump as an XML property list or JSON, into the values
+// encoding/json produces: maps, slices, strings, float64s and bools, with
+// []byte for plist data.
+func decode(data []byte) (any, error) {
+	trimmed := bytes.TrimSpace(data)
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("bplist")):
+		return nil, errors.New("binary property lists are not supported; convert with plutil -convert xml1")
+	case bytes.HasPrefix(trimmed, []byte("{")) || bytes.HasPrefix(trimmed, []byte("[")):
+		var v any
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+	return decodePlist(data)
+}
+
+// decodePlist parses an XML property list.
+func decodePlist(data []byte) (any, error) {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return nil, errors.New("no plist element")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			if start.Name.Local != "plist" {
+				return nil, fmt.Errorf("unexpected <%s>, want <plist>", start.Name.Local)
+			}
+			start, err := nextElement(d)
+			if err != nil {
+				return nil, err
+			}
+			if start == nil {
+				return nil, errors.New("empty plist")
+			}
+			return plistValue(d, *start)
+		}
+	}
+}
+
+// nextElement returns the next start element in the current element, or
+// nil at its end.
+func nextElement(d *xml.Decoder) (*xml.StartElement, error) {
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			return &tok, nil
+		case xml.EndElement:
+			return nil, nil
+		}
+	}
+}
+
+// plistValue parses the value of the element started by start.
+func plistValue(d *xml.Decoder, start xml.StartElement) (any, error) {
+	switch start.Name.Local {
+	case "dict":
+		dict := map[string]any{}
+		for {
+			key, err := nextElement(d)
+			if err != nil {
+				return nil, err
+			}
+			if key == nil {
+				return dict, nil
+			}
+			if key.Name.Local != "key" {
+				return nil, fmt.Errorf("unexpected <%s> in dict, want <key>", key.Name.Local)
+			}
+			var name string
+			if err := d.DecodeElement(&name, key); err != nil {
+				return nil, err
+			}
+			value, err := nextElement(d)
+			if err != nil {
+				return nil, err
+			}
+			if value == nil {
+				return nil, fmt.Errorf("dict key %q has no value", name)
+			}
+			if dict[name], err = plistValue(d, *value); err != nil {
+				return nil, err
+			}
+		}
+	case "array":
+		array := []any{}
+		for {
+			elem, err := nextElement(d)
+			if err != nil {
+				return nil, err
+			}
+			if elem == nil {
+				return array, nil
+			}
+			v, err := plistValue(d, *elem)
+			if err != nil {
+				return nil, err
+			}
+			array = append(array, v)
+		}
+	case "true", "false":
+		if err := d.Skip(); err != nil {
+			return nil, err
+		}
+		return start.Name.Local == "true", nil
+	}
+
+	var text string
+	if err := d.DecodeElement(&text, &start); err != nil {
+		return nil, err
+	}
+	switch start.Name.Local {
+	case "string", "date":
+		return text, nil
+	case "integer", "real":
+		f, err := strconv.ParseFloat(strings.TrimSpace(text), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid <%s> %q", start.Name.Local, text)
+		}
+		return f, nil
+	case "data":
+		b, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(text), ""))
+		if err != nil {
+			return nil, fmt.Errorf("invalid <data>: %v", err)
+		}
+		return b, nil
+	}
+	return nil, fmt.Errorf("unknown plist element <%s>", start.Name.Local)
+}
@@ -0,0 +1,76 @@
+package wfactions
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDecodePlist(t *testing.T) {
+	got, err := decode([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>Name</key>
+	<string>Show &amp; Tell</string>
+	<key>Count</key>
+	<integer>3</integer>
+	<key>Scale</key>
+	<real>1.5</real>
+	<key>Flags</key>
+	<array>
+		<true/>
+		<false/>
+	</array>
+	<key>Icon</key>
+	<data>
+	aGVs
+	bG8=
+	</data>
+	<key>Empty</key>
+	<dict/>
+</dict>
+</plist>
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{
+		"Name":  "Show & Tell",
+		"Count": 3.0,
+		"Scale": 1.5,
+		"Flags": []any{true, false},
+		"Icon":  []byte("hello"),
+		"Empty": map[string]any{},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("decode = %#v\nwant %#v", got, want)
+	}
+}
+
+func TestDecodeJSON(t *testing.T) {
+	got, err := decode([]byte(` {"a": [1, "b", true]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{"a": []any{1.0, "b", true}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("decode = %#v, want %#v", got, want)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	for _, tt := range []struct{ data, err string }{
+		{"bplist00\x00", "plutil"},
+		{`<plist><dict><string>x</string></dict></plist>`, "want <key>"},
+		{`<plist><dict><key>a</key></dict></plist>`, "no value"},
+		{`<plist><integer>x</integer></plist>`, "invalid <integer>"},
+		{`<plist><set/></plist>`, "unknown plist element <set>"},
+		{`<array/>`, "want <plist>"},
+	} {
+		_, err := decode([]byte(tt.data))
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("decode(%q) = %v, want error containing %q", tt.data, err, tt.err)
+		}
+	}
+}
@@ -0,0 +1,286 @@
+// Package wfactions generates the action catalog from a dump of the
+// actions Shortcuts defines: WFActions.plist from the Shortcuts app, as an
+// XML property list or converted to JSON. It also reports how two
+// versions of the catalog differ.
+//
+// The dump maps each action identifier to a dictionary with its
+// Parameters and RequiredResources. Each parameter has a Class, such as
+// WFTextInputParameter, a Key, and optionally a Label, the Items of an
+// enumeration and a DefaultValue.
+package wfactions
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/analysis"
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/catalog"
+)
+
+// identifierPrefix is the prefix of the identifiers of built-in actions.
+const identifierPrefix = "is.workflow.actions."
+
+// parameterTypes maps parameter classes to types. Other classes take
+// variables.
+var parameterTypes = map[string]catalog.ParamType{
+	"WFTextInputParameter":   catalog.Text,
+	"WFNumberFieldParameter": catalog.Number,
+	"WFStepperParameter":     catalog.Number,
+	"WFSliderParameter":      catalog.Number,
+	"WFSwitchParameter":      catalog.Bool,
+	"WFDictionaryParameter":  catalog.Dictionary,
+	"WFArrayParameter":       catalog.Array,
+	"WFEnumerationParameter": catalog.Enum,
+}
+
+// textTypes are the types of text parameters the dump does not tell apart
+// from plain text.
+var textTypes = map[catalog.ParamType]bool{
+	catalog.Regex:        true,
+	catalog.DateFormat:   true,
+	catalog.NumberFormat: true,
+}
+
+// allPlatforms are the platforms Cherri builds shortcuts for.
+var allPlatforms = []string{"ios", "macos"}
+
+// idioms maps device idioms to platforms.
+var idioms = map[string]string{
+	"Phone":   "ios",
+	"Pad":     "ios",
+	"Desktop": "macos",
+}
+
+// Generate returns the catalog of the actions in a dump, sorted by name.
+// Actions and parameters already in previous keep their Cherri names,
+// purity, fixed parameters, optional flags and parameter order, and text
+// parameters keep a more specific type such as regex. An identifier with
+// several entries in previous, such as text.replace for replaceText and
+// regReplaceText, generates one action per entry. New actions and
+// parameters are named after their labels, and new parameters with a
+// default value or a switch are optional.
+func Generate(dump []byte, previous []*catalog.Action) ([]*catalog.Action, error) {
+	v, err := decode(dump)
+	if err != nil {
+		return nil, err
+	}
+	defs, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("dump is not a dictionary of actions")
+	}
+	known := map[string][]*catalog.Action{}
+	for _, a := range previous {
+		known[a.Identifier] = append(known[a.Identifier], a)
+	}
+
+	identifiers := make([]string, 0, len(defs))
+	for id := range defs {
+		identifiers = append(identifiers, id)
+	}
+	// Known actions claim their names before new ones are named.
+	sort.Slice(identifiers, func(i, j int) bool {
+		ki, kj := len(known[identifiers[i]]) > 0, len(known[identifiers[j]]) > 0
+		if ki != kj {
+			return ki
+		}
+		return identifiers[i] < identifiers[j]
+	})
+	names := map[string]bool{}
+	var actions []*catalog.Action
+	for _, id := range identifiers {
+		def, ok := defs[id].(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s: action is not a dictionary", id)
+		}
+		if b, _ := def["Discontinued"].(bool); b {
+			continue
+		}
+		aliases := known[id]
+		if len(aliases) == 0 {
+			aliases = []*catalog.Action{nil}
+		}
+		for _, old := range aliases {
+			a, err := action(id, def, old)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", id, err)
+			}
+			if a == nil {
+				// Not available on any platform, e.g. watch-only actions.
+				break
+			}
+			if old == nil {
+				a.Name = analysis.UniqueName(a.Name, names)
+			}
+			names[a.Name] = true
+			actions = append(actions, a)
+		}
+	}
+	sort.Slice(actions, func(i, j int) bool { return actions[i].Name < actions[j].Name })
+	return actions, nil
+}
+
+// action returns the catalog entry of the action id defined by def, given
+// its previous entry, if any, or nil if the action is not available on
+// any platform.
+func action(id string, def map[string]any, old *catalog.Action) (*catalog.Action, error) {
+	a := &catalog.Action{Identifier: id, Parameters: []catalog.Parameter{}}
+	if old != nil {
+		a.Name, a.Pure, a.Fixed = old.Name, old.Pure, old.Fixed
+	} else {
+		a.Name = actionName(id, def)
+	}
+	platforms, err := platforms(def["RequiredResources"])
+	if err != nil || len(platforms) == 0 {
+		return nil, err
+	}
+	if len(platforms) < len(allPlatforms) {
+		a.Platforms = platforms
+	}
+
+	params, _ := def["Parameters"].([]any)
+	generated := map[string]catalog.Parameter{}
+	var order []string
+	for _, p := range params {
+		pd, ok := p.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("parameter is not a dictionary")
+		}
+		key, _ := pd["Key"].(string)
+		if key == "" {
+			continue
+		}
+		if _, dup := generated[key]; dup {
+			return nil, fmt.Errorf("duplicate parameter %s", key)
+		}
+		generated[key] = parameter(pd)
+		order = append(order, key)
+	}
+
+	names := map[string]bool{}
+	if old != nil {
+		for _, p := range old.Parameters {
+			g, ok := generated[p.Key]
+			if !ok {
+				continue
+			}
+			g.Name, g.Optional = p.Name, p.Optional
+			if textTypes[p.Type] && g.Type == catalog.Text {
+				g.Type = p.Type
+			}
+			a.Parameters = append(a.Parameters, g)
+			names[g.Name] = true
+			delete(generated, p.Key)
+		}
+	}
+	for _, key := range order {
+		g, ok := generated[key]
+		if !ok {
+			continue
+		}
+		g.Name = analysis.UniqueName(g.Name, names)
+		names[g.Name] = true
+		a.Parameters = append(a.Parameters, g)
+	}
+	return a, nil
+}
+
+// parameter returns the catalog entry of a parameter definition, named
+// after its label.
+func parameter(def map[string]any) catalog.Parameter {
+	class, _ := def["Class"].(string)
+	key, _ := def["Key"].(string)
+	p := catalog.Parameter{Key: key, Type: catalog.Variable}
+	if t, ok := parameterTypes[class]; ok {
+		p.Type = t
+	}
+	label, _ := def["Label"].(string)
+	if p.Name = analysis.Identifier(label); p.Name == "" {
+		p.Name = analysis.Identifier(strings.TrimPrefix(key, "WF"))
+	}
+	if p.Name == "" {
+		p.Name = "value"
+	}
+	_, hasDefault := def["DefaultValue"]
+	p.Optional = hasDefault || p.Type == catalog.Bool
+	if p.Type == catalog.Enum {
+		items, _ := def["Items"].([]any)
+		for _, item := range items {
+			if s, ok := item.(string); ok {
+				p.Enum = append(p.Enum, s)
+			}
+		}
+	}
+	return p
+}
+
+// actionName names a new action after its Name in the dump or else its
+// identifier, e.g. "is.workflow.actions.text.match" is "textMatch".
+func actionName(id string, def map[string]any) string {
+	if name, _ := def["Name"].(string); analysis.Identifier(name) != "" {
+		return analysis.Identifier(name)
+	}
+	words := strings.TrimPrefix(id, identifierPrefix)
+	if words == id {
+		// Identifiers of app actions start with the app's bundle
+		// identifier, e.g. "com.apple.mobilenotes.SharingExtension".
+		words = words[strings.LastIndex(words, ".")+1:]
+	}
+	if name := analysis.Identifier(words); name != "" {
+		return name
+	}
+	return "action"
+}
+
+// platforms returns the sorted platforms the device attribute resources
+// of an action limit it to. A resource names its
+// idioms as WFDeviceAttributes.WFDeviceAttributeIdiom, and excludes them
+// instead with a WFDeviceAttributeRelation of "!=".
+func platforms(resources any) ([]string, error) {
+	list, _ := resources.([]any)
+	allowed := map[string]bool{}
+	for _, p := range allPlatforms {
+		allowed[p] = true
+	}
+	for _, r := range list {
+		rd, ok := r.(map[string]any)
+		if !ok || rd["WFResourceClass"] != "WFDeviceAttributesResource" {
+			continue
+		}
+		attrs, _ := rd["WFDeviceAttributes"].(map[string]any)
+		var named []string
+		switch idiom := attrs["WFDeviceAttributeIdiom"].(type) {
+		case string:
+			named = []string{idiom}
+		case []any:
+			for _, i := range idiom {
+				if s, ok := i.(string); ok {
+					named = append(named, s)
+				}
+			}
+		case nil:
+			continue
+		default:
+			return nil, fmt.Errorf("invalid WFDeviceAttributeIdiom %v", idiom)
+		}
+		matched := map[string]bool{}
+		for _, idiom := range named {
+			if p, ok := idioms[idiom]; ok {
+				matched[p] = true
+			}
+		}
+		exclude := attrs["WFDeviceAttributeRelation"] == "!=" || rd["WFDeviceAttributeRelation"] == "!="
+		for p := range allowed {
+			if matched[p] == exclude {
+				delete(allowed, p)
+			}
+		}
+	}
+	var out []string
+	for _, p := range allPlatforms {
+		if allowed[p] {
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,208 @@
+package wfactions
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/catalog"
+)
+
+const dump = `<?xml version="1.0" encoding="UTF-8"?>
+<plist version="1.0">
+<dict>
+	<key>is.workflow.actions.text.match</key>
+	<dict>
+		<key>Parameters</key>
+		<array>
+			<dict>
+				<key>Class</key><string>WFVariablePickerParameter</string>
+				<key>Key</key><string>text</string>
+				<key>Label</key><string>Text</string>
+			</dict>
+			<dict>
+				<key>Class</key><string>WFTextInputParameter</string>
+				<key>Key</key><string>WFMatchTextPattern</string>
+				<key>Label</key><string>Pattern</string>
+			</dict>
+			<dict>
+				<key>Class</key><string>WFSwitchParameter</string>
+				<key>Key</key><string>WFMatchTextCaseSensitive</string>
+				<key>Label</key><string>Case Sensitive</string>
+			</dict>
+		</array>
+	</dict>
+	<key>is.workflow.actions.getipaddress</key>
+	<dict>
+		<key>Name</key><string>Get Current IP Address</string>
+		<key>Parameters</key>
+		<array>
+			<dict>
+				<key>Class</key><string>WFEnumerationParameter</string>
+				<key>Key</key><string>WFIPAddressSourceOption</string>
+				<key>Label</key><string>Address</string>
+				<key>Items</key><array><string>External</string><string>Local</string></array>
+				<key>DefaultValue</key><string>External</string>
+			</dict>
+			<dict>
+				<key>Class</key><string>WFStepperParameter</string>
+				<key>Key</key><string>WFIPAddressRetries</string>
+			</dict>
+		</array>
+		<key>RequiredResources</key>
+		<array>
+			<dict>
+				<key>WFResourceClass</key><string>WFDeviceAttributesResource</string>
+				<key>WFDeviceAttributes</key>
+				<dict>
+					<key>WFDeviceAttributeIdiom</key><string>Desktop</string>
+				</dict>
+			</dict>
+		</array>
+	</dict>
+	<key>com.apple.mobilenotes.SharingExtension</key>
+	<dict>
+		<key>RequiredResources</key>
+		<array>
+			<dict>
+				<key>WFResourceClass</key><string>WFDeviceAttributesResource</string>
+				<key>WFDeviceAttributes</key>
+				<dict>
+					<key>WFDeviceAttributeIdiom</key><string>Desktop</string>
+					<key>WFDeviceAttributeRelation</key><string>!=</string>
+				</dict>
+			</dict>
+		</array>
+	</dict>
+	<key>is.workflow.actions.watch.haptic</key>
+	<dict>
+		<key>RequiredResources</key>
+		<array>
+			<dict>
+				<key>WFResourceClass</key><string>WFDeviceAttributesResource</string>
+				<key>WFDeviceAttributes</key>
+				<dict>
+					<key>WFDeviceAttributeIdiom</key><string>Watch</string>
+				</dict>
+			</dict>
+		</array>
+	</dict>
+	<key>is.workflow.actions.oldthing</key>
+	<dict>
+		<key>Discontinued</key><true/>
+	</dict>
+</dict>
+</plist>
+`
+
+func TestGenerate(t *testing.T) {
+	previous := []*catalog.Action{{
+		Name:       "matchText",
+		Identifier: "is.workflow.actions.text.match",
+		Pure:       true,
+		Parameters: []catalog.Parameter{
+			{Name: "regex", Key: "WFMatchTextPattern", Type: catalog.Regex},
+			{Name: "text", Key: "text", Type: catalog.Text},
+			{Name: "caseSensitive", Key: "WFMatchTextCaseSensitive", Type: catalog.Bool, Optional: true},
+			{Name: "gone", Key: "WFGone", Type: catalog.Text},
+		},
+	}}
+	got, err := Generate([]byte(dump), previous)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*catalog.Action{
+		{
+			Name:       "getCurrentIPAddress",
+			Identifier: "is.workflow.actions.getipaddress",
+			Platforms:  []string{"macos"},
+			Parameters: []catalog.Parameter{
+				{Name: "address", Key: "WFIPAddressSourceOption", Type: catalog.Enum, Optional: true, Enum: []string{"External", "Local"}},
+				{Name: "ipAddressRetries", Key: "WFIPAddressRetries", Type: catalog.Number},
+			},
+		},
+		{
+			Name:       "matchText",
+			Identifier: "is.workflow.actions.text.match",
+			Pure:       true,
+			Parameters: []catalog.Parameter{
+				{Name: "regex", Key: "WFMatchTextPattern", Type: catalog.Regex},
+				{Name: "text", Key: "text", Type: catalog.Variable},
+				{Name: "caseSensitive", Key: "WFMatchTextCaseSensitive", Type: catalog.Bool, Optional: true},
+			},
+		},
+		{
+			Name:       "sharingExtension",
+			Identifier: "com.apple.mobilenotes.SharingExtension",
+			Platforms:  []string{"ios"},
+			Parameters: []catalog.Parameter{},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Generate =")
+		for _, a := range got {
+			t.Errorf("%+v", *a)
+		}
+	}
+}
+
+func TestGenerateSharedIdentifier(t *testing.T) {
+	var previous []*catalog.Action
+	for _, a := range catalog.Actions() {
+		if a.Identifier == "is.workflow.actions.text.replace" {
+			previous = append(previous, a)
+		}
+	}
+	if len(previous) < 2 {
+		t.Fatalf("the catalog has %d text.replace actions", len(previous))
+	}
+	got, err := Generate([]byte(`{"is.workflow.actions.text.replace": {"Parameters": [
+		{"Class": "WFTextInputParameter", "Key": "WFReplaceTextFind", "Label": "Find"},
+		{"Class": "WFTextInputParameter", "Key": "WFReplaceTextReplace", "Label": "Replace With"},
+		{"Class": "WFVariablePickerParameter", "Key": "WFInput", "Label": "Input"}
+	]}}`), previous)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, previous) {
+		t.Errorf("Generate =")
+		for _, a := range got {
+			t.Errorf("%+v", *a)
+		}
+	}
+	if d := Compare(previous, got); !d.Empty() {
+		t.Errorf("Compare =\n%s", d)
+	}
+}
+
+func TestGenerateUniqueNames(t *testing.T) {
+	previous := []*catalog.Action{{Name: "textMatch", Identifier: "is.workflow.actions.text.match"}}
+	got, err := Generate([]byte(`{
+		"is.workflow.actions.text.match": {},
+		"is.workflow.actions.textmatch": {"Name": "Text Match", "Parameters": [
+			{"Class": "WFTextInputParameter", "Key": "WFText", "Label": "Text"},
+			{"Class": "WFTextInputParameter", "Key": "WFOther", "Label": "Text"}
+		]}
+	}`), previous)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0].Name != "textMatch" || got[1].Name != "textMatch2" {
+		t.Fatalf("Generate named actions %v", got)
+	}
+	if p := got[1].Parameters; p[0].Name != "text" || p[1].Name != "text2" {
+		t.Errorf("Generate named parameters %+v", p)
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	for _, data := range []string{
+		`[]`,
+		`{"a": 1}`,
+		`{"a": {"Parameters": [1]}}`,
+		`{"a": {"Parameters": [{"Key": "x"}, {"Key": "x"}]}}`,
+	} {
+		if _, err := Generate([]byte(data), nil); err == nil {
+			t.Errorf("Generate(%s) succeeded", data)
+		}
+	}
+}
@@ -0,0 +1,345 @@
+// Command cherri-ts is a toolchain for Cherri projects built on the
+// tree-sitter grammar.
+//
+// Usage:
+//
+//	cherri-ts build [-C dir] [-j jobs] [-force]
+//	cherri-ts gallery [-C dir] [-o dir]
+//	cherri-ts preview [-o file] file.cherri
+//	cherri-ts explore file.cherri
+//	cherri-ts catalog [-base actions.json] [-o actions.json] WFActions.plist
+//	cherri-ts scpl [-o file.cherri] file.scpl
+//	cherri-ts relnotes [-C dir] [-o file.md] old new
+//	cherri-ts mutate [-timeout duration] file.cherri command [args...]
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/build"
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/catalog"
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/catalog/wfactions"
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/explore"
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/gallery"
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/mutate"
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/preview"
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/relnotes"
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/scpl"
+)
+
+const usage = `usage: cherri-ts <command> [arguments]
+
+commands:
+  build    compile every entry point of a project
+  gallery  generate a static site listing the shortcuts of a project
+  preview  render a shortcut as HTML action cards
+  explore  browse the syntax tree of a file in the terminal
+  catalog  generate the action catalog from a WFActions dump
+  scpl     convert a ScPL file to Cherri
+  relnotes summarize the changes to shortcuts between two git revisions
+  mutate   check how many mutants of a shortcut its tests catch
+`
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run runs the command line args and returns the exit status: 1 when the
+// command fails, 2 when it is misused.
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+	switch args[0] {
+	case "build":
+		return runBuild(args[1:], stdout, stderr)
+	case "gallery":
+		return runGallery(args[1:], stdout, stderr)
+	case "preview":
+		return runPreview(args[1:], stdout, stderr)
+	case "explore":
+		return runExplore(args[1:], stderr)
+	case "catalog":
+		return runCatalog(args[1:], stdout, stderr)
+	case "scpl":
+		return runSCPL(args[1:], stdout, stderr)
+	case "relnotes":
+		return runRelnotes(args[1:], stdout, stderr)
+	case "mutate":
+		return runMutate(args[1:], stdout, stderr)
+	case "help", "-h", "-help", "--help":
+		fmt.Fprint(stdout, usage)
+		return 0
+	}
+	fmt.Fprintf(stderr, "cherri-ts: unknown command %q\n%s", args[0], usage)
+	return 2
+}
+
+func runBuild(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("build", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	dir := flags.String("C", ".", "project `directory`")
+	jobs := flags.Int("j", 0, "number of targets compiled at once (default: number of CPUs)")
+	force := flags.Bool("force", false, "rebuild targets that are up to date")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() > 0 {
+		fmt.Fprintf(stderr, "cherri-ts build: unexpected argument %q\n", flags.Arg(0))
+		return 2
+	}
+
+	report, err := build.Build(*dir, build.Options{Jobs: *jobs, Force: *force})
+	if report != nil {
+		for _, d := range report.Diagnostics() {
+			fmt.Fprintln(stderr, d)
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "cherri-ts build: %v\n", err)
+		return 1
+	}
+	built, skipped, failed := 0, 0, 0
+	for _, t := range report.Targets {
+		switch {
+		case t.Skipped:
+			skipped++
+		case t.Failed():
+			failed++
+		default:
+			built++
+		}
+	}
+	fmt.Fprintf(stdout, "%d built, %d up to date, %d failed\n", built, skipped, failed)
+	if report.HasErrors() {
+		return 1
+	}
+	return 0
+}
+
+func runGallery(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("gallery", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	dir := flags.String("C", ".", "project `directory`")
+	out := flags.String("o", "gallery", "output `directory`, relative to the project")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() > 0 {
+		fmt.Fprintf(stderr, "cherri-ts gallery: unexpected argument %q\n", flags.Arg(0))
+		return 2
+	}
+
+	if !filepath.IsAbs(*out) {
+		*out = filepath.Join(*dir, *out)
+	}
+	project, err := build.LoadProject(*dir)
+	if err == nil {
+		err = gallery.Generate(project, *out)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "cherri-ts gallery: %v\n", err)
+		return 1
+	}
+	fmt.Fprintf(stdout, "%d shortcuts\n", len(project.Entries))
+	return 0
+}
+
+func runPreview(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("preview", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	out := flags.String("o", "", "output `file` (default: standard output)")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 1 {
+		fmt.Fprintln(stderr, "usage: cherri-ts preview [-o file] file.cherri")
+		return 2
+	}
+
+	file := flags.Arg(0)
+	source, err := os.ReadFile(file)
+	if err != nil {
+		fmt.Fprintf(stderr, "cherri-ts preview: %v\n", err)
+		return 1
+	}
+	page := preview.Page(filepath.Base(file), source)
+	if *out == "" {
+		fmt.Fprint(stdout, page)
+		return 0
+	}
+	if err := os.WriteFile(*out, []byte(page), 0o644); err != nil {
+		fmt.Fprintf(stderr, "cherri-ts preview: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+func runExplore(args []string, stderr io.Writer) int {
+	if len(args) != 1 || strings.HasPrefix(args[0], "-") {
+		fmt.Fprintln(stderr, "usage: cherri-ts explore file.cherri")
+		return 2
+	}
+	if err := explore.Run(args[0], os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintf(stderr, "cherri-ts explore: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+func runCatalog(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("catalog", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	base := flags.String("base", "", "previous catalog `file` (default: the embedded catalog)")
+	out := flags.String("o", "", "write the catalog to `file` (default: only report differences)")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 1 {
+		fmt.Fprintln(stderr, "usage: cherri-ts catalog [-base actions.json] [-o actions.json] WFActions.plist")
+		return 2
+	}
+
+	fail := func(err error) int {
+		fmt.Fprintf(stderr, "cherri-ts catalog: %v\n", err)
+		return 1
+	}
+	previous := catalog.Actions()
+	if *base != "" {
+		data, err := os.ReadFile(*base)
+		if err == nil {
+			previous, err = catalog.Decode(data)
+		}
+		if err != nil {
+			return fail(err)
+		}
+	}
+	dump, err := os.ReadFile(flags.Arg(0))
+	if err != nil {
+		return fail(err)
+	}
+	actions, err := wfactions.Generate(dump, previous)
+	if err != nil {
+		return fail(fmt.Errorf("%s: %v", flags.Arg(0), err))
+	}
+	fmt.Fprint(stdout, wfactions.Compare(previous, actions))
+	if *out == "" {
+		return 0
+	}
+	data, err := catalog.Encode(actions)
+	if err == nil {
+		err = os.WriteFile(*out, data, 0o644)
+	}
+	if err != nil {
+		return fail(err)
+	}
+	return 0
+}
+
+func runSCPL(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("scpl", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	out := flags.String("o", "", "output `file` (default: standard output)")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 1 {
+		fmt.Fprintln(stderr, "usage: cherri-ts scpl [-o file.cherri] file.scpl")
+		return 2
+	}
+
+	file := flags.Arg(0)
+	source, err := os.ReadFile(file)
+	if err != nil {
+		fmt.Fprintf(stderr, "cherri-ts scpl: %v\n", err)
+		return 1
+	}
+	cherri, problems, err := scpl.Convert(source)
+	var syntax *scpl.SyntaxError
+	if errors.As(err, &syntax) {
+		fmt.Fprintf(stderr, "%s:%v\n", file, err)
+		return 1
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "cherri-ts scpl: %s: %v\n", file, err)
+		return 1
+	}
+	if *out == "" {
+		stdout.Write(cherri)
+	} else if err := os.WriteFile(*out, cherri, 0o644); err != nil {
+		fmt.Fprintf(stderr, "cherri-ts scpl: %v\n", err)
+		return 1
+	}
+	// The translation is written even if parts of it are missing, so
+	// they can be finished by hand.
+	for _, p := range problems {
+		fmt.Fprintf(stderr, "%s:%s: not translated: %s\n", file, p.Pos, p.Message)
+	}
+	if len(problems) > 0 {
+		return 1
+	}
+	return 0
+}
+
+func runRelnotes(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("relnotes", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	dir := flags.String("C", ".", "git repository `directory`")
+	out := flags.String("o", "", "output `file` (default: standard output)")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 2 {
+		fmt.Fprintln(stderr, "usage: cherri-ts relnotes [-C dir] [-o file.md] old new")
+		return 2
+	}
+
+	notes, err := relnotes.FromGit(*dir, flags.Arg(0), flags.Arg(1))
+	if err != nil {
+		fmt.Fprintf(stderr, "cherri-ts relnotes: %v\n", err)
+		return 1
+	}
+	if *out == "" {
+		fmt.Fprint(stdout, notes.Markdown())
+		return 0
+	}
+	if err := os.WriteFile(*out, []byte(notes.Markdown()), 0o644); err != nil {
+		fmt.Fprintf(stderr, "cherri-ts relnotes: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+func runMutate(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("mutate", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	timeout := flags.Duration("timeout", time.Minute, "kill a test run after `duration`, counting the mutant as killed")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() < 2 {
+		fmt.Fprintln(stderr, "usage: cherri-ts mutate [-timeout duration] file.cherri command [args...]")
+		return 2
+	}
+
+	source, err := os.ReadFile(flags.Arg(0))
+	if err != nil {
+		fmt.Fprintf(stderr, "cherri-ts mutate: %v\n", err)
+		return 1
+	}
+	report, err := mutate.Run(source, mutate.CommandRunner(*timeout, flags.Arg(1), flags.Args()[2:]...))
+	if err != nil {
+		fmt.Fprintf(stderr, "cherri-ts mutate: %v\n", err)
+		return 1
+	}
+	fmt.Fprint(stdout, report)
+	return 0
+}
@@ -0,0 +1,227 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunBuild(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "main.cherri"), []byte("alert(\"hi\")\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"build", "-C", dir}, &stdout, &stderr); code != 0 {
+		t.Fatalf("exit %d: %s", code, &stderr)
+	}
+	if got := stdout.String(); got != "1 built, 0 up to date, 0 failed\n" {
+		t.Errorf("stdout = %q", got)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "build", "main.plist")); err != nil {
+		t.Error(err)
+	}
+
+	stdout.Reset()
+	run([]string{"build", "-C", dir}, &stdout, &stderr)
+	if got := stdout.String(); got != "0 built, 1 up to date, 0 failed\n" {
+		t.Errorf("second build: stdout = %q", got)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "main.cherri"), []byte("frobnicate()\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	stdout.Reset()
+	stderr.Reset()
+	if code := run([]string{"build", "-C", dir}, &stdout, &stderr); code != 1 {
+		t.Errorf("build with errors exited %d", code)
+	}
+	if got := stderr.String(); !strings.Contains(got, "main.cherri:1:1: error: unknown action frobnicate") {
+		t.Errorf("stderr = %q", got)
+	}
+}
+
+func TestRunUsage(t *testing.T) {
+	for _, args := range [][]string{nil, {"frobnicate"}, {"build", "extra"}, {"build", "-nope"}, {"explore"}} {
+		var stdout, stderr bytes.Buffer
+		if code := run(args, &stdout, &stderr); code != 2 {
+			t.Errorf("run(%q) exited %d, want 2", args, code)
+		}
+	}
+}
+
+func TestRunGallery(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "main.cherri"), []byte("#define name Hello\nalert(\"hi\")\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"gallery", "-C", dir, "-o", "site"}, &stdout, &stderr); code != 0 {
+		t.Fatalf("exit %d: %s", code, &stderr)
+	}
+	for _, name := range []string{"index.html", "catalog.json", "source/main.cherri.html"} {
+		if _, err := os.Stat(filepath.Join(dir, "site", name)); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestRunPreview(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "hello.cherri")
+	if err := os.WriteFile(file, []byte("alert(\"hi\")\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"preview", file}, &stdout, &stderr); code != 0 {
+		t.Fatalf("exit %d: %s", code, &stderr)
+	}
+	if got := stdout.String(); !strings.Contains(got, "<title>hello.cherri</title>") || !strings.Contains(got, `<span class="name">Alert</span>`) {
+		t.Errorf("stdout = %s", got)
+	}
+	if code := run([]string{"preview"}, &stdout, &stderr); code != 2 {
+		t.Errorf("preview without a file exited %d", code)
+	}
+}
+
+func TestRunCatalog(t *testing.T) {
+	dir := t.TempDir()
+	base := filepath.Join(dir, "base.json")
+	if err := os.WriteFile(base, []byte(`[
+  {
+    "name": "alert",
+    "identifier": "is.workflow.actions.alert",
+    "parameters": [
+      {
+        "name": "alert",
+        "key": "WFAlertActionMessage",
+        "type": "text"
+      }
+    ]
+  }
+]
+`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	dump := filepath.Join(dir, "WFActions.json")
+	if err := os.WriteFile(dump, []byte(`{"is.workflow.actions.alert": {"Parameters": [
+		{"Class": "WFTextInputParameter", "Key": "WFAlertActionMessage"},
+		{"Class": "WFTextInputParameter", "Key": "WFAlertActionTitle", "Label": "Title", "DefaultValue": ""}
+	]}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	out := filepath.Join(dir, "actions.json")
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"catalog", "-base", base, "-o", out, dump}, &stdout, &stderr); code != 0 {
+		t.Fatalf("exit %d: %s", code, &stderr)
+	}
+	if got, want := stdout.String(), "~ alert: parameter title (WFAlertActionTitle) added\n0 added, 0 removed, 1 changes\n"; got != want {
+		t.Errorf("stdout = %q, want %q", got, want)
+	}
+	data, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"key": "WFAlertActionTitle",`) {
+		t.Errorf("catalog =\n%s", data)
+	}
+	if code := run([]string{"catalog"}, &stdout, &stderr); code != 2 {
+		t.Errorf("catalog without a dump exited %d", code)
+	}
+}
+
+func TestRunSCPL(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "hello.scpl")
+	if err := os.WriteFile(file, []byte("text \"hi\"\nshowResult\nfrobnicate\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"scpl", file}, &stdout, &stderr); code != 1 {
+		t.Errorf("exit %d, want 1 for untranslated constructs", code)
+	}
+	if got, want := stdout.String(), "show(\"hi\")\n// scpl 3:1: unknown action frobnicate\n"; got != want {
+		t.Errorf("stdout = %q, want %q", got, want)
+	}
+	if got, want := stderr.String(), file+":3:1: not translated: unknown action frobnicate\n"; got != want {
+		t.Errorf("stderr = %q, want %q", got, want)
+	}
+
+	if err := os.WriteFile(file, []byte("text \"open\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	stderr.Reset()
+	if code := run([]string{"scpl", file}, &stdout, &stderr); code != 1 {
+		t.Errorf("syntax error exited %d", code)
+	}
+	if got := stderr.String(); !strings.Contains(got, "hello.scpl:1:6: unterminated string") {
+		t.Errorf("stderr = %q", got)
+	}
+}
+
+func TestRunRelnotes(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	dir := t.TempDir()
+	git := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", dir, "-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	git("init", "-q")
+	for i, source := range []string{"#define name Old\nalert(\"hi\")\n", "#define name New\nalert(\"hi\")\n"} {
+		if err := os.WriteFile(filepath.Join(dir, "main.cherri"), []byte(source), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		git("add", "-A")
+		git("commit", "-q", "-m", "version")
+		git("tag", fmt.Sprintf("v%d", i+1))
+	}
+
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"relnotes", "-C", dir, "v1", "v2"}, &stdout, &stderr); code != 0 {
+		t.Fatalf("exit %d: %s", code, &stderr)
+	}
+	if got := stdout.String(); !strings.Contains(got, "## main.cherri") || !strings.Contains(got, "New") {
+		t.Errorf("stdout = %s", got)
+	}
+	if code := run([]string{"relnotes", "-C", dir, "v1", "missing"}, &stdout, &stderr); code != 1 {
+		t.Errorf("relnotes with an unknown revision exited %d", code)
+	}
+	if code := run([]string{"relnotes", "v1"}, &stdout, &stderr); code != 2 {
+		t.Errorf("relnotes with one revision exited %d", code)
+	}
+}
+
+func TestRunMutate(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh is not installed")
+	}
+	file := filepath.Join(t.TempDir(), "limit.cherri")
+	if err := os.WriteFile(file, []byte("@limit = 10\nif @limit >= 5 {\n    alert(\"big\")\n}\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	// The suite only checks the limit, and hangs when the alert is gone.
+	suite := `grep -q "@limit = 10" "$0" && { grep -q alert "$0" || sleep 5; }`
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"mutate", "-timeout", "500ms", file, "sh", "-c", suite}, &stdout, &stderr); code != 0 {
+		t.Fatalf("exit %d: %s", code, stderr.String())
+	}
+	want := "4 mutant(s) killed, 2 survived, 0 invalid (score 67%)\n" +
+		"  2:11: flip comparison: >= to <\n" +
+		"  2:14: change number: 5 to 6\n"
+	if got := stdout.String(); got != want {
+		t.Errorf("stdout = %q, want %q", got, want)
+	}
+
+	stderr.Reset()
+	if code := run([]string{"mutate", file}, &stdout, &stderr); code != 2 {
+		t.Errorf("missing command exited %d, want 2", code)
+	}
+}
@@ -0,0 +1,1079 @@
+// Package compiler compiles Cherri shortcuts to unsigned Shortcuts property
+// lists.
+//
+// It supports the core of the language: metadata pragmas, import
+// questions, variables and constants, catalog action calls, string
+// interpolation, dictionaries, arithmetic, conditionals, loops and menus.
+// Constructs outside that subset, such as action definitions, are reported
+// as errors rather than compiled approximately.
+//
+// Output is reproducible: the same sources compile to the same bytes on
+// any machine, so compiled shortcuts can be diffed and cached.
+package compiler
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/analysis"
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/catalog"
+)
+
+// A Platform is a device family a shortcut is compiled for.
+type Platform string
+
+const (
+	IOS   Platform = "ios"
+	MacOS Platform = "macos"
+)
+
+// Options configure Compile.
+type Options struct {
+	// Platform drops workflow types the platform does not support. The
+	// empty platform keeps them all.
+	Platform Platform
+	// Defines override `#define` values, keyed by name.
+	Defines map[string]string
+}
+
+// A Diagnostic is a problem found while compiling a file.
+type Diagnostic struct {
+	File string
+	analysis.Diagnostic
+}
+
+func (d Diagnostic) String() string {
+	p := d.Range.StartPoint
+	return fmt.Sprintf("%s:%d:%d: %s: %s", d.File, p.Row+1, p.Column+1, d.Severity, d.Message)
+}
+
+// A Result is the outcome of Compile.
+type Result struct {
+	// Plist is the compiled shortcut, or nil if there were errors.
+	Plist []byte
+	// Name is the value of `#define name`, if any.
+	Name        string
+	Diagnostics []Diagnostic
+}
+
+// HasErrors reports whether any diagnostic is an error.
+func (r *Result) HasErrors() bool {
+	for _, d := range r.Diagnostics {
+		if d.Severity == analysis.SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// Version identifies the output of the compiler, for caches.
+const Version = "3"
+
+// clientVersion is the Shortcuts version compiled shortcuts claim to be
+// written by.
+const clientVersion = "1146.14"
+
+// colors maps `#define color` names to icon colors. The values are the
+// WFWorkflowIconStartColor integers Shortcuts writes for the 15 swatches
+// of its icon color picker, in picker order, and the same table the
+// reference compiler uses. They pack 8-bit red, green, blue and alpha
+// channels, and are kept in decimal as they appear in shortcut files.
+// The dark gray swatch is stored as opaque black, 255.
+var colors = map[string]int{
+	"red":        4282601983, // 0xFF4351FF
+	"darkorange": 4251333119, // 0xFD6631FF
+	"orange":     4271458815, // 0xFE9949FF
+	"yellow":     4274264319, // 0xFEC418FF
+	"green":      4292093695, // 0xFFD426FF
+	"teal":       431817727,  // 0x19BD03FF
+	"lightblue":  1440408063, // 0x55DAE1FF
+	"blue":       463140863,  // 0x1B9AF7FF
+	"darkblue":   946986751,  // 0x3871DEFF
+	"violet":     2071128575, // 0x7B72E9FF
+	"purple":     3679049983, // 0xDB49D8FF
+	"pink":       3980825855, // 0xED4694FF
+	"taupe":      3031607807, // 0xB4B2A9FF
+	"gray":       2846468607, // 0xA9A9A9FF
+	"darkgray":   255,        // 0x000000FF
+}
+
+// IconColor returns the icon color named by `#define color` as 0xRRGGBBAA.
+func IconColor(name string) (uint32, bool) {
+	color, ok := colors[name]
+	return uint32(color), ok
+}
+
+// contentClasses maps `#define inputs` and `#define outputs` names to
+// content item classes.
+var contentClasses = map[string]string{
+	"app":         "WFAppStoreAppContentItem",
+	"article":     "WFArticleContentItem",
+	"contact":     "WFContactContentItem",
+	"date":        "WFDateContentItem",
+	"dictionary":  "WFDictionaryContentItem",
+	"email":       "WFEmailAddressContentItem",
+	"file":        "WFGenericFileContentItem",
+	"folder":      "WFFolderContentItem",
+	"image":       "WFImageContentItem",
+	"location":    "WFLocationContentItem",
+	"maplink":     "WFDCMapsLinkContentItem",
+	"media":       "WFAVAssetContentItem",
+	"number":      "WFNumberContentItem",
+	"pdf":         "WFPDFContentItem",
+	"phonenumber": "WFPhoneNumberContentItem",
+	"richtext":    "WFRichTextContentItem",
+	"text":        "WFStringContentItem",
+	"url":         "WFURLContentItem",
+	"webpage":     "WFSafariWebPageContentItem",
+}
+
+// workflowTypes maps `#define from` names to workflow types and the
+// platforms that support them.
+var workflowTypes = map[string]struct {
+	name      string
+	platforms []Platform
+}{
+	"menubar":       {"MenuBar", []Platform{MacOS}},
+	"quickactions":  {"QuickActions", []Platform{MacOS}},
+	"sharesheet":    {"ActionExtension", []Platform{IOS, MacOS}},
+	"notifications": {"NCWidget", []Platform{IOS}},
+	"sleepmode":     {"Sleep", []Platform{IOS}},
+	"watch":         {"Watch", []Platform{IOS}},
+	"onscreen":      {"ReceivesOnScreenContent", []Platform{IOS, MacOS}},
+}
+
+// conditions maps comparison operators to WFCondition codes.
+var conditions = map[string]int{
+	"<":  0,
+	"<=": 1,
+	">":  2,
+	">=": 3,
+	"==": 4,
+	"!=": 5,
+}
+
+// hasAnyValue is the WFCondition of `if value`.
+const hasAnyValue = 100
+
+// mathOperations maps arithmetic operators to WFMathOperation values.
+var mathOperations = map[string]string{
+	"+": "+",
+	"-": "-",
+	"*": "×",
+	"/": "÷",
+}
+
+// A question is a `#question` import question.
+type question struct {
+	name, prompt, value string
+	file                string
+	node                *tree_sitter.Node
+	// actionIndex and key locate the action parameter the question
+	// fills in, once it is used.
+	actionIndex int
+	key         string
+}
+
+type compiler struct {
+	files   map[string][]byte
+	opts    Options
+	result  *Result
+	actions []any
+
+	// file and source are those of the file being compiled.
+	file   string
+	source []byte
+
+	included  map[string]bool
+	constants map[string]dict
+	questions map[string]*question
+	order     []*question
+	defines   map[string]string
+	// defineNodes records where each define was set, for diagnostics.
+	defineNodes map[string]diagnosticSite
+}
+
+type diagnosticSite struct {
+	file string
+	node *tree_sitter.Node
+}
+
+// Compile compiles the file entry of files, keyed by slash-separated path,
+// along with the files it includes.
+func Compile(files map[string][]byte, entry string, opts Options) *Result {
+	result, workflow := compile(files, entry, opts)
+	if result.HasErrors() {
+		return result
+	}
+	plist, err := encodePlist(workflow)
+	if err != nil {
+		result.Diagnostics = append(result.Diagnostics, Diagnostic{File: entry, Diagnostic: analysis.Diagnostic{
+			Severity: analysis.SeverityError, Code: "encode", Message: err.Error(),
+		}})
+		return result
+	}
+	result.Plist = plist
+	return result
+}
+
+// compile returns the diagnostics and top-level dictionary of a shortcut.
+func compile(files map[string][]byte, entry string, opts Options) (*Result, dict) {
+	c := &compiler{
+		files:       files,
+		opts:        opts,
+		result:      &Result{},
+		included:    map[string]bool{},
+		constants:   map[string]dict{},
+		questions:   map[string]*question{},
+		defines:     map[string]string{},
+		defineNodes: map[string]diagnosticSite{},
+	}
+	if _, ok := files[entry]; !ok {
+		c.errorIn(entry, nil, "missing-file", "file not found")
+		return c.result, nil
+	}
+	var trees []*tree_sitter.Tree
+	defer func() {
+		for _, tree := range trees {
+			tree.Close()
+		}
+	}()
+	c.compileFile(entry, &trees)
+	for name, value := range opts.Defines {
+		c.defines[name] = value
+	}
+	c.result.Name = c.defines["name"]
+
+	workflow := c.workflow()
+	for _, q := range c.order {
+		if q.actionIndex < 0 {
+			c.errorIn(q.file, q.node, "unused-question", "import question %s is never passed to an action", q.name)
+		}
+	}
+	return c.result, workflow
+}
+
+// compileFile compiles the statements of a file, compiling included files
+// where they are included. trees collects the parsed trees, which must
+// outlive compilation as diagnostics refer to their nodes.
+func (c *compiler) compileFile(file string, trees *[]*tree_sitter.Tree) {
+	c.included[file] = true
+	source := c.files[file]
+	tree := analysis.Parse(source)
+	*trees = append(*trees, tree)
+	root := tree.RootNode()
+
+	for i := uint(0); i < root.NamedChildCount(); i++ {
+		c.file, c.source = file, source
+		n := root.NamedChild(i)
+		if n.Kind() != "pragma" {
+			c.statement(n)
+			continue
+		}
+		rest := analysis.PragmaArguments(n)
+		i += uint(len(rest))
+		c.pragma(n, rest, trees)
+	}
+}
+
+func (c *compiler) pragma(n *tree_sitter.Node, rest []*tree_sitter.Node, trees *[]*tree_sitter.Tree) {
+	value := n.ChildByFieldName("value")
+	switch directive := n.NamedChild(0).Utf8Text(c.source); directive {
+	case "#include":
+		include, ok := analysis.StringValue(value, c.source)
+		if !ok {
+			c.error(value, "invalid-include", "include path must be a string")
+			return
+		}
+		target := path.Join(path.Dir(c.file), include)
+		if _, ok := c.files[target]; !ok {
+			c.error(value, "missing-include", "included file %s not found", target)
+			return
+		}
+		if !c.included[target] {
+			file, source := c.file, c.source
+			c.compileFile(target, trees)
+			c.file, c.source = file, source
+		}
+	case "#define":
+		key := value.Utf8Text(c.source)
+		text := ""
+		if len(rest) == 1 {
+			text, _ = analysis.StringValue(rest[0], c.source)
+		}
+		if text == "" && len(rest) > 0 {
+			text = string(c.source[rest[0].StartByte():rest[len(rest)-1].EndByte()])
+		}
+		c.defines[key] = text
+		c.defineNodes[key] = diagnosticSite{c.file, n}
+	case "#question":
+		name := value.Utf8Text(c.source)
+		if value.Kind() != "identifier" || len(rest) == 0 {
+			c.error(n, "invalid-question", `expected #question name "prompt" "default"`)
+			return
+		}
+		q := &question{name: name, file: c.file, node: n, actionIndex: -1}
+		q.prompt, _ = analysis.StringValue(rest[0], c.source)
+		if len(rest) > 1 {
+			q.value, _ = analysis.StringValue(rest[1], c.source)
+		}
+		c.questions[name] = q
+		c.order = append(c.order, q)
+	default:
+		c.error(n, "unsupported", "%s is not supported by the compiler yet", directive)
+	}
+}
+
+func (c *compiler) statements(parent *tree_sitter.Node) {
+	for i := uint(0); i < parent.NamedChildCount(); i++ {
+		c.statement(parent.NamedChild(i))
+	}
+}
+
+func (c *compiler) statement(n *tree_sitter.Node) {
+	switch n.Kind() {
+	case "comment", "declaration":
+	case "pragma":
+		c.error(n, "misplaced-pragma", "pragmas must be at the top level")
+	case "variable_assignment", "identifier_assignment":
+		c.assign(n)
+	case "constant_assignment":
+		c.constant(n)
+	case "if_statement":
+		c.ifStatement(n)
+	case "for_statement":
+		c.forStatement(n)
+	case "repeat_statement":
+		c.repeatStatement(n)
+	case "menu_statement":
+		c.menu(n)
+	case "item_statement":
+		c.error(n, "misplaced-item", "menu items must be inside a menu")
+	case "action_definition":
+		c.error(n.Child(0), "unsupported", "action definitions are not supported by the compiler yet")
+	case "block":
+		c.statements(n)
+	case "call":
+		c.call(n)
+	case "builtin_keyword":
+		switch n.Utf8Text(c.source) {
+		case "stop":
+			c.add("is.workflow.actions.exit", dict{})
+		case "nothing":
+			c.add("is.workflow.actions.nothing", dict{})
+		default:
+			c.error(n, "unsupported", "%s is not supported by the compiler yet", n.Utf8Text(c.source))
+		}
+	case "ERROR":
+		c.error(n, "syntax", "syntax error")
+	default:
+		c.warning(n, "no-effect", "expression has no effect")
+	}
+	if n.IsMissing() {
+		c.error(n, "syntax", "missing %s", n.Kind())
+	}
+}
+
+// add appends an action and returns its parameters.
+func (c *compiler) add(identifier string, params dict) dict {
+	c.actions = append(c.actions, dict{
+		"WFWorkflowActionIdentifier": identifier,
+		"WFWorkflowActionParameters": params,
+	})
+	return params
+}
+
+// output returns an attachment referring to the output of the action n
+// compiled to.
+func (c *compiler) output(n *tree_sitter.Node, params dict, name string) dict {
+	if _, ok := params["UUID"]; !ok {
+		params["UUID"] = c.uuid(n, "output")
+	}
+	if custom, ok := params["CustomOutputName"].(string); ok {
+		name = custom
+	}
+	return dict{"Type": "ActionOutput", "OutputUUID": params["UUID"], "OutputName": name}
+}
+
+func (c *compiler) setVariable(name string, input dict) {
+	c.add("is.workflow.actions.setvariable", dict{
+		"WFVariableName": name,
+		"WFInput":        tokenAttachment(input),
+	})
+}
+
+func (c *compiler) assign(n *tree_sitter.Node) {
+	nameNode := n.ChildByFieldName("name")
+	name := nameNode.Utf8Text(c.source)
+	if nameNode.Kind() == "at_variable" {
+		name = analysis.VariableName(nameNode, c.source)
+	}
+	if _, ok := c.constants[name]; ok {
+		c.error(nameNode, "constant-assignment", "cannot assign to constant %s", name)
+		return
+	}
+	if input := c.valueOutput(n.ChildByFieldName("value"), ""); input != nil {
+		c.setVariable(name, input)
+	}
+}
+
+func (c *compiler) constant(n *tree_sitter.Node) {
+	name := n.ChildByFieldName("name").Utf8Text(c.source)
+	if _, ok := c.constants[name]; ok {
+		c.error(n.ChildByFieldName("name"), "redefined-constant", "constant %s is already defined", name)
+		return
+	}
+	if out := c.valueOutput(n.ChildByFieldName("value"), name); out != nil {
+		c.constants[name] = out
+	}
+}
+
+// valueOutput compiles an expression to an action, or reuses the action
+// or variable it refers to, and returns an attachment referring to its
+// value. A non-empty name becomes the custom output name of a new action.
+func (c *compiler) valueOutput(value *tree_sitter.Node, name string) dict {
+	var params dict
+	switch value.Kind() {
+	case "string", "single_quoted_string":
+		params = c.add("is.workflow.actions.gettext", dict{"WFTextActionText": c.text(value)})
+	case "number":
+		params = c.add("is.workflow.actions.number", dict{"WFNumberActionNumber": c.number(value)})
+	case "dictionary":
+		params = c.add("is.workflow.actions.dictionary", dict{"WFItems": c.dictionary(value)})
+	case "call":
+		params = c.call(value)
+	case "binary_expression":
+		params = c.math(value)
+	case "boolean":
+		c.error(value, "unsupported", "boolean variables are not supported by the compiler yet")
+		return nil
+	default:
+		att := c.attachment(value)
+		if att == nil || name == "" {
+			return att
+		}
+		// A constant naming another value still needs an action to name.
+		params = c.add("is.workflow.actions.getvariable", dict{"WFVariable": tokenAttachment(att)})
+	}
+	if params == nil {
+		return nil
+	}
+	if name != "" {
+		params["CustomOutputName"] = name
+	}
+	return c.output(value, params, outputName(value, c.source))
+}
+
+// outputName returns the default name Shortcuts gives the output of the
+// action an expression compiles to.
+func outputName(value *tree_sitter.Node, source []byte) string {
+	switch value.Kind() {
+	case "string", "single_quoted_string":
+		return "Text"
+	case "number":
+		return "Number"
+	case "dictionary":
+		return "Dictionary"
+	case "binary_expression":
+		return "Calculation Result"
+	case "call":
+		return analysis.CallName(value, source)
+	}
+	return "Variable"
+}
+
+// attachment returns the attachment for an expression that refers to a
+// value rather than being a literal, compiling calls and arithmetic.
+func (c *compiler) attachment(n *tree_sitter.Node) dict {
+	switch n.Kind() {
+	case "parenthesized_expression":
+		return c.attachment(n.NamedChild(0))
+	case "at_variable":
+		return c.variable(n, analysis.VariableName(n, c.source))
+	case "identifier":
+		return c.variable(n, n.Utf8Text(c.source))
+	case "builtin_constant":
+		if att := builtinConstants[n.Utf8Text(c.source)]; att != nil {
+			return att
+		}
+	case "builtin_keyword":
+		if n.Utf8Text(c.source) == "getclipboard" {
+			return dict{"Type": "Clipboard"}
+		}
+	case "call", "binary_expression":
+		if params := c.valueParams(n); params != nil {
+			return c.output(n, params, outputName(n, c.source))
+		}
+		return nil
+	}
+	c.error(n, "unsupported", "%s is not supported here", strings.ReplaceAll(n.Kind(), "_", " "))
+	return nil
+}
+
+func (c *compiler) valueParams(n *tree_sitter.Node) dict {
+	if n.Kind() == "call" {
+		return c.call(n)
+	}
+	return c.math(n)
+}
+
+func (c *compiler) variable(n *tree_sitter.Node, name string) dict {
+	if out, ok := c.constants[name]; ok {
+		return out
+	}
+	if _, ok := c.questions[name]; ok {
+		c.error(n, "misplaced-question", "import question %s can only be passed directly to an action", name)
+		return nil
+	}
+	return dict{"Type": "Variable", "VariableName": name}
+}
+
+// builtinConstants maps builtin constants to their attachments.
+var builtinConstants = map[string]dict{
+	"ShortcutInput": {"Type": "ExtensionInput"},
+	"CurrentDate":   {"Type": "CurrentDate"},
+	"Ask":           {"Type": "Ask"},
+	"RepeatItem":    {"Type": "Variable", "VariableName": "Repeat Item"},
+	"RepeatIndex":   {"Type": "Variable", "VariableName": "Repeat Index"},
+}
+
+func tokenAttachment(att dict) dict {
+	return dict{"Value": att, "WFSerializationType": "WFTextTokenAttachment"}
+}
+
+// text returns the value of a text parameter.
+func (c *compiler) text(n *tree_sitter.Node) any {
+	switch n.Kind() {
+	case "single_quoted_string":
+		value, _ := analysis.StringValue(n, c.source)
+		return value
+	case "string":
+		parts := analysis.StringParts(n, c.source)
+		if len(parts) == 0 {
+			return ""
+		}
+		if len(parts) == 1 && parts[0].Interpolation == nil {
+			return parts[0].Text
+		}
+		var b strings.Builder
+		attachments := dict{}
+		offset := 0
+		for _, part := range parts {
+			if part.Interpolation == nil {
+				b.WriteString(part.Text)
+				offset += len(utf16.Encode([]rune(part.Text)))
+				continue
+			}
+			att := c.interpolation(part.Interpolation)
+			if att == nil {
+				continue
+			}
+			attachments[fmt.Sprintf("{%d, 1}", offset)] = att
+			b.WriteString("￼")
+			offset++
+		}
+		return dict{
+			"Value":               dict{"string": b.String(), "attachmentsByRange": attachments},
+			"WFSerializationType": "WFTextTokenString",
+		}
+	case "number":
+		return n.Utf8Text(c.source)
+	}
+	if att := c.attachment(n); att != nil {
+		return tokenAttachment(att)
+	}
+	return nil
+}
+
+func (c *compiler) interpolation(n *tree_sitter.Node) dict {
+	names := analysis.InterpolationNames(n, c.source)
+	inner := strings.TrimSpace(strings.Trim(n.Utf8Text(c.source), "{}"))
+	if len(names) == 0 || strings.TrimPrefix(inner, "@") != names[0] {
+		c.error(n, "unsupported", "interpolation accessors are not supported by the compiler yet")
+		return nil
+	}
+	if att := builtinConstants[names[0]]; att != nil {
+		return att
+	}
+	return c.variable(n, names[0])
+}
+
+func (c *compiler) number(n *tree_sitter.Node) any {
+	text := n.Utf8Text(c.source)
+	if i, err := strconv.Atoi(text); err == nil {
+		return i
+	}
+	f, _ := strconv.ParseFloat(text, 64)
+	return f
+}
+
+// dictionary returns a dictionary literal as a WFDictionaryFieldValue.
+func (c *compiler) dictionary(n *tree_sitter.Node) dict {
+	var items []any
+	for i := uint(0); i < n.NamedChildCount(); i++ {
+		pair := n.NamedChild(i)
+		if pair.Kind() != "dictionary_pair" {
+			continue
+		}
+		key := pair.ChildByFieldName("key")
+		keyText := key.Utf8Text(c.source)
+		if key.Kind() == "string" {
+			keyText, _ = analysis.StringValue(key, c.source)
+		}
+		value := pair.ChildByFieldName("value")
+		item := dict{"WFKey": textToken(keyText)}
+		switch value.Kind() {
+		case "number":
+			item["WFItemType"] = 3
+			item["WFValue"] = textToken(value.Utf8Text(c.source))
+		case "boolean":
+			item["WFItemType"] = 4
+			item["WFValue"] = dict{"Value": value.Utf8Text(c.source) == "true", "WFSerializationType": "WFNumberSubstitutableState"}
+		case "dictionary":
+			item["WFItemType"] = 1
+			item["WFValue"] = dict{"Value": c.dictionary(value)["Value"], "WFSerializationType": "WFDictionaryFieldValue"}
+		default:
+			item["WFItemType"] = 0
+			text := c.text(value)
+			if s, ok := text.(string); ok {
+				text = textToken(s)
+			}
+			item["WFValue"] = text
+		}
+		items = append(items, item)
+	}
+	if items == nil {
+		items = []any{}
+	}
+	return dict{
+		"Value":               dict{"WFDictionaryFieldValueItems": items},
+		"WFSerializationType": "WFDictionaryFieldValue",
+	}
+}
+
+func textToken(s string) dict {
+	return dict{"Value": dict{"string": s}, "WFSerializationType": "WFTextTokenString"}
+}
+
+// call compiles a call to a catalog action and returns its parameters.
+func (c *compiler) call(n *tree_sitter.Node) dict {
+	name := analysis.CallName(n, c.source)
+	action, ok := catalog.Lookup(name)
+	if !ok {
+		c.error(n.ChildByFieldName("function"), "unknown-action", "unknown action %s", name)
+		return nil
+	}
+	args := analysis.Arguments(n)
+	if len(args) > len(action.Parameters) {
+		c.error(n, "arguments", "%s takes at most %d argument(s)", name, len(action.Parameters))
+		return nil
+	}
+	for i, p := range action.Parameters {
+		if i >= len(args) && !p.Optional {
+			c.error(n, "arguments", "%s is missing argument %s", name, p.Name)
+			return nil
+		}
+	}
+	params := dict{}
+	for key, value := range action.Fixed {
+		params[key] = value
+	}
+	var questions []*question
+	for i, arg := range args {
+		p := action.Param(i)
+		if arg.Kind() == "identifier" {
+			if q, ok := c.questions[arg.Utf8Text(c.source)]; ok {
+				if q.actionIndex >= 0 {
+					c.error(arg, "reused-question", "import question %s is already passed to another action", q.name)
+				}
+				q.key = p.Key
+				questions = append(questions, q)
+				params[p.Key] = q.value
+				continue
+			}
+		}
+		if value := c.argument(arg, p); value != nil {
+			params[p.Key] = value
+		}
+	}
+	// Arguments may compile to actions of their own, so the index is only
+	// known now.
+	for _, q := range questions {
+		q.actionIndex = len(c.actions)
+	}
+	return c.add(action.Identifier, params)
+}
+
+func (c *compiler) argument(arg *tree_sitter.Node, p *catalog.Parameter) any {
+	switch p.Type {
+	case catalog.Number:
+		if arg.Kind() == "number" {
+			return c.number(arg)
+		}
+	case catalog.Bool:
+		if arg.Kind() == "boolean" {
+			return arg.Utf8Text(c.source) == "true"
+		}
+	case catalog.Dictionary:
+		if arg.Kind() == "dictionary" {
+			return c.dictionary(arg)
+		}
+	case catalog.Enum:
+		if value, ok := analysis.StringValue(arg, c.source); ok {
+			return value
+		}
+		return arg.Utf8Text(c.source)
+	}
+	if arg.Kind() == "dictionary" || arg.Kind() == "boolean" {
+		c.error(arg, "argument-type", "%s cannot be passed as %s", strings.ReplaceAll(arg.Kind(), "_", " "), p.Name)
+		return nil
+	}
+	return c.text(arg)
+}
+
+// math compiles arithmetic and returns the parameters of the calculation.
+func (c *compiler) math(n *tree_sitter.Node) dict {
+	op := n.Child(1).Kind()
+	operation, ok := mathOperations[op]
+	if !ok {
+		c.error(n, "unsupported", "comparisons can only be used as if conditions")
+		return nil
+	}
+	left, right := c.operand(n.Child(0)), c.operand(n.Child(2))
+	if left == nil || right == nil {
+		return nil
+	}
+	return c.add("is.workflow.actions.math", dict{
+		"WFInput":         left,
+		"WFMathOperation": operation,
+		"WFMathOperand":   right,
+	})
+}
+
+func (c *compiler) operand(n *tree_sitter.Node) any {
+	if n.Kind() == "number" {
+		return c.number(n)
+	}
+	if att := c.attachment(n); att != nil {
+		return tokenAttachment(att)
+	}
+	return nil
+}
+
+// group returns the grouping identifier of the control flow actions a
+// statement compiles to.
+func (c *compiler) group(n *tree_sitter.Node) string {
+	return c.uuid(n, "group")
+}
+
+func (c *compiler) ifStatement(n *tree_sitter.Node) {
+	condition := n.ChildByFieldName("condition")
+	params := dict{}
+	if condition.Kind() == "binary_expression" {
+		code, ok := conditions[condition.Child(1).Kind()]
+		if !ok {
+			c.error(condition, "condition", "%s is not a comparison", condition.Utf8Text(c.source))
+			return
+		}
+		left := c.attachment(condition.Child(0))
+		if left == nil {
+			return
+		}
+		params["WFInput"] = dict{"Type": "Variable", "Variable": tokenAttachment(left)}
+		params["WFCondition"] = code
+		right := condition.Child(2)
+		if right.Kind() == "number" {
+			params["WFNumberValue"] = c.number(right)
+		} else if value := c.text(right); value != nil {
+			params["WFConditionalActionString"] = value
+		}
+	} else {
+		att := c.attachment(condition)
+		if att == nil {
+			return
+		}
+		params["WFInput"] = dict{"Type": "Variable", "Variable": tokenAttachment(att)}
+		params["WFCondition"] = hasAnyValue
+	}
+	group := c.group(n)
+	params["GroupingIdentifier"] = group
+	params["WFControlFlowMode"] = 0
+	c.add("is.workflow.actions.conditional", params)
+	c.statement(n.ChildByFieldName("consequence"))
+	if alternative := n.ChildByFieldName("alternative"); alternative != nil {
+		c.add("is.workflow.actions.conditional", dict{"GroupingIdentifier": group, "WFControlFlowMode": 1})
+		c.statement(alternative)
+	}
+	c.add("is.workflow.actions.conditional", dict{"GroupingIdentifier": group, "WFControlFlowMode": 2})
+}
+
+func (c *compiler) forStatement(n *tree_sitter.Node) {
+	iterable := c.attachment(n.ChildByFieldName("iterable"))
+	if iterable == nil {
+		return
+	}
+	group := c.group(n)
+	c.add("is.workflow.actions.repeat.each", dict{
+		"GroupingIdentifier": group,
+		"WFControlFlowMode":  0,
+		"WFInput":            tokenAttachment(iterable),
+	})
+	c.setVariable(n.ChildByFieldName("variable").Utf8Text(c.source), dict{"Type": "Variable", "VariableName": "Repeat Item"})
+	c.statement(n.ChildByFieldName("body"))
+	c.add("is.workflow.actions.repeat.each", dict{"GroupingIdentifier": group, "WFControlFlowMode": 2})
+}
+
+func (c *compiler) repeatStatement(n *tree_sitter.Node) {
+	count := n.ChildByFieldName("count")
+	if count == nil {
+		c.error(n, "unsupported", "repeat needs a count")
+		return
+	}
+	var value any
+	if count.Kind() == "number" {
+		value = c.number(count)
+	} else if att := c.attachment(count); att != nil {
+		value = tokenAttachment(att)
+	} else {
+		return
+	}
+	group := c.group(n)
+	c.add("is.workflow.actions.repeat.count", dict{
+		"GroupingIdentifier": group,
+		"WFControlFlowMode":  0,
+		"WFRepeatCount":      value,
+	})
+	if v := n.ChildByFieldName("variable"); v != nil {
+		c.setVariable(v.Utf8Text(c.source), dict{"Type": "Variable", "VariableName": "Repeat Index"})
+	}
+	c.statement(n.ChildByFieldName("body"))
+	c.add("is.workflow.actions.repeat.count", dict{"GroupingIdentifier": group, "WFControlFlowMode": 2})
+}
+
+func (c *compiler) menu(n *tree_sitter.Node) {
+	body := n.ChildByFieldName("body")
+	var items []*tree_sitter.Node
+	var titles []any
+	for i := uint(0); i < body.NamedChildCount(); i++ {
+		child := body.NamedChild(i)
+		switch child.Kind() {
+		case "item_statement":
+			title, ok := analysis.StringValue(child.ChildByFieldName("title"), c.source)
+			if !ok {
+				c.error(child.ChildByFieldName("title"), "item-title", "menu item titles must be plain strings")
+				return
+			}
+			items = append(items, child)
+			titles = append(titles, title)
+		case "comment":
+		default:
+			c.error(child, "misplaced-statement", "menus can only contain items")
+			return
+		}
+	}
+	group := c.group(n)
+	params := dict{"GroupingIdentifier": group, "WFControlFlowMode": 0, "WFMenuItems": titles}
+	if titles == nil {
+		params["WFMenuItems"] = []any{}
+	}
+	if title := n.ChildByFieldName("title"); title != nil {
+		params["WFMenuPrompt"] = c.text(title)
+	}
+	c.add("is.workflow.actions.choosefrommenu", params)
+	for i, item := range items {
+		c.add("is.workflow.actions.choosefrommenu", dict{
+			"GroupingIdentifier": group,
+			"WFControlFlowMode":  1,
+			"WFMenuItemTitle":    titles[i],
+		})
+		c.statement(item.ChildByFieldName("body"))
+	}
+	c.add("is.workflow.actions.choosefrommenu", dict{"GroupingIdentifier": group, "WFControlFlowMode": 2})
+}
+
+// workflow returns the top-level dictionary of the shortcut.
+func (c *compiler) workflow() dict {
+	icon := dict{"WFWorkflowIconStartColor": colors["blue"], "WFWorkflowIconGlyphNumber": 61440}
+	if color, ok := c.defines["color"]; ok {
+		if value, ok := colors[color]; ok {
+			icon["WFWorkflowIconStartColor"] = value
+		} else {
+			c.defineError("color", "unknown color %q", color)
+		}
+	}
+	if glyph, ok := c.defines["glyph"]; ok {
+		if value, err := strconv.Atoi(glyph); err == nil {
+			icon["WFWorkflowIconGlyphNumber"] = value
+		} else {
+			c.defineError("glyph", "glyph must be a glyph number, not %q", glyph)
+		}
+	}
+
+	inputs := c.classes("inputs")
+	types := []any{}
+	for _, name := range c.list("from") {
+		t, ok := workflowTypes[name]
+		if !ok {
+			c.defineError("from", "unknown workflow type %q", name)
+			continue
+		}
+		if c.opts.Platform != "" && !supports(t.platforms, c.opts.Platform) {
+			c.defineInfo("from", "%s is not available on %s and is left out", name, c.opts.Platform)
+			continue
+		}
+		types = append(types, t.name)
+	}
+
+	questions := []any{}
+	for _, q := range c.order {
+		if q.actionIndex < 0 {
+			continue
+		}
+		questions = append(questions, dict{
+			"ActionIndex":  q.actionIndex,
+			"Category":     "Parameter",
+			"DefaultValue": q.value,
+			"ParameterKey": q.key,
+			"Text":         q.prompt,
+		})
+	}
+
+	actions := c.actions
+	if actions == nil {
+		actions = []any{}
+	}
+	return dict{
+		"WFWorkflowActions":                    actions,
+		"WFWorkflowClientVersion":              clientVersion,
+		"WFWorkflowHasOutputFallback":          false,
+		"WFWorkflowHasShortcutInputVariables":  len(inputs) > 0,
+		"WFWorkflowIcon":                       icon,
+		"WFWorkflowImportQuestions":            questions,
+		"WFWorkflowInputContentItemClasses":    inputs,
+		"WFWorkflowMinimumClientVersion":       900,
+		"WFWorkflowMinimumClientVersionString": "900",
+		"WFWorkflowOutputContentItemClasses":   c.classes("outputs"),
+		"WFWorkflowTypes":                      types,
+	}
+}
+
+func supports(platforms []Platform, p Platform) bool {
+	for _, q := range platforms {
+		if q == p {
+			return true
+		}
+	}
+	return false
+}
+
+// list returns the comma-separated values of a define.
+func (c *compiler) list(key string) []string {
+	var out []string
+	for _, v := range strings.Split(c.defines[key], ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func (c *compiler) classes(key string) []any {
+	out := []any{}
+	for _, name := range c.list(key) {
+		if class, ok := contentClasses[name]; ok {
+			out = append(out, class)
+		} else {
+			c.defineError(key, "unknown content type %q", name)
+		}
+	}
+	return out
+}
+
+// defineError reports a problem with a define, at the pragma that set it
+// or at the top of the entry file when it was overridden by Options.
+func (c *compiler) defineError(key, format string, args ...any) {
+	site := c.defineNodes[key]
+	if _, overridden := c.opts.Defines[key]; overridden {
+		site.node = nil
+	}
+	c.errorIn(site.file, site.node, "define", format, args...)
+}
+
+func (c *compiler) defineInfo(key, format string, args ...any) {
+	site := c.defineNodes[key]
+	c.report(site.file, site.node, analysis.SeverityInformation, "define", format, args...)
+}
+
+func (c *compiler) error(n *tree_sitter.Node, code, format string, args ...any) {
+	c.errorIn(c.file, n, code, format, args...)
+}
+
+func (c *compiler) warning(n *tree_sitter.Node, code, format string, args ...any) {
+	c.report(c.file, n, analysis.SeverityWarning, code, format, args...)
+}
+
+func (c *compiler) errorIn(file string, n *tree_sitter.Node, code, format string, args ...any) {
+	c.report(file, n, analysis.SeverityError, code, format, args...)
+}
+
+func (c *compiler) report(file string, n *tree_sitter.Node, severity analysis.Severity, code, format string, args ...any) {
+	d := Diagnostic{File: file, Diagnostic: analysis.Diagnostic{
+		Severity: severity,
+		Code:     code,
+		Message:  fmt.Sprintf(format, args...),
+	}}
+	if n != nil {
+		d.Range = n.Range()
+	}
+	c.result.Diagnostics = append(c.result.Diagnostics, d)
+}
+
+// uuid returns the UUID of something a node compiles to, named by role.
+// It is derived from the file and the node's path from the root of its
+// tree rather than its byte offsets, so edits elsewhere in a file only
+// change the UUIDs of the statements after them in the same block.
+func (c *compiler) uuid(n *tree_sitter.Node, role string) string {
+	return nameUUID(c.file + "\x00" + nodePath(n) + "\x00" + role)
+}
+
+// nameUUID returns the name-based (version 5) UUID of name.
+func nameUUID(name string) string {
+	h := sha1.New()
+	h.Write(uuidNamespace[:])
+	h.Write([]byte(name))
+	var b [16]byte
+	copy(b[:], h.Sum(nil))
+	b[6] = b[6]&0x0f | 0x50
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%X-%X-%X-%X-%X", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// uuidNamespace is the namespace of compiled UUIDs, itself the version 5
+// UUID of the repository's URL in the URL namespace.
+var uuidNamespace = [16]byte{
+	0x87, 0xf3, 0xc5, 0x01, 0xda, 0x77, 0x58, 0x67,
+	0xac, 0xdb, 0xc2, 0x22, 0x7c, 0xaf, 0x19, 0xb9,
+}
+
+// nodePath returns the child indices leading from the root to n, e.g.
+// "3.0.1".
+func nodePath(n *tree_sitter.Node) string {
+	var indices []string
+	for parent := n.Parent(); parent != nil; n, parent = parent, parent.Parent() {
+		for i := uint(0); i < parent.ChildCount(); i++ {
+			if parent.Child(i).Id() == n.Id() {
+				indices = append(indices, strconv.FormatUint(uint64(i), 10))
+				break
+			}
+		}
+	}
+	slices.Reverse(indices)
+	return strings.Join(indices, ".")
+}
@@ -0,0 +1,325 @@
+package compiler
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// compileActions compiles a file and returns its actions, failing on
+// errors.
+func compileActions(t *testing.T, files map[string][]byte, entry string, opts Options) ([]dict, dict) {
+	t.Helper()
+	result, workflow := compile(files, entry, opts)
+	if result.HasErrors() {
+		t.Fatalf("diagnostics: %v", result.Diagnostics)
+	}
+	var actions []dict
+	for _, a := range workflow["WFWorkflowActions"].([]any) {
+		actions = append(actions, a.(dict))
+	}
+	return actions, workflow
+}
+
+func identifiers(actions []dict) []string {
+	var out []string
+	for _, a := range actions {
+		out = append(out, strings.TrimPrefix(a["WFWorkflowActionIdentifier"].(string), "is.workflow.actions."))
+	}
+	return out
+}
+
+func params(a dict) dict {
+	return a["WFWorkflowActionParameters"].(dict)
+}
+
+func TestCompile(t *testing.T) {
+	source := `@name = "Ada"
+@greeting = "Hi, {name}!"
+if @greeting == "Hi" {
+    alert(@greeting , "Title")
+} else {
+    show(@name )
+}
+`
+	actions, _ := compileActions(t, map[string][]byte{"main.cherri": []byte(source)}, "main.cherri", Options{})
+	want := []string{"gettext", "setvariable", "gettext", "setvariable", "conditional", "alert", "conditional", "showresult", "conditional"}
+	if got := identifiers(actions); !reflect.DeepEqual(got, want) {
+		t.Fatalf("actions = %v, want %v", got, want)
+	}
+
+	text := params(actions[2])["WFTextActionText"].(dict)["Value"].(dict)
+	if text["string"] != "Hi, ￼!" || !reflect.DeepEqual(text["attachmentsByRange"], dict{"{4, 1}": dict{"Type": "Variable", "VariableName": "name"}}) {
+		t.Errorf("interpolated text = %v", text)
+	}
+	set := params(actions[1])
+	if set["WFVariableName"] != "name" || set["WFInput"].(dict)["Value"].(dict)["OutputUUID"] != params(actions[0])["UUID"] {
+		t.Errorf("setvariable = %v", set)
+	}
+	cond := params(actions[4])
+	if cond["WFCondition"] != 4 || cond["WFConditionalActionString"] != "Hi" || cond["WFControlFlowMode"] != 0 {
+		t.Errorf("conditional = %v", cond)
+	}
+	group := cond["GroupingIdentifier"]
+	if params(actions[6])["GroupingIdentifier"] != group || params(actions[8])["WFControlFlowMode"] != 2 {
+		t.Errorf("conditional group is not closed consistently")
+	}
+	if alert := params(actions[5]); alert["WFAlertActionTitle"] != "Title" {
+		t.Errorf("alert = %v", alert)
+	}
+}
+
+func TestCompileIncludesAndQuestions(t *testing.T) {
+	files := map[string][]byte{
+		"main.cherri": []byte(`#define name "Lucky"
+#define color red
+#define inputs text
+#include "lib/limits.cherri"
+#question city "Which city?" "Paris"
+
+@n = randomNumber(1, limit)
+show(city)
+`),
+		"lib/limits.cherri": []byte(`const limit = 10
+`),
+	}
+	actions, workflow := compileActions(t, files, "main.cherri", Options{})
+	if got, want := identifiers(actions), []string{"number", "number.random", "setvariable", "showresult"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("actions = %v, want %v", got, want)
+	}
+	limit := params(actions[0])
+	if limit["CustomOutputName"] != "limit" || limit["WFNumberActionNumber"] != 10 {
+		t.Errorf("constant = %v", limit)
+	}
+	max := params(actions[1])["WFRandomNumberMaximum"].(dict)["Value"].(dict)
+	if max["Type"] != "ActionOutput" || max["OutputName"] != "limit" || max["OutputUUID"] != limit["UUID"] {
+		t.Errorf("constant reference = %v", max)
+	}
+	questions := workflow["WFWorkflowImportQuestions"].([]any)
+	want := dict{"ActionIndex": 3, "Category": "Parameter", "DefaultValue": "Paris", "ParameterKey": "Text", "Text": "Which city?"}
+	if len(questions) != 1 || !reflect.DeepEqual(questions[0], want) {
+		t.Errorf("questions = %v, want %v", questions, want)
+	}
+	if workflow["WFWorkflowIcon"].(dict)["WFWorkflowIconStartColor"] != colors["red"] {
+		t.Errorf("icon = %v", workflow["WFWorkflowIcon"])
+	}
+	if !reflect.DeepEqual(workflow["WFWorkflowInputContentItemClasses"], []any{"WFStringContentItem"}) {
+		t.Errorf("inputs = %v", workflow["WFWorkflowInputContentItemClasses"])
+	}
+}
+
+func TestCompileLoopsAndMenus(t *testing.T) {
+	source := `repeat i for 3 {
+    show("{i}")
+}
+for item in ShortcutInput {
+    show(RepeatItem)
+}
+menu "Pick" {
+    item "One": alert("1")
+    item "Two": stop
+}
+@total = @count * 2
+`
+	actions, _ := compileActions(t, map[string][]byte{"main.cherri": []byte(source)}, "main.cherri", Options{})
+	want := []string{
+		"repeat.count", "setvariable", "showresult", "repeat.count",
+		"repeat.each", "setvariable", "showresult", "repeat.each",
+		"choosefrommenu", "choosefrommenu", "alert", "choosefrommenu", "exit", "choosefrommenu",
+		"math", "setvariable",
+	}
+	if got := identifiers(actions); !reflect.DeepEqual(got, want) {
+		t.Fatalf("actions = %v, want %v", got, want)
+	}
+	menu := params(actions[8])
+	if !reflect.DeepEqual(menu["WFMenuItems"], []any{"One", "Two"}) || menu["WFMenuPrompt"] != "Pick" {
+		t.Errorf("menu = %v", menu)
+	}
+	if params(actions[11])["WFMenuItemTitle"] != "Two" {
+		t.Errorf("menu item = %v", params(actions[11]))
+	}
+	if params(actions[14])["WFMathOperation"] != "×" {
+		t.Errorf("math = %v", params(actions[14]))
+	}
+}
+
+func TestCompilePlatform(t *testing.T) {
+	files := map[string][]byte{"main.cherri": []byte("#define from menubar, sharesheet, watch\nshow(\"hi\")\n")}
+	for platform, want := range map[Platform][]any{
+		"":    {"MenuBar", "ActionExtension", "Watch"},
+		IOS:   {"ActionExtension", "Watch"},
+		MacOS: {"MenuBar", "ActionExtension"},
+	} {
+		_, workflow := compileActions(t, files, "main.cherri", Options{Platform: platform})
+		if got := workflow["WFWorkflowTypes"]; !reflect.DeepEqual(got, want) {
+			t.Errorf("%q: types = %v, want %v", platform, got, want)
+		}
+	}
+	result := Compile(files, "main.cherri", Options{Platform: IOS, Defines: map[string]string{"name": "Mobile"}})
+	if result.Plist == nil || result.Name != "Mobile" || len(result.Diagnostics) != 1 {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+func TestCompileFixedParameters(t *testing.T) {
+	source := `@r = regReplaceText("a+", "b", "aaa")
+@i = iRegReplaceText("A+", "b", "aaa")
+@p = replaceText("a", "b", "aaa")
+`
+	actions, _ := compileActions(t, map[string][]byte{"main.cherri": []byte(source)}, "main.cherri", Options{})
+	var replaces []dict
+	for _, a := range actions {
+		if a["WFWorkflowActionIdentifier"] == "is.workflow.actions.text.replace" {
+			replaces = append(replaces, params(a))
+		}
+	}
+	if len(replaces) != 3 {
+		t.Fatalf("compiled %d text.replace actions, want 3", len(replaces))
+	}
+	for i, want := range []struct {
+		regex, caseSensitive any
+	}{{true, nil}, {true, false}, {nil, nil}} {
+		p := replaces[i]
+		if p["WFReplaceTextRegularExpression"] != want.regex || p["WFReplaceTextCaseSensitive"] != want.caseSensitive {
+			t.Errorf("replace %d: regular expression = %v, case sensitive = %v, want %v, %v",
+				i, p["WFReplaceTextRegularExpression"], p["WFReplaceTextCaseSensitive"], want.regex, want.caseSensitive)
+		}
+		if p["WFReplaceTextReplace"] != "b" {
+			t.Errorf("replace %d: replacement = %v", i, p["WFReplaceTextReplace"])
+		}
+	}
+}
+
+func TestCompileDiagnostics(t *testing.T) {
+	files := map[string][]byte{"main.cherri": []byte(`#include "missing.cherri"
+#question unused "Never asked" ""
+frobnicate("x")
+action helper() {
+    show("x")
+}
+alert()
+`)}
+	result := Compile(files, "main.cherri", Options{})
+	if result.Plist != nil {
+		t.Error("compiled despite errors")
+	}
+	var got []string
+	for _, d := range result.Diagnostics {
+		got = append(got, d.String())
+	}
+	want := []string{
+		"main.cherri:1:10: error: included file missing.cherri not found",
+		"main.cherri:3:1: error: unknown action frobnicate",
+		"main.cherri:4:1: error: action definitions are not supported by the compiler yet",
+		"main.cherri:7:1: error: alert is missing argument alert",
+		"main.cherri:2:1: error: import question unused is never passed to an action",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("diagnostics:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+func TestCompileReproducible(t *testing.T) {
+	files := map[string][]byte{
+		"main.cherri": []byte(`#include "lib.cherri"
+const greeting = "Hello"
+@items = getDictionary(ShortcutInput)
+for item in items {
+    if item == "a" {
+        alert("{greeting} {item}")
+    } else {
+        show(changeCase(item, "uppercase"))
+    }
+}
+menu "Pick" {
+    item "One":
+        show(count(items) + 1)
+}
+`),
+		"lib.cherri": []byte("@n = count(getName(\"x\"))\n"),
+	}
+	first := Compile(files, "main.cherri", Options{})
+	if first.HasErrors() {
+		t.Fatalf("diagnostics: %v", first.Diagnostics)
+	}
+	golden := filepath.Join("testdata", "reproducible.plist")
+	if *update {
+		if err := os.WriteFile(golden, first.Plist, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(first.Plist, want) {
+		t.Errorf("compiled plist differs from %s (run with -update to rewrite it):\n%s", golden, first.Plist)
+	}
+	for i := 0; i < 5; i++ {
+		if again := Compile(files, "main.cherri", Options{}); !bytes.Equal(again.Plist, first.Plist) {
+			t.Fatalf("compilation %d differs:\n%s\n---\n%s", i+2, again.Plist, first.Plist)
+		}
+	}
+
+	// Every action output and control flow group has its own UUID.
+	_, workflow := compile(files, "main.cherri", Options{})
+	uuids := map[string]bool{}
+	groups := map[string]bool{}
+	for _, a := range workflow["WFWorkflowActions"].([]any) {
+		p := params(a.(dict))
+		if id, ok := p["UUID"].(string); ok {
+			if uuids[id] {
+				t.Errorf("UUID %s is used twice", id)
+			}
+			uuids[id] = true
+		}
+		if id, ok := p["GroupingIdentifier"].(string); ok && p["WFControlFlowMode"] == 0 {
+			if groups[id] || uuids[id] {
+				t.Errorf("grouping identifier %s is used twice", id)
+			}
+			groups[id] = true
+		}
+	}
+	if len(uuids) < 6 || len(groups) != 3 {
+		t.Errorf("found %d UUIDs and %d groups", len(uuids), len(groups))
+	}
+
+	// Editing the end of a file leaves the UUIDs before it alone.
+	edited := map[string][]byte{"main.cherri": append(slices.Clone(files["main.cherri"]), "show(\"done\")\n"...), "lib.cherri": files["lib.cherri"]}
+	actions, _ := compileActions(t, edited, "main.cherri", Options{})
+	for i, a := range actions[:len(actions)-1] {
+		if id, ok := params(a)["UUID"].(string); ok && !uuids[id] {
+			t.Errorf("action %d has new UUID %s after an edit at the end", i, id)
+		}
+	}
+}
+
+func TestNameUUID(t *testing.T) {
+	// UUIDs are standard version 5 UUIDs, so they can be reproduced
+	// without this package.
+	got := nameUUID("main.cherri\x000.2\x00output")
+	if want := "1857218B-DED9-5B91-846E-2F16BF8D4C41"; got != want {
+		t.Errorf("nameUUID = %s, want %s", got, want)
+	}
+}
+
+func TestIconColors(t *testing.T) {
+	if len(colors) != 15 {
+		t.Errorf("%d icon colors, want the 15 of the Shortcuts picker", len(colors))
+	}
+	for name := range colors {
+		if color, _ := IconColor(name); color&0xFF != 0xFF {
+			t.Errorf("%s = %#08x is not opaque", name, color)
+		}
+	}
+	if color, _ := IconColor("red"); color != 0xFF4351FF {
+		t.Errorf("red = %#08x", color)
+	}
+}
@@ -0,0 +1,86 @@
+package compiler
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// A dict is a plist dictionary. Keys are written in sorted order.
+type dict map[string]any
+
+// encodePlist returns v as an XML property list. Values are dicts, slices
+// of values, strings, ints, float64s and bools.
+func encodePlist(v any) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+`)
+	if err := writeValue(&b, v, 0); err != nil {
+		return nil, err
+	}
+	b.WriteString("</plist>\n")
+	return b.Bytes(), nil
+}
+
+func writeValue(b *bytes.Buffer, v any, depth int) error {
+	indent := bytes.Repeat([]byte{'\t'}, depth)
+	b.Write(indent)
+	switch v := v.(type) {
+	case dict:
+		if len(v) == 0 {
+			b.WriteString("<dict/>\n")
+			return nil
+		}
+		b.WriteString("<dict>\n")
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			b.Write(indent)
+			b.WriteString("\t<key>")
+			xml.EscapeText(b, []byte(k))
+			b.WriteString("</key>\n")
+			if err := writeValue(b, v[k], depth+1); err != nil {
+				return fmt.Errorf("%s: %w", k, err)
+			}
+		}
+		b.Write(indent)
+		b.WriteString("</dict>\n")
+	case []any:
+		if len(v) == 0 {
+			b.WriteString("<array/>\n")
+			return nil
+		}
+		b.WriteString("<array>\n")
+		for _, item := range v {
+			if err := writeValue(b, item, depth+1); err != nil {
+				return err
+			}
+		}
+		b.Write(indent)
+		b.WriteString("</array>\n")
+	case string:
+		b.WriteString("<string>")
+		xml.EscapeText(b, []byte(v))
+		b.WriteString("</string>\n")
+	case int:
+		fmt.Fprintf(b, "<integer>%d</integer>\n", v)
+	case float64:
+		fmt.Fprintf(b, "<real>%s</real>\n", strconv.FormatFloat(v, 'g', -1, 64))
+	case bool:
+		if v {
+			b.WriteString("<true/>\n")
+		} else {
+			b.WriteString("<false/>\n")
+		}
+	default:
+		return fmt.Errorf("cannot encode %T in a plist", v)
+	}
+	return nil
+}
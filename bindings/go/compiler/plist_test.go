@@ -0,0 +1,38 @@
+package compiler
+
+import "testing"
+
+func TestEncodePlist(t *testing.T) {
+	got, err := encodePlist(dict{
+		"b": []any{1, 2.5, true, "x < y"},
+		"a": dict{},
+		"c": []any{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>a</key>
+	<dict/>
+	<key>b</key>
+	<array>
+		<integer>1</integer>
+		<real>2.5</real>
+		<true/>
+		<string>x &lt; y</string>
+	</array>
+	<key>c</key>
+	<array/>
+</dict>
+</plist>
+`
+	if string(got) != want {
+		t.Errorf("plist:\n%s\nwant:\n%s", got, want)
+	}
+	if _, err := encodePlist(dict{"k": struct{}{}}); err == nil {
+		t.Error("encodePlist accepted an unsupported type")
+	}
+}
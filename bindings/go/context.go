@@ -0,0 +1,97 @@
+package tree_sitter_cherri
+
+import (
+	"strings"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// A Context is one construct enclosing a position in the source, such as a
+// menu, an item, an if statement, a repeat loop or an action definition.
+type Context struct {
+	// Node is the enclosing statement.
+	Node *tree_sitter.Node
+	// Label is the part of the header that identifies the construct: the
+	// menu or item title, the if condition, the for iterable, the repeat
+	// count or the action name. It is nil when the construct has none (e.g.
+	// an untitled menu).
+	Label *tree_sitter.Node
+	// Else is set when the position is inside the else branch of an if.
+	Else bool
+	// HeaderRow is the zero-based row the construct starts on.
+	HeaderRow uint
+	// HeaderStart and HeaderEnd are the byte range of the header, which runs
+	// from the keyword up to the start of the body.
+	HeaderStart, HeaderEnd uint
+}
+
+// Kind returns the node kind of the enclosing statement.
+func (c Context) Kind() string {
+	return c.Node.Kind()
+}
+
+// Header returns the header text of the construct, e.g. `item "Two":`.
+func (c Context) Header(source []byte) string {
+	return strings.TrimSpace(string(source[c.HeaderStart:c.HeaderEnd]))
+}
+
+// ContextAt returns the chain of constructs enclosing point, outermost
+// first. The nodes borrow from tree and are only valid while it is open.
+func ContextAt(tree *tree_sitter.Tree, point tree_sitter.Point) []Context {
+	node := tree.RootNode().DescendantForPointRange(point, point)
+	var chain []Context
+	for child := node; child != nil; child = child.Parent() {
+		parent := child.Parent()
+		if parent == nil {
+			break
+		}
+		if c, ok := contextFor(parent, child); ok {
+			chain = append(chain, c)
+		}
+	}
+	for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+		chain[i], chain[j] = chain[j], chain[i]
+	}
+	return chain
+}
+
+// contextFor reports whether node is a context construct, given the child
+// on the path down to the position.
+func contextFor(node, child *tree_sitter.Node) (Context, bool) {
+	var label, body *tree_sitter.Node
+	elseBranch := false
+	switch node.Kind() {
+	case "menu_statement", "item_statement":
+		label = node.ChildByFieldName("title")
+		body = node.ChildByFieldName("body")
+	case "if_statement":
+		label = node.ChildByFieldName("condition")
+		body = node.ChildByFieldName("consequence")
+		if alt := node.ChildByFieldName("alternative"); alt != nil && alt.Id() == child.Id() {
+			elseBranch = true
+		}
+	case "for_statement":
+		label = node.ChildByFieldName("iterable")
+		body = node.ChildByFieldName("body")
+	case "repeat_statement":
+		label = node.ChildByFieldName("count")
+		body = node.ChildByFieldName("body")
+	case "action_definition":
+		label = node.ChildByFieldName("name")
+		body = node.ChildByFieldName("body")
+	default:
+		return Context{}, false
+	}
+	end := node.EndByte()
+	if body != nil {
+		end = body.StartByte()
+	}
+	return Context{
+		Node:        node,
+		Label:       label,
+		Else:        elseBranch,
+		HeaderRow:   node.StartPosition().Row,
+		HeaderStart: node.StartByte(),
+		HeaderEnd:   end,
+	}, true
+}
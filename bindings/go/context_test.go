@@ -0,0 +1,79 @@
+package tree_sitter_cherri_test
+
+import (
+	"os"
+	"testing"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_cherri "github.com/tree-sitter/tree-sitter-cherri/bindings/go"
+)
+
+const contextSource = `menu "Pick" {
+  item "One": alert("one")
+  item "Two": {
+    if @v == 1 {
+      repeat 3 {
+        show(RepeatItem)
+      }
+    } else {
+      stop
+    }
+  }
+}
+action greet(text name): text {
+  output("Hi {name}")
+}
+`
+
+func TestContextAt(t *testing.T) {
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	if err := parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_cherri.Language())); err != nil {
+		t.Fatal(err)
+	}
+	source := []byte(contextSource)
+	tree := parser.Parse(source, nil)
+	defer tree.Close()
+
+	tests := []struct {
+		point   tree_sitter.Point
+		headers []string
+		rows    []uint
+		inElse  bool
+	}{
+		{tree_sitter.NewPoint(5, 12), []string{`menu "Pick"`, `item "Two":`, `if @v == 1`, `repeat 3`}, []uint{0, 2, 3, 4}, false},
+		{tree_sitter.NewPoint(8, 7), []string{`menu "Pick"`, `item "Two":`, `if @v == 1`}, []uint{0, 2, 3}, true},
+		{tree_sitter.NewPoint(1, 16), []string{`menu "Pick"`, `item "One":`}, []uint{0, 1}, false},
+		{tree_sitter.NewPoint(13, 4), []string{`action greet(text name): text`}, []uint{12}, false},
+	}
+	for _, tt := range tests {
+		chain := tree_sitter_cherri.ContextAt(tree, tt.point)
+		if len(chain) != len(tt.headers) {
+			t.Fatalf("ContextAt(%v) returned %d entries, want %d", tt.point, len(chain), len(tt.headers))
+		}
+		for i, c := range chain {
+			if got := c.Header(source); got != tt.headers[i] {
+				t.Errorf("ContextAt(%v)[%d].Header = %q, want %q", tt.point, i, got, tt.headers[i])
+			}
+			if c.HeaderRow != tt.rows[i] {
+				t.Errorf("ContextAt(%v)[%d].HeaderRow = %d, want %d", tt.point, i, c.HeaderRow, tt.rows[i])
+			}
+		}
+		if last := chain[len(chain)-1]; last.Else != tt.inElse {
+			t.Errorf("ContextAt(%v) innermost Else = %v, want %v", tt.point, last.Else, tt.inElse)
+		}
+	}
+}
+
+func TestContextQuery(t *testing.T) {
+	source, err := os.ReadFile("../../queries/context.scm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	language := tree_sitter.NewLanguage(tree_sitter_cherri.Language())
+	query, qerr := tree_sitter.NewQuery(language, string(source))
+	if qerr != nil {
+		t.Fatalf("Error compiling context.scm: %v", qerr)
+	}
+	query.Close()
+}
@@ -0,0 +1,74 @@
+// Command cherri-reference parses files with the reference Cherri compiler
+// and prints how it handled each as a difftest.Outcome in JSON, one per
+// line, including the lines its statements start on. It is the adapter
+// difftest.Command runs.
+//
+// Usage:
+//
+//	cherri-reference (-compiler cherri | -checkout dir) [-parser-errors regexp] file.cherri...
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/difftest"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run runs the command line args and returns the exit status: 1 when a
+// file cannot be parsed, 2 when the command is misused.
+func run(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("cherri-reference", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	exe := flags.String("compiler", "", "build of the reference `compiler`")
+	checkout := flags.String("checkout", "", "checkout of the reference compiler to build")
+	parserErrors := flags.String("parser-errors", "", "`regexp` matching parser errors (default: difftest.DefaultParserErrors)")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if (*exe == "") == (*checkout == "") || flags.NArg() == 0 {
+		fmt.Fprintln(stderr, "usage: cherri-reference (-compiler cherri | -checkout dir) [-parser-errors regexp] file.cherri...")
+		return 2
+	}
+
+	fail := func(err error) int {
+		fmt.Fprintf(stderr, "cherri-reference: %v\n", err)
+		return 1
+	}
+	var reference *difftest.CompilerReference
+	var err error
+	if *exe != "" {
+		reference, err = difftest.CompilerBinary(*exe)
+	} else {
+		reference, err = difftest.Compiler(*checkout)
+	}
+	if err != nil {
+		return fail(err)
+	}
+	defer reference.Close()
+	if *parserErrors != "" {
+		if reference.ParserErrors, err = regexp.Compile(*parserErrors); err != nil {
+			return fail(err)
+		}
+	}
+
+	enc := json.NewEncoder(stdout)
+	for _, path := range flags.Args() {
+		outcome, err := reference.Parse(path)
+		if err != nil {
+			return fail(err)
+		}
+		if err := enc.Encode(outcome); err != nil {
+			return fail(err)
+		}
+	}
+	return 0
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/difftest"
+)
+
+func TestRun(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("needs a POSIX shell")
+	}
+	dir := t.TempDir()
+	// A stand-in compiler rejecting files that leave a brace open.
+	files := map[string]string{
+		"cherri":   "#!/bin/sh\nif [ \"$(tr -cd '{' < \"$1\" | wc -c)\" -ne \"$(tr -cd '}' < \"$1\" | wc -c)\" ]; then\n  echo \"Parser Error: expected '}' (2:1)\"\n  exit 1\nfi\n",
+		"a.cherri": "show(1)\nif x {\n    show(2)\n}\n",
+		"b.cherri": "if x {\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var stdout, stderr bytes.Buffer
+	args := []string{"-compiler", filepath.Join(dir, "cherri"), filepath.Join(dir, "a.cherri"), filepath.Join(dir, "b.cherri")}
+	if code := run(args, &stdout, &stderr); code != 0 {
+		t.Fatalf("exit %d: %s", code, &stderr)
+	}
+	dec := json.NewDecoder(&stdout)
+	for _, want := range []difftest.Outcome{
+		{Accepted: true, Statements: []int{1, 2}},
+		{Line: 2, Message: "Parser Error: expected '}' (2:1)"},
+	} {
+		var got difftest.Outcome
+		if err := dec.Decode(&got); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("outcome = %+v, want %+v", got, want)
+		}
+	}
+
+	if code := run([]string{filepath.Join(dir, "a.cherri")}, &stdout, &stderr); code != 2 {
+		t.Errorf("run without a compiler exited %d", code)
+	}
+}
@@ -0,0 +1,387 @@
+// Package difftest compares the grammar with the parser of the reference
+// Cherri compiler over a corpus of files, to find where they drift apart:
+// files one accepts and the other rejects, and files both reject at
+// different statements or split into statements differently.
+//
+// The reference parser lives in the compiler's main package and cannot be
+// imported, so a CompilerReference drives a build of the compiler instead.
+// It finds the statement boundaries of a file the compiler accepts by
+// compiling the lines before each one, and the cherri-reference command
+// under cmd prints the outcome as JSON for use with Command.
+package difftest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/analysis"
+)
+
+// An Outcome is how a parser handled a file.
+type Outcome struct {
+	Accepted bool `json:"accepted"`
+	// Line is the 1-based line of the first error of a rejected file, or
+	// 0 if unknown.
+	Line    int    `json:"line,omitempty"`
+	Message string `json:"message,omitempty"`
+	// Statements holds the 1-based lines top-level statements start on,
+	// or nil if the parser does not report them.
+	Statements []int `json:"statements,omitempty"`
+}
+
+// A Reference parses files with the reference compiler.
+type Reference interface {
+	Parse(path string) (*Outcome, error)
+}
+
+// ReferenceFunc adapts a function to a Reference.
+type ReferenceFunc func(path string) (*Outcome, error)
+
+// Parse calls f.
+func (f ReferenceFunc) Parse(path string) (*Outcome, error) {
+	return f(path)
+}
+
+// Command returns a Reference running an adapter command with the path of
+// each file appended to args. The command prints the Outcome as JSON.
+func Command(name string, args ...string) Reference {
+	return ReferenceFunc(func(path string) (*Outcome, error) {
+		cmd := exec.Command(name, append(args, path)...)
+		var stderr bytes.Buffer
+		cmd.Stderr = &stderr
+		out, err := cmd.Output()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v: %s", name, err, strings.TrimSpace(stderr.String()))
+		}
+		var outcome Outcome
+		if err := json.Unmarshal(out, &outcome); err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		return &outcome, nil
+	})
+}
+
+// A CompilerReference compiles files with a build of the reference
+// compiler. The compiler stops at the first error of any stage, so a file
+// is rejected only when that error is a parser error, told apart by
+// ParserErrors; a file that parses but fails to compile, say for an
+// unknown action, is accepted.
+//
+// The compiler does not report statements, so they are found from the
+// parser's errors: a non-blank line of an accepted file starts a
+// top-level statement when the lines before it parse on their own, and
+// not when it continues a statement or block they leave open.
+type CompilerReference struct {
+	// ParserErrors matches the output of the compiler for parser errors.
+	// It defaults to DefaultParserErrors; TestDefaultParserErrors checks it
+	// against the build.
+	ParserErrors *regexp.Regexp
+	// dir holds scratch directories, and the build of the compiler if
+	// Compiler made it.
+	dir string
+	exe string
+}
+
+// Compiler builds the reference compiler from a checkout at dir. Close
+// removes the build.
+func Compiler(dir string) (*CompilerReference, error) {
+	work, err := os.MkdirTemp("", "cherri-reference")
+	if err != nil {
+		return nil, err
+	}
+	c := &CompilerReference{ParserErrors: DefaultParserErrors, dir: work, exe: filepath.Join(work, "cherri")}
+	build := exec.Command("go", "build", "-o", c.exe, ".")
+	build.Dir = dir
+	if out, err := build.CombinedOutput(); err != nil {
+		os.RemoveAll(work)
+		return nil, fmt.Errorf("building reference compiler: %v\n%s", err, out)
+	}
+	return c, nil
+}
+
+// CompilerBinary returns a reference running an existing build of the
+// reference compiler. Close removes its scratch directories.
+func CompilerBinary(exe string) (*CompilerReference, error) {
+	exe, err := filepath.Abs(exe)
+	if err != nil {
+		return nil, err
+	}
+	work, err := os.MkdirTemp("", "cherri-reference")
+	if err != nil {
+		return nil, err
+	}
+	return &CompilerReference{ParserErrors: DefaultParserErrors, dir: work, exe: exe}, nil
+}
+
+// Parse compiles the file, and for an accepted file the lines before each
+// of its statements.
+func (c *CompilerReference) Parse(path string) (*Outcome, error) {
+	source, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	name := filepath.Base(path)
+	outcome, err := c.compile(name, source)
+	if err != nil || !outcome.Accepted {
+		return outcome, err
+	}
+	outcome.Statements = []int{}
+	lines := strings.SplitAfter(string(source), "\n")
+	var prefix strings.Builder
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed != "" && !strings.HasPrefix(trimmed, "//") {
+			// Lines before the first statement need no check.
+			start := len(outcome.Statements) == 0
+			if !start {
+				before, err := c.compile(name, []byte(prefix.String()))
+				if err != nil {
+					return nil, err
+				}
+				start = before.Accepted
+			}
+			if start {
+				outcome.Statements = append(outcome.Statements, i+1)
+			}
+		}
+		prefix.WriteString(line)
+	}
+	return outcome, nil
+}
+
+// compile compiles source as a file called name in a scratch directory,
+// so no output is left next to the original.
+func (c *CompilerReference) compile(name string, source []byte) (*Outcome, error) {
+	scratch, err := os.MkdirTemp(c.dir, "run")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(scratch)
+	if err := os.WriteFile(filepath.Join(scratch, name), source, 0o644); err != nil {
+		return nil, err
+	}
+	cmd := exec.Command(c.exe, name, "--skip-sign")
+	cmd.Dir = scratch
+	out, err := cmd.CombinedOutput()
+	var exit *exec.ExitError
+	if errors.As(err, &exit) {
+		return c.outcome(strings.TrimSpace(string(out))), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Outcome{Accepted: true}, nil
+}
+
+// Close removes the build of the compiler, if Compiler built it, and the
+// scratch directories.
+func (c *CompilerReference) Close() error {
+	return os.RemoveAll(c.dir)
+}
+
+// DefaultParserErrors matches the parser errors of the reference
+// compiler, as opposed to errors of later stages such as type checking.
+var DefaultParserErrors = regexp.MustCompile(`(?i)\b(?:pars(?:e|er|ing)|syntax) error\b`)
+
+// outcome returns the outcome of a failed compilation with the given
+// output.
+func (c *CompilerReference) outcome(message string) *Outcome {
+	pattern := c.ParserErrors
+	if pattern == nil {
+		pattern = DefaultParserErrors
+	}
+	if !pattern.MatchString(message) {
+		return &Outcome{Accepted: true, Message: message}
+	}
+	return &Outcome{Line: errorLine(message), Message: message}
+}
+
+// positionPattern matches the line:column positions compilers put in
+// error messages.
+var positionPattern = regexp.MustCompile(`\b(\d+):(\d+)\b`)
+
+// errorLine returns the line of the first position in an error message.
+func errorLine(message string) int {
+	if m := positionPattern.FindStringSubmatch(message); m != nil {
+		line, _ := strconv.Atoi(m[1])
+		return line
+	}
+	return 0
+}
+
+// Parse returns the outcome of parsing source with the grammar.
+func Parse(source []byte) *Outcome {
+	tree := analysis.Parse(source)
+	defer tree.Close()
+	root := tree.RootNode()
+	outcome := &Outcome{Accepted: !root.HasError(), Statements: []int{}}
+	analysis.Walk(root, func(n *tree_sitter.Node) bool {
+		if outcome.Line == 0 && (n.IsError() || n.IsMissing()) {
+			outcome.Line = int(n.StartPosition().Row) + 1
+			outcome.Message = n.ToSexp()
+		}
+		return outcome.Line == 0
+	})
+	for i := uint(0); i < root.NamedChildCount(); i++ {
+		n := root.NamedChild(i)
+		if n.Kind() == "comment" {
+			continue
+		}
+		outcome.Statements = append(outcome.Statements, int(n.StartPosition().Row)+1)
+		if n.Kind() == "pragma" {
+			i += uint(len(analysis.PragmaArguments(n)))
+		}
+	}
+	return outcome
+}
+
+// A Kind is a kind of disagreement.
+type Kind string
+
+const (
+	// OnlyGrammarAccepts is a file the reference rejects.
+	OnlyGrammarAccepts Kind = "accepted by the grammar only"
+	// OnlyReferenceAccepts is a file the grammar rejects.
+	OnlyReferenceAccepts Kind = "accepted by the reference only"
+	// ErrorStatement is a file both reject, at different statements.
+	ErrorStatement Kind = "rejected at different statements"
+	// Boundaries is a file both accept but split into statements
+	// differently.
+	Boundaries Kind = "different statement boundaries"
+)
+
+// A Disagreement is a file the parsers handle differently.
+type Disagreement struct {
+	Path               string
+	Kind               Kind
+	Grammar, Reference *Outcome
+	// Lines holds the lines of statements only one parser starts.
+	Lines []int
+}
+
+func (d *Disagreement) String() string {
+	var detail string
+	switch d.Kind {
+	case OnlyGrammarAccepts:
+		detail = d.Reference.Message
+	case OnlyReferenceAccepts:
+		detail = fmt.Sprintf("line %d: %s", d.Grammar.Line, d.Grammar.Message)
+	case ErrorStatement:
+		detail = fmt.Sprintf("grammar at line %d, reference at line %d", d.Grammar.Line, d.Reference.Line)
+	case Boundaries:
+		detail = fmt.Sprintf("lines %v", d.Lines)
+	}
+	return fmt.Sprintf("%s: %s: %s", d.Path, d.Kind, detail)
+}
+
+// Compare returns how the outcomes of a file disagree, or nil if they do
+// not.
+func Compare(path string, grammar, reference *Outcome) *Disagreement {
+	d := &Disagreement{Path: path, Grammar: grammar, Reference: reference}
+	switch {
+	case grammar.Accepted && !reference.Accepted:
+		d.Kind = OnlyGrammarAccepts
+	case !grammar.Accepted && reference.Accepted:
+		d.Kind = OnlyReferenceAccepts
+	case !grammar.Accepted:
+		if reference.Line == 0 || statementAt(grammar.Statements, grammar.Line) == statementAt(grammar.Statements, reference.Line) {
+			return nil
+		}
+		d.Kind = ErrorStatement
+	default:
+		if reference.Statements == nil {
+			return nil
+		}
+		d.Lines = symmetricDifference(grammar.Statements, reference.Statements)
+		if len(d.Lines) == 0 {
+			return nil
+		}
+		d.Kind = Boundaries
+	}
+	return d
+}
+
+// statementAt returns the index of the statement line is in.
+func statementAt(statements []int, line int) int {
+	return sort.SearchInts(statements, line+1) - 1
+}
+
+func symmetricDifference(a, b []int) []int {
+	count := map[int]int{}
+	for _, x := range a {
+		count[x]++
+	}
+	for _, x := range b {
+		count[x]--
+	}
+	var out []int
+	for x, n := range count {
+		if n != 0 {
+			out = append(out, x)
+		}
+	}
+	sort.Ints(out)
+	return out
+}
+
+// A Report is the result of a differential run.
+type Report struct {
+	// Files counts the files compared.
+	Files         int
+	Disagreements []*Disagreement
+}
+
+func (r *Report) String() string {
+	var b strings.Builder
+	for _, d := range r.Disagreements {
+		b.WriteString(d.String() + "\n")
+	}
+	fmt.Fprintf(&b, "%d of %d files disagree\n", len(r.Disagreements), r.Files)
+	return b.String()
+}
+
+// Corpus returns the sorted paths of the .cherri files under dir.
+func Corpus(dir string) ([]string, error) {
+	var files []string
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() && filepath.Ext(p) == ".cherri" {
+			files = append(files, p)
+		}
+		return err
+	})
+	sort.Strings(files)
+	return files, err
+}
+
+// Run parses each file with the grammar and the reference and reports
+// where they disagree.
+func Run(files []string, reference Reference) (*Report, error) {
+	report := &Report{}
+	for _, path := range files {
+		source, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		outcome, err := reference.Parse(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		report.Files++
+		if d := Compare(path, Parse(source), outcome); d != nil {
+			report.Disagreements = append(report.Disagreements, d)
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,261 @@
+package difftest
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	got := Parse([]byte("#define name Demo\n// note\nshow(1)\nif x {\n    show(2)\n}\n"))
+	want := &Outcome{Accepted: true, Statements: []int{1, 3, 4}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse = %+v, want %+v", got, want)
+	}
+	got = Parse([]byte("show(1)\nshow(2\nshow(3)\n"))
+	if got.Accepted || got.Line != 2 {
+		t.Errorf("Parse of a broken file = %+v", got)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	grammar := &Outcome{Accepted: true, Statements: []int{1, 2, 5}}
+	tests := []struct {
+		name      string
+		grammar   *Outcome
+		reference *Outcome
+		want      Kind
+		lines     []int
+	}{
+		{"both accept", grammar, &Outcome{Accepted: true}, "", nil},
+		{"same boundaries", grammar, &Outcome{Accepted: true, Statements: []int{1, 2, 5}}, "", nil},
+		{"different boundaries", grammar, &Outcome{Accepted: true, Statements: []int{1, 3, 5}}, Boundaries, []int{2, 3}},
+		{"only grammar accepts", grammar, &Outcome{Line: 2, Message: "2:1: unexpected"}, OnlyGrammarAccepts, nil},
+		{"only reference accepts", &Outcome{Line: 2}, &Outcome{Accepted: true}, OnlyReferenceAccepts, nil},
+		{"same statement", &Outcome{Line: 3, Statements: []int{1, 2, 5}}, &Outcome{Line: 4}, "", nil},
+		{"different statement", &Outcome{Line: 3, Statements: []int{1, 2, 5}}, &Outcome{Line: 6}, ErrorStatement, nil},
+		{"unknown line", &Outcome{Line: 3, Statements: []int{1}}, &Outcome{}, "", nil},
+	}
+	for _, test := range tests {
+		d := Compare("a.cherri", test.grammar, test.reference)
+		if test.want == "" {
+			if d != nil {
+				t.Errorf("%s: got disagreement %s", test.name, d)
+			}
+			continue
+		}
+		if d == nil || d.Kind != test.want || !reflect.DeepEqual(d.Lines, test.lines) {
+			t.Errorf("%s: got %v, want %s %v", test.name, d, test.want, test.lines)
+		}
+	}
+}
+
+func writeCorpus(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestRun(t *testing.T) {
+	dir := writeCorpus(t, map[string]string{
+		"ok.cherri":         "show(1)\n",
+		"nested/bad.cherri": "show(1\n",
+		"strict.cherri":     "@x = 1\n",
+		"notes.txt":         "not a shortcut",
+	})
+	files, err := Corpus(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 3 {
+		t.Fatalf("Corpus = %v", files)
+	}
+	// A reference that rejects assignments, and otherwise agrees.
+	reference := ReferenceFunc(func(path string) (*Outcome, error) {
+		source, _ := os.ReadFile(path)
+		if strings.Contains(string(source), "=") {
+			return &Outcome{Line: 1, Message: "1:4: assignments are not allowed"}, nil
+		}
+		return Parse(source), nil
+	})
+	report, err := Run(files, reference)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := filepath.Join(dir, "strict.cherri") + ": accepted by the grammar only: 1:4: assignments are not allowed\n1 of 3 files disagree\n"
+	if got := report.String(); got != want {
+		t.Errorf("report =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("needs a POSIX shell")
+	}
+	dir := writeCorpus(t, map[string]string{"a.cherri": "show(1)\nshow(2)\n"})
+	reference := Command("sh", "-c", `echo '{"accepted": true, "statements": [1, 2]}'`, "adapter")
+	outcome, err := reference.Parse(filepath.Join(dir, "a.cherri"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !outcome.Accepted || !reflect.DeepEqual(outcome.Statements, []int{1, 2}) {
+		t.Errorf("outcome = %+v", outcome)
+	}
+	if _, err := Command("sh", "-c", "exit 3", "adapter").Parse("a.cherri"); err == nil {
+		t.Error("a failing adapter was not reported")
+	}
+}
+
+func TestCompilerReferenceParse(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("needs a POSIX shell")
+	}
+	// A stand-in compiler failing with a parser error on files that
+	// mention "broken" or leave a brace open, and with a later error on
+	// those that mention "unknown".
+	dir := writeCorpus(t, map[string]string{
+		"cherri": `#!/bin/sh
+if grep -q broken "$1"; then
+  echo "Parser Error: unexpected '}' (3:1)"
+elif [ "$(tr -cd '{' < "$1" | wc -c)" -ne "$(tr -cd '}' < "$1" | wc -c)" ]; then
+  echo "Parser Error: expected '}' (1:1)"
+elif grep -q unknown "$1"; then
+  echo "Action 'unknown' does not exist (2:1)"
+else
+  exit 0
+fi
+exit 1
+`,
+		"ok.cherri":      "show(1)\n",
+		"broken.cherri":  "// broken\nshow(1\n}\n",
+		"unknown.cherri": "show(1)\nunknown()\n",
+		"block.cherri":   "// note\nshow(1)\nif x {\n    show(2)\n}\n\n// done\nshow(3)\n",
+	})
+	exe := filepath.Join(dir, "cherri")
+	if err := os.Chmod(exe, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	reference := &CompilerReference{dir: t.TempDir(), exe: exe}
+	for name, want := range map[string]*Outcome{
+		"ok.cherri":      {Accepted: true, Statements: []int{1}},
+		"broken.cherri":  {Line: 3, Message: "Parser Error: unexpected '}' (3:1)"},
+		"unknown.cherri": {Accepted: true, Message: "Action 'unknown' does not exist (2:1)", Statements: []int{1, 2}},
+		"block.cherri":   {Accepted: true, Statements: []int{2, 3, 8}},
+	} {
+		got, err := reference.Parse(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: outcome = %+v, want %+v", name, got, want)
+		}
+	}
+
+	// Builds that word their errors differently set ParserErrors.
+	reference.ParserErrors = regexp.MustCompile(`does not exist`)
+	got, err := reference.Parse(filepath.Join(dir, "unknown.cherri"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Accepted || got.Line != 2 {
+		t.Errorf("outcome with a custom ParserErrors = %+v", got)
+	}
+}
+
+// referenceCheckout returns a checkout of the reference compiler: the one
+// named by CHERRI_REFERENCE, else a clone in testdata/cherri, else the
+// newest copy in the module cache. It skips the test if there is none.
+func referenceCheckout(t *testing.T) string {
+	t.Helper()
+	if dir := os.Getenv("CHERRI_REFERENCE"); dir != "" {
+		return dir
+	}
+	if _, err := os.Stat(filepath.Join("testdata", "cherri", "go.mod")); err == nil {
+		return filepath.Join("testdata", "cherri")
+	}
+	if out, err := exec.Command("go", "env", "GOMODCACHE").Output(); err == nil {
+		matches, _ := filepath.Glob(filepath.Join(strings.TrimSpace(string(out)), "github.com", "electrikmilk", "cherri@*"))
+		if len(matches) > 0 {
+			sort.Strings(matches)
+			return matches[len(matches)-1]
+		}
+	}
+	t.Skip("no checkout of the reference compiler: clone it into testdata/cherri or set CHERRI_REFERENCE")
+	return ""
+}
+
+// TestDefaultParserErrors checks DefaultParserErrors against the output of
+// the reference compiler: a syntax error must count as a rejection, and
+// an unknown action must not.
+func TestDefaultParserErrors(t *testing.T) {
+	reference, err := Compiler(referenceCheckout(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reference.Close()
+	dir := writeCorpus(t, map[string]string{
+		"broken.cherri":  "show(\"a\"\nshow(\"b\")\n",
+		"unknown.cherri": "show(\"a\")\nfrobnicate()\n",
+	})
+	broken, err := reference.Parse(filepath.Join(dir, "broken.cherri"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if broken.Accepted {
+		t.Errorf("DefaultParserErrors does not match the parser error of the reference:\n%s", broken.Message)
+	}
+	unknown, err := reference.Parse(filepath.Join(dir, "unknown.cherri"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !unknown.Accepted {
+		t.Errorf("DefaultParserErrors matches an error of a later stage of the reference:\n%s", unknown.Message)
+	}
+}
+
+// TestReferenceCompiler compares the grammar with a checkout of the
+// reference compiler over the .cherri files in CHERRI_CORPUS or else the
+// checkout itself. Files only the reference accepts fail the test, as the
+// grammar should accept all valid Cherri; other disagreements, including
+// statement boundaries, are logged.
+func TestReferenceCompiler(t *testing.T) {
+	checkout := referenceCheckout(t)
+	corpus := os.Getenv("CHERRI_CORPUS")
+	if corpus == "" {
+		corpus = checkout
+	}
+	files, err := Corpus(corpus)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reference, err := Compiler(checkout)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reference.Close()
+	report, err := Run(files, reference)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Logf("\n%s", report)
+	for _, d := range report.Disagreements {
+		if d.Kind == OnlyReferenceAccepts {
+			t.Errorf("%s", d)
+		}
+	}
+}
@@ -0,0 +1,622 @@
+// Package explore is a terminal parse-tree explorer for debugging the
+// grammar: it shows a file's source next to its syntax tree, highlights
+// the byte range of the selected node, runs queries as they are typed and
+// reloads the file when it changes.
+package explore
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	"golang.org/x/term"
+
+	tree_sitter_cherri "github.com/tree-sitter/tree-sitter-cherri/bindings/go"
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/analysis"
+)
+
+// A Key is a key press: the name of a special key such as "up" or
+// "enter", or the text of a printable key.
+type Key string
+
+// Special keys.
+const (
+	KeyUp        Key = "up"
+	KeyDown      Key = "down"
+	KeyLeft      Key = "left"
+	KeyRight     Key = "right"
+	KeyPageUp    Key = "pgup"
+	KeyPageDown  Key = "pgdown"
+	KeyHome      Key = "home"
+	KeyEnd       Key = "end"
+	KeyEnter     Key = "enter"
+	KeyEscape    Key = "esc"
+	KeyBackspace Key = "backspace"
+	KeyInterrupt Key = "ctrl+c"
+)
+
+// escapeKeys maps the escape sequences terminals send to keys.
+var escapeKeys = map[string]Key{
+	"\x1b[A": KeyUp, "\x1bOA": KeyUp,
+	"\x1b[B": KeyDown, "\x1bOB": KeyDown,
+	"\x1b[C": KeyRight, "\x1bOC": KeyRight,
+	"\x1b[D": KeyLeft, "\x1bOD": KeyLeft,
+	"\x1b[5~": KeyPageUp,
+	"\x1b[6~": KeyPageDown,
+	"\x1b[H":  KeyHome, "\x1b[1~": KeyHome, "\x1bOH": KeyHome,
+	"\x1b[F": KeyEnd, "\x1b[4~": KeyEnd, "\x1bOF": KeyEnd,
+}
+
+// DecodeKeys returns the keys in a chunk of terminal input. Unknown escape
+// sequences are dropped.
+func DecodeKeys(b []byte) []Key {
+	var keys []Key
+	for len(b) > 0 {
+		switch c := b[0]; {
+		case c == 0x1b:
+			if len(b) == 1 {
+				return append(keys, KeyEscape)
+			}
+			n := 2
+			for n < len(b) && (b[n] < 0x40 || b[n] > 0x7e) {
+				n++
+			}
+			n = min(n+1, len(b))
+			if key, ok := escapeKeys[string(b[:n])]; ok {
+				keys = append(keys, key)
+			}
+			b = b[n:]
+		case c == '\r' || c == '\n':
+			keys = append(keys, KeyEnter)
+			b = b[1:]
+		case c == 0x7f || c == 0x08:
+			keys = append(keys, KeyBackspace)
+			b = b[1:]
+		case c == 0x03:
+			keys = append(keys, KeyInterrupt)
+			b = b[1:]
+		case c < 0x20:
+			b = b[1:]
+		default:
+			r, size := utf8.DecodeRune(b)
+			keys = append(keys, Key(string(r)))
+			b = b[size:]
+		}
+	}
+	return keys
+}
+
+// An entry is a line of the tree pane.
+type entry struct {
+	node  *tree_sitter.Node
+	depth int
+	field string
+}
+
+// An Explorer is the state of the explorer for one file.
+type Explorer struct {
+	source []byte
+	tree   *tree_sitter.Tree
+	// lines holds the byte offset each source line starts at.
+	lines []uint
+
+	entries   []entry
+	cursor    int
+	anonymous bool
+	// treeTop and sourceTop are the first visible lines of the panes.
+	treeTop, sourceTop int
+
+	query      string
+	editing    bool
+	queryError string
+	// matches holds the byte ranges of the nodes the query captured, and
+	// matched the tree-sitter ids of those nodes.
+	matches [][2]uint
+	matched map[uintptr]bool
+}
+
+// New returns an explorer showing source.
+func New(source []byte) *Explorer {
+	e := &Explorer{}
+	e.Reload(source)
+	return e
+}
+
+// Close releases the syntax tree.
+func (e *Explorer) Close() {
+	e.tree.Close()
+}
+
+// Reload replaces the source, keeping the selection on the node at the
+// same position where possible.
+func (e *Explorer) Reload(source []byte) {
+	var selStart uint
+	var selKind string
+	if sel := e.Selected(); sel != nil {
+		selStart, selKind = sel.StartByte(), sel.Kind()
+	}
+	if e.tree != nil {
+		e.tree.Close()
+	}
+	e.source = source
+	e.tree = analysis.Parse(source)
+	e.lines = []uint{0}
+	for i, c := range source {
+		if c == '\n' {
+			e.lines = append(e.lines, uint(i+1))
+		}
+	}
+	e.flatten()
+	// Select the same node if it is still there, or else the outermost
+	// node starting closest before it.
+	e.cursor = 0
+	for i, en := range e.entries {
+		start := en.node.StartByte()
+		if start == selStart && en.node.Kind() == selKind {
+			e.cursor = i
+			break
+		}
+		if start > selStart {
+			break
+		}
+		if start > e.entries[e.cursor].node.StartByte() {
+			e.cursor = i
+		}
+	}
+	e.runQuery()
+}
+
+// flatten lists the nodes shown in the tree pane in document order.
+func (e *Explorer) flatten() {
+	e.entries = e.entries[:0]
+	cursor := e.tree.Walk()
+	defer cursor.Close()
+	depth := 0
+	for {
+		n := cursor.Node()
+		if n.IsNamed() || n.IsMissing() || e.anonymous {
+			e.entries = append(e.entries, entry{node: n, depth: depth, field: cursor.FieldName()})
+		}
+		if cursor.GotoFirstChild() {
+			depth++
+			continue
+		}
+		for !cursor.GotoNextSibling() {
+			if !cursor.GotoParent() {
+				return
+			}
+			depth--
+		}
+	}
+}
+
+// Selected returns the selected node, or nil before the first Reload.
+func (e *Explorer) Selected() *tree_sitter.Node {
+	if e.cursor < len(e.entries) {
+		return e.entries[e.cursor].node
+	}
+	return nil
+}
+
+// Query returns the query being run and, if it does not compile, why.
+func (e *Explorer) Query() (query, err string) {
+	return e.query, e.queryError
+}
+
+// Matches returns the number of nodes the query captured.
+func (e *Explorer) Matches() int {
+	return len(e.matches)
+}
+
+func (e *Explorer) runQuery() {
+	e.matches, e.matched, e.queryError = nil, map[uintptr]bool{}, ""
+	if strings.TrimSpace(e.query) == "" {
+		return
+	}
+	language := tree_sitter.NewLanguage(tree_sitter_cherri.Language())
+	query, err := tree_sitter.NewQuery(language, e.query)
+	if err != nil {
+		e.queryError = err.Error()
+		return
+	}
+	defer query.Close()
+	cursor := tree_sitter.NewQueryCursor()
+	defer cursor.Close()
+	captures := cursor.Captures(query, e.tree.RootNode(), e.source)
+	for {
+		match, index := captures.Next()
+		if match == nil {
+			break
+		}
+		n := match.Captures[index].Node
+		if !e.matched[n.Id()] {
+			e.matched[n.Id()] = true
+			e.matches = append(e.matches, [2]uint{n.StartByte(), n.EndByte()})
+		}
+	}
+}
+
+// Key handles a key press and reports whether the explorer should quit.
+// height is the height of the screen, for paging.
+func (e *Explorer) Key(key Key, height int) (quit bool) {
+	if key == KeyInterrupt {
+		return true
+	}
+	if e.editing {
+		switch key {
+		case KeyEnter:
+			e.editing = false
+		case KeyEscape:
+			e.editing, e.query = false, ""
+			e.runQuery()
+		case KeyBackspace:
+			if _, size := utf8.DecodeLastRuneInString(e.query); size > 0 {
+				e.query = e.query[:len(e.query)-size]
+				e.runQuery()
+			}
+		default:
+			if utf8.RuneCountInString(string(key)) == 1 {
+				e.query += string(key)
+				e.runQuery()
+			}
+		}
+		return false
+	}
+
+	page := max(height-3, 1)
+	switch key {
+	case "q":
+		return true
+	case "j", KeyDown:
+		e.move(e.cursor + 1)
+	case "k", KeyUp:
+		e.move(e.cursor - 1)
+	case "J", KeyPageDown:
+		e.move(e.cursor + page)
+	case "K", KeyPageUp:
+		e.move(e.cursor - page)
+	case "g", KeyHome:
+		e.move(0)
+	case "G", KeyEnd:
+		e.move(len(e.entries) - 1)
+	case "h", KeyLeft:
+		depth := e.entries[e.cursor].depth
+		for i := e.cursor - 1; i >= 0; i-- {
+			if e.entries[i].depth < depth {
+				e.move(i)
+				break
+			}
+		}
+	case "l", KeyRight:
+		if next := e.cursor + 1; next < len(e.entries) && e.entries[next].depth > e.entries[e.cursor].depth {
+			e.move(next)
+		}
+	case "n":
+		e.next(1, func(n *tree_sitter.Node) bool { return e.matched[n.Id()] })
+	case "N":
+		e.next(-1, func(n *tree_sitter.Node) bool { return e.matched[n.Id()] })
+	case "e":
+		e.next(1, func(n *tree_sitter.Node) bool { return n.IsError() || n.IsMissing() })
+	case "a":
+		e.anonymous = !e.anonymous
+		sel := e.Selected()
+		e.flatten()
+		e.selectNode(sel)
+	case "/":
+		e.editing = true
+	case KeyEscape:
+		e.query = ""
+		e.runQuery()
+	}
+	return false
+}
+
+// selectNode selects n, or else its nearest ancestor in the tree pane,
+// such as the named parent of an anonymous node that is hidden again.
+func (e *Explorer) selectNode(n *tree_sitter.Node) {
+	index := make(map[uintptr]int, len(e.entries))
+	for i, en := range e.entries {
+		index[en.node.Id()] = i
+	}
+	for ; n != nil; n = n.Parent() {
+		if i, ok := index[n.Id()]; ok {
+			e.cursor = i
+			return
+		}
+	}
+	e.move(e.cursor)
+}
+
+func (e *Explorer) move(i int) {
+	e.cursor = max(0, min(i, len(e.entries)-1))
+}
+
+// next moves to the next entry in direction dir whose node satisfies ok,
+// wrapping around.
+func (e *Explorer) next(dir int, ok func(*tree_sitter.Node) bool) {
+	n := len(e.entries)
+	for step := 1; step <= n; step++ {
+		i := ((e.cursor+dir*step)%n + n) % n
+		if ok(e.entries[i].node) {
+			e.cursor = i
+			return
+		}
+	}
+}
+
+// Terminal styles.
+const (
+	reset     = "\x1b[0m"
+	reverse   = "\x1b[7m"
+	highlight = "\x1b[30;43m"
+	red       = "\x1b[31m"
+	dim       = "\x1b[2m"
+)
+
+// Render returns the screen as height lines of at most width columns,
+// with terminal escape sequences for styling.
+func (e *Explorer) Render(width, height int) []string {
+	width, height = max(width, 20), max(height, 4)
+	paneHeight := height - 2
+	left := width / 2
+	right := width - left - 1
+
+	sel := e.Selected()
+	row := int(sel.StartPosition().Row)
+	if row < e.sourceTop || row >= e.sourceTop+paneHeight {
+		e.sourceTop = max(row-paneHeight/3, 0)
+	}
+	if e.cursor < e.treeTop {
+		e.treeTop = e.cursor
+	} else if e.cursor >= e.treeTop+paneHeight {
+		e.treeTop = e.cursor - paneHeight + 1
+	}
+
+	screen := make([]string, 0, height)
+	for i := 0; i < paneHeight; i++ {
+		screen = append(screen, e.sourceLine(e.sourceTop+i, left)+dim+"│"+reset+e.treeLine(e.treeTop+i, right))
+	}
+	screen = append(screen, reverse+pad(e.status(), width)+reset)
+	return append(screen, e.queryLine(width))
+}
+
+// sourceLine renders a line of the source pane.
+func (e *Explorer) sourceLine(row, width int) string {
+	if row >= len(e.lines) {
+		return strings.Repeat(" ", width)
+	}
+	var b strings.Builder
+	gutter := fmt.Sprintf("%4d ", row+1)
+	b.WriteString(dim + gutter + reset)
+	columns := len(gutter)
+
+	sel := e.Selected()
+	start, end := sel.StartByte(), sel.EndByte()
+	if start == end {
+		// Show where zero-width nodes, such as missing tokens, are.
+		end++
+	}
+	style := ""
+	offset := e.lines[row]
+	for offset < uint(len(e.source)) && e.source[offset] != '\n' && columns < width {
+		want := ""
+		switch {
+		case offset >= start && offset < end:
+			want = reverse
+		case e.inMatch(offset):
+			want = highlight
+		}
+		if want != style {
+			b.WriteString(reset + want)
+			style = want
+		}
+		r, size := utf8.DecodeRune(e.source[offset:])
+		if r == '\t' || r < 0x20 {
+			r = ' '
+		}
+		b.WriteRune(r)
+		columns++
+		offset += uint(size)
+	}
+	b.WriteString(reset)
+	// A selection running past the end of the line shows as a trailing
+	// space.
+	if columns < width && offset >= start && offset < end && offset < uint(len(e.source)) {
+		b.WriteString(reverse + " " + reset)
+		columns++
+	}
+	b.WriteString(strings.Repeat(" ", width-columns))
+	return b.String()
+}
+
+func (e *Explorer) inMatch(offset uint) bool {
+	for _, m := range e.matches {
+		if offset >= m[0] && offset < m[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// treeLine renders a line of the tree pane.
+func (e *Explorer) treeLine(i, width int) string {
+	if i >= len(e.entries) {
+		return strings.Repeat(" ", width)
+	}
+	en := e.entries[i]
+	marker := " "
+	if e.matched[en.node.Id()] {
+		marker = "●"
+	}
+	text := pad(marker+strings.Repeat("  ", en.depth)+describe(en), width)
+	switch {
+	case i == e.cursor:
+		return reverse + text + reset
+	case en.node.IsError() || en.node.IsMissing():
+		return red + text + reset
+	}
+	return text
+}
+
+// describe returns the tree pane text of an entry, e.g.
+// `condition: binary_expression [2, 3] - [2, 9]`.
+func describe(en entry) string {
+	var b strings.Builder
+	if en.field != "" {
+		b.WriteString(en.field + ": ")
+	}
+	n := en.node
+	switch {
+	case n.IsMissing():
+		b.WriteString("MISSING " + kind(n))
+	default:
+		b.WriteString(kind(n))
+	}
+	start, end := n.StartPosition(), n.EndPosition()
+	fmt.Fprintf(&b, " [%d, %d] - [%d, %d]", start.Row, start.Column, end.Row, end.Column)
+	return b.String()
+}
+
+func kind(n *tree_sitter.Node) string {
+	if n.IsNamed() {
+		return n.Kind()
+	}
+	return fmt.Sprintf("%q", n.Kind())
+}
+
+// status describes the selected node.
+func (e *Explorer) status() string {
+	if e.cursor >= len(e.entries) {
+		return ""
+	}
+	en := e.entries[e.cursor]
+	n := en.node
+	parts := []string{kind(n)}
+	if en.field != "" {
+		parts = append(parts, "field "+en.field)
+	}
+	if n.IsError() {
+		parts = append(parts, "error")
+	}
+	if n.IsMissing() {
+		parts = append(parts, "missing")
+	}
+	if n.HasError() && !n.IsError() {
+		parts = append(parts, "contains errors")
+	}
+	if n.IsExtra() {
+		parts = append(parts, "extra")
+	}
+	start, end := n.StartPosition(), n.EndPosition()
+	parts = append(parts,
+		fmt.Sprintf("bytes %d-%d", n.StartByte(), n.EndByte()),
+		fmt.Sprintf("[%d, %d] - [%d, %d]", start.Row, start.Column, end.Row, end.Column),
+	)
+	return " " + strings.Join(parts, "  ")
+}
+
+// queryLine shows the query being edited or run, or the key bindings.
+func (e *Explorer) queryLine(width int) string {
+	switch {
+	case e.editing || e.query != "":
+		line := "query: " + e.query
+		if e.editing {
+			line += "█"
+		}
+		if e.queryError != "" {
+			return red + pad(line+"  "+e.queryError, width) + reset
+		}
+		return pad(fmt.Sprintf("%s  (%d matches)", line, len(e.matches)), width)
+	}
+	return dim + pad("j/k move  h/l parent/child  e next error  / query  n/N next/previous match  a anonymous nodes  q quit", width) + reset
+}
+
+// pad truncates or pads s to width runes.
+func pad(s string, width int) string {
+	if n := utf8.RuneCountInString(s); n < width {
+		return s + strings.Repeat(" ", width-n)
+	}
+	runes := []rune(s)
+	return string(runes[:width])
+}
+
+// Run explores the file at path on a terminal until the user quits,
+// reloading the file when it changes.
+func Run(path string, in, out *os.File) error {
+	source, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if !term.IsTerminal(int(in.Fd())) || !term.IsTerminal(int(out.Fd())) {
+		return fmt.Errorf("explore needs a terminal")
+	}
+	state, err := term.MakeRaw(int(in.Fd()))
+	if err != nil {
+		return err
+	}
+	defer term.Restore(int(in.Fd()), state)
+	// Switch to the alternate screen and hide the cursor.
+	fmt.Fprint(out, "\x1b[?1049h\x1b[?25l")
+	defer fmt.Fprint(out, "\x1b[?25h\x1b[?1049l")
+
+	e := New(source)
+	defer e.Close()
+	keys := make(chan []Key)
+	go func() {
+		buf := make([]byte, 256)
+		for {
+			n, err := in.Read(buf)
+			if err != nil {
+				close(keys)
+				return
+			}
+			keys <- DecodeKeys(buf[:n])
+		}
+	}()
+	modified := modTime(path)
+	ticker := time.NewTicker(300 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		width, height, err := term.GetSize(int(out.Fd()))
+		if err != nil {
+			return err
+		}
+		draw(out, e.Render(width, height))
+		select {
+		case batch, ok := <-keys:
+			if !ok {
+				return nil
+			}
+			for _, key := range batch {
+				if e.Key(key, height) {
+					return nil
+				}
+			}
+		case <-ticker.C:
+			// Redrawing on every tick also picks up terminal resizes.
+			if t := modTime(path); !t.Equal(modified) {
+				modified = t
+				if source, err := os.ReadFile(path); err == nil {
+					e.Reload(source)
+				}
+			}
+		}
+	}
+}
+
+func modTime(path string) time.Time {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
+
+func draw(w io.Writer, screen []string) {
+	fmt.Fprint(w, "\x1b[H"+strings.Join(screen, "\r\n"))
+}
@@ -0,0 +1,177 @@
+package explore
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestDecodeKeys(t *testing.T) {
+	got := DecodeKeys([]byte("j\x1b[A\x1b[6~é\r\x7f\x03\x1b[99Zq"))
+	want := []Key{"j", KeyUp, KeyPageDown, "é", KeyEnter, KeyBackspace, KeyInterrupt, "q"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DecodeKeys = %q, want %q", got, want)
+	}
+	if got := DecodeKeys([]byte{0x1b}); !reflect.DeepEqual(got, []Key{KeyEscape}) {
+		t.Errorf("DecodeKeys(esc) = %q", got)
+	}
+}
+
+func typeText(e *Explorer, text string) {
+	for _, r := range text {
+		e.Key(Key(string(r)), 24)
+	}
+}
+
+func TestNavigation(t *testing.T) {
+	e := New([]byte("@a = 1\nif a {\n    show(a)\n}\n"))
+	defer e.Close()
+	if got := e.Selected().Kind(); got != "source_file" {
+		t.Fatalf("selected %s at start", got)
+	}
+	steps := []struct {
+		key  Key
+		want string
+	}{
+		{"j", "variable_assignment"},
+		{"l", "at_variable"},
+		{"j", "number"},
+		{"h", "variable_assignment"},
+		{"j", "at_variable"},
+		{"G", "identifier"},
+		{"g", "source_file"},
+		{KeyEnd, "identifier"},
+		{"h", "call"},
+	}
+	for _, step := range steps {
+		e.Key(step.key, 24)
+		if got := e.Selected().Kind(); got != step.want {
+			t.Fatalf("after %q selected %s, want %s", step.key, got, step.want)
+		}
+	}
+
+	e.Key("a", 24)
+	if got := e.Selected().Kind(); got != "call" {
+		t.Errorf("showing anonymous nodes moved the selection to %s", got)
+	}
+	e.Key("G", 24)
+	if got := e.Selected().Kind(); got != "}" {
+		t.Errorf("last node with anonymous nodes shown is %s", got)
+	}
+	if e.Key("q", 24) != true {
+		t.Error("q does not quit")
+	}
+}
+
+func TestHideAnonymousSelection(t *testing.T) {
+	e := New([]byte("show(1)\n"))
+	defer e.Close()
+	e.Key("a", 24)
+	e.Key("G", 24)
+	if got := e.Selected().Kind(); got != ")" {
+		t.Fatalf("last node with anonymous nodes shown is %s", got)
+	}
+	e.Key("a", 24)
+	if got := e.Selected().Kind(); got != "call" {
+		t.Errorf("hiding the selected anonymous node selected %s", got)
+	}
+	if screen := e.Render(80, 24); len(screen) != 24 {
+		t.Errorf("rendered %d lines", len(screen))
+	}
+}
+
+func TestErrors(t *testing.T) {
+	e := New([]byte("show(1)\nif x {\n    alert(\"hi\"\n}\n"))
+	defer e.Close()
+	e.Key("e", 24)
+	if sel := e.Selected(); !sel.IsError() && !sel.IsMissing() {
+		t.Errorf("e selected %s", sel.Kind())
+	}
+	if status := e.status(); !strings.Contains(status, "error") && !strings.Contains(status, "missing") {
+		t.Errorf("status = %q", status)
+	}
+}
+
+func TestQuery(t *testing.T) {
+	e := New([]byte("show(1)\nalert(2)\n@x = 3\n"))
+	defer e.Close()
+	e.Key("/", 24)
+	typeText(e, "(call) @c")
+	if q, err := e.Query(); q != "(call) @c" || err != "" || e.Matches() != 2 {
+		t.Fatalf("query %q: %d matches, error %q", q, e.Matches(), err)
+	}
+	// Editing the query reruns it.
+	for range len(" @c") {
+		e.Key(KeyBackspace, 24)
+	}
+	typeText(e, " @")
+	if _, err := e.Query(); err == "" {
+		t.Error("an incomplete query has no error")
+	}
+	typeText(e, "c")
+	e.Key(KeyEnter, 24)
+
+	// In normal mode, n and N move between matches.
+	e.Key("n", 24)
+	if got := e.Selected().Utf8Text(e.source); got != "show(1)" {
+		t.Errorf("n selected %q", got)
+	}
+	e.Key("n", 24)
+	e.Key("n", 24)
+	if got := e.Selected().Utf8Text(e.source); got != "show(1)" {
+		t.Errorf("n does not wrap around, selected %q", got)
+	}
+	e.Key("N", 24)
+	if got := e.Selected().Utf8Text(e.source); got != "alert(2)" {
+		t.Errorf("N selected %q", got)
+	}
+
+	screen := strings.Join(e.Render(80, 10), "\n")
+	if !strings.Contains(screen, highlight+"show(1)") || !strings.Contains(screen, reverse+"alert(2)") || !strings.Contains(screen, "(2 matches)") {
+		t.Errorf("matches are not highlighted:\n%s", screen)
+	}
+	e.Key(KeyEscape, 24)
+	if q, _ := e.Query(); q != "" || e.Matches() != 0 {
+		t.Error("escape does not clear the query")
+	}
+}
+
+func TestReload(t *testing.T) {
+	e := New([]byte("show(1)\nalert(2)\n"))
+	defer e.Close()
+	e.Key("G", 24)
+	e.Key("h", 24)
+	if got := e.Selected().Utf8Text(e.source); got != "alert(2)" {
+		t.Fatalf("selected %q", got)
+	}
+	e.Reload([]byte("show(1)\nalert(2, 3)\n"))
+	if got := e.Selected().Utf8Text(e.source); got != "alert(2, 3)" {
+		t.Errorf("after reload selected %q", got)
+	}
+}
+
+var escapes = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+func TestRender(t *testing.T) {
+	e := New([]byte("show(\"héllo\")\n\n\n\n\n\n\n\n\nalert(1)\n"))
+	defer e.Close()
+	e.Key("G", 24)
+	screen := e.Render(60, 8)
+	if len(screen) != 8 {
+		t.Fatalf("rendered %d lines", len(screen))
+	}
+	for i, line := range screen {
+		if n := utf8.RuneCountInString(escapes.ReplaceAllString(line, "")); n != 60 {
+			t.Errorf("line %d is %d columns: %q", i, n, line)
+		}
+	}
+	// The source pane scrolls to the selection, which is highlighted.
+	if joined := strings.Join(screen, "\n"); !strings.Contains(joined, "  10 ") || !strings.Contains(joined, reverse+"1"+reset+")") {
+		t.Errorf("selection is not shown:\n%s", strings.Join(screen, "\n"))
+	}
+	if status := escapes.ReplaceAllString(screen[6], ""); !strings.HasPrefix(status, ` number  field arguments  bytes 29-30  [9, 6] - [9, 7]`) {
+		t.Errorf("status = %q", status)
+	}
+}
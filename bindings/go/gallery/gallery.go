@@ -0,0 +1,257 @@
+// Package gallery generates a static site listing the shortcuts of a
+// project, with a JSON catalog and a highlighted source page for each.
+package gallery
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/analysis"
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/build"
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/compiler"
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/relnotes"
+)
+
+// CatalogFile is the name of the JSON catalog in the output directory.
+const CatalogFile = "catalog.json"
+
+// A Shortcut is the catalog entry of an entry-point shortcut.
+type Shortcut struct {
+	// Path is the path of the entry point, relative to the project root.
+	Path string `json:"path"`
+	// Name is the `#define name`, or the file name without its extension.
+	Name string `json:"name"`
+	// Doc is the comment at the top of the entry point, without comment
+	// markers.
+	Doc   string `json:"doc,omitempty"`
+	Glyph int    `json:"glyph,omitempty"`
+	// Color is the icon color as a CSS hex color, e.g. "#FF4351".
+	Color       string     `json:"color,omitempty"`
+	Inputs      []string   `json:"inputs,omitempty"`
+	From        []string   `json:"from,omitempty"`
+	Questions   []Question `json:"questions,omitempty"`
+	Permissions []string   `json:"permissions,omitempty"`
+	// Source is the path of the highlighted source page, relative to the
+	// output directory.
+	Source string `json:"source"`
+}
+
+// A Question is an import question asked when the shortcut is added.
+type Question struct {
+	Name   string `json:"name"`
+	Prompt string `json:"prompt"`
+}
+
+// Catalog returns the entry points of a project, sorted by name. Import
+// questions and permissions include those of included files.
+func Catalog(project *build.Project) []*Shortcut {
+	var shortcuts []*Shortcut
+	for _, entry := range project.Entries {
+		source := project.Files[entry]
+		snapshot := relnotes.Extract(source)
+		s := &Shortcut{
+			Path:   entry,
+			Name:   snapshot.Defines["name"],
+			Doc:    docComment(source),
+			Inputs: list(snapshot.Defines["inputs"]),
+			From:   list(snapshot.Defines["from"]),
+			Source: "source/" + entry + ".html",
+		}
+		if s.Name == "" {
+			s.Name = strings.TrimSuffix(path.Base(entry), path.Ext(entry))
+		}
+		if glyph, err := strconv.Atoi(snapshot.Defines["glyph"]); err == nil {
+			s.Glyph = glyph
+		}
+		if color, ok := compiler.IconColor(snapshot.Defines["color"]); ok {
+			s.Color = fmt.Sprintf("#%06X", color>>8)
+		}
+
+		permissions := map[string]bool{}
+		for _, file := range project.Closure(entry) {
+			included := snapshot
+			if file != entry {
+				included = relnotes.Extract(project.Files[file])
+			}
+			for name, prompt := range included.Questions {
+				s.Questions = append(s.Questions, Question{Name: name, Prompt: prompt})
+			}
+			for permission := range included.Permissions {
+				permissions[permission] = true
+			}
+		}
+		sort.Slice(s.Questions, func(i, j int) bool { return s.Questions[i].Name < s.Questions[j].Name })
+		for permission := range permissions {
+			s.Permissions = append(s.Permissions, permission)
+		}
+		sort.Strings(s.Permissions)
+		shortcuts = append(shortcuts, s)
+	}
+	sort.SliceStable(shortcuts, func(i, j int) bool {
+		return strings.ToLower(shortcuts[i].Name) < strings.ToLower(shortcuts[j].Name)
+	})
+	return shortcuts
+}
+
+// Generate writes the gallery of a project to out: index.html, the JSON
+// catalog, and a highlighted source page for each entry point.
+func Generate(project *build.Project, out string) error {
+	shortcuts := Catalog(project)
+	data, err := json.MarshalIndent(shortcuts, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeFile(filepath.Join(out, CatalogFile), append(data, '\n')); err != nil {
+		return err
+	}
+
+	var b strings.Builder
+	if err := indexTemplate.Execute(&b, shortcuts); err != nil {
+		return err
+	}
+	if err := writeFile(filepath.Join(out, "index.html"), []byte(b.String())); err != nil {
+		return err
+	}
+	for _, s := range shortcuts {
+		b.Reset()
+		err := sourceTemplate.Execute(&b, sourcePage{
+			Shortcut: s,
+			Root:     strings.Repeat("../", strings.Count(s.Source, "/")),
+			Code:     template.HTML(Highlight(project.Files[s.Path])),
+		})
+		if err != nil {
+			return err
+		}
+		if err := writeFile(filepath.Join(out, filepath.FromSlash(s.Source)), []byte(b.String())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type sourcePage struct {
+	*Shortcut
+	// Root is the relative path from the page to the output directory.
+	Root string
+	Code template.HTML
+}
+
+func writeFile(name string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(name, data, 0o644)
+}
+
+// docComment returns the text of the comments at the top of a file.
+func docComment(source []byte) string {
+	tree := analysis.Parse(source)
+	defer tree.Close()
+	root := tree.RootNode()
+	var lines []string
+	var last *tree_sitter.Node
+	for i := uint(0); i < root.NamedChildCount(); i++ {
+		n := root.NamedChild(i)
+		// A blank line ends the doc comment.
+		if n.Kind() != "comment" || last != nil && n.StartPosition().Row > last.EndPosition().Row+1 {
+			break
+		}
+		lines = append(lines, analysis.CommentText(n.Utf8Text(source)))
+		last = n
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// list returns the comma-separated values of a define.
+func list(value string) []string {
+	var out []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+const style = `
+body { font: 15px/1.5 -apple-system, system-ui, sans-serif; margin: 2em auto; max-width: 60em; padding: 0 1em; color: #1d1d1f; }
+a { color: inherit; }
+.cards { display: grid; grid-template-columns: repeat(auto-fill, minmax(16em, 1fr)); gap: 1em; list-style: none; padding: 0; }
+.card { border-radius: 14px; padding: 1em; color: white; background: var(--color, #1B76FF); }
+.card h2 { margin: 0 0 .5em; font-size: 1.1em; }
+.card p, .card dl { margin: .5em 0; font-size: .9em; }
+dt { font-weight: 600; }
+dd { margin: 0 0 .3em; }
+pre { background: #f5f5f7; border-radius: 8px; padding: 1em; overflow: auto; }
+.keyword, .directive { color: #ad3da4; font-weight: 600; }
+.comment { color: #707f8c; }
+.string { color: #d12f1b; }
+.escape, .interpolation { color: #272ad8; }
+.number, .constant { color: #272ad8; }
+.variable { color: #3e8087; }
+.builtin, .function { color: #804fb8; }
+.error { text-decoration: red wavy underline; }
+`
+
+var indexTemplate = template.Must(template.New("index").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Shortcuts</title>
+<style>` + style + `</style>
+</head>
+<body>
+<h1>Shortcuts</h1>
+<ul class="cards">
+{{- range .}}
+<li class="card"{{with .Color}} style="--color: {{.}}"{{end}}>
+<h2><a href="{{.Source}}">{{.Name}}</a></h2>
+{{- with .Doc}}
+<p>{{.}}</p>
+{{- end}}
+<dl>
+{{- with .Inputs}}
+<dt>Accepts</dt><dd>{{range $i, $v := .}}{{if $i}}, {{end}}{{$v}}{{end}}</dd>
+{{- end}}
+{{- with .From}}
+<dt>Runs from</dt><dd>{{range $i, $v := .}}{{if $i}}, {{end}}{{$v}}{{end}}</dd>
+{{- end}}
+{{- with .Permissions}}
+<dt>Permissions</dt><dd>{{range $i, $v := .}}{{if $i}}, {{end}}{{$v}}{{end}}</dd>
+{{- end}}
+{{- with .Questions}}
+<dt>Asks on import</dt>{{range .}}<dd>{{if .Prompt}}{{.Prompt}}{{else}}{{.Name}}{{end}}</dd>{{end}}
+{{- end}}
+</dl>
+</li>
+{{- end}}
+</ul>
+<p><a href="` + CatalogFile + `">JSON catalog</a></p>
+</body>
+</html>
+`))
+
+var sourceTemplate = template.Must(template.New("source").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Name}}</title>
+<style>` + style + `</style>
+</head>
+<body>
+<p><a href="{{.Root}}index.html">All shortcuts</a></p>
+<h1>{{.Name}}</h1>
+<p><code>{{.Path}}</code></p>
+<pre><code>{{.Code}}</code></pre>
+</body>
+</html>
+`))
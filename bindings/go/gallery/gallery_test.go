@@ -0,0 +1,106 @@
+package gallery
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/build"
+)
+
+func testProject() *build.Project {
+	return &build.Project{
+		Config: &build.Config{},
+		Files: map[string][]byte{
+			"weather.cherri": []byte(`// Shows the weather
+// where you are.
+
+// Not part of the doc comment.
+#define name Weather Now
+#define glyph 59446
+#define color yellow
+#define inputs text, url
+#define from sharesheet, menubar
+#include "lib.cherri"
+#question apiKey "Your API key"
+@here = getCurrentLocation()
+show(downloadURL("https://example.com", "GET", apiKey))
+`),
+			"lib.cherri": []byte("#question units \"Units\"\n@clip = getclipboard\n"),
+			"zap.cherri": []byte("/*\n * Zaps things.\n */\nalert(\"zap\")\n"),
+		},
+		Includes: map[string][]string{"weather.cherri": {"lib.cherri"}},
+		Entries:  []string{"weather.cherri", "zap.cherri"},
+	}
+}
+
+func TestCatalog(t *testing.T) {
+	got := Catalog(testProject())
+	want := []*Shortcut{
+		{
+			Path:        "weather.cherri",
+			Name:        "Weather Now",
+			Doc:         "Shows the weather\nwhere you are.",
+			Glyph:       59446,
+			Color:       "#FEC418",
+			Inputs:      []string{"text", "url"},
+			From:        []string{"sharesheet", "menubar"},
+			Questions:   []Question{{"apiKey", "Your API key"}, {"units", "Units"}},
+			Permissions: []string{"Clipboard", "Location", "Network access"},
+			Source:      "source/weather.cherri.html",
+		},
+		{Path: "zap.cherri", Name: "zap", Doc: "Zaps things.", Source: "source/zap.cherri.html"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		g, _ := json.MarshalIndent(got, "", "  ")
+		t.Errorf("Catalog =\n%s", g)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	out := t.TempDir()
+	if err := Generate(testProject(), out); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(out, CatalogFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var catalog []Shortcut
+	if err := json.Unmarshal(data, &catalog); err != nil || len(catalog) != 2 {
+		t.Fatalf("catalog = %s, %v", data, err)
+	}
+
+	index, err := os.ReadFile(filepath.Join(out, "index.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`<li class="card" style="--color: #FEC418">`,
+		`<h2><a href="source/weather.cherri.html">Weather Now</a></h2>`,
+		`<dd>Clipboard, Location, Network access</dd>`,
+		`<dd>Your API key</dd>`,
+		`<h2><a href="source/zap.cherri.html">zap</a></h2>`,
+	} {
+		if !strings.Contains(string(index), want) {
+			t.Errorf("index.html is missing %s", want)
+		}
+	}
+
+	page, err := os.ReadFile(filepath.Join(out, "source", "zap.cherri.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`<a href="../index.html">All shortcuts</a>`,
+		`<span class="function">alert</span>(<span class="string">&#34;zap&#34;</span>)`,
+	} {
+		if !strings.Contains(string(page), want) {
+			t.Errorf("zap.cherri.html is missing %s", want)
+		}
+	}
+}
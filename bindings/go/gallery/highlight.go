@@ -0,0 +1,104 @@
+package gallery
+
+import (
+	"html"
+	"strings"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/analysis"
+)
+
+// Highlight returns source as HTML, wrapping tokens in spans whose class
+// names their kind: keyword, directive, comment, string, escape,
+// interpolation, number, variable, constant, builtin, function or error.
+func Highlight(source []byte) string {
+	tree := analysis.Parse(source)
+	defer tree.Close()
+	var b strings.Builder
+	h := highlighter{source: source, b: &b}
+	h.children(tree.RootNode(), 0, uint(len(source)))
+	return b.String()
+}
+
+type highlighter struct {
+	source []byte
+	b      *strings.Builder
+}
+
+func (h highlighter) node(n *tree_sitter.Node) {
+	class := highlightClass(n)
+	if class == "" {
+		h.children(n, n.StartByte(), n.EndByte())
+		return
+	}
+	h.b.WriteString(`<span class="` + class + `">`)
+	// Strings and errors contain tokens of their own to highlight.
+	if n.NamedChildCount() > 0 {
+		h.children(n, n.StartByte(), n.EndByte())
+	} else {
+		h.b.WriteString(html.EscapeString(n.Utf8Text(h.source)))
+	}
+	h.b.WriteString("</span>")
+}
+
+// children writes source[start:end], highlighting the children of n in it.
+func (h highlighter) children(n *tree_sitter.Node, start, end uint) {
+	pos := start
+	for i := uint(0); i < n.ChildCount(); i++ {
+		child := n.Child(i)
+		if child.StartByte() < pos {
+			continue
+		}
+		h.b.WriteString(html.EscapeString(string(h.source[pos:child.StartByte()])))
+		h.node(child)
+		pos = child.EndByte()
+	}
+	if pos < end {
+		h.b.WriteString(html.EscapeString(string(h.source[pos:end])))
+	}
+}
+
+func highlightClass(n *tree_sitter.Node) string {
+	switch n.Kind() {
+	case "comment":
+		return "comment"
+	case "string", "single_quoted_string":
+		return "string"
+	case "escape_sequence":
+		return "escape"
+	case "interpolation":
+		return "interpolation"
+	case "number":
+		return "number"
+	case "at_variable":
+		return "variable"
+	case "builtin_constant", "boolean":
+		return "constant"
+	case "builtin_keyword", "type_keyword":
+		return "builtin"
+	case "pragma_directive":
+		return "directive"
+	case "ERROR":
+		return "error"
+	case "identifier":
+		if parent := n.Parent(); parent != nil && parent.Kind() == "call" {
+			if f := parent.ChildByFieldName("function"); f != nil && f.Id() == n.Id() {
+				return "function"
+			}
+		}
+	}
+	if !n.IsNamed() && isWord(n.Kind()) {
+		return "keyword"
+	}
+	return ""
+}
+
+func isWord(s string) bool {
+	for _, c := range s {
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,17 @@
+package gallery
+
+import "testing"
+
+func TestHighlight(t *testing.T) {
+	source := "// Greets\n#define name Hi\n@x = \"a\\n{b}\" // <tag>\nif x == 1 {\n    alert(ShortcutInput, true)\n}\n"
+	want := `<span class="comment">// Greets</span>
+<span class="directive">#define</span> <span class="builtin">name</span> Hi
+<span class="variable">@x</span> = <span class="string">&#34;a<span class="escape">\n</span><span class="interpolation">{b}</span>&#34;</span> <span class="comment">// &lt;tag&gt;</span>
+<span class="keyword">if</span> x == <span class="number">1</span> {
+    <span class="function">alert</span>(<span class="constant">ShortcutInput</span>, <span class="constant">true</span>)
+}
+`
+	if got := Highlight([]byte(source)); got != want {
+		t.Errorf("Highlight =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,502 @@
+// Package lsif exports code intelligence for a Cherri workspace as an LSIF
+// index, for code browsers with precise navigation.
+package lsif
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode/utf16"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/analysis"
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/catalog"
+)
+
+// Version is the LSIF version of the output.
+const Version = "0.4.3"
+
+// A symbol is a variable, constant, action parameter, action or catalog
+// action.
+type symbol struct {
+	hover      string
+	defs, refs []*occurrence
+	resultSet  int
+	// defResult and refResult are the ids of the definition and reference
+	// results; defResult is 0 for a symbol without definitions.
+	defResult, refResult int
+}
+
+// An occurrence is a reference to a symbol in a document, by byte range.
+type occurrence struct {
+	doc        *document
+	start, end uint
+	sym        *symbol
+	def        bool
+	id         int
+}
+
+type link struct {
+	start, end uint
+	target     string
+}
+
+type document struct {
+	path       string
+	source     []byte
+	lineStarts []uint
+	// symbols holds the variables and constants defined at the top
+	// level, and actions the action definitions.
+	symbols     map[string]*symbol
+	actions     map[string]*symbol
+	includes    []string
+	links       []link
+	occurrences []*occurrence
+	id          int
+}
+
+// A scope is the body of an action definition, whose variables are local,
+// or the range of its signature.
+type scope struct {
+	start, end uint
+	symbols    map[string]*symbol
+}
+
+// A reference is an unresolved use of a name.
+type reference struct {
+	doc        *document
+	scope      *scope
+	name       string
+	start, end uint
+	call       bool
+}
+
+// LoadWorkspace reads every .cherri file under root, keyed by
+// slash-separated path relative to root.
+func LoadWorkspace(root string) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || filepath.Ext(p) != ".cherri" {
+			return err
+		}
+		source, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = source
+		return nil
+	})
+	return files, err
+}
+
+// Index writes an LSIF index of a workspace to w as JSON lines. files holds
+// the source of every file keyed by slash-separated path relative to root,
+// the absolute path of the workspace.
+//
+// The index has definitions, references and hovers for variables,
+// constants, action parameters and action definitions, hovers for catalog
+// actions, and document links for `#include` pragmas. As the Cherri
+// compiler pastes included files in place, names a file does not define
+// resolve to the files it includes, directly or transitively, in include
+// order. Variables written inside an action definition are local to it.
+func Index(w io.Writer, root string, files map[string][]byte) error {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	docs := map[string]*document{}
+	var ordered []*document
+	var refs []reference
+	var symbols []*symbol
+	builtins := map[string]*symbol{}
+	newSymbol := func(hover string) *symbol {
+		s := &symbol{hover: hover}
+		symbols = append(symbols, s)
+		return s
+	}
+
+	for _, name := range names {
+		d := &document{path: name, source: files[name], symbols: map[string]*symbol{}, actions: map[string]*symbol{}}
+		d.lineStarts = lineStarts(d.source)
+		docs[name] = d
+		ordered = append(ordered, d)
+
+		tree := analysis.Parse(d.source)
+		root := tree.RootNode()
+		source := d.source
+		for _, inc := range analysis.Includes(root, source) {
+			target := path.Join(path.Dir(name), inc.Path)
+			if _, ok := files[target]; !ok {
+				continue
+			}
+			d.includes = append(d.includes, target)
+			value := inc.Node.ChildByFieldName("value")
+			d.links = append(d.links, link{value.StartByte(), value.EndByte(), target})
+		}
+		for i := uint(0); i < root.NamedChildCount(); i++ {
+			pragma := root.NamedChild(i)
+			if pragma.Kind() != "pragma" || pragma.NamedChild(0).Utf8Text(source) != "#question" {
+				continue
+			}
+			if value := pragma.ChildByFieldName("value"); value.Kind() == "identifier" {
+				s := newSymbol(codeBlock(oneLine(questionText(pragma, source))))
+				d.symbols[value.Utf8Text(source)] = s
+				d.add(s, value.StartByte(), value.EndByte(), true)
+			}
+		}
+
+		var scopes, signatures []*scope
+		for i := uint(0); i < root.NamedChildCount(); i++ {
+			def := root.NamedChild(i)
+			if def.Kind() != "action_definition" {
+				continue
+			}
+			name, body := def.ChildByFieldName("name"), def.ChildByFieldName("body")
+			action := name.Utf8Text(source)
+			signatures = append(signatures, &scope{start: def.StartByte(), end: body.StartByte()})
+			hover := codeBlock(oneLine(string(source[def.StartByte():body.StartByte()])))
+			if doc := docComment(def, source); doc != "" {
+				hover += "\n\n" + doc
+			}
+			if _, ok := d.actions[action]; !ok {
+				d.actions[action] = newSymbol(hover)
+			}
+			d.add(d.actions[action], name.StartByte(), name.EndByte(), true)
+			sc := &scope{start: body.StartByte(), end: body.EndByte(), symbols: map[string]*symbol{}}
+			scopes = append(scopes, sc)
+			for _, p := range analysis.Parameters(def) {
+				param := p.ChildByFieldName("name")
+				text := param.Utf8Text(source)
+				s := newSymbol(codeBlock("@"+text+": "+p.ChildByFieldName("type").Utf8Text(source)) + "\n\nParameter of " + action + ".")
+				sc.symbols[text] = s
+				d.add(s, param.StartByte(), param.EndByte(), true)
+			}
+		}
+
+		for _, occ := range analysis.Occurrences(root, source) {
+			if occ.Mutation || occ.Node.Kind() == "builtin_constant" || scopeAt(signatures, occ.Node.StartByte()) != nil {
+				continue
+			}
+			start := occ.Start
+			end := start + uint(len(occ.Name))
+			sc := scopeAt(scopes, start)
+			if occ.Write {
+				table := d.symbols
+				if sc != nil {
+					table = sc.symbols
+				}
+				if _, ok := table[occ.Name]; !ok {
+					table[occ.Name] = newSymbol(variableHover(occ, source))
+					d.add(table[occ.Name], start, end, true)
+					continue
+				}
+			}
+			refs = append(refs, reference{doc: d, scope: sc, name: occ.Name, start: start, end: end})
+		}
+		analysis.Walk(root, func(n *tree_sitter.Node) bool {
+			if n.Kind() == "call" && scopeAt(signatures, n.StartByte()) == nil {
+				if fn := n.ChildByFieldName("function"); fn != nil {
+					refs = append(refs, reference{doc: d, name: fn.Utf8Text(source), start: fn.StartByte(), end: fn.EndByte(), call: true})
+				}
+			}
+			return true
+		})
+		tree.Close()
+	}
+
+	for _, ref := range refs {
+		var s *symbol
+		if ref.scope != nil {
+			s = ref.scope.symbols[ref.name]
+		}
+		for _, d := range includeOrder(docs, ref.doc) {
+			if s != nil {
+				break
+			}
+			if ref.call {
+				s = d.actions[ref.name]
+			} else {
+				s = d.symbols[ref.name]
+			}
+		}
+		if s == nil && ref.call {
+			if s = builtins[ref.name]; s == nil {
+				if action, ok := catalog.Lookup(ref.name); ok {
+					s = newSymbol(catalogHover(action))
+					builtins[ref.name] = s
+				}
+			}
+		}
+		if s != nil {
+			ref.doc.add(s, ref.start, ref.end, false)
+		}
+	}
+
+	e := &encoder{enc: json.NewEncoder(w)}
+	return e.write(root, ordered, symbols)
+}
+
+// scopeAt returns the scope containing offset, or nil.
+func scopeAt(scopes []*scope, offset uint) *scope {
+	for _, sc := range scopes {
+		if offset >= sc.start && offset < sc.end {
+			return sc
+		}
+	}
+	return nil
+}
+
+func (d *document) add(s *symbol, start, end uint, def bool) {
+	occ := &occurrence{doc: d, start: start, end: end, sym: s, def: def}
+	d.occurrences = append(d.occurrences, occ)
+	if def {
+		s.defs = append(s.defs, occ)
+	} else {
+		s.refs = append(s.refs, occ)
+	}
+}
+
+// includeOrder returns d followed by the files it includes, breadth
+// first.
+func includeOrder(docs map[string]*document, d *document) []*document {
+	order := []*document{d}
+	seen := map[string]bool{d.path: true}
+	for i := 0; i < len(order); i++ {
+		for _, target := range order[i].includes {
+			if !seen[target] {
+				seen[target] = true
+				order = append(order, docs[target])
+			}
+		}
+	}
+	return order
+}
+
+func variableHover(occ analysis.Occurrence, source []byte) string {
+	parent := occ.Node.Parent()
+	switch parent.Kind() {
+	case "declaration":
+		return codeBlock("@" + occ.Name + ": " + parent.ChildByFieldName("type").Utf8Text(source))
+	case "constant_assignment":
+		value := parent.ChildByFieldName("value")
+		if text := value.Utf8Text(source); len(text) <= 80 && !strings.Contains(text, "\n") {
+			return codeBlock("const " + occ.Name + " = " + text)
+		}
+		return codeBlock("const " + occ.Name + ": " + analysis.LiteralType(value))
+	case "variable_assignment", "identifier_assignment":
+		return codeBlock("@" + occ.Name + ": " + analysis.LiteralType(parent.ChildByFieldName("value")))
+	}
+	return codeBlock("@" + occ.Name + ": variable")
+}
+
+func catalogHover(action *catalog.Action) string {
+	var params []string
+	for _, p := range action.Parameters {
+		text := string(p.Type) + " " + p.Name
+		if p.Optional {
+			text += "?"
+		}
+		params = append(params, text)
+	}
+	return codeBlock(action.Name+"("+strings.Join(params, ", ")+")") + "\n\n`" + action.Identifier + "`"
+}
+
+func questionText(pragma *tree_sitter.Node, source []byte) string {
+	end := pragma.EndByte()
+	if rest := analysis.PragmaArguments(pragma); len(rest) > 0 {
+		end = rest[len(rest)-1].EndByte()
+	}
+	return string(source[pragma.StartByte():end])
+}
+
+// docComment returns the text of the comments on the lines directly above
+// node.
+func docComment(node *tree_sitter.Node, source []byte) string {
+	var lines []string
+	row := node.StartPosition().Row
+	for c := node.PrevSibling(); c != nil && c.Kind() == "comment" && c.EndPosition().Row+1 == row; c = c.PrevSibling() {
+		if text := analysis.CommentText(c.Utf8Text(source)); text != "" {
+			lines = append([]string{text}, lines...)
+		}
+		row = c.StartPosition().Row
+	}
+	return strings.Join(lines, "\n")
+}
+
+func codeBlock(code string) string {
+	return "```cherri\n" + code + "\n```"
+}
+
+func oneLine(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+func lineStarts(source []byte) []uint {
+	starts := []uint{0}
+	for i, c := range source {
+		if c == '\n' {
+			starts = append(starts, uint(i+1))
+		}
+	}
+	return starts
+}
+
+type position struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+// position converts a byte offset to a line and UTF-16 character offset.
+func (d *document) position(offset uint) position {
+	line := sort.Search(len(d.lineStarts), func(i int) bool { return d.lineStarts[i] > offset }) - 1
+	text := string(d.source[d.lineStarts[line]:offset])
+	return position{Line: line, Character: len(utf16.Encode([]rune(text)))}
+}
+
+// An encoder writes LSIF vertices and edges with sequential ids.
+type encoder struct {
+	enc *json.Encoder
+	id  int
+	err error
+}
+
+func (e *encoder) emit(element map[string]any) int {
+	e.id++
+	element["id"] = e.id
+	if e.err == nil {
+		e.err = e.enc.Encode(element)
+	}
+	return e.id
+}
+
+func (e *encoder) vertex(label string, fields map[string]any) int {
+	if fields == nil {
+		fields = map[string]any{}
+	}
+	fields["type"], fields["label"] = "vertex", label
+	return e.emit(fields)
+}
+
+func (e *encoder) edge(label string, out int, in any, fields map[string]any) {
+	if fields == nil {
+		fields = map[string]any{}
+	}
+	fields["type"], fields["label"], fields["outV"] = "edge", label, out
+	if ids, ok := in.([]int); ok {
+		fields["inVs"] = ids
+	} else {
+		fields["inV"] = in
+	}
+	e.emit(fields)
+}
+
+func (e *encoder) event(kind, scope string, data int) {
+	e.vertex("$event", map[string]any{"kind": kind, "scope": scope, "data": data})
+}
+
+func fileURI(root, name string) string {
+	return (&url.URL{Scheme: "file", Path: path.Join(filepath.ToSlash(root), name)}).String()
+}
+
+func (e *encoder) write(root string, docs []*document, symbols []*symbol) error {
+	e.vertex("metaData", map[string]any{
+		"version":          Version,
+		"projectRoot":      fileURI(root, ""),
+		"positionEncoding": "utf-16",
+		"toolInfo":         map[string]any{"name": "tree-sitter-cherri"},
+	})
+	project := e.vertex("project", map[string]any{"kind": "cherri"})
+	e.event("begin", "project", project)
+
+	for _, s := range symbols {
+		s.resultSet = e.vertex("resultSet", nil)
+		hover := e.vertex("hoverResult", map[string]any{
+			"result": map[string]any{"contents": map[string]any{"kind": "markdown", "value": s.hover}},
+		})
+		e.edge("textDocument/hover", s.resultSet, hover, nil)
+		if len(s.defs) > 0 {
+			s.defResult = e.vertex("definitionResult", nil)
+			e.edge("textDocument/definition", s.resultSet, s.defResult, nil)
+		}
+		s.refResult = e.vertex("referenceResult", nil)
+		e.edge("textDocument/references", s.resultSet, s.refResult, nil)
+	}
+
+	for _, d := range docs {
+		d.id = e.vertex("document", map[string]any{"uri": fileURI(root, d.path), "languageId": "cherri"})
+		e.event("begin", "document", d.id)
+		e.edge("contains", project, []int{d.id}, nil)
+		sort.SliceStable(d.occurrences, func(i, j int) bool { return d.occurrences[i].start < d.occurrences[j].start })
+		var ranges []int
+		for _, occ := range d.occurrences {
+			occ.id = e.vertex("range", map[string]any{"start": d.position(occ.start), "end": d.position(occ.end)})
+			e.edge("next", occ.id, occ.sym.resultSet, nil)
+			ranges = append(ranges, occ.id)
+		}
+		if len(ranges) > 0 {
+			e.edge("contains", d.id, ranges, nil)
+		}
+		if len(d.links) > 0 {
+			var links []map[string]any
+			for _, l := range d.links {
+				links = append(links, map[string]any{
+					"range":  map[string]any{"start": d.position(l.start), "end": d.position(l.end)},
+					"target": fileURI(root, l.target),
+				})
+			}
+			result := e.vertex("documentLinkResult", map[string]any{"result": links})
+			e.edge("textDocument/documentLink", d.id, result, nil)
+		}
+		// Items name their document, so they are emitted before it ends.
+		for _, s := range symbols {
+			defs, refs := inDocument(s.defs, d), inDocument(s.refs, d)
+			if len(defs) > 0 {
+				e.edge("item", s.defResult, ids(defs), map[string]any{"document": d.id})
+				e.edge("item", s.refResult, ids(defs), map[string]any{"document": d.id, "property": "definitions"})
+			}
+			if len(refs) > 0 {
+				e.edge("item", s.refResult, ids(refs), map[string]any{"document": d.id, "property": "references"})
+			}
+		}
+		e.event("end", "document", d.id)
+	}
+
+	e.event("end", "project", project)
+	if e.err != nil {
+		return fmt.Errorf("lsif: %w", e.err)
+	}
+	return nil
+}
+
+// inDocument returns the occurrences in d, keeping their order.
+func inDocument(occs []*occurrence, d *document) []*occurrence {
+	var out []*occurrence
+	for _, occ := range occs {
+		if occ.doc == d {
+			out = append(out, occ)
+		}
+	}
+	return out
+}
+
+func ids(occs []*occurrence) []int {
+	out := make([]int, len(occs))
+	for i, occ := range occs {
+		out[i] = occ.id
+	}
+	return out
+}
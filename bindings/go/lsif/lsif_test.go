@@ -0,0 +1,212 @@
+package lsif
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+var workspace = map[string][]byte{
+	"main.cherri": []byte(`#include "lib/util.cherri"
+#question apiKey "Enter your key" ""
+
+@greeting = "Hi"
+show("{greeting} {limit}")
+@n = double(limit)
+alert(apiKey)
+`),
+	"lib/util.cherri": []byte(`const limit = 5
+
+// Doubles a number.
+action double(number x): number {
+    @result = x * 2
+    output(@result )
+}
+`),
+}
+
+// index is a decoded LSIF index.
+type index struct {
+	elements map[int]map[string]any
+	// out maps a vertex id and edge label to the edges leaving it.
+	out map[int]map[string][]map[string]any
+}
+
+func decodeIndex(t *testing.T, data []byte) *index {
+	t.Helper()
+	idx := &index{elements: map[int]map[string]any{}, out: map[int]map[string][]map[string]any{}}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		var element map[string]any
+		if err := json.Unmarshal(scanner.Bytes(), &element); err != nil {
+			t.Fatalf("invalid line %s: %v", scanner.Text(), err)
+		}
+		id := int(element["id"].(float64))
+		idx.elements[id] = element
+		if element["type"] == "edge" {
+			from := int(element["outV"].(float64))
+			if idx.out[from] == nil {
+				idx.out[from] = map[string][]map[string]any{}
+			}
+			label := element["label"].(string)
+			idx.out[from][label] = append(idx.out[from][label], element)
+		}
+	}
+	return idx
+}
+
+func (idx *index) target(edge map[string]any) int {
+	return int(edge["inV"].(float64))
+}
+
+// rangeAt returns the id of the range in the document with the given URI
+// suffix that starts at line and character.
+func (idx *index) rangeAt(t *testing.T, doc string, line, character int) int {
+	t.Helper()
+	for id, element := range idx.elements {
+		if element["label"] != "document" || !strings.HasSuffix(element["uri"].(string), doc) {
+			continue
+		}
+		for _, contains := range idx.out[id]["contains"] {
+			for _, r := range contains["inVs"].([]any) {
+				start := idx.elements[int(r.(float64))]["start"].(map[string]any)
+				if int(start["line"].(float64)) == line && int(start["character"].(float64)) == character {
+					return int(r.(float64))
+				}
+			}
+		}
+	}
+	t.Fatalf("no range at %s:%d:%d", doc, line, character)
+	return 0
+}
+
+func (idx *index) hover(rangeID int) string {
+	resultSet := idx.target(idx.out[rangeID]["next"][0])
+	hover := idx.elements[idx.target(idx.out[resultSet]["textDocument/hover"][0])]
+	return hover["result"].(map[string]any)["contents"].(map[string]any)["value"].(string)
+}
+
+// definition returns the URI and start of the definition of a range.
+func (idx *index) definition(rangeID int) (string, map[string]any) {
+	resultSet := idx.target(idx.out[rangeID]["next"][0])
+	edges := idx.out[resultSet]["textDocument/definition"]
+	if len(edges) == 0 {
+		return "", nil
+	}
+	item := idx.out[idx.target(edges[0])]["item"][0]
+	doc := idx.elements[int(item["document"].(float64))]
+	r := idx.elements[int(item["inVs"].([]any)[0].(float64))]
+	return doc["uri"].(string), r["start"].(map[string]any)
+}
+
+func TestIndex(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Index(&buf, "/work", workspace); err != nil {
+		t.Fatal(err)
+	}
+	idx := decodeIndex(t, buf.Bytes())
+
+	tests := []struct {
+		doc             string
+		line, character int
+		hover           string
+		definition      string
+		defLine         int
+	}{
+		// limit in an interpolation resolves through the include.
+		{"main.cherri", 4, 18, "```cherri\nconst limit = 5\n```", "file:///work/lib/util.cherri", 0},
+		{"main.cherri", 5, 5, "```cherri\naction double(number x): number\n```\n\nDoubles a number.", "file:///work/lib/util.cherri", 3},
+		{"main.cherri", 6, 6, "```cherri\n#question apiKey \"Enter your key\" \"\"\n```", "file:///work/main.cherri", 1},
+		{"main.cherri", 4, 7, "```cherri\n@greeting: text\n```", "file:///work/main.cherri", 3},
+		{"lib/util.cherri", 4, 14, "```cherri\n@x: number\n```\n\nParameter of double.", "file:///work/lib/util.cherri", 3},
+		{"main.cherri", 6, 0, "```cherri\nalert(text alert, text title?, bool cancelButton?)\n```\n\n`is.workflow.actions.alert`", "", 0},
+	}
+	for _, test := range tests {
+		r := idx.rangeAt(t, test.doc, test.line, test.character)
+		if got := idx.hover(r); got != test.hover {
+			t.Errorf("%s:%d:%d: hover = %q, want %q", test.doc, test.line, test.character, got, test.hover)
+		}
+		uri, start := idx.definition(r)
+		if uri != test.definition || start != nil && int(start["line"].(float64)) != test.defLine {
+			t.Errorf("%s:%d:%d: definition = %s %v, want %s line %d", test.doc, test.line, test.character, uri, start, test.definition, test.defLine)
+		}
+	}
+
+	var links int
+	for _, element := range idx.elements {
+		if element["label"] == "documentLinkResult" {
+			link := element["result"].([]any)[0].(map[string]any)
+			if link["target"] != "file:///work/lib/util.cherri" {
+				t.Errorf("include link target = %v", link["target"])
+			}
+			links++
+		}
+	}
+	if links != 1 {
+		t.Errorf("%d document link results, want 1", links)
+	}
+}
+
+func TestIndexAccessorNames(t *testing.T) {
+	files := map[string][]byte{"main.cherri": []byte(`@list = "x"
+@i = 1
+show("{list[i]} {i[i]}")
+`)}
+	var buf bytes.Buffer
+	if err := Index(&buf, "/work", files); err != nil {
+		t.Fatal(err)
+	}
+	idx := decodeIndex(t, buf.Bytes())
+	// Each name in an accessor gets its own range, even when it is part
+	// of the name before it.
+	for _, test := range []struct{ character, defLine int }{{7, 0}, {12, 1}, {17, 1}, {19, 1}} {
+		r := idx.rangeAt(t, "main.cherri", 2, test.character)
+		if _, start := idx.definition(r); start == nil || int(start["line"].(float64)) != test.defLine {
+			t.Errorf("2:%d: definition starts at %v, want line %d", test.character, start, test.defLine)
+		}
+	}
+}
+
+func TestIndexDocumentEvents(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Index(&buf, "/work", workspace); err != nil {
+		t.Fatal(err)
+	}
+
+	// No element may refer to a document after its end event.
+	ended := map[int]bool{}
+	refers := func(element map[string]any) []int {
+		var out []int
+		for _, key := range []string{"document", "outV", "inV"} {
+			if v, ok := element[key].(float64); ok {
+				out = append(out, int(v))
+			}
+		}
+		if vs, ok := element["inVs"].([]any); ok {
+			for _, v := range vs {
+				out = append(out, int(v.(float64)))
+			}
+		}
+		return out
+	}
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var element map[string]any
+		if err := json.Unmarshal(scanner.Bytes(), &element); err != nil {
+			t.Fatalf("invalid line %s: %v", scanner.Text(), err)
+		}
+		if element["label"] == "$event" {
+			if element["kind"] == "end" && element["scope"] == "document" {
+				ended[int(element["data"].(float64))] = true
+			}
+			continue
+		}
+		for _, id := range refers(element) {
+			if ended[id] {
+				t.Errorf("element %v refers to document %d after it ended", element["id"], id)
+			}
+		}
+	}
+}
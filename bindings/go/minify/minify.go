@@ -0,0 +1,304 @@
+// Package minify shrinks Cherri source for distribution builds.
+package minify
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/analysis"
+)
+
+// Options configure Minify.
+type Options struct {
+	// Keep lists names that must not be renamed, such as variables that
+	// other files read.
+	Keep []string
+	// RenameConstants shortens the names of constants too. Constants are
+	// kept by default, as the files that include this one read them by
+	// name; set it for entry points that no other file includes.
+	RenameConstants bool
+}
+
+// A Result is minified source.
+type Result struct {
+	Source []byte
+	// Renames maps each renamed variable or constant to its new name.
+	Renames map[string]string
+}
+
+// Minify strips comments and declarations, collapses whitespace and
+// shortens variable and constant names.
+//
+// Statements stay one per line, as the Cherri compiler expects. Names are
+// not shortened when they are used inside string interpolations, are
+// parameters of action definitions, are never assigned in this file (and
+// so may come from an include), are constants (unless opts.RenameConstants
+// is set), or are listed in opts.Keep. The result is re-parsed and must
+// have the same structure as the input, apart from the dropped comments
+// and declarations. Source with syntax errors is rejected.
+func Minify(source []byte, opts Options) (*Result, error) {
+	tree := analysis.Parse(source)
+	defer tree.Close()
+	root := tree.RootNode()
+	if n := syntaxError(root); n != nil {
+		p := n.StartPosition()
+		return nil, fmt.Errorf("syntax error at %d:%d", p.Row+1, p.Column+1)
+	}
+
+	renames, byNode := planRenames(tree, source, opts)
+	m := &minifier{source: source, renames: byNode}
+	m.statements(root)
+	m.newline()
+
+	out := m.buf.Bytes()
+	minified := analysis.Parse(out)
+	defer minified.Close()
+	if shape(root) != shape(minified.RootNode()) {
+		return nil, errors.New("minified source does not parse to the same structure")
+	}
+	return &Result{Source: out, Renames: renames}, nil
+}
+
+// syntaxError returns the first ERROR or MISSING node under root, or nil
+// if the source parses cleanly.
+func syntaxError(root *tree_sitter.Node) *tree_sitter.Node {
+	if !root.HasError() {
+		return nil
+	}
+	var found *tree_sitter.Node
+	analysis.Walk(root, func(n *tree_sitter.Node) bool {
+		if found == nil && (n.IsError() || n.IsMissing()) {
+			found = n
+		}
+		return found == nil
+	})
+	return found
+}
+
+// planRenames chooses new names and maps the id of every node to rename to
+// its new text.
+func planRenames(tree *tree_sitter.Tree, source []byte, opts Options) (map[string]string, map[uintptr]string) {
+	root := tree.RootNode()
+	occurrences := analysis.Occurrences(root, source)
+
+	keep := map[string]bool{}
+	for _, name := range opts.Keep {
+		keep[name] = true
+	}
+	analysis.Walk(root, func(n *tree_sitter.Node) bool {
+		if n.Kind() == "action_definition" {
+			for _, param := range analysis.ParameterNames(n, source) {
+				keep[param] = true
+			}
+		}
+		return true
+	})
+	written := map[string]bool{}
+	counts := map[string]int{}
+	first := map[string]int{}
+	for i, occ := range occurrences {
+		if occ.Node.Kind() == "interpolation" || occ.Node.Kind() == "builtin_constant" {
+			keep[occ.Name] = true
+			continue
+		}
+		if occ.Write && !occ.Mutation {
+			written[occ.Name] = true
+			if !opts.RenameConstants && occ.Node.Parent().Kind() == "constant_assignment" {
+				keep[occ.Name] = true
+			}
+		}
+		if _, ok := first[occ.Name]; !ok {
+			first[occ.Name] = i
+		}
+		counts[occ.Name]++
+	}
+
+	var candidates []string
+	for name := range written {
+		if !keep[name] {
+			candidates = append(candidates, name)
+		}
+	}
+	// The most used names get the shortest replacements.
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if counts[a] != counts[b] {
+			return counts[a] > counts[b]
+		}
+		return first[a] < first[b]
+	})
+
+	taken := analysis.NamesInUse(tree, source)
+	analysis.Walk(root, func(n *tree_sitter.Node) bool {
+		if n.Kind() == "call" {
+			taken[analysis.CallName(n, source)] = true
+		}
+		return true
+	})
+	for name := range keep {
+		taken[name] = true
+	}
+
+	renames := map[string]string{}
+	gen := nameGenerator{}
+	for _, name := range candidates {
+		if len(name) == 1 {
+			continue
+		}
+		if short := gen.next(taken); len(short) < len(name) {
+			renames[name] = short
+		}
+	}
+
+	byNode := map[uintptr]string{}
+	for _, occ := range occurrences {
+		short, ok := renames[occ.Name]
+		if !ok {
+			continue
+		}
+		if occ.Node.Kind() == "at_variable" {
+			text := occ.Node.Utf8Text(source)
+			// Keep any punctuation the at_variable token swallowed.
+			suffix := strings.TrimPrefix(text, "@"+occ.Name)
+			byNode[occ.Node.Id()] = "@" + short + suffix
+		} else {
+			byNode[occ.Node.Id()] = short
+		}
+	}
+	return renames, byNode
+}
+
+// nameGenerator yields a, b, ..., z, aa, ab, ... skipping taken names and
+// keywords.
+type nameGenerator struct{ n int }
+
+func (g *nameGenerator) next(taken map[string]bool) string {
+	for {
+		name := ""
+		for n := g.n; ; n = n/26 - 1 {
+			name = string(rune('a'+n%26)) + name
+			if n < 26 {
+				break
+			}
+		}
+		g.n++
+		if !taken[name] && !analysis.IsKeyword(name) {
+			taken[name] = true
+			return name
+		}
+	}
+}
+
+type minifier struct {
+	source  []byte
+	renames map[uintptr]string
+	buf     bytes.Buffer
+	// last is the most recently written token, for spacing decisions.
+	last string
+	// lastAtVariable is set when last is an at_variable, which swallows
+	// any following character other than whitespace, `:` and `=`.
+	lastAtVariable bool
+}
+
+func (m *minifier) token(text string, atVariable bool) {
+	if m.last != "" && needsSpace(m.last, text, m.lastAtVariable) {
+		m.buf.WriteByte(' ')
+	}
+	m.buf.WriteString(text)
+	m.last = text
+	m.lastAtVariable = atVariable
+}
+
+func (m *minifier) newline() {
+	if m.buf.Len() > 0 && m.last != "" {
+		m.buf.WriteByte('\n')
+	}
+	m.last = ""
+	m.lastAtVariable = false
+}
+
+func needsSpace(prev, next string, prevAtVariable bool) bool {
+	if prevAtVariable {
+		return next[0] != ':' && next[0] != '='
+	}
+	p, n := prev[len(prev)-1], next[0]
+	if isWordByte(p) && (isWordByte(n) || n == '@' || n == '"' || n == '\'') {
+		return true
+	}
+	return p == '/' && (n == '/' || n == '*')
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= 0x80
+}
+
+// statements writes the statements of a file or block one per line.
+func (m *minifier) statements(parent *tree_sitter.Node) {
+	var prev *tree_sitter.Node
+	for i := uint(0); i < parent.ChildCount(); i++ {
+		child := parent.Child(i)
+		if !child.IsNamed() || child.IsExtra() || child.Kind() == "declaration" {
+			if !child.IsNamed() {
+				// The braces of a block.
+				if child.Kind() == "}" {
+					m.newline()
+				}
+				m.token(child.Kind(), false)
+				if child.Kind() == "{" {
+					m.newline()
+				}
+			}
+			continue
+		}
+		// The value of `#define name "..."` must stay on the pragma's line.
+		if prev == nil || prev.Kind() != "pragma" || child.StartPosition().Row != prev.EndPosition().Row {
+			m.newline()
+		}
+		m.node(child)
+		prev = child
+	}
+}
+
+func (m *minifier) node(n *tree_sitter.Node) {
+	if text, ok := m.renames[n.Id()]; ok {
+		m.token(text, n.Kind() == "at_variable")
+		return
+	}
+	switch {
+	case n.Kind() == "comment":
+	case n.Kind() == "block":
+		m.statements(n)
+	case n.Kind() == "string" || n.Kind() == "single_quoted_string" || n.ChildCount() == 0:
+		m.token(n.Utf8Text(m.source), n.Kind() == "at_variable")
+	default:
+		for i := uint(0); i < n.ChildCount(); i++ {
+			m.node(n.Child(i))
+		}
+	}
+}
+
+// shape returns the structure of a tree as an S-expression of node kinds,
+// leaving out comments and declarations.
+func shape(n *tree_sitter.Node) string {
+	var b strings.Builder
+	var walk func(*tree_sitter.Node)
+	walk = func(n *tree_sitter.Node) {
+		b.WriteString("(" + n.Kind())
+		for i := uint(0); i < n.NamedChildCount(); i++ {
+			child := n.NamedChild(i)
+			if child.Kind() == "comment" || child.Kind() == "declaration" {
+				continue
+			}
+			b.WriteByte(' ')
+			walk(child)
+		}
+		b.WriteByte(')')
+	}
+	walk(n)
+	return b.String()
+}
@@ -0,0 +1,117 @@
+package minify
+
+import "testing"
+
+func TestMinify(t *testing.T) {
+	source := []byte(`#define name "Counter"
+#include "lib.cherri"
+
+/* Counts to the limit. */
+@greeting: text
+@greeting = "Hello" // comment
+const limit = 10
+@counter = 0
+repeat i for limit {
+    @counter = @counter + i
+    if @counter > 5 {
+        alert("Big {counter}")
+    } else {
+        show(@greeting )
+    }
+}
+@items = {"a": 1, b: @counter }
+setValue(@items , "c", libValue)
+`)
+	result, err := Minify(source, Options{RenameConstants: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `#define name "Counter"
+#include "lib.cherri"
+@a="Hello"
+const d=10
+@counter=0
+repeat i for d{
+@counter=@counter +i
+if @counter >5{
+alert("Big {counter}")
+}else{
+show(@a )
+}
+}
+@c={"a":1,b:@counter }
+setValue(@c ,"c",libValue)
+`
+	if got := string(result.Source); got != want {
+		t.Errorf("minified:\n%s\nwant:\n%s", got, want)
+	}
+	if result.Renames["greeting"] != "a" || result.Renames["limit"] != "d" {
+		t.Errorf("renames = %v", result.Renames)
+	}
+	// counter is interpolated, libValue comes from the include and i is
+	// already short.
+	for _, name := range []string{"counter", "libValue", "i"} {
+		if _, ok := result.Renames[name]; ok {
+			t.Errorf("%s was renamed", name)
+		}
+	}
+}
+
+func TestMinifyOptions(t *testing.T) {
+	source := []byte(`const version = "1.0"
+@message = "hi"
+@keepMe = 1
+`)
+	result, err := Minify(source, Options{Keep: []string{"keepMe"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(result.Source), "const version=\"1.0\"\n@a=\"hi\"\n@keepMe=1\n"; got != want {
+		t.Errorf("minified = %q, want %q", got, want)
+	}
+}
+
+func TestMinifyActionDefinitions(t *testing.T) {
+	source := []byte(`action greet(text who): text {
+    @message = "Hello"
+    output(@message )
+}
+greet("Ada")
+`)
+	result, err := Minify(source, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "action greet(text who):text{\n@a=\"Hello\"\noutput(@a )\n}\ngreet(\"Ada\")\n"
+	if got := string(result.Source); got != want {
+		t.Errorf("minified = %q, want %q", got, want)
+	}
+}
+
+func TestMinifySyntaxErrors(t *testing.T) {
+	for _, source := range []string{
+		"if x {\n",
+		"@x = 1 +\n",
+		"const c =\n",
+		"alert(@x,)\n",
+		"repeat {\n",
+	} {
+		if _, err := Minify([]byte(source), Options{}); err == nil {
+			t.Errorf("Minify(%q) succeeded", source)
+		}
+	}
+}
+
+func TestMinifyInterpolationAccessors(t *testing.T) {
+	source := []byte(`@items = list(1, 2)
+@position = 1
+show("{items[position]}")
+`)
+	result, err := Minify(source, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(result.Source), "@items=list(1,2)\n@position=1\nshow(\"{items[position]}\")\n"; got != want {
+		t.Errorf("minified = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,334 @@
+// Package mutate measures how well a shortcut's tests catch bugs by running
+// them against mutated copies of the source.
+package mutate
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/analysis"
+)
+
+// A Kind is a class of mutation.
+type Kind string
+
+const (
+	FlipComparison  Kind = "flip comparison"
+	SwapBranches    Kind = "swap branches"
+	ChangeNumber    Kind = "change number"
+	DeleteStatement Kind = "delete statement"
+	RemoveStop      Kind = "remove stop"
+	ReorderItems    Kind = "reorder items"
+)
+
+// A Mutant is a single small change to a shortcut.
+type Mutant struct {
+	Kind        Kind
+	Range       tree_sitter.Range
+	Description string
+	Edits       []analysis.TextEdit
+}
+
+// Apply returns source with the mutation applied.
+func (m Mutant) Apply(source []byte) []byte {
+	return analysis.ApplyEdits(source, m.Edits)
+}
+
+func (m Mutant) String() string {
+	return fmt.Sprintf("%d:%d: %s: %s", m.Range.StartPoint.Row+1, m.Range.StartPoint.Column+1, m.Kind, m.Description)
+}
+
+// flippedComparisons maps each comparison operator to its negation.
+var flippedComparisons = map[string]string{
+	"==": "!=",
+	"!=": "==",
+	"<":  ">=",
+	">=": "<",
+	">":  "<=",
+	"<=": ">",
+}
+
+// Mutants returns every mutant of the source in document order.
+func Mutants(tree *tree_sitter.Tree, source []byte) []Mutant {
+	root := tree.RootNode()
+	var out []Mutant
+	analysis.Walk(root, func(n *tree_sitter.Node) bool {
+		if n.IsError() {
+			return false
+		}
+		// Deleting an action definition only breaks the calls to it.
+		if isStatement(n) && n.Kind() != "action_definition" {
+			out = append(out, deletion(n, source))
+		}
+		switch n.Kind() {
+		case "binary_expression":
+			op := n.Child(1)
+			if flipped, ok := flippedComparisons[op.Kind()]; ok {
+				out = append(out, Mutant{
+					Kind:        FlipComparison,
+					Range:       op.Range(),
+					Description: fmt.Sprintf("%s to %s", op.Kind(), flipped),
+					Edits:       []analysis.TextEdit{{Range: op.Range(), NewText: flipped}},
+				})
+			}
+		case "if_statement":
+			consequence := n.ChildByFieldName("consequence")
+			alternative := n.ChildByFieldName("alternative")
+			if consequence != nil && alternative != nil {
+				out = append(out, Mutant{
+					Kind:        SwapBranches,
+					Range:       n.Range(),
+					Description: "swap if and else branches",
+					Edits:       swap(consequence, alternative, source),
+				})
+			}
+		case "number":
+			text := n.Utf8Text(source)
+			changed := changeNumber(text)
+			out = append(out, Mutant{
+				Kind:        ChangeNumber,
+				Range:       n.Range(),
+				Description: fmt.Sprintf("%s to %s", text, changed),
+				Edits:       []analysis.TextEdit{{Range: n.Range(), NewText: changed}},
+			})
+		case "block":
+			if n.Parent() == nil || n.Parent().Kind() != "menu_statement" {
+				break
+			}
+			var items []*tree_sitter.Node
+			for i := uint(0); i < n.NamedChildCount(); i++ {
+				if child := n.NamedChild(i); child.Kind() == "item_statement" {
+					items = append(items, child)
+				}
+			}
+			for i := 0; i+1 < len(items); i++ {
+				out = append(out, Mutant{
+					Kind:  ReorderItems,
+					Range: items[i].Range(),
+					Description: fmt.Sprintf("swap items %s and %s",
+						items[i].ChildByFieldName("title").Utf8Text(source),
+						items[i+1].ChildByFieldName("title").Utf8Text(source)),
+					Edits: swap(items[i], items[i+1], source),
+				})
+			}
+		}
+		return true
+	})
+	return out
+}
+
+// isStatement reports whether n is a statement directly inside a file or
+// block, which can be deleted without breaking the syntax of its parent.
+func isStatement(n *tree_sitter.Node) bool {
+	parent := n.Parent()
+	if parent == nil || parent.Kind() != "source_file" && parent.Kind() != "block" || !n.IsNamed() || n.IsExtra() {
+		return false
+	}
+	switch n.Kind() {
+	case "pragma", "declaration", "block":
+		return false
+	}
+	return true
+}
+
+func deletion(n *tree_sitter.Node, source []byte) Mutant {
+	m := Mutant{
+		Kind:        DeleteStatement,
+		Range:       n.Range(),
+		Description: "delete " + strings.ReplaceAll(n.Kind(), "_", " "),
+		Edits:       []analysis.TextEdit{analysis.StatementDeletion(n, source)},
+	}
+	if n.Kind() == "builtin_keyword" && n.Utf8Text(source) == "stop" {
+		m.Kind = RemoveStop
+		m.Description = "remove stop"
+	}
+	return m
+}
+
+// swap returns edits exchanging the text of two nodes.
+func swap(a, b *tree_sitter.Node, source []byte) []analysis.TextEdit {
+	return []analysis.TextEdit{
+		{Range: a.Range(), NewText: b.Utf8Text(source)},
+		{Range: b.Range(), NewText: a.Utf8Text(source)},
+	}
+}
+
+// changeNumber increments a number literal.
+func changeNumber(text string) string {
+	if n, err := strconv.Atoi(text); err == nil {
+		return strconv.Itoa(n + 1)
+	}
+	f, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return "0"
+	}
+	return strconv.FormatFloat(f+1, 'f', -1, 64)
+}
+
+// A Runner runs a test suite against a version of the shortcut and reports
+// whether every test passed. An error means the suite could not be run.
+type Runner func(source []byte) (passed bool, err error)
+
+// CommandRunner returns a Runner that writes the source to a temporary file
+// and runs the named command, such as a local evaluator, with the file's
+// path as its last argument. The tests pass when the command exits with
+// status 0. A command still running after timeout is killed and counts as
+// failing, as mutants often turn loops into endless ones; a zero timeout
+// means no limit.
+func CommandRunner(timeout time.Duration, name string, args ...string) Runner {
+	return func(source []byte) (bool, error) {
+		dir, err := os.MkdirTemp("", "cherri-mutant")
+		if err != nil {
+			return false, err
+		}
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "mutant.cherri")
+		if err := os.WriteFile(path, source, 0o644); err != nil {
+			return false, err
+		}
+		ctx := context.Background()
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		err = exec.CommandContext(ctx, name, append(args, path)...).Run()
+		if _, ok := err.(*exec.ExitError); ok || ctx.Err() != nil {
+			return false, nil
+		}
+		return err == nil, err
+	}
+}
+
+// A Report is the outcome of a mutation testing run.
+type Report struct {
+	Killed int
+	// Invalid counts mutants that did not parse and were not run.
+	Invalid   int
+	Survivors []Mutant
+}
+
+// Score returns the fraction of valid mutants killed by the tests.
+func (r *Report) Score() float64 {
+	total := r.Killed + len(r.Survivors)
+	if total == 0 {
+		return 1
+	}
+	return float64(r.Killed) / float64(total)
+}
+
+func (r *Report) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d mutant(s) killed, %d survived, %d invalid (score %.0f%%)\n",
+		r.Killed, len(r.Survivors), r.Invalid, r.Score()*100)
+	for _, m := range r.Survivors {
+		fmt.Fprintf(&b, "  %s\n", m)
+	}
+	return b.String()
+}
+
+// Run runs the test suite against every mutant of source and reports the
+// mutants the tests did not catch. The suite must pass on the unmutated
+// source. Mutants with syntax errors the source does not have are counted
+// as invalid and not run.
+func Run(source []byte, run Runner) (*Report, error) {
+	passed, err := run(source)
+	if err != nil {
+		return nil, err
+	}
+	if !passed {
+		return nil, fmt.Errorf("tests fail on the unmutated source")
+	}
+
+	tree := analysis.Parse(source)
+	defer tree.Close()
+	original := syntaxErrors(tree.RootNode(), source)
+
+	report := &Report{}
+	for _, m := range Mutants(tree, source) {
+		mutated := m.Apply(source)
+		check := analysis.Parse(mutated)
+		invalid := introducesErrors(original, m.Edits, syntaxErrors(check.RootNode(), mutated))
+		check.Close()
+		if invalid {
+			report.Invalid++
+			continue
+		}
+		passed, err := run(mutated)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", m, err)
+		}
+		if passed {
+			report.Survivors = append(report.Survivors, m)
+		} else {
+			report.Killed++
+		}
+	}
+	return report, nil
+}
+
+// A syntaxError is an ERROR or MISSING node, identified by its start offset
+// and text.
+type syntaxError struct {
+	start uint
+	text  string
+}
+
+// syntaxErrors returns the outermost ERROR and MISSING nodes under root.
+func syntaxErrors(root *tree_sitter.Node, source []byte) []syntaxError {
+	var errs []syntaxError
+	analysis.Walk(root, func(n *tree_sitter.Node) bool {
+		if n.IsError() || n.IsMissing() {
+			errs = append(errs, syntaxError{n.StartByte(), n.Kind() + " " + n.Utf8Text(source)})
+			return false
+		}
+		return n.HasError()
+	})
+	return errs
+}
+
+// introducesErrors reports whether a mutant made with edits has a syntax
+// error that is not one of the source's errors moved by the edits.
+func introducesErrors(original []syntaxError, edits []analysis.TextEdit, mutated []syntaxError) bool {
+	known := map[syntaxError]bool{}
+	for _, e := range original {
+		known[e] = true
+	}
+	sorted := append([]analysis.TextEdit(nil), edits...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Range.StartByte < sorted[j].Range.StartByte
+	})
+	for _, e := range mutated {
+		start, ok := sourceOffset(e.start, sorted)
+		if !ok || !known[syntaxError{start, e.text}] {
+			return true
+		}
+	}
+	return false
+}
+
+// sourceOffset maps an offset in a mutant back to the source the sorted
+// edits were applied to. It reports false for offsets in inserted text.
+func sourceOffset(offset uint, edits []analysis.TextEdit) (uint, bool) {
+	shift := 0
+	for _, edit := range edits {
+		from := int(edit.Range.StartByte) + shift
+		switch {
+		case int(offset) < from:
+			return uint(int(offset) - shift), true
+		case int(offset) < from+len(edit.NewText):
+			return 0, false
+		}
+		shift += len(edit.NewText) - int(edit.Range.EndByte-edit.Range.StartByte)
+	}
+	return uint(int(offset) - shift), true
+}
@@ -0,0 +1,110 @@
+package mutate
+
+import (
+	"bytes"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/analysis"
+)
+
+const source = `@limit = 10
+if @limit >= 5 {
+    alert("big")
+} else {
+    stop
+}
+menu "Pick" {
+    item "One": alert("1")
+    item "Two": alert("2")
+}
+`
+
+func TestMutants(t *testing.T) {
+	src := []byte(source)
+	tree := analysis.Parse(src)
+	defer tree.Close()
+
+	var got []string
+	for _, m := range Mutants(tree, src) {
+		got = append(got, m.String())
+	}
+	want := []string{
+		"1:1: delete statement: delete variable assignment",
+		"1:10: change number: 10 to 11",
+		"2:1: delete statement: delete if statement",
+		"2:1: swap branches: swap if and else branches",
+		"2:11: flip comparison: >= to <",
+		"2:14: change number: 5 to 6",
+		"3:5: delete statement: delete call",
+		"5:5: remove stop: remove stop",
+		"7:1: delete statement: delete menu statement",
+		"8:5: reorder items: swap items \"One\" and \"Two\"",
+		"8:5: delete statement: delete item statement",
+		"9:5: delete statement: delete item statement",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("mutants:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	for _, m := range Mutants(tree, src) {
+		if m.Kind == ReorderItems {
+			mutated := string(m.Apply(src))
+			if !strings.Contains(mutated, "item \"Two\": alert(\"2\")\n    item \"One\": alert(\"1\")") {
+				t.Errorf("reordered source:\n%s", mutated)
+			}
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	// The suite only checks that the limit is 10 and that the "big" alert
+	// is shown.
+	runner := func(src []byte) (bool, error) {
+		return bytes.Contains(src, []byte("@limit = 10")) && bytes.Contains(src, []byte(`alert("big")`)), nil
+	}
+	report, err := Run([]byte(source), runner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Killed != 4 || len(report.Survivors) != 8 {
+		t.Errorf("report:\n%s", report)
+	}
+	if !strings.Contains(report.String(), "  5:5: remove stop: remove stop\n") {
+		t.Errorf("report does not list the surviving stop removal:\n%s", report)
+	}
+
+	if _, err := Run([]byte(source), func([]byte) (bool, error) { return false, nil }); err == nil {
+		t.Error("Run accepted a suite failing on the unmutated source")
+	}
+}
+
+func TestRunWithSyntaxErrors(t *testing.T) {
+	// The unfinished call at the end is an error in the source, so only
+	// the mutant that deletes its name, turning the if statement before it
+	// into part of the error, is invalid.
+	src := source + "if @limit > 99 stop\nalert(\n"
+	report, err := Run([]byte(src), func([]byte) (bool, error) { return true, nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Invalid != 1 || len(report.Survivors) != 15 {
+		t.Errorf("report:\n%s", report)
+	}
+}
+
+func TestCommandRunnerTimeout(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh is not installed")
+	}
+	passed, err := CommandRunner(50*time.Millisecond, "sh", "-c", "sleep 5")([]byte(source))
+	if passed || err != nil {
+		t.Errorf("timed out run = %v, %v, want false, nil", passed, err)
+	}
+	passed, err = CommandRunner(time.Minute, "sh", "-c", "exit 0")([]byte(source))
+	if !passed || err != nil {
+		t.Errorf("passing run = %v, %v, want true, nil", passed, err)
+	}
+}
@@ -0,0 +1,506 @@
+// Package openapi generates Cherri include files with API client actions
+// from OpenAPI 3 documents.
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	"gopkg.in/yaml.v3"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/analysis"
+)
+
+type document struct {
+	OpenAPI string `json:"openapi"`
+	Info    struct {
+		Title   string `json:"title"`
+		Version string `json:"version"`
+	} `json:"info"`
+	Servers []struct {
+		URL string `json:"url"`
+	} `json:"servers"`
+	Paths      map[string]map[string]json.RawMessage `json:"paths"`
+	Components struct {
+		Schemas       map[string]*schema      `json:"schemas"`
+		Parameters    map[string]*parameter   `json:"parameters"`
+		RequestBodies map[string]*requestBody `json:"requestBodies"`
+	} `json:"components"`
+}
+
+type operation struct {
+	OperationID string       `json:"operationId"`
+	Summary     string       `json:"summary"`
+	Parameters  []*parameter `json:"parameters"`
+	RequestBody *requestBody `json:"requestBody"`
+}
+
+type parameter struct {
+	Ref      string  `json:"$ref"`
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *schema `json:"schema"`
+}
+
+type requestBody struct {
+	Ref      string `json:"$ref"`
+	Required bool   `json:"required"`
+	Content  map[string]struct {
+		Schema *schema `json:"schema"`
+	} `json:"content"`
+}
+
+type schema struct {
+	Ref        string             `json:"$ref"`
+	Type       string             `json:"type"`
+	Properties map[string]*schema `json:"properties"`
+	Required   []string           `json:"required"`
+}
+
+// methods are the operations of a path item in the order they are
+// generated.
+var methods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// Options configure Generate.
+type Options struct {
+	// BaseURL overrides the URL of the first server of the document.
+	BaseURL string
+}
+
+// Generate returns a Cherri include file with an action definition for
+// every operation of an OpenAPI 3 document, in JSON or YAML.
+//
+// Each action takes the operation's path, query and header parameters and
+// the properties of a JSON request body as parameters, in that order with
+// required ones first, builds the URL, headers and body dictionary, and
+// outputs the result of downloadURL. Optional values are only sent when
+// they are set. The output is parsed to check that it has no syntax
+// errors.
+func Generate(spec []byte, opts Options) ([]byte, error) {
+	doc, err := decode(spec)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(doc.OpenAPI, "3.") {
+		return nil, fmt.Errorf("unsupported OpenAPI version %q", doc.OpenAPI)
+	}
+	baseURL := opts.BaseURL
+	if baseURL == "" && len(doc.Servers) > 0 {
+		baseURL = doc.Servers[0].URL
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "// Generated from %s %s. Do not edit.\n\n", doc.Info.Title, doc.Info.Version)
+	fmt.Fprintf(&b, "const baseURL = %s\n", quote(strings.TrimSuffix(baseURL, "/")))
+
+	paths := make([]string, 0, len(doc.Paths))
+	for path := range doc.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	actionNames := map[string]bool{"baseURL": true}
+	var generated []string
+	for _, path := range paths {
+		item := doc.Paths[path]
+		var shared []*parameter
+		if raw, ok := item["parameters"]; ok {
+			if err := json.Unmarshal(raw, &shared); err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+		}
+		for _, method := range methods {
+			raw, ok := item[method]
+			if !ok {
+				continue
+			}
+			var op operation
+			if err := json.Unmarshal(raw, &op); err != nil {
+				return nil, fmt.Errorf("%s %s: %w", strings.ToUpper(method), path, err)
+			}
+			name := op.OperationID
+			if name == "" {
+				name = method + " " + path
+			}
+			name = analysis.Identifier(name)
+			if name == "" {
+				name = "operation"
+			}
+			name = analysis.UniqueName(name, actionNames)
+			actionNames[name] = true
+			text, err := doc.action(name, method, path, &op, shared)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %w", strings.ToUpper(method), path, err)
+			}
+			b.WriteString("\n" + text)
+			generated = append(generated, name)
+		}
+	}
+
+	out := []byte(b.String())
+	if err := check(out, generated); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// decode reads a JSON or YAML document.
+func decode(spec []byte) (*document, error) {
+	data := bytes.TrimSpace(spec)
+	if !bytes.HasPrefix(data, []byte("{")) {
+		var v any
+		if err := yaml.Unmarshal(spec, &v); err != nil {
+			return nil, err
+		}
+		var err error
+		if data, err = json.Marshal(v); err != nil {
+			return nil, err
+		}
+	}
+	var doc document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// field is an input of a generated action.
+type field struct {
+	// Name is the name in the API: a parameter name, header or property.
+	Name string
+	// Variable is the name of the action parameter.
+	Variable string
+	// In is "path", "query", "header", "body" for a property of the body,
+	// or "requestBody" for the whole body.
+	In       string
+	Type     string
+	Required bool
+}
+
+func (doc *document) action(name, method, path string, op *operation, shared []*parameter) (string, error) {
+	// Locals of the generated body are taken so parameters do not shadow
+	// them.
+	names := map[string]bool{"baseURL": true, "url": true, "query": true, "headers": true, "body": true, "response": true}
+	var fields []field
+	add := func(apiName, in string, s *schema, required bool) {
+		v := analysis.Identifier(apiName)
+		if v == "" {
+			v = "value"
+		} else if analysis.IsKeyword(v) {
+			v += "Value"
+		}
+		v = analysis.UniqueName(v, names)
+		names[v] = true
+		fields = append(fields, field{Name: apiName, Variable: v, In: in, Type: doc.typeOf(s), Required: required})
+	}
+
+	// Operation parameters override path item parameters with the same
+	// name and location.
+	params := map[string]*parameter{}
+	var order []string
+	for _, p := range append(shared, op.Parameters...) {
+		p, err := doc.resolveParameter(p)
+		if err != nil {
+			return "", err
+		}
+		key := p.In + " " + p.Name
+		if _, ok := params[key]; !ok {
+			order = append(order, key)
+		}
+		params[key] = p
+	}
+	for _, key := range order {
+		if p := params[key]; p.In == "path" {
+			add(p.Name, p.In, p.Schema, true)
+		}
+	}
+	for _, required := range []bool{true, false} {
+		for _, key := range order {
+			if p := params[key]; (p.In == "query" || p.In == "header") && p.Required == required {
+				add(p.Name, p.In, p.Schema, required)
+			}
+		}
+	}
+
+	contentType := ""
+	if op.RequestBody != nil {
+		body, err := doc.resolveBody(op.RequestBody)
+		if err != nil {
+			return "", err
+		}
+		var s *schema
+		contentType, s = bodySchema(body)
+		if s, err = doc.resolveSchema(s); err != nil {
+			return "", err
+		}
+		if s != nil && len(s.Properties) > 0 && strings.Contains(contentType, "json") {
+			required := map[string]bool{}
+			for _, name := range s.Required {
+				required[name] = true
+			}
+			props := make([]string, 0, len(s.Properties))
+			for prop := range s.Properties {
+				props = append(props, prop)
+			}
+			sort.Strings(props)
+			for _, want := range []bool{true, false} {
+				for _, prop := range props {
+					if required[prop] == want {
+						add(prop, "body", s.Properties[prop], want)
+					}
+				}
+			}
+		} else {
+			// The whole body is a parameter, named body.
+			delete(names, "body")
+			add("body", "requestBody", s, body.Required)
+		}
+	}
+
+	var b strings.Builder
+	if op.Summary != "" {
+		fmt.Fprintf(&b, "// %s\n", strings.Join(strings.Fields(op.Summary), " "))
+	}
+	fmt.Fprintf(&b, "// %s %s\n", strings.ToUpper(method), path)
+	var sig []string
+	for _, f := range fields {
+		sig = append(sig, f.Type+" "+f.Variable)
+	}
+	fmt.Fprintf(&b, "action %s(%s) {\n", name, strings.Join(sig, ", "))
+
+	// The URL, with path parameters substituted.
+	url := quote(path)
+	for _, f := range fields {
+		if f.In == "path" {
+			url = strings.ReplaceAll(url, `\{`+f.Name+"}", "{"+f.Variable+"}")
+		}
+	}
+	url = `"{baseURL}` + url[1:]
+	for _, f := range fields {
+		if (f.In == "path" || f.In == "query") && f.Type == "text" {
+			fmt.Fprintf(&b, "    @%s = urlEncode(%s)\n", f.Variable, f.Variable)
+		}
+	}
+	fmt.Fprintf(&b, "    @url = %s\n", url)
+
+	var query, optionalQuery []string
+	for _, f := range fields {
+		if f.In != "query" {
+			continue
+		}
+		pair := quote(f.Name)
+		pair = pair[1:len(pair)-1] + "={" + f.Variable + "}"
+		if f.Required {
+			query = append(query, pair)
+		} else {
+			optionalQuery = append(optionalQuery, pair)
+		}
+	}
+	switch {
+	case len(optionalQuery) > 0 && len(query) > 0:
+		fmt.Fprintf(&b, "    @query = \"%s\"\n", strings.Join(query, "&"))
+		for i, f := range optional(fields, "query") {
+			fmt.Fprintf(&b, "    if %s {\n        @query = \"{query}&%s\"\n    }\n", isSet(f), optionalQuery[i])
+		}
+		b.WriteString("    @url = \"{url}?{query}\"\n")
+	case len(optionalQuery) > 0:
+		// The query may stay empty, so the separators are added as the
+		// parameters are.
+		b.WriteString("    @query = \"\"\n")
+		for i, f := range optional(fields, "query") {
+			fmt.Fprintf(&b, "    if %s {\n        if @query {\n            @query = \"{query}&\"\n        }\n        @query = \"{query}%s\"\n    }\n", isSet(f), optionalQuery[i])
+		}
+		b.WriteString("    if @query {\n        @url = \"{url}?{query}\"\n    }\n")
+	case len(query) > 0:
+		fmt.Fprintf(&b, "    @url = \"{url}?%s\"\n", strings.Join(query, "&"))
+	}
+
+	headers := []string{`"Accept": "application/json"`}
+	if contentType != "" {
+		headers = append(headers, `"Content-Type": `+quote(contentType))
+	}
+	writeDictionary(&b, "headers", headers, fields, "header")
+
+	bodyArg := ""
+	for _, f := range fields {
+		if f.In == "requestBody" {
+			bodyArg = f.Variable
+		}
+	}
+	if op.RequestBody != nil && bodyArg == "" {
+		writeDictionary(&b, "body", nil, fields, "body")
+		bodyArg = "@body "
+	}
+	if bodyArg == "" {
+		bodyArg = "{}"
+	}
+	fmt.Fprintf(&b, "    @response = downloadURL(@url , %s, %s, @headers )\n", quote(strings.ToUpper(method)), bodyArg)
+	b.WriteString("    output(@response )\n}\n")
+	return b.String(), nil
+}
+
+// writeDictionary writes the assignment of a dictionary with the given
+// entries and the required fields of a location, followed by setValue
+// calls for the optional fields that are set.
+func writeDictionary(b *strings.Builder, variable string, entries []string, fields []field, in string) {
+	for _, f := range fields {
+		if f.In == in && f.Required {
+			entries = append(entries, quote(f.Name)+": "+f.Variable)
+		}
+	}
+	fmt.Fprintf(b, "    @%s = {%s}\n", variable, strings.Join(entries, ", "))
+	for _, f := range optional(fields, in) {
+		fmt.Fprintf(b, "    if %s {\n        setValue(@%s , %s, %s)\n    }\n", isSet(f), variable, quote(f.Name), f.Variable)
+	}
+}
+
+// optional returns the fields of a location that are not required.
+func optional(fields []field, in string) []field {
+	var out []field
+	for _, f := range fields {
+		if f.In == in && !f.Required {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// isSet returns the condition that an optional field was given. An empty
+// text is left out, but a number or bool is sent even when it is 0 or false.
+func isSet(f field) string {
+	if f.Type == "text" {
+		return f.Variable
+	}
+	return f.Variable + " != nil"
+}
+
+// bodySchema returns the content type and schema of a request body,
+// preferring JSON.
+func bodySchema(body *requestBody) (string, *schema) {
+	types := make([]string, 0, len(body.Content))
+	for t := range body.Content {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	for _, t := range types {
+		if strings.Contains(t, "json") {
+			return t, body.Content[t].Schema
+		}
+	}
+	if len(types) == 0 {
+		return "", nil
+	}
+	return types[0], body.Content[types[0]].Schema
+}
+
+// typeOf returns the Cherri type of values of a schema.
+func (doc *document) typeOf(s *schema) string {
+	s, err := doc.resolveSchema(s)
+	if err != nil || s == nil {
+		return "variable"
+	}
+	switch s.Type {
+	case "string":
+		return "text"
+	case "integer", "number":
+		return "number"
+	case "boolean":
+		return "bool"
+	case "object":
+		return "dictionary"
+	case "array":
+		return "array"
+	}
+	if len(s.Properties) > 0 {
+		return "dictionary"
+	}
+	return "variable"
+}
+
+// componentName returns the name of a local component reference of the
+// given kind, such as #/components/schemas/Pet.
+func componentName(ref, kind string) (string, error) {
+	prefix := "#/components/" + kind + "/"
+	if !strings.HasPrefix(ref, prefix) {
+		return "", fmt.Errorf("unsupported reference %q", ref)
+	}
+	return strings.TrimPrefix(ref, prefix), nil
+}
+
+func (doc *document) resolveSchema(s *schema) (*schema, error) {
+	for seen := 0; s != nil && s.Ref != ""; seen++ {
+		name, err := componentName(s.Ref, "schemas")
+		if err != nil {
+			return nil, err
+		}
+		if seen > len(doc.Components.Schemas) || doc.Components.Schemas[name] == nil {
+			return nil, fmt.Errorf("cannot resolve %q", s.Ref)
+		}
+		s = doc.Components.Schemas[name]
+	}
+	return s, nil
+}
+
+func (doc *document) resolveParameter(p *parameter) (*parameter, error) {
+	if p.Ref == "" {
+		return p, nil
+	}
+	name, err := componentName(p.Ref, "parameters")
+	if err != nil {
+		return nil, err
+	}
+	if resolved := doc.Components.Parameters[name]; resolved != nil && resolved.Ref == "" {
+		return resolved, nil
+	}
+	return nil, fmt.Errorf("cannot resolve %q", p.Ref)
+}
+
+func (doc *document) resolveBody(body *requestBody) (*requestBody, error) {
+	if body.Ref == "" {
+		return body, nil
+	}
+	name, err := componentName(body.Ref, "requestBodies")
+	if err != nil {
+		return nil, err
+	}
+	if resolved := doc.Components.RequestBodies[name]; resolved != nil && resolved.Ref == "" {
+		return resolved, nil
+	}
+	return nil, fmt.Errorf("cannot resolve %q", body.Ref)
+}
+
+// quote returns s as a double-quoted Cherri string without interpolations.
+func quote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, `{`, `\{`).Replace(s) + `"`
+}
+
+// check parses generated source and reports syntax errors, or actions
+// that are not recognized.
+func check(source []byte, actions []string) error {
+	tree := analysis.Parse(source)
+	defer tree.Close()
+	root := tree.RootNode()
+	if root.HasError() {
+		return errors.New("generated source has a syntax error")
+	}
+	var defs []*tree_sitter.Node
+	for i := uint(0); i < root.NamedChildCount(); i++ {
+		if n := root.NamedChild(i); n.Kind() == "action_definition" {
+			defs = append(defs, n)
+		}
+	}
+	if len(defs) != len(actions) {
+		return fmt.Errorf("generated %d action(s) but %d parse as definitions", len(actions), len(defs))
+	}
+	for i, def := range defs {
+		if name := def.ChildByFieldName("name").Utf8Text(source); name != actions[i] {
+			return errors.New("generated action " + actions[i] + " parses as " + name)
+		}
+	}
+	return nil
+}
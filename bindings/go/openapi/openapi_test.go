@@ -0,0 +1,168 @@
+package openapi
+
+import (
+	"strings"
+	"testing"
+)
+
+const petstore = `
+openapi: 3.0.3
+info:
+  title: Petstore
+  version: 1.0.0
+servers:
+  - url: https://petstore.example.com/v1/
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      summary: List all pets
+      parameters:
+        - $ref: '#/components/parameters/limit'
+        - name: tag
+          in: query
+          schema:
+            type: string
+    post:
+      operationId: create-pet
+      summary: Create a pet
+      parameters:
+        - name: X-Request-Id
+          in: header
+          required: true
+          schema:
+            type: string
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/NewPet'
+  /pets/{petId}:
+    parameters:
+      - name: petId
+        in: path
+        required: true
+        schema:
+          type: string
+    get:
+      summary: Info for a specific pet
+    put:
+      operationId: replacePet
+      requestBody:
+        content:
+          text/plain:
+            schema:
+              type: string
+components:
+  parameters:
+    limit:
+      name: limit
+      in: query
+      required: true
+      schema:
+        type: integer
+  schemas:
+    NewPet:
+      type: object
+      required: [name]
+      properties:
+        name:
+          type: string
+        age:
+          type: integer
+        tags:
+          type: array
+`
+
+func TestGenerate(t *testing.T) {
+	out, err := Generate([]byte(petstore), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(out)
+	for _, want := range []string{
+		"const baseURL = \"https://petstore.example.com/v1\"\n",
+		"action listPets(number limit, text tag) {\n    @tag = urlEncode(tag)\n    @url = \"{baseURL}/pets\"\n    @query = \"limit={limit}\"\n    if tag {\n        @query = \"{query}&tag={tag}\"\n    }\n    @url = \"{url}?{query}\"\n",
+		"action createPet(text xRequestId, text nameValue, number age, array tags) {\n",
+		"    @headers = {\"Accept\": \"application/json\", \"Content-Type\": \"application/json\", \"X-Request-Id\": xRequestId}\n    @body = {\"name\": nameValue}\n    if age != nil {\n        setValue(@body , \"age\", age)\n    }\n",
+		"    @response = downloadURL(@url , \"POST\", @body , @headers )\n    output(@response )\n}\n",
+		"// Info for a specific pet\n// GET /pets/{petId}\naction getPetsPetId(text petId) {\n    @petId = urlEncode(petId)\n    @url = \"{baseURL}/pets/{petId}\"\n",
+		"action replacePet(text petId, text body) {\n",
+		"    @response = downloadURL(@url , \"PUT\", body, @headers )\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("generated source does not contain\n%s\ngot:\n%s", want, got)
+		}
+	}
+}
+
+func TestGenerateOptionalQuery(t *testing.T) {
+	spec := `{"openapi": "3.0.0", "info": {"title": "Pets", "version": "1"},
+		"paths": {"/pets": {"get": {"operationId": "listPets",
+			"parameters": [{"name": "limit", "in": "query", "schema": {"type": "integer"}}]}}}}`
+	out, err := Generate([]byte(spec), Options{BaseURL: "https://example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "    @url = \"{baseURL}/pets\"\n" +
+		"    @query = \"\"\n" +
+		"    if limit != nil {\n        if @query {\n            @query = \"{query}&\"\n        }\n        @query = \"{query}limit={limit}\"\n    }\n" +
+		"    if @query {\n        @url = \"{url}?{query}\"\n    }\n"
+	if !strings.Contains(string(out), want) {
+		t.Errorf("generated source does not contain\n%s\ngot:\n%s", want, out)
+	}
+}
+
+func TestGenerateJSON(t *testing.T) {
+	spec := `{"openapi": "3.1.0", "info": {"title": "Echo", "version": "2"},
+		"paths": {"/echo": {"post": {"operationId": "echo", "requestBody": {"$ref": "#/components/requestBodies/Message"}}}},
+		"components": {"requestBodies": {"Message": {"content": {"application/json": {"schema": {"type": "object", "properties": {"text": {"type": "string"}}}}}}}}}`
+	out, err := Generate([]byte(spec), Options{BaseURL: "http://localhost:8080"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`const baseURL = "http://localhost:8080"`,
+		"action echo(text textValue) {",
+		"    @body = {}\n    if textValue {\n        setValue(@body , \"text\", textValue)\n    }\n",
+	} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("generated source does not contain %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestGenerateUnnamed(t *testing.T) {
+	spec := `{"openapi": "3.0.0", "info": {"title": "Codes", "version": "1"},
+		"paths": {"/codes": {
+			"get": {"operationId": "123", "parameters": [
+				{"name": "1", "in": "query", "schema": {"type": "string"}},
+				{"name": "2", "in": "query", "schema": {"type": "string"}}]},
+			"post": {"operationId": "456"}}}}`
+	out, err := Generate([]byte(spec), Options{BaseURL: "https://example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Names without a letter fall back to generic ones.
+	for _, want := range []string{
+		"action operation(text value, text value2) {",
+		"action operation2() {",
+	} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("generated source does not contain %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	for _, spec := range []string{
+		`{"swagger": "2.0"}`,
+		`{"openapi": "3.0.0", "paths": {"/a": {"get": {"parameters": [{"$ref": "#/components/parameters/missing"}]}}}}`,
+		`openapi: [`,
+	} {
+		if _, err := Generate([]byte(spec), Options{}); err == nil {
+			t.Errorf("Generate(%s) succeeded", spec)
+		}
+	}
+}
@@ -0,0 +1,363 @@
+// Package preview renders shortcuts as HTML resembling the action cards
+// of the Shortcuts editor, for reviewing changes without a device.
+package preview
+
+import (
+	"html"
+	"strings"
+	"unicode"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/analysis"
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/catalog"
+)
+
+// Render returns the action cards of a shortcut as an HTML fragment. Calls
+// nested in arguments become cards of their own before the card using
+// them, as in Shortcuts, and variables become inline pills.
+func Render(source []byte) string {
+	tree := analysis.Parse(source)
+	defer tree.Close()
+	root := tree.RootNode()
+	r := &renderer{source: source}
+	r.b.WriteString(`<div class="shortcut">` + "\n")
+
+	for i := uint(0); i < root.NamedChildCount(); i++ {
+		n := root.NamedChild(i)
+		if n.Kind() == "pragma" {
+			i += uint(len(analysis.PragmaArguments(n)))
+			continue
+		}
+		r.statement(n)
+	}
+	r.b.WriteString("</div>\n")
+	return r.b.String()
+}
+
+// Page returns a standalone HTML document previewing a shortcut.
+func Page(title string, source []byte) string {
+	return `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>` + html.EscapeString(title) + `</title>
+<style>` + Style + `</style>
+</head>
+<body>
+<h1>` + html.EscapeString(title) + `</h1>
+` + Render(source) + `</body>
+</html>
+`
+}
+
+// Style is the CSS for rendered action cards.
+const Style = `
+body { font: 15px/1.4 -apple-system, system-ui, sans-serif; background: #f2f2f7; margin: 2em auto; max-width: 40em; padding: 0 1em; }
+.action { background: white; border-radius: 12px; padding: .7em 1em; margin: .5em 0; box-shadow: 0 1px 2px rgba(0, 0, 0, .1); }
+.action .name { font-weight: 600; margin-right: .4em; }
+.action .output { float: right; }
+.control { background: #e5e5ea; }
+.comment { background: #fffbe6; color: #6b5d00; white-space: pre-wrap; }
+.error { border: 2px solid #ff3b30; }
+.error code { white-space: pre-wrap; }
+.body { margin-left: 1.5em; padding-left: .5em; border-left: 2px solid #d1d1d6; }
+.pill { display: inline-block; background: #1b76ff; color: white; border-radius: 6px; padding: 0 .4em; font-size: .9em; }
+.label { color: #8e8e93; }
+table.dictionary { border-collapse: collapse; margin: .3em 0; }
+table.dictionary td { border: 1px solid #d1d1d6; padding: .1em .5em; }
+`
+
+type renderer struct {
+	source []byte
+	b      strings.Builder
+}
+
+func (r *renderer) text(n *tree_sitter.Node) string {
+	return n.Utf8Text(r.source)
+}
+
+// card writes an action card.
+func (r *renderer) card(class, name, content string) {
+	r.b.WriteString(`<div class="action` + class + `">`)
+	if name != "" {
+		r.b.WriteString(`<span class="name">` + html.EscapeString(name) + "</span>")
+	}
+	r.b.WriteString(content + "</div>\n")
+}
+
+// body writes the statements of a container action. A loop variable is
+// set from the value named loopValue at the start of the body.
+func (r *renderer) body(n, loopVariable *tree_sitter.Node, loopValue string) {
+	r.b.WriteString(`<div class="body">` + "\n")
+	if loopVariable != nil {
+		r.card("", "Set variable", " "+pill(r.text(loopVariable))+` <span class="label">to</span> `+pill(loopValue))
+	}
+	if n != nil {
+		r.statement(n)
+	}
+	r.b.WriteString("</div>\n")
+}
+
+func (r *renderer) statements(n *tree_sitter.Node) {
+	for i := uint(0); i < n.NamedChildCount(); i++ {
+		r.statement(n.NamedChild(i))
+	}
+}
+
+func (r *renderer) statement(n *tree_sitter.Node) {
+	switch n.Kind() {
+	case "block":
+		r.statements(n)
+	case "comment":
+		r.card(" comment", "Comment", " "+html.EscapeString(analysis.CommentText(r.text(n))))
+	case "pragma", "declaration":
+	case "variable_assignment", "identifier_assignment":
+		name := n.ChildByFieldName("name")
+		value := r.value(n.ChildByFieldName("value"))
+		r.card("", "Set variable", " "+pill(strings.TrimPrefix(r.text(name), "@"))+` <span class="label">to</span> `+value)
+	case "constant_assignment":
+		r.constant(n)
+	case "if_statement":
+		r.ifStatement(n)
+	case "for_statement":
+		iterable := r.value(n.ChildByFieldName("iterable"))
+		r.b.WriteString(`<div class="container">` + "\n")
+		r.card(" control", "Repeat with each item in", " "+iterable)
+		r.body(n.ChildByFieldName("body"), n.ChildByFieldName("variable"), "Repeat Item")
+		r.card(" control", "End Repeat", "")
+		r.b.WriteString("</div>\n")
+	case "repeat_statement":
+		content := ""
+		if count := n.ChildByFieldName("count"); count != nil {
+			content = " " + r.value(count) + " times"
+		}
+		r.b.WriteString(`<div class="container">` + "\n")
+		r.card(" control", "Repeat", content)
+		r.body(n.ChildByFieldName("body"), n.ChildByFieldName("variable"), "Repeat Index")
+		r.card(" control", "End Repeat", "")
+		r.b.WriteString("</div>\n")
+	case "menu_statement":
+		r.menu(n)
+	case "action_definition":
+		r.definition(n)
+	case "call":
+		r.call(n, "")
+	case "builtin_keyword":
+		r.card("", Title(r.text(n)), "")
+	case "ERROR":
+		r.card(" error", "Unparsable code", " <code>"+html.EscapeString(r.text(n))+"</code>")
+	default:
+		r.card("", "Get", " "+r.value(n))
+	}
+}
+
+// constant writes the action a constant names the output of.
+func (r *renderer) constant(n *tree_sitter.Node) {
+	name := r.text(n.ChildByFieldName("name"))
+	value := n.ChildByFieldName("value")
+	output := ` <span class="output">` + pill(name) + "</span>"
+	switch value.Kind() {
+	case "call":
+		r.call(value, output)
+	case "string", "single_quoted_string":
+		r.card("", "Text", output+" "+r.value(value))
+	case "number":
+		r.card("", "Number", output+" "+r.value(value))
+	case "dictionary":
+		r.card("", "Dictionary", output+" "+r.value(value))
+	default:
+		r.card("", "Get", output+" "+r.value(value))
+	}
+}
+
+func (r *renderer) ifStatement(n *tree_sitter.Node) {
+	condition := n.ChildByFieldName("condition")
+	var content string
+	if comparison, ok := comparisons[operator(condition)]; ok {
+		content = " " + r.value(condition.Child(0)) + ` <span class="label">` + comparison + "</span> " + r.value(condition.Child(2))
+	} else {
+		content = " " + r.value(condition) + ` <span class="label">has any value</span>`
+	}
+	r.b.WriteString(`<div class="container">` + "\n")
+	r.card(" control", "If", content)
+	r.body(n.ChildByFieldName("consequence"), nil, "")
+	if alternative := n.ChildByFieldName("alternative"); alternative != nil {
+		r.card(" control", "Otherwise", "")
+		r.body(alternative, nil, "")
+	}
+	r.card(" control", "End If", "")
+	r.b.WriteString("</div>\n")
+}
+
+func (r *renderer) menu(n *tree_sitter.Node) {
+	content := ""
+	if title := n.ChildByFieldName("title"); title != nil {
+		content = " " + r.value(title)
+	}
+	r.b.WriteString(`<div class="container">` + "\n")
+	r.card(" control", "Choose from Menu", content)
+	body := n.ChildByFieldName("body")
+	for i := uint(0); i < body.NamedChildCount(); i++ {
+		item := body.NamedChild(i)
+		if item.Kind() != "item_statement" {
+			r.statement(item)
+			continue
+		}
+		r.card(" control", "", r.value(item.ChildByFieldName("title")))
+		r.body(item.ChildByFieldName("body"), nil, "")
+	}
+	r.card(" control", "End Menu", "")
+	r.b.WriteString("</div>\n")
+}
+
+// definition writes an action definition as a container of its body.
+func (r *renderer) definition(def *tree_sitter.Node) {
+	var params []string
+	for _, p := range analysis.ParameterNames(def, r.source) {
+		params = append(params, pill(p))
+	}
+	r.b.WriteString(`<div class="container">` + "\n")
+	r.card(" control", "Action "+r.text(def.ChildByFieldName("name")), " "+strings.Join(params, " "))
+	r.body(def.ChildByFieldName("body"), nil, "")
+	r.card(" control", "End Action", "")
+	r.b.WriteString("</div>\n")
+}
+
+// call writes the card of an action call, after the cards of the calls in
+// its arguments. output is written after the action name.
+func (r *renderer) call(n *tree_sitter.Node, output string) {
+	name := analysis.CallName(n, r.source)
+	action, _ := catalog.Lookup(name)
+	var params []string
+	for i, arg := range analysis.Arguments(n) {
+		value := r.value(arg)
+		if action != nil {
+			if p := action.Param(i); p != nil {
+				value = `<span class="label">` + html.EscapeString(p.Name) + "</span> " + value
+			}
+		}
+		params = append(params, value)
+	}
+	content := output
+	if len(params) > 0 {
+		content += " " + strings.Join(params, " ")
+	}
+	r.card("", Title(name), content)
+}
+
+// value returns the inline HTML of an expression, writing the cards of
+// the actions it needs first.
+func (r *renderer) value(n *tree_sitter.Node) string {
+	switch n.Kind() {
+	case "string":
+		var b strings.Builder
+		for _, part := range analysis.StringParts(n, r.source) {
+			if part.Interpolation == nil {
+				b.WriteString(html.EscapeString(part.Text))
+				continue
+			}
+			inner := strings.TrimSpace(strings.Trim(r.text(part.Interpolation), "{}"))
+			b.WriteString(pill(strings.TrimPrefix(inner, "@")))
+		}
+		return b.String()
+	case "single_quoted_string":
+		value, _ := analysis.StringValue(n, r.source)
+		return html.EscapeString(value)
+	case "at_variable":
+		return pill(analysis.VariableName(n, r.source))
+	case "identifier":
+		return pill(r.text(n))
+	case "builtin_constant":
+		return pill(Title(r.text(n)))
+	case "builtin_keyword":
+		// Keywords such as name are also used as variable names.
+		if title, ok := titles[r.text(n)]; ok {
+			return pill(title)
+		}
+		return pill(r.text(n))
+	case "parenthesized_expression":
+		return r.value(n.NamedChild(0))
+	case "call":
+		r.call(n, "")
+		return pill(Title(analysis.CallName(n, r.source)))
+	case "binary_expression":
+		if _, ok := comparisons[operator(n)]; !ok {
+			left, right := r.value(n.Child(0)), r.value(n.Child(2))
+			op := operator(n)
+			if symbol, ok := symbols[op]; ok {
+				op = symbol
+			}
+			r.card("", "Calculate", " "+left+" "+html.EscapeString(op)+" "+right)
+			return pill("Calculation Result")
+		}
+	case "dictionary":
+		var b strings.Builder
+		b.WriteString(`<table class="dictionary">`)
+		for i := uint(0); i < n.NamedChildCount(); i++ {
+			pair := n.NamedChild(i)
+			if pair.Kind() != "dictionary_pair" {
+				continue
+			}
+			b.WriteString("<tr><td>" + r.value(pair.ChildByFieldName("key")) + "</td><td>" + r.value(pair.ChildByFieldName("value")) + "</td></tr>")
+		}
+		b.WriteString("</table>")
+		return b.String()
+	}
+	return html.EscapeString(r.text(n))
+}
+
+// comparisons maps comparison operators to their wording in conditions.
+var comparisons = map[string]string{
+	"==": "is",
+	"!=": "is not",
+	"<":  "is less than",
+	"<=": "is less than or equal to",
+	">":  "is greater than",
+	">=": "is greater than or equal to",
+}
+
+// symbols maps arithmetic operators to the symbols Shortcuts shows.
+var symbols = map[string]string{
+	"*": "×",
+	"/": "÷",
+}
+
+// operator returns the operator of a binary expression, or "".
+func operator(n *tree_sitter.Node) string {
+	if n.Kind() != "binary_expression" || n.ChildCount() != 3 {
+		return ""
+	}
+	return n.Child(1).Kind()
+}
+
+func pill(name string) string {
+	return `<span class="pill">` + html.EscapeString(name) + "</span>"
+}
+
+// titles holds the display names that Title cannot derive.
+var titles = map[string]string{
+	"Ask":          "Ask Each Time",
+	"getclipboard": "Clipboard",
+	"stop":         "Stop This Shortcut",
+}
+
+// Title returns the display name of an action or builtin from its Cherri
+// name, e.g. "Download URL" for downloadURL.
+func Title(name string) string {
+	if title, ok := titles[name]; ok {
+		return title
+	}
+	runes := []rune(name)
+	var b strings.Builder
+	for i, c := range runes {
+		if i > 0 && unicode.IsUpper(c) && (unicode.IsLower(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+			b.WriteRune(' ')
+		}
+		if i == 0 {
+			c = unicode.ToUpper(c)
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
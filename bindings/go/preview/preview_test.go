@@ -0,0 +1,98 @@
+package preview
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	source := `// Greets people
+#define name Greeter
+const greeting = "Hello"
+@names = getDictionary(ShortcutInput)
+for name in names {
+    if name != "" {
+        alert("{greeting}, {name} <3")
+    } else {
+        stop
+    }
+}
+menu "Again?" {
+    item "Yes":
+        show(count(names) * 2)
+    item "No": {
+        @done = {"ok": true}
+    }
+}
+`
+	want := `<div class="shortcut">
+<div class="action comment"><span class="name">Comment</span> Greets people</div>
+<div class="action"><span class="name">Text</span> <span class="output"><span class="pill">greeting</span></span> Hello</div>
+<div class="action"><span class="name">Get Dictionary</span> <span class="label">input</span> <span class="pill">Shortcut Input</span></div>
+<div class="action"><span class="name">Set variable</span> <span class="pill">names</span> <span class="label">to</span> <span class="pill">Get Dictionary</span></div>
+<div class="container">
+<div class="action control"><span class="name">Repeat with each item in</span> <span class="pill">names</span></div>
+<div class="body">
+<div class="action"><span class="name">Set variable</span> <span class="pill">name</span> <span class="label">to</span> <span class="pill">Repeat Item</span></div>
+<div class="container">
+<div class="action control"><span class="name">If</span> <span class="pill">name</span> <span class="label">is not</span> </div>
+<div class="body">
+<div class="action"><span class="name">Alert</span> <span class="label">alert</span> <span class="pill">greeting</span>, <span class="pill">name</span> &lt;3</div>
+</div>
+<div class="action control"><span class="name">Otherwise</span></div>
+<div class="body">
+<div class="action"><span class="name">Stop This Shortcut</span></div>
+</div>
+<div class="action control"><span class="name">End If</span></div>
+</div>
+</div>
+<div class="action control"><span class="name">End Repeat</span></div>
+</div>
+<div class="container">
+<div class="action control"><span class="name">Choose from Menu</span> Again?</div>
+<div class="action control">Yes</div>
+<div class="body">
+<div class="action"><span class="name">Count</span> <span class="label">input</span> <span class="pill">names</span></div>
+<div class="action"><span class="name">Calculate</span> <span class="pill">Count</span> × 2</div>
+<div class="action"><span class="name">Show</span> <span class="label">input</span> <span class="pill">Calculation Result</span></div>
+</div>
+<div class="action control">No</div>
+<div class="body">
+<div class="action"><span class="name">Set variable</span> <span class="pill">done</span> <span class="label">to</span> <table class="dictionary"><tr><td>ok</td><td>true</td></tr></table></div>
+</div>
+<div class="action control"><span class="name">End Menu</span></div>
+</div>
+</div>
+`
+	if got := Render([]byte(source)); got != want {
+		t.Errorf("Render =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestRenderErrorsAndDefinitions(t *testing.T) {
+	got := Render([]byte("action greet(text who) {\n    alert(who)\n}\nrepeat 3 {\n"))
+	for _, want := range []string{
+		`<div class="action control"><span class="name">Action greet</span> <span class="pill">who</span></div>`,
+		`<div class="action"><span class="name">Alert</span> <span class="label">alert</span> <span class="pill">who</span></div>`,
+		`<div class="action error"><span class="name">Unparsable code</span>`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Render is missing %s:\n%s", want, got)
+		}
+	}
+}
+
+func TestTitle(t *testing.T) {
+	for name, want := range map[string]string{
+		"downloadURL":        "Download URL",
+		"getCurrentLocation": "Get Current Location",
+		"URLEncode":          "URL Encode",
+		"RepeatItem":         "Repeat Item",
+		"getclipboard":       "Clipboard",
+		"show":               "Show",
+	} {
+		if got := Title(name); got != want {
+			t.Errorf("Title(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+// Package recovery measures how well the parser recovers from typos: a
+// typo should produce errors only in the statement it is in, and the
+// statements around it should parse as if it were not there.
+package recovery
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/analysis"
+)
+
+// A Fixture is a typo in an otherwise valid file. The file with the typo
+// is Before+Typo+After, and the corrected file Before+Fixed+After.
+type Fixture struct {
+	Name                       string
+	Before, Typo, Fixed, After string
+}
+
+// Source returns the file with the typo.
+func (f *Fixture) Source() string {
+	return f.Before + f.Typo + f.After
+}
+
+// A Result is how well the parser recovered from a fixture's typo.
+type Result struct {
+	Fixture *Fixture
+	// Detected is set when the typo produces an ERROR or MISSING node.
+	Detected bool
+	// Localized is set when every ERROR and MISSING node lies within the
+	// typo.
+	Localized bool
+	// Statements counts the top-level statements around the typo, and
+	// Preserved those that parse as they do in the corrected file.
+	Statements, Preserved int
+}
+
+// Score returns the quality of the recovery between 0 and 1: the mean of
+// whether the typo was detected, whether its errors were localized, and
+// the share of statements around it that were preserved.
+func (r *Result) Score() float64 {
+	preserved := 1.0
+	if r.Statements > 0 {
+		preserved = float64(r.Preserved) / float64(r.Statements)
+	}
+	return (b2f(r.Detected) + b2f(r.Localized) + preserved) / 3
+}
+
+func b2f(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// Evaluate parses a fixture with and without its typo and compares the
+// trees. It returns an error if the corrected file does not parse
+// cleanly.
+func Evaluate(f *Fixture) (*Result, error) {
+	fixedSource := []byte(f.Before + f.Fixed + f.After)
+	fixed := analysis.Parse(fixedSource)
+	defer fixed.Close()
+	if fixed.RootNode().HasError() {
+		return nil, fmt.Errorf("%s: corrected file has errors: %s", f.Name, fixed.RootNode().ToSexp())
+	}
+	source := []byte(f.Source())
+	broken := analysis.Parse(source)
+	defer broken.Close()
+
+	r := &Result{Fixture: f, Localized: true}
+	typoStart, typoEnd := uint(len(f.Before)), uint(len(f.Before)+len(f.Typo))
+	analysis.Walk(broken.RootNode(), func(n *tree_sitter.Node) bool {
+		if n.IsError() || n.IsMissing() {
+			r.Detected = true
+			if n.StartByte() < typoStart || n.EndByte() > typoEnd {
+				r.Localized = false
+			}
+		}
+		return true
+	})
+
+	// Statements after the typo move by the difference in length.
+	shift := len(f.Typo) - len(f.Fixed)
+	root := fixed.RootNode()
+	fixedStart, fixedEnd := typoStart, typoStart+uint(len(f.Fixed))
+	for i := uint(0); i < root.NamedChildCount(); i++ {
+		stmt := root.NamedChild(i)
+		if stmt.Kind() == "comment" {
+			continue
+		}
+		start, end := stmt.StartByte(), stmt.EndByte()
+		switch {
+		case end <= fixedStart:
+		case start >= fixedEnd:
+			start, end = uint(int(start)+shift), uint(int(end)+shift)
+		default:
+			// The corrected statement itself.
+			continue
+		}
+		r.Statements++
+		if preserved(broken.RootNode(), stmt, start, end) {
+			r.Preserved++
+		}
+	}
+	return r, nil
+}
+
+// preserved reports whether the broken tree has a top-level statement
+// spanning start to end with the same structure as stmt.
+func preserved(root, stmt *tree_sitter.Node, start, end uint) bool {
+	for i := uint(0); i < root.NamedChildCount(); i++ {
+		n := root.NamedChild(i)
+		if n.StartByte() == start && n.EndByte() == end {
+			return !n.HasError() && n.ToSexp() == stmt.ToSexp()
+		}
+	}
+	return false
+}
+
+// Score returns the mean score of results.
+func Score(results []*Result) float64 {
+	if len(results) == 0 {
+		return 0
+	}
+	total := 0.0
+	for _, r := range results {
+		total += r.Score()
+	}
+	return total / float64(len(results))
+}
+
+// Table returns a table of results, one fixture per line.
+func Table(results []*Result) string {
+	var b strings.Builder
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "fixture\tdetected\tlocalized\tpreserved\tscore")
+	for _, r := range results {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d/%d\t%.2f\n", r.Fixture.Name, yesNo(r.Detected), yesNo(r.Localized), r.Preserved, r.Statements, r.Score())
+	}
+	fmt.Fprintf(w, "total\t\t\t\t%.2f\n", Score(results))
+	w.Flush()
+	return b.String()
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
@@ -0,0 +1,122 @@
+package recovery
+
+import (
+	"math"
+	"testing"
+)
+
+// baseline is the recovery score of the grammar over fixtures. Raise it
+// when a grammar change improves recovery; the test fails when a change
+// makes recovery worse.
+const baseline = 0.79
+
+// weak are the fixtures the grammar does not recover from cleanly yet,
+// pinned at how well it recovers from them now. Every other fixture must
+// be detected, localized and fully preserved.
+var weak = map[string]Result{
+	// The block runs on to the end of the file.
+	"missing closing brace":          {Detected: true, Localized: false, Preserved: 4},
+	"missing colon after item title": {Detected: true, Localized: false, Preserved: 4},
+	// String content may span lines, so the string runs on to the end of
+	// the file.
+	"unclosed string": {Detected: true, Localized: false, Preserved: 4},
+	// else parses as an identifier followed by a block.
+	"stray else": {Detected: false, Localized: true, Preserved: 7},
+	// These parse as valid, if different, code.
+	"missing assignment operator": {Detected: false, Localized: true, Preserved: 7},
+	"missing operand":             {Detected: false, Localized: true, Preserved: 6},
+}
+
+const before = `#define name Typos
+@greeting = "Hello"
+show(greeting)
+`
+
+const after = `alert("after", "typo")
+@count = 1 + 2
+if count > 2 {
+    show(count)
+}
+`
+
+// fixtures are common typos, each surrounded by the same valid code.
+var fixtures = []*Fixture{
+	{Name: "missing closing brace", Typo: "if greeting {\n    alert(greeting)\n\n", Fixed: "if greeting {\n    alert(greeting)\n}\n"},
+	{Name: "extra closing brace", Typo: "if greeting {\n    alert(greeting)\n}}\n", Fixed: "if greeting {\n    alert(greeting)\n}\n"},
+	{Name: "missing colon after item title", Typo: "menu {\n    item \"One\"\n        show(1)\n}\n", Fixed: "menu {\n    item \"One\":\n        show(1)\n}\n"},
+	{Name: "unclosed string", Typo: "@name = \"Bob\n", Fixed: "@name = \"Bob\"\n"},
+	{Name: "stray else", Typo: "else {\n    show(1)\n}\n", Fixed: "if greeting {\n    show(1)\n}\n"},
+	{Name: "missing closing parenthesis", Typo: "show(greeting\n", Fixed: "show(greeting)\n"},
+	{Name: "unclosed grouping", Typo: "@total = (1 + 2\n", Fixed: "@total = (1 + 2)\n"},
+	{Name: "trailing comma", Typo: "alert(\"a\",)\n", Fixed: "alert(\"a\")\n"},
+	{Name: "missing in", Typo: "for x greeting {\n    show(x)\n}\n", Fixed: "for x in greeting {\n    show(x)\n}\n"},
+	{Name: "missing assignment operator", Typo: "@total 3\n", Fixed: "@total = 3\n"},
+	{Name: "missing operand", Typo: "@total = 1 +\n", Fixed: "@total = 1 + 2\n"},
+	{Name: "unclosed dictionary", Typo: "@d = {\"a\": 1\n", Fixed: "@d = {\"a\": 1}\n"},
+}
+
+func init() {
+	for _, f := range fixtures {
+		f.Before, f.After = before, after
+	}
+}
+
+func TestRecovery(t *testing.T) {
+	var results []*Result
+	for _, f := range fixtures {
+		r, err := Evaluate(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		results = append(results, r)
+		want, ok := weak[f.Name]
+		if !ok {
+			want = Result{Detected: true, Localized: true, Preserved: r.Statements}
+		}
+		switch {
+		case want.Detected && !r.Detected || want.Localized && !r.Localized || r.Preserved < want.Preserved:
+			t.Errorf("%s: recovery regressed: detected %v, localized %v, preserved %d/%d, want %v, %v, %d\n%s",
+				f.Name, r.Detected, r.Localized, r.Preserved, r.Statements,
+				want.Detected, want.Localized, want.Preserved, f.Source())
+		case r.Detected != want.Detected || r.Localized != want.Localized || r.Preserved != want.Preserved:
+			t.Logf("%s: recovery improved; update its entry in weak", f.Name)
+		}
+	}
+	t.Logf("\n%s", Table(results))
+
+	score := math.Round(Score(results)*100) / 100
+	switch {
+	case score < baseline:
+		t.Errorf("recovery score dropped from %.2f to %.2f", baseline, score)
+	case score > baseline:
+		t.Logf("recovery score improved from %.2f to %.2f; raise baseline", baseline, score)
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	r, err := Evaluate(&Fixture{
+		Name:   "test",
+		Before: "show(1)\n",
+		Typo:   "show(2\n",
+		Fixed:  "show(2)\n",
+		After:  "show(3)\nshow(4)\n",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.Detected || !r.Localized || r.Statements != 3 || r.Preserved != 3 || r.Score() != 1 {
+		t.Errorf("result = %+v", r)
+	}
+
+	r, err = Evaluate(&Fixture{Name: "test", Typo: "@x = \"a\n", Fixed: "@x = \"a\"\n", After: "show(1)\n"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.Detected || r.Localized || r.Statements != 1 || r.Preserved != 0 || math.Abs(r.Score()-1.0/3) > 1e-9 {
+		t.Errorf("result = %+v", r)
+	}
+
+	if _, err := Evaluate(&Fixture{Name: "bad", Typo: "show(", Fixed: "show("}); err == nil {
+		t.Error("a fixture whose correction has errors was accepted")
+	}
+}
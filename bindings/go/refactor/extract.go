@@ -0,0 +1,270 @@
+package refactor
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/analysis"
+)
+
+// A Parameter is an input or output of an extracted action.
+type Parameter struct {
+	Name string
+	// Type is a Cherri type keyword, or "variable" when unknown.
+	Type string
+	// Argument is the expression passed for the parameter at the call
+	// site.
+	Argument string
+}
+
+// An Extraction is the result of ExtractAction.
+type Extraction struct {
+	// Definition is the text of the new action definition.
+	Definition string
+	// Call is the text replacing the extracted statements.
+	Call string
+	// Parameters are the variables the statements read but do not define.
+	Parameters []Parameter
+	// Result is the variable the statements assign and later code reads,
+	// which the action outputs. It is nil when there is none.
+	Result *Parameter
+	Edits  []analysis.TextEdit
+}
+
+// ExtractAction moves the statements overlapping the byte range
+// [start, end) into a new action called name, replacing them with a call.
+//
+// Variables read by the statements but assigned before them become
+// parameters, as do RepeatItem and RepeatIndex of an enclosing loop. A
+// variable assigned by the statements and read afterwards becomes the
+// action's output; since an action outputs a single value, extraction
+// fails if there is more than one. The definition is appended to the end
+// of the file, as plain text in the syntax of the Cherri compiler.
+func ExtractAction(tree *tree_sitter.Tree, source []byte, start, end uint, name string) (*Extraction, error) {
+	if !identifierPattern.MatchString(name) {
+		return nil, fmt.Errorf("%q is not a valid action name", name)
+	}
+	root := tree.RootNode()
+	stmts := selectedStatements(root, start, end)
+	if len(stmts) == 0 {
+		return nil, errors.New("selection does not contain any statements")
+	}
+	for _, stmt := range stmts {
+		if stmt.Kind() == "item_statement" {
+			return nil, errors.New("menu items cannot be extracted out of their menu")
+		}
+		if stmt.HasError() {
+			return nil, errors.New("selection contains syntax errors")
+		}
+	}
+	first, last := stmts[0], stmts[len(stmts)-1]
+	rangeStart, rangeEnd := first.StartByte(), last.EndByte()
+	inRange := func(n *tree_sitter.Node) bool {
+		return n.StartByte() >= rangeStart && n.EndByte() <= rangeEnd
+	}
+	loop := enclosingLoop(first)
+
+	all := analysis.Occurrences(root, source)
+	writtenBefore := map[string]bool{}
+	constants := map[string]bool{}
+	for _, occ := range all {
+		if occ.Write && occ.Node.EndByte() <= rangeStart {
+			writtenBefore[occ.Name] = true
+		}
+		if occ.Write && !occ.Mutation && occ.Node.Parent().Kind() == "constant_assignment" {
+			constants[occ.Name] = true
+		}
+	}
+
+	var params []Parameter
+	isParam := map[string]bool{}
+	written := map[string]bool{}
+	// writtenFrom holds the offset from which the first write of each
+	// variable takes effect: the end of an assignment, whose value is
+	// read before its name is written, or else the write itself.
+	writtenFrom := map[string]uint{}
+	var writeOrder []string
+	var repeatRefs []*tree_sitter.Node
+	for _, occ := range all {
+		if !inRange(occ.Node) {
+			continue
+		}
+		if occ.Name == "RepeatItem" || occ.Name == "RepeatIndex" {
+			if l := enclosingLoop(occ.Node); l == nil || !inRange(l) {
+				repeatRefs = append(repeatRefs, occ.Node)
+				param := strings.ToLower(occ.Name[:1]) + occ.Name[1:]
+				if !isParam[param] {
+					isParam[param] = true
+					params = append(params, Parameter{Name: param, Type: "variable", Argument: occ.Name})
+				}
+			}
+			continue
+		}
+		read := !occ.Write || occ.Mutation
+		from, ok := writtenFrom[occ.Name]
+		writtenEarlier := ok && from <= occ.Node.StartByte()
+		if read && !writtenEarlier && !isParam[occ.Name] && !constants[occ.Name] &&
+			(writtenBefore[occ.Name] || isLoopVariableOf(loop, occ.Name, source)) {
+			isParam[occ.Name] = true
+			params = append(params, Parameter{Name: occ.Name, Type: variableType(all, occ.Name, rangeStart, source), Argument: "@" + occ.Name})
+		}
+		if occ.Write && !written[occ.Name] {
+			written[occ.Name] = true
+			writtenFrom[occ.Name] = occ.Node.StartByte()
+			if parent := occ.Node.Parent(); !occ.Mutation && strings.HasSuffix(parent.Kind(), "assignment") {
+				writtenFrom[occ.Name] = parent.EndByte()
+			}
+			writeOrder = append(writeOrder, occ.Name)
+		}
+	}
+
+	// A variable escapes if it is read after the statements, or anywhere
+	// else in an enclosing loop, which runs the statements again.
+	escapes := map[string]bool{}
+	for _, occ := range all {
+		if occ.Write && !occ.Mutation || !written[occ.Name] || inRange(occ.Node) {
+			continue
+		}
+		if occ.Node.StartByte() >= rangeEnd || loop != nil && occ.Node.StartByte() >= loop.StartByte() && occ.Node.EndByte() <= loop.EndByte() {
+			escapes[occ.Name] = true
+		}
+	}
+	var escaping []string
+	for _, name := range writeOrder {
+		if escapes[name] {
+			escaping = append(escaping, name)
+		}
+	}
+	if len(escaping) > 1 {
+		sort.Strings(escaping)
+		return nil, fmt.Errorf("statements assign %d variables used later (@%s), but an action can only output one",
+			len(escaping), strings.Join(escaping, ", @"))
+	}
+
+	ext := &Extraction{Parameters: params}
+	if len(escaping) == 1 {
+		ext.Result = &Parameter{
+			Name:     escaping[0],
+			Type:     variableType(all, escaping[0], rangeEnd, source),
+			Argument: "@" + escaping[0],
+		}
+	}
+
+	// Build the action body, renaming loop constants to their parameters.
+	var bodyEdits []analysis.TextEdit
+	for _, ref := range repeatRefs {
+		text := ref.Utf8Text(source)
+		var newText string
+		if ref.Kind() == "interpolation" {
+			newText = strings.Replace(text, "RepeatItem", "repeatItem", 1)
+			newText = strings.Replace(newText, "RepeatIndex", "repeatIndex", 1)
+		} else {
+			param := strings.ToLower(text[:1]) + text[1:]
+			newText = analysis.VariableReference(param, source, ref.EndByte())
+		}
+		bodyEdits = append(bodyEdits, analysis.TextEdit{Range: ref.Range(), NewText: newText})
+	}
+	body := applyWithin(source, rangeStart, rangeEnd, bodyEdits)
+	unit := indentUnit(source)
+	firstIndent := analysis.LineIndent(source, rangeStart)
+
+	var def strings.Builder
+	paramDecls := make([]string, len(params))
+	for i, p := range params {
+		paramDecls[i] = p.Type + " " + p.Name
+	}
+	fmt.Fprintf(&def, "action %s(%s)", name, strings.Join(paramDecls, ", "))
+	if ext.Result != nil {
+		fmt.Fprintf(&def, ": %s", ext.Result.Type)
+	}
+	def.WriteString(" {\n")
+	def.WriteString(reindent(body, firstIndent, unit))
+	def.WriteString("\n")
+	if ext.Result != nil {
+		fmt.Fprintf(&def, "%soutput(@%s )\n", unit, ext.Result.Name)
+	}
+	def.WriteString("}\n")
+	ext.Definition = def.String()
+
+	args := make([]string, len(params))
+	for i, p := range params {
+		args[i] = p.Argument
+		if strings.HasPrefix(p.Argument, "@") {
+			args[i] += " "
+		}
+	}
+	ext.Call = fmt.Sprintf("%s(%s)", name, strings.Join(args, ", "))
+	if ext.Result != nil {
+		ext.Call = fmt.Sprintf("@%s = %s", ext.Result.Name, ext.Call)
+	}
+
+	end = uint(len(source))
+	insert := "\n" + ext.Definition
+	if !strings.HasSuffix(string(source), "\n") {
+		insert = "\n" + insert
+	}
+	ext.Edits = []analysis.TextEdit{
+		{
+			Range: tree_sitter.Range{
+				StartByte:  rangeStart,
+				EndByte:    rangeEnd,
+				StartPoint: first.StartPosition(),
+				EndPoint:   last.EndPosition(),
+			},
+			NewText: ext.Call,
+		},
+		{
+			Range:   analysis.InsertionAt(root, source, end),
+			NewText: insert,
+		},
+	}
+	return ext, nil
+}
+
+// enclosingLoop returns the innermost repeat or for loop containing node.
+func enclosingLoop(node *tree_sitter.Node) *tree_sitter.Node {
+	for n := node.Parent(); n != nil; n = n.Parent() {
+		if n.Kind() == "repeat_statement" || n.Kind() == "for_statement" {
+			return n
+		}
+	}
+	return nil
+}
+
+// isLoopVariableOf reports whether name is the variable of loop or of a
+// loop enclosing it.
+func isLoopVariableOf(loop *tree_sitter.Node, name string, source []byte) bool {
+	for l := loop; l != nil; l = enclosingLoop(l) {
+		if v := l.ChildByFieldName("variable"); v != nil && v.Utf8Text(source) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// variableType infers the type of a variable from its declaration, or
+// from the literal most recently assigned to it before offset.
+func variableType(all []analysis.Occurrence, name string, offset uint, source []byte) string {
+	typ := "variable"
+	for _, occ := range all {
+		if occ.Name != name || !occ.Write || occ.Mutation {
+			continue
+		}
+		parent := occ.Node.Parent()
+		switch parent.Kind() {
+		case "declaration":
+			if t := parent.ChildByFieldName("type"); t != nil {
+				return t.Utf8Text(source)
+			}
+		case "variable_assignment", "identifier_assignment", "constant_assignment":
+			if occ.Node.StartByte() < offset {
+				typ = analysis.LiteralType(parent.ChildByFieldName("value"))
+			}
+		}
+	}
+	return typ
+}
@@ -0,0 +1,122 @@
+package refactor
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/analysis"
+)
+
+func TestExtractAction(t *testing.T) {
+	source := []byte(`@greeting = "Hello"
+@names = getValue(ShortcutInput, "names")
+repeat 3 {
+  @line = "{greeting}, {RepeatItem}!"
+  @shout = changeCase(@line , "UPPERCASE")
+  show(@shout )
+}
+`)
+	tree := analysis.Parse(source)
+	defer tree.Close()
+
+	start := uint(strings.Index(string(source), "@line"))
+	end := uint(strings.Index(string(source), "  show"))
+	ext, err := ExtractAction(tree, source, start, end, "shout")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ext.Parameters) != 2 || ext.Parameters[0].Name != "greeting" || ext.Parameters[0].Type != "text" ||
+		ext.Parameters[1].Name != "repeatItem" {
+		t.Errorf("parameters = %+v", ext.Parameters)
+	}
+	if ext.Result == nil || ext.Result.Name != "shout" {
+		t.Errorf("result = %+v, want @shout", ext.Result)
+	}
+
+	got := string(analysis.ApplyEdits(source, ext.Edits))
+	want := `@greeting = "Hello"
+@names = getValue(ShortcutInput, "names")
+repeat 3 {
+  @shout = shout(@greeting , RepeatItem)
+  show(@shout )
+}
+
+action shout(text greeting, variable repeatItem): variable {
+  @line = "{greeting}, {repeatItem}!"
+  @shout = changeCase(@line , "UPPERCASE")
+  output(@shout )
+}
+`
+	if got != want {
+		t.Errorf("extracted source:\n%s\nwant:\n%s", got, want)
+	}
+
+	// The edited source parses cleanly, with the new definition last.
+	edited := analysis.Parse([]byte(got))
+	defer edited.Close()
+	root := edited.RootNode()
+	if root.HasError() {
+		t.Fatalf("extracted source has syntax errors: %s", root.ToSexp())
+	}
+	def := root.NamedChild(root.NamedChildCount() - 1)
+	if def.Kind() != "action_definition" || def.ChildByFieldName("name").Utf8Text([]byte(got)) != "shout" {
+		t.Fatalf("last statement of the extracted source = %s", def.ToSexp())
+	}
+	if params := analysis.ParameterNames(def, []byte(got)); !reflect.DeepEqual(params, []string{"greeting", "repeatItem"}) {
+		t.Errorf("shout parameters = %v", params)
+	}
+
+	// An assignment reads its value before it writes its name.
+	source = []byte("@count = 0\n@count = @count + 1\nshow(@count )\n")
+	counter := analysis.Parse(source)
+	defer counter.Close()
+	start = uint(strings.Index(string(source), "@count = @"))
+	end = uint(strings.Index(string(source), "show"))
+	ext, err = ExtractAction(counter, source, start, end, "bump")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ext.Parameters) != 1 || ext.Parameters[0].Name != "count" {
+		t.Errorf("parameters of bump = %+v, want @count", ext.Parameters)
+	}
+	got = string(analysis.ApplyEdits(source, ext.Edits))
+	want = `@count = 0
+@count = bump(@count )
+show(@count )
+
+action bump(number count): variable {
+    @count = @count + 1
+    output(@count )
+}
+`
+	if got != want {
+		t.Errorf("extracted source:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestExtractActionErrors(t *testing.T) {
+	source := []byte(`@a = 1
+@b = @a + 1
+@c = @a + 2
+show("{b} {c}")
+menu {
+  item "One": show("one")
+}
+`)
+	tree := analysis.Parse(source)
+	defer tree.Close()
+
+	start := uint(strings.Index(string(source), "@b"))
+	end := uint(strings.Index(string(source), "show"))
+	if _, err := ExtractAction(tree, source, start, end, "compute"); err == nil || !strings.Contains(err.Error(), "@b, @c") {
+		t.Errorf("extracting two escaping variables: err = %v", err)
+	}
+	item := uint(strings.Index(string(source), "item"))
+	if _, err := ExtractAction(tree, source, item, item+4, "one"); err == nil {
+		t.Error("extracting a menu item succeeded")
+	}
+	if _, err := ExtractAction(tree, source, start, end, "not valid"); err == nil {
+		t.Error("extracting with an invalid name succeeded")
+	}
+}
@@ -0,0 +1,153 @@
+package refactor
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/analysis"
+)
+
+// builtinConstants are written bare outside strings; every other
+// interpolated name is a variable.
+var builtinConstants = map[string]bool{
+	"CurrentDate":   true,
+	"Device":        true,
+	"RepeatIndex":   true,
+	"RepeatItem":    true,
+	"ShortcutInput": true,
+	"Ask":           true,
+}
+
+// ConcatenationToInterpolation collapses the chain of + expressions
+// containing offset, such as `"Hello " + @name + "!"`, into a single
+// interpolated string, "Hello {name}!".
+//
+// The chain must be a string concatenation: since + associates to the
+// left, one of its first two operands must be a string literal. Operands
+// other than strings, numbers, variables and builtin constants cannot be
+// interpolated. Single-quoted strings are raw, so their backslashes,
+// quotes and braces are escaped in the result.
+func ConcatenationToInterpolation(tree *tree_sitter.Tree, source []byte, offset uint) ([]analysis.TextEdit, error) {
+	chain := concatenationAt(tree.RootNode(), offset)
+	if chain == nil {
+		return nil, errors.New("no + expression at offset")
+	}
+	operands := flattenConcatenation(chain)
+	if !isStringLiteral(operands[0]) && !isStringLiteral(operands[1]) {
+		return nil, errors.New("neither of the first two operands is a string, so + is not a concatenation")
+	}
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, op := range operands {
+		switch op.Kind() {
+		case "string":
+			text := op.Utf8Text(source)
+			b.WriteString(text[1 : len(text)-1])
+		case "single_quoted_string":
+			value, _ := analysis.StringValue(op, source)
+			b.WriteString(escapeDoubleQuoted(value))
+		case "number":
+			b.WriteString(op.Utf8Text(source))
+		case "at_variable":
+			fmt.Fprintf(&b, "{%s}", analysis.VariableName(op, source))
+		case "identifier", "builtin_constant":
+			fmt.Fprintf(&b, "{%s}", op.Utf8Text(source))
+		default:
+			return nil, fmt.Errorf("cannot interpolate %s at %d:%d", strings.ReplaceAll(op.Kind(), "_", " "),
+				op.StartPosition().Row+1, op.StartPosition().Column+1)
+		}
+	}
+	b.WriteByte('"')
+	return []analysis.TextEdit{{Range: chain.Range(), NewText: b.String()}}, nil
+}
+
+// InterpolationToConcatenation expands the interpolated string containing
+// offset into a concatenation of its parts, e.g. "Hello {name}!" becomes
+// `"Hello " + @name + "!"`. When the string starts with an interpolation
+// an empty string is prepended, so that + still concatenates.
+func InterpolationToConcatenation(tree *tree_sitter.Tree, source []byte, offset uint) ([]analysis.TextEdit, error) {
+	str := tree.RootNode().DescendantForByteRange(offset, offset)
+	for str != nil && str.Kind() != "string" {
+		str = str.Parent()
+	}
+	if str == nil {
+		return nil, errors.New("no string at offset")
+	}
+	var parts []string
+	var literal strings.Builder
+	flush := func() {
+		if literal.Len() > 0 {
+			parts = append(parts, `"`+literal.String()+`"`)
+			literal.Reset()
+		}
+	}
+	interpolated := false
+	for i := uint(0); i < str.NamedChildCount(); i++ {
+		child := str.NamedChild(i)
+		if child.Kind() != "interpolation" {
+			literal.WriteString(child.Utf8Text(source))
+			continue
+		}
+		interpolated = true
+		names := analysis.InterpolationNames(child, source)
+		inner := strings.TrimSpace(strings.Trim(child.Utf8Text(source), "{}"))
+		if len(names) != 1 || strings.TrimPrefix(inner, "@") != names[0] {
+			return nil, fmt.Errorf("cannot expand interpolation %s, which is not a plain name", child.Utf8Text(source))
+		}
+		if len(parts) == 0 && literal.Len() == 0 {
+			parts = append(parts, `""`)
+		}
+		flush()
+		if builtinConstants[names[0]] {
+			parts = append(parts, names[0])
+		} else {
+			parts = append(parts, "@"+names[0])
+		}
+	}
+	flush()
+	if !interpolated {
+		return nil, errors.New("string has no interpolations")
+	}
+	text := strings.Join(parts, " + ")
+	if last := parts[len(parts)-1]; strings.HasPrefix(last, "@") {
+		text = strings.TrimSuffix(text, last) + analysis.VariableReference(last[1:], source, str.EndByte())
+	}
+	return []analysis.TextEdit{{Range: str.Range(), NewText: text}}, nil
+}
+
+// concatenationAt returns the outermost + chain containing offset.
+func concatenationAt(root *tree_sitter.Node, offset uint) *tree_sitter.Node {
+	var chain *tree_sitter.Node
+	for n := root.DescendantForByteRange(offset, offset); n != nil; n = n.Parent() {
+		if isConcatenation(n) {
+			chain = n
+		} else if chain != nil {
+			break
+		}
+	}
+	return chain
+}
+
+func isConcatenation(n *tree_sitter.Node) bool {
+	return n.Kind() == "binary_expression" && n.ChildCount() >= 3 && n.Child(1).Kind() == "+"
+}
+
+// flattenConcatenation returns the operands of a + chain in order.
+func flattenConcatenation(n *tree_sitter.Node) []*tree_sitter.Node {
+	if !isConcatenation(n) {
+		return []*tree_sitter.Node{n}
+	}
+	return append(flattenConcatenation(n.Child(0)), flattenConcatenation(n.Child(2))...)
+}
+
+func isStringLiteral(n *tree_sitter.Node) bool {
+	return n.Kind() == "string" || n.Kind() == "single_quoted_string"
+}
+
+// escapeDoubleQuoted escapes s for the inside of a double-quoted string.
+func escapeDoubleQuoted(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, `{`, `\{`).Replace(s)
+}
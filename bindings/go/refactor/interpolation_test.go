@@ -0,0 +1,73 @@
+package refactor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/analysis"
+)
+
+func TestConcatenationToInterpolation(t *testing.T) {
+	tests := []struct {
+		source, want string
+	}{
+		{`@msg = "Hello " + @name + "!"`, `@msg = "Hello {name}!"`},
+		{`@msg = @name + " has " + 3 + ' {raw} \d'`, `@msg = "{name} has 3 \{raw} \\d"`},
+		{`show("Input: " + ShortcutInput)`, `show("Input: {ShortcutInput}")`},
+	}
+	for _, tt := range tests {
+		source := []byte(tt.source)
+		tree := analysis.Parse(source)
+		edits, err := ConcatenationToInterpolation(tree, source, uint(strings.Index(tt.source, "+")))
+		tree.Close()
+		if err != nil {
+			t.Errorf("ConcatenationToInterpolation(%s): %v", tt.source, err)
+			continue
+		}
+		if got := string(analysis.ApplyEdits(source, edits)); got != tt.want {
+			t.Errorf("ConcatenationToInterpolation(%s) = %s, want %s", tt.source, got, tt.want)
+		}
+	}
+
+	for _, src := range []string{`@n = 1 + 2 + "x"`, `@s = "a" + count(@list )`} {
+		source := []byte(src)
+		tree := analysis.Parse(source)
+		if _, err := ConcatenationToInterpolation(tree, source, uint(strings.Index(src, "+"))); err == nil {
+			t.Errorf("ConcatenationToInterpolation(%s) succeeded, want an error", src)
+		}
+		tree.Close()
+	}
+}
+
+func TestInterpolationToConcatenation(t *testing.T) {
+	tests := []struct {
+		source, want string
+	}{
+		{`@msg = "Hello {name}!"`, `@msg = "Hello " + @name + "!"`},
+		{`show("{first}{last}")`, `show("" + @first + @last )`},
+		{`@s = "In: {ShortcutInput} \{x}"`, `@s = "In: " + ShortcutInput + " \{x}"`},
+	}
+	for _, tt := range tests {
+		source := []byte(tt.source)
+		tree := analysis.Parse(source)
+		edits, err := InterpolationToConcatenation(tree, source, uint(strings.Index(tt.source, `"`)+1))
+		tree.Close()
+		if err != nil {
+			t.Errorf("InterpolationToConcatenation(%s): %v", tt.source, err)
+			continue
+		}
+		got := analysis.ApplyEdits(source, edits)
+		if string(got) != tt.want {
+			t.Errorf("InterpolationToConcatenation(%s) = %s, want %s", tt.source, got, tt.want)
+		}
+		// Converting back must give the original string, apart from the
+		// space that keeps a trailing @variable from swallowing `)`.
+		tree = analysis.Parse(got)
+		back, err := ConcatenationToInterpolation(tree, got, uint(strings.Index(string(got), "+")))
+		tree.Close()
+		roundTrip := strings.ReplaceAll(string(analysis.ApplyEdits(got, back)), " )", ")")
+		if err != nil || roundTrip != tt.source {
+			t.Errorf("round trip of %s = %s, %v", tt.source, roundTrip, err)
+		}
+	}
+}
@@ -0,0 +1,327 @@
+package refactor
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/analysis"
+)
+
+// A WorkspaceEdit is a set of edits across several files.
+type WorkspaceEdit struct {
+	// Creates lists files to create, empty, before applying Changes.
+	Creates []string
+	// Changes maps file paths to the edits to make to them.
+	Changes map[string][]analysis.TextEdit
+}
+
+// Apply returns the contents of files after the edit, leaving files
+// unmodified.
+func (w *WorkspaceEdit) Apply(files map[string][]byte) map[string][]byte {
+	out := make(map[string][]byte, len(files))
+	for name, source := range files {
+		out[name] = source
+	}
+	for _, name := range w.Creates {
+		out[name] = nil
+	}
+	for name, edits := range w.Changes {
+		out[name] = analysis.ApplyEdits(out[name], edits)
+	}
+	return out
+}
+
+func (w *WorkspaceEdit) add(file string, edit analysis.TextEdit) {
+	if w.Changes == nil {
+		w.Changes = map[string][]analysis.TextEdit{}
+	}
+	w.Changes[file] = append(w.Changes[file], edit)
+}
+
+// A movedUnit is a top-level construct that can be moved, and the name it
+// defines.
+type movedUnit struct {
+	name       string
+	start, end uint
+	startPoint tree_sitter.Point
+	endPoint   tree_sitter.Point
+}
+
+// MoveToInclude moves the top-level constants and action definitions of
+// file overlapping the byte range [start, end) into the include file
+// target, creating it if needed. files holds the source of every file in
+// the workspace, keyed by slash-separated path.
+//
+// An `#include` of target is added to file and to every other file that
+// refers to a moved name but does not already include target, directly or
+// through other includes. The includes of file that define constants or
+// actions the moved statements use are added to target. Moving fails if
+// the moved statements refer to constants or actions that stay behind, or
+// if an include they need includes target in turn.
+func MoveToInclude(files map[string][]byte, file string, start, end uint, target string) (*WorkspaceEdit, error) {
+	source, ok := files[file]
+	if !ok {
+		return nil, fmt.Errorf("%s is not in the workspace", file)
+	}
+	if target == file {
+		return nil, errors.New("cannot move statements into the same file")
+	}
+	tree := analysis.Parse(source)
+	defer tree.Close()
+	root := tree.RootNode()
+
+	units := topLevelUnits(root, source)
+	var moved []movedUnit
+	movedNames := map[string]bool{}
+	stayingNames := map[string]bool{}
+	for _, u := range units {
+		if u.end > start && u.start < end {
+			moved = append(moved, u)
+			movedNames[u.name] = true
+		} else {
+			stayingNames[u.name] = true
+		}
+	}
+	if len(moved) == 0 {
+		return nil, errors.New("selection contains no constants or action definitions")
+	}
+	// Anything else top-level in the selection cannot be moved.
+	for i := uint(0); i < root.NamedChildCount(); i++ {
+		child := root.NamedChild(i)
+		if child.EndByte() > start && child.StartByte() < end && !child.IsExtra() && !coveredBy(child, moved) {
+			return nil, fmt.Errorf("only constants and action definitions can be moved, not the %s on line %d",
+				strings.ReplaceAll(child.Kind(), "_", " "), child.StartPosition().Row+1)
+		}
+	}
+	span := movedUnit{
+		start: moved[0].start, end: moved[len(moved)-1].end,
+		startPoint: moved[0].startPoint, endPoint: moved[len(moved)-1].endPoint,
+	}
+	used := map[string]bool{}
+	for _, occ := range referencedNames(root, source, span.start, span.end) {
+		if stayingNames[occ] {
+			return nil, fmt.Errorf("the moved statements use %s, which stays in %s", occ, file)
+		}
+		if !movedNames[occ] {
+			used[occ] = true
+		}
+	}
+
+	graph := includeGraph(files)
+	var includes strings.Builder
+	for _, inc := range graph[file] {
+		if inc == target || reaches(graph, target, inc, map[string]bool{}) || !defines(files, graph, inc, used) {
+			continue
+		}
+		if reaches(graph, inc, target, map[string]bool{}) {
+			return nil, fmt.Errorf("the moved statements use %s, which includes %s", inc, target)
+		}
+		fmt.Fprintf(&includes, "#include %q\n", relativeInclude(target, inc))
+	}
+
+	edit := &WorkspaceEdit{}
+	edit.add(file, lineDeletion(source, span))
+	targetSource, exists := files[target]
+	if !exists {
+		edit.Creates = append(edit.Creates, target)
+	}
+	insert := string(source[span.start:span.end]) + "\n"
+	switch {
+	case len(targetSource) > 0:
+		insert = "\n" + insert
+		if !strings.HasSuffix(string(targetSource), "\n") {
+			insert = "\n" + insert
+		}
+		if includes.Len() > 0 {
+			targetTree := analysis.Parse(targetSource)
+			edit.add(target, pragmaEdit(targetTree.RootNode(), targetSource, includes.String()))
+			targetTree.Close()
+		}
+	case includes.Len() > 0:
+		insert = includes.String() + "\n" + insert
+	}
+	edit.add(target, analysis.TextEdit{
+		Range:   endOfFile(targetSource),
+		NewText: insert,
+	})
+
+	alreadyIncluded := reaches(graph, file, target, map[string]bool{})
+	graph[file] = append(graph[file], target)
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if name == file {
+			if !alreadyIncluded {
+				edit.add(name, includeEdit(files[name], relativeInclude(name, target)))
+			}
+			continue
+		}
+		if name == target || reaches(graph, name, target, map[string]bool{}) || !refersTo(files[name], movedNames) {
+			continue
+		}
+		edit.add(name, includeEdit(files[name], relativeInclude(name, target)))
+	}
+	return edit, nil
+}
+
+// topLevelUnits returns the constants and action definitions of a file.
+func topLevelUnits(root *tree_sitter.Node, source []byte) []movedUnit {
+	var units []movedUnit
+	for i := uint(0); i < root.NamedChildCount(); i++ {
+		child := root.NamedChild(i)
+		if child.Kind() == "constant_assignment" || child.Kind() == "action_definition" {
+			units = append(units, movedUnit{
+				name:  child.ChildByFieldName("name").Utf8Text(source),
+				start: child.StartByte(), end: child.EndByte(),
+				startPoint: child.StartPosition(), endPoint: child.EndPosition(),
+			})
+		}
+	}
+	return units
+}
+
+func coveredBy(node *tree_sitter.Node, units []movedUnit) bool {
+	for _, u := range units {
+		if node.StartByte() >= u.start && node.EndByte() <= u.end {
+			return true
+		}
+	}
+	return false
+}
+
+// referencedNames returns the constants read and actions called between
+// start and end.
+func referencedNames(root *tree_sitter.Node, source []byte, start, end uint) []string {
+	var names []string
+	analysis.Walk(root, func(n *tree_sitter.Node) bool {
+		if n.EndByte() <= start || n.StartByte() >= end {
+			return false
+		}
+		switch n.Kind() {
+		case "call":
+			names = append(names, analysis.CallName(n, source))
+		}
+		return true
+	})
+	for _, occ := range analysis.Occurrences(root, source) {
+		if !occ.Write && occ.Node.StartByte() >= start && occ.Node.EndByte() <= end {
+			names = append(names, occ.Name)
+		}
+	}
+	return names
+}
+
+// defines reports whether file, or a file it includes, defines a constant
+// or action in names.
+func defines(files map[string][]byte, graph map[string][]string, file string, names map[string]bool) bool {
+	seen := map[string]bool{}
+	var visit func(string) bool
+	visit = func(name string) bool {
+		if seen[name] {
+			return false
+		}
+		seen[name] = true
+		if source, ok := files[name]; ok {
+			tree := analysis.Parse(source)
+			defer tree.Close()
+			for _, u := range topLevelUnits(tree.RootNode(), source) {
+				if names[u.name] {
+					return true
+				}
+			}
+		}
+		return slices.ContainsFunc(graph[name], visit)
+	}
+	return visit(file)
+}
+
+// refersTo reports whether source calls or reads any of names.
+func refersTo(source []byte, names map[string]bool) bool {
+	tree := analysis.Parse(source)
+	defer tree.Close()
+	for _, name := range referencedNames(tree.RootNode(), source, 0, uint(len(source))) {
+		if names[name] {
+			return true
+		}
+	}
+	return false
+}
+
+// includeGraph maps each file to the workspace paths it includes.
+func includeGraph(files map[string][]byte) map[string][]string {
+	graph := map[string][]string{}
+	for name, source := range files {
+		tree := analysis.Parse(source)
+		for _, inc := range analysis.Includes(tree.RootNode(), source) {
+			graph[name] = append(graph[name], path.Join(path.Dir(name), inc.Path))
+		}
+		tree.Close()
+	}
+	return graph
+}
+
+func reaches(graph map[string][]string, from, to string, seen map[string]bool) bool {
+	if seen[from] {
+		return false
+	}
+	seen[from] = true
+	for _, next := range graph[from] {
+		if next == to || reaches(graph, next, to, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+// relativeInclude returns the include path of target from file.
+func relativeInclude(file, target string) string {
+	rel, err := filepath.Rel(filepath.Dir(filepath.FromSlash(file)), filepath.FromSlash(target))
+	if err != nil {
+		return target
+	}
+	return filepath.ToSlash(rel)
+}
+
+// includeEdit returns an edit adding an #include pragma after the pragmas
+// at the top of source.
+func includeEdit(source []byte, include string) analysis.TextEdit {
+	tree := analysis.Parse(source)
+	defer tree.Close()
+	return pragmaEdit(tree.RootNode(), source, fmt.Sprintf("#include %q\n", include))
+}
+
+// lineDeletion removes a unit along with the rest of its lines when
+// nothing else is on them.
+func lineDeletion(source []byte, u movedUnit) analysis.TextEdit {
+	start, end := u.start, u.end
+	startPoint, endPoint := u.startPoint, u.endPoint
+	for start > 0 && (source[start-1] == ' ' || source[start-1] == '\t') {
+		start--
+		startPoint.Column--
+	}
+	for int(end) < len(source) && (source[end] == ' ' || source[end] == '\t') {
+		end++
+		endPoint.Column++
+	}
+	if int(end) < len(source) && source[end] == '\n' && (start == 0 || source[start-1] == '\n') {
+		end++
+		endPoint = tree_sitter.Point{Row: endPoint.Row + 1}
+		// Don't leave two blank lines where the unit was.
+		blankBefore := start == 0 || start >= 2 && source[start-2] == '\n'
+		if blankBefore && int(end) < len(source) && source[end] == '\n' {
+			end++
+			endPoint.Row++
+		}
+	}
+	return analysis.TextEdit{Range: tree_sitter.Range{StartByte: start, EndByte: end, StartPoint: startPoint, EndPoint: endPoint}}
+}
@@ -0,0 +1,153 @@
+package refactor
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMoveToInclude(t *testing.T) {
+	files := map[string][]byte{
+		"main.cherri": []byte(`#define name "Main"
+#define color red
+
+const greeting = "Hello"
+
+action greet(text who) {
+  show("{greeting}, {who}")
+}
+
+greet("world")
+`),
+		"other.cherri": []byte(`greet("other")
+`),
+		"wrapper.cherri": []byte(`#include "main.cherri"
+greet("again")
+`),
+		"unrelated.cherri": []byte(`show("hi")
+`),
+	}
+	main := string(files["main.cherri"])
+	start := uint(strings.Index(main, "const"))
+	end := uint(strings.Index(main, "\ngreet("))
+
+	edit, err := MoveToInclude(files, "main.cherri", start, end, "lib/helpers.cherri")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(edit.Creates) != 1 || edit.Creates[0] != "lib/helpers.cherri" {
+		t.Errorf("creates = %v", edit.Creates)
+	}
+	if _, ok := edit.Changes["wrapper.cherri"]; ok {
+		t.Error("wrapper.cherri already reaches the moved statements through main.cherri")
+	}
+	if _, ok := edit.Changes["unrelated.cherri"]; ok {
+		t.Error("unrelated.cherri does not use the moved statements")
+	}
+
+	out := edit.Apply(files)
+	want := map[string]string{
+		"main.cherri": `#define name "Main"
+#define color red
+#include "lib/helpers.cherri"
+
+greet("world")
+`,
+		"lib/helpers.cherri": `const greeting = "Hello"
+
+action greet(text who) {
+  show("{greeting}, {who}")
+}
+`,
+		"other.cherri": `#include "lib/helpers.cherri"
+greet("other")
+`,
+	}
+	for name, w := range want {
+		if got := string(out[name]); got != w {
+			t.Errorf("%s:\n%s\nwant:\n%s", name, got, w)
+		}
+	}
+}
+
+func TestMoveToIncludeKeepsIncludes(t *testing.T) {
+	files := map[string][]byte{
+		"main.cherri": []byte(`#include "lib/text.cherri"
+#include "lib/numbers.cherri"
+
+action greet(text who) {
+  shout("Hello, {who}")
+}
+
+greet("world")
+`),
+		"lib/text.cherri": []byte(`action shout(text message) {
+  show(message)
+}
+`),
+		"lib/numbers.cherri": []byte(`const answer = 42
+`),
+		"lib/existing.cherri": []byte(`#define name "Existing"
+const other = 1
+`),
+	}
+	main := string(files["main.cherri"])
+	start := uint(strings.Index(main, "action"))
+	end := uint(strings.Index(main, "\ngreet("))
+
+	out := mustMove(t, files, "main.cherri", start, end, "lib/helpers.cherri")
+	want := `#include "text.cherri"
+
+action greet(text who) {
+  shout("Hello, {who}")
+}
+`
+	if got := string(out["lib/helpers.cherri"]); got != want {
+		t.Errorf("lib/helpers.cherri:\n%s\nwant:\n%s", got, want)
+	}
+
+	out = mustMove(t, files, "main.cherri", start, end, "lib/existing.cherri")
+	want = `#define name "Existing"
+#include "text.cherri"
+const other = 1
+
+action greet(text who) {
+  shout("Hello, {who}")
+}
+`
+	if got := string(out["lib/existing.cherri"]); got != want {
+		t.Errorf("lib/existing.cherri:\n%s\nwant:\n%s", got, want)
+	}
+
+	// The include the action needs would include its new file.
+	files["lib/text.cherri"] = append([]byte("#include \"helpers.cherri\"\n"), files["lib/text.cherri"]...)
+	files["lib/helpers.cherri"] = nil
+	if _, err := MoveToInclude(files, "main.cherri", start, end, "lib/helpers.cherri"); err == nil || !strings.Contains(err.Error(), "includes") {
+		t.Errorf("moving into a file the needed include includes: err = %v", err)
+	}
+}
+
+func mustMove(t *testing.T, files map[string][]byte, file string, start, end uint, target string) map[string][]byte {
+	t.Helper()
+	edit, err := MoveToInclude(files, file, start, end, target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return edit.Apply(files)
+}
+
+func TestMoveToIncludeErrors(t *testing.T) {
+	files := map[string][]byte{
+		"main.cherri": []byte(`const a = 1
+const b = a + 1
+show("{b}")
+`),
+	}
+	main := string(files["main.cherri"])
+	b := uint(strings.Index(main, "const b"))
+	if _, err := MoveToInclude(files, "main.cherri", b, b+5, "lib.cherri"); err == nil || !strings.Contains(err.Error(), "stays") {
+		t.Errorf("moving a constant that depends on one staying behind: err = %v", err)
+	}
+	if _, err := MoveToInclude(files, "main.cherri", 0, uint(len(main)), "lib.cherri"); err == nil {
+		t.Error("moving a show call succeeded")
+	}
+}
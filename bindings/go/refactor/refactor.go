@@ -0,0 +1,133 @@
+// Package refactor implements source transformations over Cherri parse
+// trees. Refactorings do not modify their input; they return the text
+// edits that perform them.
+package refactor
+
+import (
+	"regexp"
+	"strings"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/analysis"
+)
+
+var identifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// selectedStatements returns the statements of the innermost block (or the
+// file) that overlap the byte range [start, end).
+func selectedStatements(root *tree_sitter.Node, start, end uint) []*tree_sitter.Node {
+	container := root.DescendantForByteRange(start, max(start, end-1))
+	for container != nil && container.Kind() != "block" && container.Kind() != "source_file" {
+		container = container.Parent()
+	}
+	if container == nil {
+		return nil
+	}
+	var stmts []*tree_sitter.Node
+	for i := uint(0); i < container.NamedChildCount(); i++ {
+		child := container.NamedChild(i)
+		if child.EndByte() > start && child.StartByte() < end {
+			stmts = append(stmts, child)
+		}
+	}
+	return stmts
+}
+
+// applyWithin applies edits lying within source[start:end] to that span
+// and returns the result.
+func applyWithin(source []byte, start, end uint, edits []analysis.TextEdit) string {
+	shifted := make([]analysis.TextEdit, len(edits))
+	for i, e := range edits {
+		shifted[i] = e
+		shifted[i].Range.StartByte -= start
+		shifted[i].Range.EndByte -= start
+	}
+	return string(analysis.ApplyEdits(source[start:end], shifted))
+}
+
+// pragmaEdit returns an edit inserting lines, which must end in a newline,
+// after the pragmas at the top of a file.
+func pragmaEdit(root *tree_sitter.Node, source []byte, lines string) analysis.TextEdit {
+	offset, row, lastRow := uint(0), uint(0), -1
+	for i := uint(0); i < root.NamedChildCount(); i++ {
+		child := root.NamedChild(i)
+		// The value of `#define name "..."` parses as a separate statement
+		// on the pragma's line.
+		sameLine := int(child.StartPosition().Row) == lastRow
+		if child.Kind() != "pragma" && !sameLine {
+			if child.IsExtra() {
+				continue
+			}
+			break
+		}
+		end := child.EndByte()
+		for int(end) < len(source) && source[end] != '\n' {
+			end++
+		}
+		offset = min(end+1, uint(len(source)))
+		lastRow = int(child.EndPosition().Row)
+		row = child.EndPosition().Row + 1
+	}
+	point := tree_sitter.Point{Row: row}
+	if offset == uint(len(source)) && len(source) > 0 && source[len(source)-1] != '\n' {
+		lines = "\n" + lines
+		point = endOfFile(source).StartPoint
+	}
+	return analysis.TextEdit{
+		Range:   tree_sitter.Range{StartByte: offset, EndByte: offset, StartPoint: point, EndPoint: point},
+		NewText: lines,
+	}
+}
+
+// endOfFile returns an empty range at the end of source.
+func endOfFile(source []byte) tree_sitter.Range {
+	point := tree_sitter.Point{}
+	for _, c := range source {
+		if c == '\n' {
+			point.Row++
+			point.Column = 0
+		} else {
+			point.Column++
+		}
+	}
+	end := uint(len(source))
+	return tree_sitter.Range{StartByte: end, EndByte: end, StartPoint: point, EndPoint: point}
+}
+
+// indentUnit returns the indentation used for one level in source: the
+// shortest leading whitespace of any indented line, or four spaces.
+func indentUnit(source []byte) string {
+	unit := ""
+	for _, line := range strings.Split(string(source), "\n") {
+		trimmed := strings.TrimLeft(line, " \t")
+		if trimmed == "" || len(trimmed) == len(line) {
+			continue
+		}
+		if indent := line[:len(line)-len(trimmed)]; unit == "" || len(indent) < len(unit) {
+			unit = indent
+		}
+	}
+	if unit == "" {
+		return "    "
+	}
+	return unit
+}
+
+// reindent strips the indentation common to text's lines and prefixes
+// each non-blank line with indent. The first line is assumed to start
+// after its indentation, as node text does.
+func reindent(text, firstIndent, indent string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if i > 0 {
+			line = strings.TrimPrefix(line, firstIndent)
+		}
+		if strings.TrimSpace(line) == "" {
+			lines[i] = ""
+			continue
+		}
+		lines[i] = indent + line
+	}
+	return strings.Join(lines, "\n")
+}
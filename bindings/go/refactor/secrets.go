@@ -0,0 +1,343 @@
+package refactor
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/analysis"
+)
+
+// SecretKind classifies sensitive data found in literals.
+type SecretKind string
+
+const (
+	SecretToken SecretKind = "token"
+	SecretEmail SecretKind = "email"
+	SecretPhone SecretKind = "phone number"
+)
+
+// A Secret is sensitive data found in a string literal.
+type Secret struct {
+	Kind SecretKind
+	// Reason explains why the text was flagged.
+	Reason string
+	// Range is the span of the secret in the source.
+	Range tree_sitter.Range
+	// Redacted is the secret with all but its first characters masked.
+	Redacted string
+	// Question is the name of the import question that replaces it.
+	Question string
+}
+
+// An Externalization is the result of ExternalizeSecrets.
+type Externalization struct {
+	Secrets []Secret
+	Edits   []analysis.TextEdit
+}
+
+// Report returns a human-readable summary of the secrets found.
+func (e *Externalization) Report() string {
+	if len(e.Secrets) == 0 {
+		return "No secrets found.\n"
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "Moved %d secret(s) into import questions:\n", len(e.Secrets))
+	for _, s := range e.Secrets {
+		fmt.Fprintf(&b, "  %d:%d  %-12s %-16s %s (%s)\n",
+			s.Range.StartPoint.Row+1, s.Range.StartPoint.Column+1, s.Kind, s.Question, s.Redacted, s.Reason)
+	}
+	return b.String()
+}
+
+var (
+	tokenPrefixes = []struct {
+		pattern *regexp.Regexp
+		reason  string
+	}{
+		{regexp.MustCompile(`\bsk-(?:proj-)?[A-Za-z0-9_-]{20,}`), "OpenAI-style API key"},
+		{regexp.MustCompile(`\b(?:sk|pk|rk)_(?:live|test)_[A-Za-z0-9]{16,}`), "Stripe API key"},
+		{regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{36,}`), "GitHub token"},
+		{regexp.MustCompile(`\bgithub_pat_[A-Za-z0-9_]{22,}`), "GitHub token"},
+		{regexp.MustCompile(`\bglpat-[A-Za-z0-9_-]{20,}`), "GitLab token"},
+		{regexp.MustCompile(`\bxox[abposr]-[A-Za-z0-9-]{10,}`), "Slack token"},
+		{regexp.MustCompile(`\bAKIA[0-9A-Z]{16}\b`), "AWS access key"},
+		{regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}`), "Google API key"},
+		{regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{10,}\.eyJ[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}`), "JSON web token"},
+	}
+	bearerPattern = regexp.MustCompile(`(?i)\b(?:bearer|basic|token)\s+([A-Za-z0-9._~+/=-]{16,})`)
+	emailPattern  = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+	phonePattern  = regexp.MustCompile(`\+?\(?[0-9][0-9 ().-]{7,}[0-9]`)
+	// secretKeys matches the words of dictionary keys and variable names
+	// whose string values are secret whatever they look like.
+	secretKeys = regexp.MustCompile(`\b(?:auth(?:orization| ?token)?|password|passwd|secret|token|api ?key|credentials?)\b`)
+)
+
+// ExternalizeSecrets finds likely secrets and personal data in string
+// literals, including dictionary values, and replaces each with a
+// reference to a new `#question` pragma that asks for it when the shortcut
+// is imported:
+//
+//	#question apiKey "Enter your API key" ""
+//
+// A literal that is entirely a secret is replaced with the question's
+// identifier; a secret inside a larger double-quoted string is replaced
+// with an interpolation. Repeated secrets share one question. Strings that
+// already contain interpolations are left alone.
+func ExternalizeSecrets(tree *tree_sitter.Tree, source []byte) *Externalization {
+	root := tree.RootNode()
+	names := analysis.NamesInUse(tree, source)
+	questions := map[string]string{}
+	var pragmas strings.Builder
+	ext := &Externalization{}
+
+	analysis.Walk(root, func(node *tree_sitter.Node) bool {
+		if node.Kind() != "string" && node.Kind() != "single_quoted_string" {
+			return true
+		}
+		if parent := node.Parent(); parent.Kind() == "pragma" || isPragmaValue(node, source) || isDictionaryKeyNode(node) {
+			return false
+		}
+		decoded, ok := analysis.DecodeString(node, source)
+		if !ok || decoded.Value == "" {
+			return false
+		}
+		context, isKey := literalContext(node, source)
+		for _, f := range findSecrets(decoded.Value, context) {
+			whole := f.start == 0 && f.end == len(decoded.Value)
+			// Raw strings cannot interpolate, so only whole literals are
+			// replaced there.
+			if !whole && node.Kind() == "single_quoted_string" {
+				continue
+			}
+			value := decoded.Value[f.start:f.end]
+			name, seen := questions[value]
+			if !seen {
+				nameHint := context
+				if !isKey && !isSecretKey(context) {
+					// Naming the question after the variable would only
+					// shadow it.
+					nameHint = ""
+				}
+				name = analysis.UniqueName(questionName(f.kind, nameHint), names)
+				names[name] = true
+				questions[value] = name
+				fmt.Fprintf(&pragmas, "#question %s %q \"\"\n", name, questionPrompt(f.kind, context))
+			}
+			var r tree_sitter.Range
+			var text string
+			if whole {
+				r = node.Range()
+				text = name
+			} else {
+				start, end := decoded.Offsets[f.start], decoded.Offsets[f.end]
+				r = tree_sitter.Range{
+					StartByte: start, EndByte: end,
+					StartPoint: analysis.PointAt(node, source, start),
+					EndPoint:   analysis.PointAt(node, source, end),
+				}
+				text = "{" + name + "}"
+			}
+			ext.Edits = append(ext.Edits, analysis.TextEdit{Range: r, NewText: text})
+			ext.Secrets = append(ext.Secrets, Secret{
+				Kind: f.kind, Reason: f.reason, Range: r,
+				Redacted: redact(value), Question: name,
+			})
+		}
+		return false
+	})
+	if pragmas.Len() > 0 {
+		ext.Edits = append(ext.Edits, pragmaEdit(root, source, pragmas.String()))
+	}
+	return ext
+}
+
+// isSecretKey reports whether a dictionary key or variable name names a
+// secret. The name is split into words at case changes, underscores and
+// punctuation first, so apiKey and X-Auth-Token match but author does not.
+func isSecretKey(name string) bool {
+	return secretKeys.MatchString(strings.ToLower(strings.Join(analysis.Words(name), " ")))
+}
+
+type secretMatch struct {
+	kind       SecretKind
+	reason     string
+	start, end int
+}
+
+// findSecrets returns the non-overlapping secrets in value. context is the
+// dictionary key or variable name the literal is assigned to, if any.
+func findSecrets(value, context string) []secretMatch {
+	if context != "" && isSecretKey(context) && strings.TrimSpace(value) != "" {
+		return []secretMatch{{SecretToken, fmt.Sprintf("value of %q", context), 0, len(value)}}
+	}
+	var found []secretMatch
+	overlaps := func(start, end int) bool {
+		for _, f := range found {
+			if start < f.end && end > f.start {
+				return true
+			}
+		}
+		return false
+	}
+	add := func(kind SecretKind, reason string, start, end int) {
+		if !overlaps(start, end) {
+			found = append(found, secretMatch{kind, reason, start, end})
+		}
+	}
+	for _, p := range tokenPrefixes {
+		for _, m := range p.pattern.FindAllStringIndex(value, -1) {
+			add(SecretToken, p.reason, m[0], m[1])
+		}
+	}
+	for _, m := range bearerPattern.FindAllStringSubmatchIndex(value, -1) {
+		add(SecretToken, "authorization header credentials", m[2], m[3])
+	}
+	for _, m := range emailPattern.FindAllStringIndex(value, -1) {
+		add(SecretEmail, "email address", m[0], m[1])
+	}
+	for _, m := range phonePattern.FindAllStringIndex(value, -1) {
+		digits := len(strings.Map(keepDigits, value[m[0]:m[1]]))
+		if digits >= 9 && digits <= 15 {
+			add(SecretPhone, "phone number", m[0], m[1])
+		}
+	}
+	for _, word := range tokenCandidates(value) {
+		if entropy(value[word[0]:word[1]]) >= 3.5 {
+			add(SecretToken, "high-entropy string", word[0], word[1])
+		}
+	}
+	sort.Slice(found, func(i, j int) bool { return found[i].start < found[j].start })
+	return found
+}
+
+func keepDigits(r rune) rune {
+	if r >= '0' && r <= '9' {
+		return r
+	}
+	return -1
+}
+
+// tokenCandidates returns the spans of whitespace-separated words at least
+// 20 bytes long that mix letters and digits, as random tokens do.
+func tokenCandidates(value string) [][2]int {
+	var spans [][2]int
+	start := -1
+	flush := func(end int) {
+		if start >= 0 && end-start >= 20 {
+			word := value[start:end]
+			if strings.IndexFunc(word, unicode.IsLetter) >= 0 && strings.IndexFunc(word, unicode.IsDigit) >= 0 &&
+				!strings.Contains(word, "://") {
+				spans = append(spans, [2]int{start, end})
+			}
+		}
+		start = -1
+	}
+	for i, r := range value {
+		if unicode.IsSpace(r) || r == '"' || r == '\'' {
+			flush(i)
+		} else if start < 0 {
+			start = i
+		}
+	}
+	flush(len(value))
+	return spans
+}
+
+// entropy returns the Shannon entropy of s in bits per byte.
+func entropy(s string) float64 {
+	var counts [256]int
+	for i := 0; i < len(s); i++ {
+		counts[s[i]]++
+	}
+	h := 0.0
+	for _, c := range counts {
+		if c > 0 {
+			p := float64(c) / float64(len(s))
+			h -= p * math.Log2(p)
+		}
+	}
+	return h
+}
+
+// literalContext returns the dictionary key or variable name a literal is
+// the value of, and whether it is a dictionary key.
+func literalContext(node *tree_sitter.Node, source []byte) (string, bool) {
+	parent := node.Parent()
+	switch parent.Kind() {
+	case "dictionary_pair":
+		if key := parent.ChildByFieldName("key"); key != nil {
+			if key.Kind() == "identifier" {
+				return key.Utf8Text(source), true
+			}
+			k, _ := analysis.StringValue(key, source)
+			return k, true
+		}
+	case "variable_assignment", "constant_assignment", "identifier_assignment":
+		return strings.TrimPrefix(parent.ChildByFieldName("name").Utf8Text(source), "@"), false
+	}
+	return "", false
+}
+
+// isPragmaValue reports whether node is the value of a pragma such as
+// `#define name "..."`, which parses as a separate statement on the same
+// line.
+func isPragmaValue(node *tree_sitter.Node, source []byte) bool {
+	prev := node.PrevNamedSibling()
+	for prev != nil && prev.StartPosition().Row == node.StartPosition().Row {
+		if prev.Kind() == "pragma" {
+			return true
+		}
+		prev = prev.PrevNamedSibling()
+	}
+	return false
+}
+
+func isDictionaryKeyNode(node *tree_sitter.Node) bool {
+	parent := node.Parent()
+	key := parent.ChildByFieldName("key")
+	return parent.Kind() == "dictionary_pair" && key != nil && key.Id() == node.Id()
+}
+
+// questionName derives an identifier for the import question from the
+// name of the key or variable holding the secret, if any.
+func questionName(kind SecretKind, context string) string {
+	if name := analysis.Identifier(context); name != "" {
+		if analysis.IsKeyword(name) {
+			// A keyword would not parse as a reference to the question.
+			name += "Value"
+		}
+		return name
+	}
+	switch kind {
+	case SecretEmail:
+		return "email"
+	case SecretPhone:
+		return "phoneNumber"
+	}
+	return "apiKey"
+}
+
+func questionPrompt(kind SecretKind, context string) string {
+	if context != "" && isSecretKey(context) {
+		return fmt.Sprintf("Enter a value for %s", context)
+	}
+	switch kind {
+	case SecretEmail:
+		return "Enter your email address"
+	case SecretPhone:
+		return "Enter your phone number"
+	}
+	return "Enter your API key"
+}
+
+// redact masks all but the first few characters of a secret.
+func redact(s string) string {
+	keep := min(4, len(s)/4)
+	return s[:keep] + strings.Repeat("*", min(len(s)-keep, 8))
+}
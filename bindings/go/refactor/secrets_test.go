@@ -0,0 +1,103 @@
+package refactor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/analysis"
+)
+
+func TestExternalizeSecrets(t *testing.T) {
+	source := []byte(`#define name "Weather"
+@headers = {"Authorization": "Bearer abc", "Accept": "application/json"}
+@key = "sk-proj-4fJ9kQ2xLmN8pR7tV3wY6zA1"
+@again = "sk-proj-4fJ9kQ2xLmN8pR7tV3wY6zA1"
+@body = {"contact": "Mail ada@example.com or call +1 (555) 123-4567"}
+@raw = '7Hq2Lp9Xk4Zr8Vn3Bw6Jt1Yc5'
+@plain = "Hello, world"
+@author = "Ada Lovelace"
+`)
+	tree := analysis.Parse(source)
+	defer tree.Close()
+
+	ext := ExternalizeSecrets(tree, source)
+	var kinds []string
+	for _, s := range ext.Secrets {
+		kinds = append(kinds, string(s.Kind)+":"+s.Question)
+	}
+	want := []string{
+		"token:authorization",
+		"token:apiKey",
+		"token:apiKey",
+		"email:contact",
+		"phone number:contact2",
+		"token:apiKey2",
+	}
+	if strings.Join(kinds, ",") != strings.Join(want, ",") {
+		t.Errorf("secrets = %v, want %v", kinds, want)
+	}
+
+	got := string(analysis.ApplyEdits(source, ext.Edits))
+	wantSource := `#define name "Weather"
+#question authorization "Enter a value for Authorization" ""
+#question apiKey "Enter your API key" ""
+#question contact "Enter your email address" ""
+#question contact2 "Enter your phone number" ""
+#question apiKey2 "Enter your API key" ""
+@headers = {"Authorization": authorization, "Accept": "application/json"}
+@key = apiKey
+@again = apiKey
+@body = {"contact": "Mail {contact} or call {contact2}"}
+@raw = apiKey2
+@plain = "Hello, world"
+@author = "Ada Lovelace"
+`
+	if got != wantSource {
+		t.Errorf("externalized source:\n%s\nwant:\n%s", got, wantSource)
+	}
+	if tree := analysis.Parse([]byte(got)); tree.RootNode().HasError() {
+		t.Errorf("externalized source does not parse: %s", tree.RootNode().ToSexp())
+	}
+	if report := ext.Report(); !strings.Contains(report, "6 secret(s)") || strings.Contains(report, "4fJ9kQ2x") {
+		t.Errorf("report leaks secrets or miscounts:\n%s", report)
+	}
+}
+
+func TestExternalizeSecretsKeywordName(t *testing.T) {
+	source := []byte(`@mail = {"from": "bob@example.com", "X-API-Key": "sk-proj-4fJ9kQ2xLmN8pR7tV3wY6zA1"}
+`)
+	tree := analysis.Parse(source)
+	defer tree.Close()
+
+	got := string(analysis.ApplyEdits(source, ExternalizeSecrets(tree, source).Edits))
+	want := `#question fromValue "Enter your email address" ""
+#question xAPIKey "Enter a value for X-API-Key" ""
+@mail = {"from": fromValue, "X-API-Key": xAPIKey}
+`
+	if got != want {
+		t.Errorf("externalized source:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestIsSecretKey(t *testing.T) {
+	for name, want := range map[string]bool{
+		"password":         true,
+		"apiKey":           true,
+		"api_key":          true,
+		"APIKey":           true,
+		"X-Auth-Token":     true,
+		"Authorization":    true,
+		"authToken":        true,
+		"clientSecret":     true,
+		"credentials":      true,
+		"author":           false,
+		"authority":        false,
+		"oauthCallbackURL": false,
+		"tokenizer":        false,
+		"secretary":        false,
+	} {
+		if got := isSecretKey(name); got != want {
+			t.Errorf("isSecretKey(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
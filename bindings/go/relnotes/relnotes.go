@@ -0,0 +1,428 @@
+// Package relnotes summarizes the user-visible changes to shortcuts
+// between two versions as Markdown release notes.
+package relnotes
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"path"
+	"sort"
+	"strings"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/analysis"
+)
+
+// permissions maps actions, and builtin keywords, to the permission a
+// shortcut asks for when it uses them.
+var permissions = map[string]string{
+	"downloadURL":        "Network access",
+	"getWebPageContents": "Network access",
+	"getCurrentLocation": "Location",
+	"getclipboard":       "Clipboard",
+	"setClipboard":       "Clipboard",
+	"notification":       "Notifications",
+	"speak":              "Speech",
+	"runShortcut":        "Running other shortcuts",
+}
+
+// A MenuItem is an item of a menu.
+type MenuItem struct {
+	// Menu is the title of the menu, preceded by the titles of the menus
+	// and items it is nested in, separated by " › ".
+	Menu  string
+	Title string
+	// Body is the text of the item's body with whitespace collapsed, used
+	// to recognize renamed items.
+	Body string
+}
+
+// A Snapshot is the user-visible surface of a shortcut.
+type Snapshot struct {
+	// Defines maps `#define` keys to their values.
+	Defines   map[string]string
+	MenuItems []MenuItem
+	// Permissions maps the permissions the shortcut uses to the sorted
+	// actions that need them.
+	Permissions map[string][]string
+	// Questions maps the names of `#question` import questions to their
+	// prompts.
+	Questions map[string]string
+}
+
+// Extract returns the user-visible surface of a shortcut.
+func Extract(source []byte) *Snapshot {
+	tree := analysis.Parse(source)
+	defer tree.Close()
+	root := tree.RootNode()
+	s := &Snapshot{Defines: map[string]string{}, Permissions: map[string][]string{}, Questions: map[string]string{}}
+
+	for i := uint(0); i < root.NamedChildCount(); i++ {
+		pragma := root.NamedChild(i)
+		if pragma.Kind() != "pragma" {
+			continue
+		}
+		key := pragma.ChildByFieldName("value").Utf8Text(source)
+		rest := analysis.PragmaArguments(pragma)
+		switch pragma.NamedChild(0).Utf8Text(source) {
+		case "#define":
+			value := ""
+			if len(rest) == 1 {
+				value, _ = analysis.StringValue(rest[0], source)
+			}
+			if value == "" && len(rest) > 0 {
+				value = string(source[rest[0].StartByte():rest[len(rest)-1].EndByte()])
+			}
+			s.Defines[key] = value
+		case "#question":
+			if len(rest) > 0 {
+				s.Questions[key], _ = analysis.StringValue(rest[0], source)
+			} else {
+				s.Questions[key] = ""
+			}
+		}
+	}
+
+	used := map[string]map[string]bool{}
+	analysis.Walk(root, func(n *tree_sitter.Node) bool {
+		name := ""
+		switch n.Kind() {
+		case "call":
+			name = analysis.CallName(n, source)
+		case "builtin_keyword":
+			name = n.Utf8Text(source)
+		case "item_statement":
+			if menu := n.Parent().Parent(); menu != nil && menu.Kind() == "menu_statement" {
+				s.MenuItems = append(s.MenuItems, MenuItem{
+					Menu:  menuPath(menu, source),
+					Title: text(n.ChildByFieldName("title"), source),
+					Body:  strings.Join(strings.Fields(n.ChildByFieldName("body").Utf8Text(source)), " "),
+				})
+			}
+		}
+		if permission, ok := permissions[name]; ok {
+			if used[permission] == nil {
+				used[permission] = map[string]bool{}
+			}
+			used[permission][name] = true
+		}
+		return true
+	})
+	for permission, names := range used {
+		s.Permissions[permission] = sortedKeys(names)
+	}
+	return s
+}
+
+// menuPath returns the title of a menu preceded by those of the menus and
+// items enclosing it.
+func menuPath(menu *tree_sitter.Node, source []byte) string {
+	var parts []string
+	for n := menu; n != nil; n = n.Parent() {
+		if n.Kind() == "menu_statement" || n.Kind() == "item_statement" {
+			parts = append([]string{text(n.ChildByFieldName("title"), source)}, parts...)
+		}
+	}
+	return strings.Join(parts, " › ")
+}
+
+// text returns the value of a string node, or the source text of any
+// other node.
+func text(node *tree_sitter.Node, source []byte) string {
+	if node == nil {
+		return ""
+	}
+	if value, ok := analysis.StringValue(node, source); ok {
+		return value
+	}
+	return node.Utf8Text(source)
+}
+
+// A Rename is a menu item whose title changed.
+type Rename struct {
+	Menu     string
+	Old, New string
+}
+
+// A DefineChange is a changed `#define`. Old is empty for added keys and
+// New for removed ones.
+type DefineChange struct {
+	Key      string
+	Old, New string
+}
+
+// FileNotes are the user-visible changes to one file.
+type FileNotes struct {
+	Path string
+	// Added and Removed are set when the whole file was added or
+	// removed.
+	Added, Removed bool
+
+	AddedItems, RemovedItems []MenuItem
+	RenamedItems             []Rename
+	Defines                  []DefineChange
+	// NewPermissions maps permissions not used before to the actions
+	// that now need them.
+	NewPermissions map[string][]string
+	// NewQuestions and RemovedQuestions map question names to prompts.
+	NewQuestions, RemovedQuestions map[string]string
+}
+
+func (f *FileNotes) empty() bool {
+	return !f.Added && !f.Removed && len(f.AddedItems) == 0 && len(f.RemovedItems) == 0 &&
+		len(f.RenamedItems) == 0 && len(f.Defines) == 0 && len(f.NewPermissions) == 0 &&
+		len(f.NewQuestions) == 0 && len(f.RemovedQuestions) == 0
+}
+
+// Notes are release notes for a set of shortcuts.
+type Notes struct {
+	Files []*FileNotes
+}
+
+// Compare returns the user-visible changes between two versions of a set
+// of files, keyed by path. Files without user-visible changes are left
+// out.
+func Compare(oldFiles, newFiles map[string][]byte) *Notes {
+	paths := map[string]bool{}
+	for p := range oldFiles {
+		paths[p] = true
+	}
+	for p := range newFiles {
+		paths[p] = true
+	}
+	notes := &Notes{}
+	for _, p := range sortedKeys(paths) {
+		oldSource, inOld := oldFiles[p]
+		newSource, inNew := newFiles[p]
+		if inOld && inNew && bytes.Equal(oldSource, newSource) {
+			continue
+		}
+		before, after := &Snapshot{}, &Snapshot{}
+		if inOld {
+			before = Extract(oldSource)
+		}
+		if inNew {
+			after = Extract(newSource)
+		}
+		f := diff(before, after)
+		f.Path, f.Added, f.Removed = p, !inOld, !inNew
+		if !f.empty() {
+			notes.Files = append(notes.Files, f)
+		}
+	}
+	return notes
+}
+
+func diff(before, after *Snapshot) *FileNotes {
+	f := &FileNotes{NewPermissions: map[string][]string{}, NewQuestions: map[string]string{}, RemovedQuestions: map[string]string{}}
+
+	key := func(item MenuItem) string { return item.Menu + "\x00" + item.Title }
+	oldItems, newItems := map[string]bool{}, map[string]bool{}
+	for _, item := range before.MenuItems {
+		oldItems[key(item)] = true
+	}
+	for _, item := range after.MenuItems {
+		newItems[key(item)] = true
+	}
+	var added, removed []MenuItem
+	for _, item := range after.MenuItems {
+		if !oldItems[key(item)] {
+			added = append(added, item)
+		}
+	}
+	for _, item := range before.MenuItems {
+		if !newItems[key(item)] {
+			removed = append(removed, item)
+		}
+	}
+	// An item removed and another added with the same body in the same
+	// menu was renamed.
+	renamed := map[int]bool{}
+	for _, r := range removed {
+		match := -1
+		for i, a := range added {
+			if !renamed[i] && a.Menu == r.Menu && a.Body == r.Body {
+				match = i
+				break
+			}
+		}
+		if match < 0 {
+			f.RemovedItems = append(f.RemovedItems, r)
+			continue
+		}
+		renamed[match] = true
+		f.RenamedItems = append(f.RenamedItems, Rename{Menu: r.Menu, Old: r.Title, New: added[match].Title})
+	}
+	for i, a := range added {
+		if !renamed[i] {
+			f.AddedItems = append(f.AddedItems, a)
+		}
+	}
+
+	keys := map[string]bool{}
+	for k := range before.Defines {
+		keys[k] = true
+	}
+	for k := range after.Defines {
+		keys[k] = true
+	}
+	for _, k := range sortedKeys(keys) {
+		if before.Defines[k] != after.Defines[k] {
+			f.Defines = append(f.Defines, DefineChange{Key: k, Old: before.Defines[k], New: after.Defines[k]})
+		}
+	}
+
+	for permission, names := range after.Permissions {
+		if _, ok := before.Permissions[permission]; !ok {
+			f.NewPermissions[permission] = names
+		}
+	}
+	for name, prompt := range after.Questions {
+		if _, ok := before.Questions[name]; !ok {
+			f.NewQuestions[name] = prompt
+		}
+	}
+	for name, prompt := range before.Questions {
+		if _, ok := after.Questions[name]; !ok {
+			f.RemovedQuestions[name] = prompt
+		}
+	}
+	return f
+}
+
+// Markdown renders the notes with a section per file.
+func (n *Notes) Markdown() string {
+	if len(n.Files) == 0 {
+		return "No user-visible changes.\n"
+	}
+	var b strings.Builder
+	for i, f := range n.Files {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "## %s\n", f.Path)
+		switch {
+		case f.Added:
+			b.WriteString("\nNew shortcut.\n")
+		case f.Removed:
+			b.WriteString("\nRemoved.\n")
+			continue
+		}
+
+		var lines []string
+		for _, item := range f.AddedItems {
+			lines = append(lines, fmt.Sprintf("Added **%s**%s", item.Title, inMenu(item.Menu)))
+		}
+		for _, r := range f.RenamedItems {
+			lines = append(lines, fmt.Sprintf("Renamed **%s** to **%s**%s", r.Old, r.New, inMenu(r.Menu)))
+		}
+		for _, item := range f.RemovedItems {
+			lines = append(lines, fmt.Sprintf("Removed **%s**%s", item.Title, inMenu(item.Menu)))
+		}
+		section(&b, "Menu items", lines)
+
+		lines = nil
+		for _, d := range f.Defines {
+			switch {
+			case d.Old == "":
+				lines = append(lines, fmt.Sprintf("`%s` set to %s", d.Key, code(d.New)))
+			case d.New == "":
+				lines = append(lines, fmt.Sprintf("`%s` removed (was %s)", d.Key, code(d.Old)))
+			default:
+				lines = append(lines, fmt.Sprintf("`%s` changed from %s to %s", d.Key, code(d.Old), code(d.New)))
+			}
+		}
+		section(&b, "Metadata", lines)
+
+		lines = nil
+		for _, permission := range sortedKeys(f.NewPermissions) {
+			lines = append(lines, fmt.Sprintf("%s (used by %s)", permission, "`"+strings.Join(f.NewPermissions[permission], "`, `")+"`"))
+		}
+		section(&b, "New permissions", lines)
+
+		lines = nil
+		for _, name := range sortedKeys(f.NewQuestions) {
+			lines = append(lines, fmt.Sprintf("New question `%s`: %s", name, f.NewQuestions[name]))
+		}
+		for _, name := range sortedKeys(f.RemovedQuestions) {
+			lines = append(lines, fmt.Sprintf("Removed question `%s`", name))
+		}
+		section(&b, "Import questions", lines)
+	}
+	return b.String()
+}
+
+func section(b *strings.Builder, title string, lines []string) {
+	if len(lines) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "\n### %s\n\n", title)
+	for _, line := range lines {
+		fmt.Fprintf(b, "- %s\n", line)
+	}
+}
+
+func inMenu(menu string) string {
+	if menu == "" {
+		return ""
+	}
+	return fmt.Sprintf(" in %s", menu)
+}
+
+func code(s string) string {
+	return "`" + s + "`"
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// FromGit compares the .cherri files of two revisions of the git
+// repository at dir, reading them from its object database.
+func FromGit(dir, oldRev, newRev string) (*Notes, error) {
+	oldFiles, err := gitFiles(dir, oldRev)
+	if err != nil {
+		return nil, err
+	}
+	newFiles, err := gitFiles(dir, newRev)
+	if err != nil {
+		return nil, err
+	}
+	return Compare(oldFiles, newFiles), nil
+}
+
+// gitFiles returns the .cherri files of a revision, keyed by path.
+func gitFiles(dir, rev string) (map[string][]byte, error) {
+	list, err := git(dir, "ls-tree", "-r", "-z", "--name-only", rev)
+	if err != nil {
+		return nil, err
+	}
+	files := map[string][]byte{}
+	for _, name := range strings.Split(string(list), "\x00") {
+		if path.Ext(name) != ".cherri" {
+			continue
+		}
+		if files[name], err = git(dir, "cat-file", "blob", rev+":"+name); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+func git(dir string, args ...string) ([]byte, error) {
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git %s: %v: %s", strings.Join(args, " "), err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
@@ -0,0 +1,117 @@
+package relnotes
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+const before = `#define name "Toolbox"
+#define color red
+#question apiKey "Enter your key" ""
+menu "Main" {
+    item "Share": alert("share")
+    item "Old Tools": {
+        menu "Tools" {
+            item "Hash": alert("hash")
+        }
+    }
+    item "Legacy": alert("legacy")
+}
+`
+
+const after = `#define name "Toolbox Pro"
+#define glyph gear
+#question apiKey "Enter your key" ""
+#question city "Which city?" "Paris"
+menu "Main" {
+    item "Share…": alert("share")
+    item "Old Tools": {
+        menu "Tools" {
+            item "Hash": alert("hash")
+            item "Weather": downloadURL("https://example.com/{city}")
+        }
+    }
+    item "Location": getCurrentLocation()
+}
+`
+
+const wantMarkdown = `## main.cherri
+
+### Menu items
+
+- Added **Weather** in Main › Old Tools › Tools
+- Added **Location** in Main
+- Renamed **Share** to **Share…** in Main
+- Removed **Legacy** in Main
+
+### Metadata
+
+- ` + "`color` removed (was `red`)" + `
+- ` + "`glyph` set to `gear`" + `
+- ` + "`name` changed from `Toolbox` to `Toolbox Pro`" + `
+
+### New permissions
+
+- Location (used by ` + "`getCurrentLocation`" + `)
+- Network access (used by ` + "`downloadURL`" + `)
+
+### Import questions
+
+- New question ` + "`city`" + `: Which city?
+
+## old.cherri
+
+Removed.
+`
+
+func TestCompare(t *testing.T) {
+	notes := Compare(
+		map[string][]byte{"main.cherri": []byte(before), "old.cherri": []byte(`alert("x")`), "same.cherri": []byte(`alert("x")`)},
+		map[string][]byte{"main.cherri": []byte(after), "same.cherri": []byte("alert(\"x\")\n// note\n")},
+	)
+	if got := notes.Markdown(); got != wantMarkdown {
+		t.Errorf("markdown:\n%s\nwant:\n%s", got, wantMarkdown)
+	}
+	if got := Compare(nil, nil).Markdown(); got != "No user-visible changes.\n" {
+		t.Errorf("empty notes = %q", got)
+	}
+}
+
+func TestFromGit(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	dir := t.TempDir()
+	run := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", dir, "-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	commit := func(source, tag string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, "main.cherri"), []byte(source), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		run("add", "-A")
+		run("commit", "-q", "-m", tag)
+		run("tag", tag)
+	}
+	run("init", "-q")
+	commit(before, "v1")
+	commit(after, "v2")
+
+	notes, err := FromGit(dir, "v1", "v2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(notes.Files) != 1 || len(notes.Files[0].RenamedItems) != 1 {
+		t.Errorf("notes:\n%s", notes.Markdown())
+	}
+	if _, err := FromGit(dir, "v1", "missing"); err == nil {
+		t.Error("FromGit accepted an unknown revision")
+	}
+}
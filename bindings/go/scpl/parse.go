@@ -0,0 +1,623 @@
+package scpl
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// A Position is a 1-based line and column in ScPL source. Columns count
+// runes.
+type Position struct {
+	Line, Column int
+}
+
+func (p Position) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// A SyntaxError is ScPL source that does not parse.
+type SyntaxError struct {
+	Pos     Position
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	return e.Pos.String() + ": " + e.Message
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	// tokEnd ends a statement: a newline or a semicolon.
+	tokEnd
+	tokIdent
+	tokString
+	tokNumber
+	tokVariable
+	tokPunct
+)
+
+type token struct {
+	kind tokenKind
+	pos  Position
+	// text is the identifier, number or punctuation, or the name of a
+	// variable.
+	text string
+	// prefix is the kind of a variable: "v", "mv" or "s".
+	prefix string
+	// aggrandized marks a variable followed by {…} options.
+	aggrandized bool
+	// parts are the pieces of a string.
+	parts []stringPart
+}
+
+// A stringPart is literal text or the tokens of an interpolation \(…).
+type stringPart struct {
+	text   string
+	tokens []token
+}
+
+// variablePrefixes are the prefixes of variable references.
+var variablePrefixes = map[string]bool{"v": true, "mv": true, "s": true}
+
+type lexer struct {
+	src  string
+	off  int
+	line int
+	col  int
+}
+
+func (l *lexer) pos() Position {
+	return Position{l.line, l.col}
+}
+
+func (l *lexer) peek() rune {
+	r, _ := utf8.DecodeRuneInString(l.src[l.off:])
+	return r
+}
+
+func (l *lexer) next() rune {
+	r, size := utf8.DecodeRuneInString(l.src[l.off:])
+	l.off += size
+	if r == '\n' {
+		l.line++
+		l.col = 1
+	} else {
+		l.col++
+	}
+	return r
+}
+
+func (l *lexer) errorf(pos Position, format string, args ...any) error {
+	return &SyntaxError{Pos: pos, Message: fmt.Sprintf(format, args...)}
+}
+
+// lex splits ScPL source into tokens.
+func lex(source string) ([]token, error) {
+	l := &lexer{src: source, line: 1, col: 1}
+	tokens, err := l.tokens(false)
+	if err != nil {
+		return nil, err
+	}
+	return append(tokens, token{kind: tokEOF, pos: l.pos()}), nil
+}
+
+// tokens lexes up to the end of the source or, in an interpolation, up to
+// the parenthesis closing it.
+func (l *lexer) tokens(interpolation bool) ([]token, error) {
+	var out []token
+	depth := 0
+	for l.off < len(l.src) {
+		pos := l.pos()
+		r := l.peek()
+		switch {
+		case r == '\n' || r == ';':
+			l.next()
+			out = append(out, token{kind: tokEnd, pos: pos})
+		case unicode.IsSpace(r):
+			l.next()
+		case r == '#' || strings.HasPrefix(l.src[l.off:], "--") || strings.HasPrefix(l.src[l.off:], "//"):
+			for l.off < len(l.src) && l.peek() != '\n' {
+				l.next()
+			}
+		case r == '"' || r == '\'':
+			t, err := l.string()
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, t)
+		case unicode.IsDigit(r) || r == '-' && l.off+1 < len(l.src) && isDigit(l.src[l.off+1]):
+			start := l.off
+			l.next()
+			for l.off < len(l.src) && (isDigit(l.src[l.off]) || l.src[l.off] == '.') {
+				l.next()
+			}
+			out = append(out, token{kind: tokNumber, pos: pos, text: l.src[start:l.off]})
+		case unicode.IsLetter(r) || r == '_':
+			t, err := l.word()
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, t)
+		case strings.HasPrefix(l.src[l.off:], "->"):
+			l.next()
+			l.next()
+			out = append(out, token{kind: tokPunct, pos: pos, text: "->"})
+		case strings.ContainsRune("()[]{},:=", r):
+			l.next()
+			if interpolation {
+				if r == '(' {
+					depth++
+				} else if r == ')' {
+					if depth == 0 {
+						return out, nil
+					}
+					depth--
+				}
+			}
+			out = append(out, token{kind: tokPunct, pos: pos, text: string(r)})
+		default:
+			return nil, l.errorf(pos, "unexpected %q", r)
+		}
+	}
+	if interpolation {
+		return nil, l.errorf(l.pos(), "unterminated interpolation")
+	}
+	return out, nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.'
+}
+
+// word lexes an identifier or a variable reference such as v:name,
+// mv:"Get Clipboard" or s:repeatitem{as: text}.
+func (l *lexer) word() (token, error) {
+	pos := l.pos()
+	start := l.off
+	for l.off < len(l.src) && isWordRune(l.peek()) {
+		l.next()
+	}
+	word := l.src[start:l.off]
+	if !variablePrefixes[strings.ToLower(word)] || l.peek() != ':' {
+		return token{kind: tokIdent, pos: pos, text: word}, nil
+	}
+	l.next()
+	t := token{kind: tokVariable, pos: pos, prefix: strings.ToLower(word)}
+	switch r := l.peek(); {
+	case r == '"' || r == '\'':
+		s, err := l.string()
+		if err != nil {
+			return token{}, err
+		}
+		for _, part := range s.parts {
+			if part.tokens != nil {
+				return token{}, l.errorf(pos, "variable names cannot be interpolated")
+			}
+			t.text += part.text
+		}
+	case isWordRune(r):
+		start := l.off
+		for l.off < len(l.src) && isWordRune(l.peek()) {
+			l.next()
+		}
+		t.text = l.src[start:l.off]
+	default:
+		return token{}, l.errorf(pos, "missing variable name after %s:", word)
+	}
+	if l.peek() == '{' {
+		t.aggrandized = true
+		depth := 0
+		for done := false; !done; {
+			if l.off >= len(l.src) {
+				return token{}, l.errorf(pos, "unterminated variable options")
+			}
+			switch l.next() {
+			case '{':
+				depth++
+			case '}':
+				depth--
+				done = depth == 0
+			}
+		}
+	}
+	return t, nil
+}
+
+// string lexes a quoted string, with \(…) interpolations in double-quoted
+// strings.
+func (l *lexer) string() (token, error) {
+	pos := l.pos()
+	quote := l.next()
+	t := token{kind: tokString, pos: pos}
+	var text strings.Builder
+	for {
+		if l.off >= len(l.src) {
+			return token{}, l.errorf(pos, "unterminated string")
+		}
+		r := l.next()
+		switch {
+		case r == quote:
+			if text.Len() > 0 || len(t.parts) == 0 {
+				t.parts = append(t.parts, stringPart{text: text.String()})
+			}
+			return t, nil
+		case r == '\\' && l.off < len(l.src):
+			switch e := l.next(); e {
+			case 'n':
+				text.WriteByte('\n')
+			case 't':
+				text.WriteByte('\t')
+			case '(':
+				if quote != '"' {
+					text.WriteString(`\(`)
+					continue
+				}
+				ipos := l.pos()
+				tokens, err := l.tokens(true)
+				if err != nil {
+					return token{}, err
+				}
+				if len(tokens) == 0 {
+					return token{}, l.errorf(ipos, "empty interpolation")
+				}
+				if text.Len() > 0 {
+					t.parts = append(t.parts, stringPart{text: text.String()})
+					text.Reset()
+				}
+				t.parts = append(t.parts, stringPart{tokens: append(tokens, token{kind: tokEOF, pos: l.pos()})})
+			default:
+				text.WriteRune(e)
+			}
+		default:
+			text.WriteRune(r)
+		}
+	}
+}
+
+// The syntax tree of a ScPL file.
+type (
+	// A statement is an action, possibly one that opens or closes a
+	// block such as if, otherwise and end, or an assignment.
+	statement struct {
+		pos  Position
+		name string
+		args []*argument
+		// output is the variable after ->.
+		output *variable
+		// assign is the variable of an assignment v:name = value, whose
+		// value is args[0].
+		assign *variable
+	}
+
+	// An argument is a positional or, with a key, a named argument.
+	argument struct {
+		pos   Position
+		key   string
+		value expression
+	}
+
+	expression interface {
+		position() Position
+	}
+
+	stringValue struct {
+		pos   Position
+		parts []stringPart
+		// exprs holds the parsed interpolations, by index in parts.
+		exprs map[int]expression
+	}
+
+	numberValue struct {
+		pos  Position
+		text string
+	}
+
+	// identValue is a bare word, such as an enumeration value or true.
+	identValue struct {
+		pos  Position
+		name string
+	}
+
+	variable struct {
+		pos         Position
+		prefix      string
+		name        string
+		aggrandized bool
+	}
+
+	listValue struct {
+		pos   Position
+		items []expression
+	}
+
+	dictValue struct {
+		pos    Position
+		keys   []expression
+		values []expression
+	}
+
+	// actionValue is an inline action, (name args) or \(name args).
+	actionValue struct {
+		*statement
+	}
+)
+
+func (e *stringValue) position() Position { return e.pos }
+func (e *numberValue) position() Position { return e.pos }
+func (e *identValue) position() Position  { return e.pos }
+func (e *variable) position() Position    { return e.pos }
+func (e *listValue) position() Position   { return e.pos }
+func (e *dictValue) position() Position   { return e.pos }
+func (e *actionValue) position() Position { return e.pos }
+
+type parser struct {
+	tokens []token
+	i      int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.i]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.i]
+	if t.kind != tokEOF {
+		p.i++
+	}
+	return t
+}
+
+func (p *parser) punct(text string) bool {
+	t := p.peek()
+	return t.kind == tokPunct && t.text == text
+}
+
+func (p *parser) expect(text string) error {
+	if !p.punct(text) {
+		t := p.peek()
+		return &SyntaxError{Pos: t.pos, Message: fmt.Sprintf("expected %q, found %s", text, describe(t))}
+	}
+	p.next()
+	return nil
+}
+
+func describe(t token) string {
+	switch t.kind {
+	case tokEOF:
+		return "end of file"
+	case tokEnd:
+		return "end of line"
+	case tokString:
+		return "string"
+	case tokVariable:
+		return t.prefix + ":" + t.text
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// parse returns the statements of ScPL source.
+func parse(source string) ([]*statement, error) {
+	tokens, err := lex(source)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	var statements []*statement
+	for {
+		for p.peek().kind == tokEnd {
+			p.next()
+		}
+		if p.peek().kind == tokEOF {
+			return statements, nil
+		}
+		s, err := p.statement()
+		if err != nil {
+			return nil, err
+		}
+		if t := p.peek(); t.kind != tokEnd && t.kind != tokEOF {
+			return nil, &SyntaxError{Pos: t.pos, Message: "unexpected " + describe(t)}
+		}
+		statements = append(statements, s)
+	}
+}
+
+// statement parses an action with its arguments, or an assignment.
+func (p *parser) statement() (*statement, error) {
+	t := p.next()
+	if t.kind == tokVariable && p.punct("=") {
+		p.next()
+		value, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		return &statement{
+			pos:    t.pos,
+			name:   "setvariable",
+			assign: variableOf(t),
+			args:   []*argument{{pos: value.position(), value: value}},
+		}, nil
+	}
+	if t.kind != tokIdent {
+		return nil, &SyntaxError{Pos: t.pos, Message: "expected an action, found " + describe(t)}
+	}
+	s := &statement{pos: t.pos, name: t.text}
+	// Arguments are in parentheses if one directly follows the name.
+	parenthesized := p.punct("(") && p.peek().pos == Position{t.pos.Line, t.pos.Column + utf8.RuneCountInString(t.text)}
+	if parenthesized {
+		p.next()
+	}
+	for {
+		if parenthesized {
+			p.skipEnds()
+		}
+		next := p.peek()
+		if parenthesized && p.punct(")") {
+			p.next()
+			break
+		}
+		if !parenthesized && (next.kind == tokEnd || next.kind == tokEOF || p.punct("->") || p.punct(")")) {
+			break
+		}
+		if p.punct(",") {
+			p.next()
+			continue
+		}
+		arg, err := p.argument()
+		if err != nil {
+			return nil, err
+		}
+		s.args = append(s.args, arg)
+	}
+	if p.punct("->") {
+		p.next()
+		out := p.next()
+		if out.kind != tokVariable {
+			return nil, &SyntaxError{Pos: out.pos, Message: "expected a variable after ->, found " + describe(out)}
+		}
+		s.output = variableOf(out)
+	}
+	return s, nil
+}
+
+func variableOf(t token) *variable {
+	return &variable{pos: t.pos, prefix: t.prefix, name: t.text, aggrandized: t.aggrandized}
+}
+
+// argument parses a value or key=value.
+func (p *parser) argument() (*argument, error) {
+	t := p.peek()
+	if t.kind == tokIdent && p.tokens[p.i+1].kind == tokPunct && p.tokens[p.i+1].text == "=" {
+		p.next()
+		p.next()
+		value, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		return &argument{pos: t.pos, key: t.text, value: value}, nil
+	}
+	value, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	return &argument{pos: t.pos, value: value}, nil
+}
+
+// skipEnds skips line breaks inside brackets.
+func (p *parser) skipEnds() {
+	for p.peek().kind == tokEnd {
+		p.next()
+	}
+}
+
+func (p *parser) expression() (expression, error) {
+	t := p.next()
+	switch t.kind {
+	case tokString:
+		s := &stringValue{pos: t.pos, parts: t.parts, exprs: map[int]expression{}}
+		for i, part := range t.parts {
+			if part.tokens == nil {
+				continue
+			}
+			inner := &parser{tokens: part.tokens}
+			var e expression
+			var err error
+			if first := inner.peek(); first.kind == tokIdent {
+				// An action, unless it is a lone word.
+				var st *statement
+				if st, err = inner.statement(); err == nil {
+					e = &actionValue{st}
+				}
+			} else {
+				e, err = inner.expression()
+			}
+			if err != nil {
+				return nil, err
+			}
+			if rest := inner.peek(); rest.kind != tokEOF {
+				return nil, &SyntaxError{Pos: rest.pos, Message: "unexpected " + describe(rest) + " in interpolation"}
+			}
+			s.exprs[i] = e
+		}
+		return s, nil
+	case tokNumber:
+		return &numberValue{pos: t.pos, text: t.text}, nil
+	case tokIdent:
+		return &identValue{pos: t.pos, name: t.text}, nil
+	case tokVariable:
+		return variableOf(t), nil
+	case tokPunct:
+		switch t.text {
+		case "(":
+			p.skipEnds()
+			if p.peek().kind != tokIdent {
+				e, err := p.expression()
+				if err != nil {
+					return nil, err
+				}
+				p.skipEnds()
+				return e, p.expect(")")
+			}
+			s, err := p.statement()
+			if err != nil {
+				return nil, err
+			}
+			p.skipEnds()
+			return &actionValue{s}, p.expect(")")
+		case "[":
+			l := &listValue{pos: t.pos}
+			for {
+				p.skipEnds()
+				if p.punct("]") {
+					p.next()
+					return l, nil
+				}
+				if p.punct(",") {
+					p.next()
+					continue
+				}
+				e, err := p.expression()
+				if err != nil {
+					return nil, err
+				}
+				l.items = append(l.items, e)
+			}
+		case "{":
+			d := &dictValue{pos: t.pos}
+			for {
+				p.skipEnds()
+				if p.punct("}") {
+					p.next()
+					return d, nil
+				}
+				if p.punct(",") {
+					p.next()
+					continue
+				}
+				key, err := p.expression()
+				if err != nil {
+					return nil, err
+				}
+				p.skipEnds()
+				if err := p.expect(":"); err != nil {
+					return nil, err
+				}
+				p.skipEnds()
+				value, err := p.expression()
+				if err != nil {
+					return nil, err
+				}
+				d.keys = append(d.keys, key)
+				d.values = append(d.values, value)
+			}
+		}
+	}
+	return nil, &SyntaxError{Pos: t.pos, Message: "expected a value, found " + describe(t)}
+}
@@ -0,0 +1,79 @@
+package scpl
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	statements, err := parse(`-- a comment
+text "Hi \(v:name), \(getClipboard)" -> mv:Greeting; showResult
+showAlert(
+    title="Hey",
+    mv:"Get Clipboard"{as: text}
+)
+v:n = [1, -2.5, {a: b}]
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statements) != 4 {
+		t.Fatalf("parsed %d statements", len(statements))
+	}
+
+	text := statements[0]
+	if text.name != "text" || text.pos != (Position{2, 1}) || text.output == nil || text.output.name != "Greeting" {
+		t.Errorf("text statement = %+v", text)
+	}
+	s := text.args[0].value.(*stringValue)
+	if len(s.parts) != 4 || s.parts[0].text != "Hi " || s.parts[2].text != ", " {
+		t.Errorf("string parts = %+v", s.parts)
+	}
+	if v, ok := s.exprs[1].(*variable); !ok || v.prefix != "v" || v.name != "name" || v.pos != (Position{2, 12}) {
+		t.Errorf("first interpolation = %#v", s.exprs[1])
+	}
+	if a, ok := s.exprs[3].(*actionValue); !ok || a.name != "getClipboard" {
+		t.Errorf("second interpolation = %#v", s.exprs[3])
+	}
+
+	if show := statements[1]; show.name != "showResult" || len(show.args) != 0 || show.pos != (Position{2, 54}) {
+		t.Errorf("showResult statement = %+v", show)
+	}
+
+	alert := statements[2]
+	if len(alert.args) != 2 || alert.args[0].key != "title" {
+		t.Fatalf("showAlert arguments = %+v", alert.args)
+	}
+	if v := alert.args[1].value.(*variable); v.prefix != "mv" || v.name != "Get Clipboard" || !v.aggrandized {
+		t.Errorf("magic variable = %+v", v)
+	}
+
+	assign := statements[3]
+	if assign.assign == nil || assign.assign.name != "n" {
+		t.Fatalf("assignment = %+v", assign)
+	}
+	list := assign.args[0].value.(*listValue)
+	if len(list.items) != 3 || list.items[1].(*numberValue).text != "-2.5" {
+		t.Errorf("list = %+v", list.items)
+	}
+	if d := list.items[2].(*dictValue); d.keys[0].(*identValue).name != "a" || d.values[0].(*identValue).name != "b" {
+		t.Errorf("dictionary = %+v", d)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, tt := range []struct{ source, err string }{
+		{`text "open`, `1:6: unterminated string`},
+		{"showResult\n\"x\" 1", `2:1: expected an action, found string`},
+		{`text "\(v:x"`, `1:12: unterminated string`},
+		{`getClipboard -> 3`, `1:17: expected a variable after ->, found "3"`},
+		{`list [1, 2`, `1:11: expected a value, found end of file`},
+		{`dictionary {a b}`, `1:15: expected ":", found "b"`},
+		{`text v:`, `1:6: missing variable name after v:`},
+		{`text ~`, `1:6: unexpected '~'`},
+	} {
+		_, err := parse(tt.source)
+		if err == nil || err.Error() != tt.err {
+			t.Errorf("parse(%q) = %v, want %s", tt.source, err, tt.err)
+		}
+	}
+}
@@ -0,0 +1,874 @@
+// Package scpl converts ScPL, a text language for Shortcuts, to Cherri.
+//
+// It translates variables, if/otherwise, repeat, repeat with each, choose
+// from menu and the actions of the catalog. ScPL passes the output of each
+// action to the next implicitly; the converter passes it explicitly, as an
+// argument or through a variable. Constructs it cannot translate are left
+// in the output as comments and reported as problems.
+package scpl
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/analysis"
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/catalog"
+)
+
+// A Problem is a construct that could not be translated.
+type Problem struct {
+	Pos     Position
+	Message string
+}
+
+func (p Problem) String() string {
+	return p.Pos.String() + ": " + p.Message
+}
+
+// An action maps a ScPL action to an action of the catalog.
+type action struct {
+	// name is the Cherri name of the action.
+	name string
+	// params lists the Cherri parameters in the order of ScPL arguments.
+	params []string
+	// input is the parameter that takes the output of the previous action
+	// when it is not given.
+	input string
+	// aliases maps normalized ScPL argument names to parameters.
+	aliases map[string]string
+}
+
+// actions maps normalized ScPL action names to Cherri actions whose names
+// or arguments differ. Other actions are found in the catalog by name, and
+// take their arguments in catalog order.
+var actions = map[string]action{
+	"showalert":              {name: "alert", params: []string{"title", "alert", "cancelButton"}, aliases: map[string]string{"message": "alert", "showcancelbutton": "cancelButton"}},
+	"showresult":             {name: "show", params: []string{"input"}, input: "input", aliases: map[string]string{"text": "input"}},
+	"shownotification":       {name: "notification", params: []string{"title", "body", "playSound"}, aliases: map[string]string{"playsound": "playSound"}},
+	"askforinput":            {name: "prompt", params: []string{"prompt", "inputType", "defaultAnswer"}, aliases: map[string]string{"question": "prompt", "type": "inputType", "defaultanswer": "defaultAnswer"}},
+	"getcontentsofurl":       {name: "downloadURL", params: []string{"url", "method", "body", "headers"}, input: "url", aliases: map[string]string{"requestbody": "body"}},
+	"openurls":               {name: "openURL", params: []string{"url"}, input: "url"},
+	"vibratedevice":          {name: "vibrate"},
+	"speaktext":              {name: "speak", params: []string{"text"}, input: "text"},
+	"copytoclipboard":        {name: "setClipboard", params: []string{"value"}, input: "value"},
+	"matchtext":              {name: "matchText", params: []string{"regex", "caseSensitive"}, input: "text", aliases: map[string]string{"pattern": "regex", "regularexpression": "regex"}},
+	"replacetext":            {name: "replaceText", params: []string{"find", "replacement"}, input: "subject", aliases: map[string]string{"findtext": "find", "replacewith": "replacement"}},
+	"changecase":             {name: "changeCase", params: []string{"case"}, input: "text"},
+	"getdictionaryvalue":     {name: "getValue", params: []string{"key"}, input: "dictionary"},
+	"setdictionaryvalue":     {name: "setValue", params: []string{"key", "value"}, input: "dictionary"},
+	"getdictionaryfrominput": {name: "getDictionary", input: "input"},
+	"runshortcut":            {name: "runShortcut", params: []string{"name"}, input: "input", aliases: map[string]string{"shortcut": "name"}},
+	"formatdate":             {name: "formatDate", params: []string{"format"}, input: "date", aliases: map[string]string{"formatstring": "format"}},
+	"formatnumber":           {name: "formatNumber", params: []string{"format"}, input: "number"},
+	"encodemedia":            {name: "base64Encode", input: "input"},
+	"generatehash":           {name: "hash", params: []string{"type"}, input: "input"},
+	"getname":                {name: "getName", input: "item"},
+	"getrandomnumber":        {name: "randomNumber", params: []string{"min", "max"}, aliases: map[string]string{"minimum": "min", "maximum": "max"}},
+	"getcontentsofwebpage":   {name: "getWebPageContents", params: []string{"url"}, input: "url"},
+	"stopandoutput":          {name: "output", params: []string{"output"}, input: "output"},
+	"count":                  {name: "count", input: "input"},
+	"delay":                  {name: "wait", params: []string{"seconds"}},
+}
+
+// specials maps normalized ScPL special variables to Cherri expressions.
+var specials = map[string]string{
+	"shortcutinput":  "ShortcutInput",
+	"extensioninput": "ShortcutInput",
+	"input":          "ShortcutInput",
+	"clipboard":      "getclipboard",
+	"currentdate":    "CurrentDate",
+	"askwhenrun":     "Ask",
+	"ask":            "Ask",
+	"repeatitem":     "RepeatItem",
+	"repeatindex":    "RepeatIndex",
+	"device":         "Device",
+	"devicedetails":  "Device",
+}
+
+// conditions maps normalized ScPL conditions to Cherri operators.
+var conditions = map[string]string{
+	"equals":                 "==",
+	"is":                     "==",
+	"isnot":                  "!=",
+	"doesnotequal":           "!=",
+	"isgreaterthan":          ">",
+	"greaterthan":            ">",
+	"islessthan":             "<",
+	"lessthan":               "<",
+	"isgreaterthanorequalto": ">=",
+	"islessthanorequalto":    "<=",
+	"hasanyvalue":            "",
+}
+
+// Convert translates ScPL source to Cherri. It returns an error if the
+// source is not valid ScPL, and the problems of constructs that could not
+// be translated otherwise. The output is parsed to check that it has no
+// syntax errors.
+func Convert(source []byte) ([]byte, []Problem, error) {
+	statements, err := parse(string(source))
+	if err != nil {
+		return nil, nil, err
+	}
+	c := &converter{vars: map[string]string{}, taken: map[string]bool{}}
+	c.block(statements, 0)
+	c.flush()
+	// Problems at the end of the file have no line to precede.
+	c.line("")
+
+	out := []byte(c.out.String())
+	tree := analysis.Parse(out)
+	defer tree.Close()
+	if root := tree.RootNode(); root.HasError() {
+		return nil, nil, fmt.Errorf("generated Cherri has syntax errors: %s", root.ToSexp())
+	}
+	return out, c.problems, nil
+}
+
+type converter struct {
+	out      strings.Builder
+	indent   int
+	problems []Problem
+	// notes holds the comments of problems until the next line of the
+	// statement they are in.
+	notes []string
+	// vars maps ScPL variables to Cherri variables, and taken holds the
+	// names of Cherri variables.
+	vars  map[string]string
+	taken map[string]bool
+	// prev is the output of the previous action as an expression, or ""
+	// if there is none. A pending prev is a call that has not been
+	// written yet, so that it can be passed directly to the action using
+	// it; prevName names a variable holding it.
+	prev     string
+	pending  bool
+	prevName string
+	// prevNotes holds the comments of the problems of a pending call.
+	prevNotes []string
+}
+
+// line writes a line of the current statement, after the comments of its
+// problems.
+func (c *converter) line(text string) {
+	for _, note := range c.notes {
+		c.write(note)
+	}
+	c.notes = nil
+	if text != "" {
+		c.write(text)
+	}
+}
+
+func (c *converter) write(text string) {
+	c.out.WriteString(strings.Repeat("    ", c.indent) + text + "\n")
+}
+
+func (c *converter) problem(pos Position, format string, args ...any) {
+	p := Problem{Pos: pos, Message: fmt.Sprintf(format, args...)}
+	c.problems = append(c.problems, p)
+	c.notes = append(c.notes, "// scpl "+p.String())
+}
+
+// take returns the output of the previous action, consuming it, or "" if
+// there is none.
+func (c *converter) take() string {
+	prev := c.prev
+	if c.pending {
+		c.notes = append(c.prevNotes, c.notes...)
+	}
+	c.prev, c.pending, c.prevNotes = "", false, nil
+	return prev
+}
+
+// flush writes a pending call that was not used. It belongs to the
+// previous statement, so it comes before the problems of the current one.
+func (c *converter) flush() {
+	if c.pending {
+		c.writePending(c.prev)
+	}
+	c.prev, c.pending = "", false
+}
+
+// materialize stores a pending call in a variable, so that actions
+// written before the action using it run after it.
+func (c *converter) materialize() {
+	if c.pending {
+		name := "@" + c.newVar(c.prevName)
+		c.writePending(name + " = " + c.prev)
+		c.prev, c.pending = name, false
+	}
+}
+
+func (c *converter) writePending(text string) {
+	for _, note := range c.prevNotes {
+		c.write(note)
+	}
+	c.prevNotes = nil
+	c.write(text)
+}
+
+// hoist writes an assignment of expr to a new variable and returns the
+// variable.
+func (c *converter) hoist(base, expr string) string {
+	c.materialize()
+	name := "@" + c.newVar(base)
+	c.line(name + " = " + expr)
+	return name
+}
+
+// setPrev makes expr the output of the last action.
+func (c *converter) setPrev(expr, name string, pending bool) {
+	c.prev, c.prevName, c.pending = expr, name, pending
+	if pending {
+		c.prevNotes, c.notes = c.notes, nil
+	}
+}
+
+func (c *converter) newVar(base string) string {
+	base = analysis.Identifier(base)
+	if base == "" {
+		base = "value"
+	}
+	name := analysis.UniqueName(base, c.taken)
+	c.taken[name] = true
+	return name
+}
+
+// block converts statements from i up to one of the statements named in
+// ends, returning the index after it and it, or nil at the end of the
+// statements.
+func (c *converter) block(statements []*statement, i int, ends ...string) (int, *statement) {
+	for i < len(statements) {
+		s := statements[i]
+		name := normalize(s.name)
+		for _, end := range ends {
+			if name == end {
+				return i + 1, s
+			}
+		}
+		i++
+		switch name {
+		case "if":
+			i = c.ifStatement(s, statements, i)
+		case "repeat":
+			i = c.repeat(s, statements, i)
+		case "repeatwitheach", "repeateach", "foreach":
+			i = c.repeatWithEach(s, statements, i)
+		case "choosefrommenu", "menu":
+			i = c.menu(s, statements, i)
+		case "otherwise", "else", "end", "case":
+			c.flush()
+			c.problem(s.pos, "unexpected %s", s.name)
+		default:
+			c.statement(s)
+		}
+	}
+	return i, nil
+}
+
+// body converts the statements of a block, indented, up to one of ends.
+func (c *converter) body(open *statement, statements []*statement, i int, ends ...string) (int, *statement) {
+	c.indent++
+	i, end := c.block(statements, i, ends...)
+	c.flush()
+	c.indent--
+	if end == nil {
+		c.problem(open.pos, "%s has no end", open.name)
+	}
+	return i, end
+}
+
+func (c *converter) ifStatement(s *statement, statements []*statement, i int) int {
+	c.line("if " + c.condition(s) + " {")
+	i, end := c.body(s, statements, i, "otherwise", "else", "end")
+	if end != nil && normalize(end.name) != "end" {
+		c.line("} else {")
+		i, _ = c.body(s, statements, i, "end")
+	}
+	c.line("}")
+	return i
+}
+
+// condition returns the condition of an if, which compares its input, the
+// output of the previous action unless given, with a value.
+func (c *converter) condition(s *statement) string {
+	var input, cond, value expression
+	var positional []expression
+	for _, arg := range s.args {
+		switch normalize(arg.key) {
+		case "":
+			positional = append(positional, arg.value)
+		case "input":
+			input = arg.value
+		case "condition":
+			cond = arg.value
+		case "value":
+			value = arg.value
+		default:
+			c.problem(arg.pos, "unknown argument %s to if", arg.key)
+		}
+	}
+	// A leading variable is the input when a condition or nothing follows
+	// it; on its own it checks that the variable has any value.
+	if input == nil && len(positional) > 0 {
+		if v, ok := positional[0].(*variable); ok && (len(positional) == 1 || isCondition(positional[1])) {
+			input, positional = v, positional[1:]
+		}
+	}
+	if cond == nil && len(positional) > 0 {
+		cond, positional = positional[0], positional[1:]
+	}
+	if value == nil && len(positional) > 0 {
+		value, positional = positional[0], positional[1:]
+	}
+	for _, extra := range positional {
+		c.problem(extra.position(), "unexpected argument to if")
+	}
+
+	op := ""
+	if cond != nil {
+		word, isWord := literal(cond)
+		var known bool
+		op, known = conditions[normalize(word)]
+		switch {
+		case !isWord || word == "":
+			c.problem(cond.position(), "the condition of if is not a word; the translation only checks for a value")
+		case !known:
+			c.problem(cond.position(), "condition %s has no Cherri equivalent; the translation only checks for a value", word)
+		}
+	}
+	rendered := ""
+	if op != "" {
+		if value == nil {
+			c.problem(s.pos, "missing value to compare with")
+			rendered = "nil"
+		} else {
+			rendered = c.value(value, "")
+		}
+	}
+	var in string
+	if input != nil {
+		in = c.value(input, "")
+	} else if in = c.take(); in == "" {
+		c.problem(s.pos, "if has no input")
+		in = "nil"
+	}
+	c.flush()
+	if op == "" {
+		return in
+	}
+	return in + " " + op + " " + rendered
+}
+
+func isCondition(e expression) bool {
+	word, ok := literal(e)
+	_, known := conditions[normalize(word)]
+	return ok && known
+}
+
+func (c *converter) repeat(s *statement, statements []*statement, i int) int {
+	named, positional := c.arguments(s)
+	count := named["count"]
+	if count == nil && len(positional) > 0 {
+		count = positional[0]
+	}
+	rendered := "1"
+	if count == nil {
+		c.problem(s.pos, "repeat has no count")
+	} else {
+		rendered = c.value(count, catalog.Number)
+	}
+	c.flush()
+	c.line("repeat " + rendered + " {")
+	i, _ = c.body(s, statements, i, "end")
+	c.line("}")
+	return i
+}
+
+func (c *converter) repeatWithEach(s *statement, statements []*statement, i int) int {
+	named, positional := c.arguments(s)
+	list := named["list"]
+	if list == nil && len(positional) > 0 {
+		list = positional[0]
+	}
+	var rendered string
+	if list != nil {
+		rendered = c.value(list, "")
+	} else if rendered = c.take(); rendered == "" {
+		c.problem(s.pos, "repeat with each has no list")
+		rendered = "nil"
+	}
+	c.flush()
+	// The body refers to the item as RepeatItem.
+	c.line("for each in " + rendered + " {")
+	i, _ = c.body(s, statements, i, "end")
+	c.line("}")
+	return i
+}
+
+func (c *converter) menu(s *statement, statements []*statement, i int) int {
+	named, positional := c.arguments(s)
+	prompt, items := named["prompt"], named["items"]
+	for _, arg := range positional {
+		if _, ok := arg.(*listValue); ok && items == nil {
+			items = arg
+		} else if prompt == nil {
+			prompt = arg
+		}
+	}
+	var labels []expression
+	if list, ok := items.(*listValue); ok {
+		labels = list.items
+	} else if items != nil {
+		c.problem(items.position(), "menu items must be a list")
+	}
+	title := `""`
+	if prompt != nil {
+		title = c.value(prompt, catalog.Text)
+	}
+	c.flush()
+	c.line("menu " + title + " {")
+	c.indent++
+	// Statements before the first case belong to no item.
+	i, end := c.block(statements, i, "case", "end")
+	for n := 0; end != nil && normalize(end.name) == "case"; n++ {
+		_, args := c.arguments(end)
+		var label expression
+		switch {
+		case len(args) > 0:
+			label = args[0]
+		case n < len(labels):
+			label = labels[n]
+		}
+		rendered := `""`
+		if label == nil {
+			c.problem(end.pos, "case has no label")
+		} else {
+			rendered = c.value(label, catalog.Text)
+		}
+		c.line("item " + rendered + ": {")
+		i, end = c.body(end, statements, i, "case", "end")
+		c.line("}")
+	}
+	c.indent--
+	if end == nil {
+		c.problem(s.pos, "%s has no end", s.name)
+	}
+	c.line("}")
+	return i
+}
+
+// statement converts a statement other than control flow.
+func (c *converter) statement(s *statement) {
+	name := normalize(s.name)
+	switch name {
+	case "comment":
+		_, args := c.arguments(s)
+		for _, arg := range args {
+			text, _ := literal(arg)
+			for _, line := range strings.Split(text, "\n") {
+				c.line(strings.TrimSpace("// " + line))
+			}
+		}
+		return
+	case "setvariable":
+		c.setVariable(s)
+		return
+	case "exitshortcut", "exit", "stop", "stopshortcut":
+		c.flush()
+		c.line("stop")
+		return
+	case "nothing":
+		c.flush()
+		return
+	}
+	expr, pending := c.expression(s)
+	if expr == "" {
+		c.flush()
+		return
+	}
+	c.flush()
+	if s.output != nil {
+		expr = c.assign(s.output, expr)
+		pending = false
+	}
+	c.setPrev(expr, outputBase(s), pending)
+}
+
+// expression returns an action as an expression and whether it is a call
+// that has to be run, or "" if it cannot be translated.
+func (c *converter) expression(s *statement) (string, bool) {
+	name := normalize(s.name)
+	_, positional := c.arguments(s)
+	switch name {
+	case "text", "number", "url", "list", "dictionary", "getvariable":
+		if len(positional) == 1 {
+			return c.value(positional[0], ""), false
+		}
+		if name == "list" {
+			return c.value(&listValue{pos: s.pos, items: positional}, ""), false
+		}
+		c.problem(s.pos, "%s takes one argument", s.name)
+		return "", false
+	case "getclipboard":
+		return "getclipboard", false
+	}
+	return c.call(s)
+}
+
+// call returns an action of the catalog as a call.
+func (c *converter) call(s *statement) (string, bool) {
+	a, ok := lookup(normalize(s.name))
+	if !ok {
+		c.problem(s.pos, "unknown action %s", s.name)
+		return "", false
+	}
+	def, _ := catalog.Lookup(a.name)
+	types := map[string]catalog.ParamType{}
+	for _, p := range def.Parameters {
+		types[p.Name] = p.Type
+	}
+	values := map[string]string{}
+	positional := 0
+	for _, arg := range s.args {
+		param := ""
+		if arg.key != "" {
+			if param = a.param(arg.key); param == "" {
+				c.problem(arg.pos, "%s has no argument %s", s.name, arg.key)
+				continue
+			}
+		} else {
+			if positional >= len(a.params) {
+				c.problem(arg.pos, "too many arguments to %s", s.name)
+				continue
+			}
+			param = a.params[positional]
+			positional++
+		}
+		values[param] = c.argument(arg.value, types[param], def, param)
+	}
+	if a.input != "" && values[a.input] == "" {
+		values[a.input] = c.take()
+	}
+
+	var args []string
+	missing := 0
+	for _, p := range def.Parameters {
+		v, given := values[p.Name]
+		if !given || v == "" {
+			if !p.Optional {
+				c.problem(s.pos, "%s is missing %s", s.name, p.Name)
+				v = "nil"
+			} else {
+				missing++
+				args = append(args, placeholder(p.Type))
+				continue
+			}
+		}
+		missing = 0
+		args = append(args, spaced(v))
+	}
+	args = args[:len(args)-missing]
+	return a.name + "(" + strings.Join(args, ", ") + ")", true
+}
+
+// argument renders an argument for a parameter, checking enumeration
+// values.
+func (c *converter) argument(e expression, t catalog.ParamType, def *catalog.Action, param string) string {
+	if t != catalog.Enum {
+		return c.value(e, t)
+	}
+	word, ok := literal(e)
+	if !ok {
+		return c.value(e, t)
+	}
+	for _, p := range def.Parameters {
+		if p.Name != param {
+			continue
+		}
+		for _, v := range p.Enum {
+			if strings.EqualFold(v, word) || normalize(v) == normalize(word) {
+				return quote(v)
+			}
+		}
+	}
+	c.problem(e.position(), "%q is not a value of %s", word, param)
+	return quote(word)
+}
+
+// placeholder returns the value of an optional parameter that is skipped
+// to pass a later one.
+func placeholder(t catalog.ParamType) string {
+	switch t {
+	case catalog.Number:
+		return "0"
+	case catalog.Bool:
+		return "false"
+	case catalog.Dictionary:
+		return "{}"
+	case catalog.Text, catalog.Regex, catalog.DateFormat, catalog.NumberFormat:
+		return `""`
+	}
+	return "nil"
+}
+
+func (a action) param(key string) string {
+	key = normalize(key)
+	if p, ok := a.aliases[key]; ok {
+		return p
+	}
+	def, _ := catalog.Lookup(a.name)
+	for _, p := range def.Parameters {
+		if normalize(p.Name) == key || normalize(strings.TrimPrefix(p.Key, "WF")) == key {
+			return p.Name
+		}
+	}
+	return ""
+}
+
+// lookup returns the Cherri action for a normalized ScPL action name.
+func lookup(name string) (action, bool) {
+	if a, ok := actions[name]; ok {
+		return a, true
+	}
+	for _, def := range catalog.Actions() {
+		if normalize(def.Name) != name {
+			continue
+		}
+		a := action{name: def.Name}
+		for _, p := range def.Parameters {
+			a.params = append(a.params, p.Name)
+			if p.Key == "WFInput" && a.input == "" {
+				a.input = p.Name
+			}
+		}
+		return a, true
+	}
+	return action{}, false
+}
+
+// setVariable converts setVariable v:name, or v:name = value.
+func (c *converter) setVariable(s *statement) {
+	target := s.assign
+	_, args := c.arguments(s)
+	var value string
+	if target != nil {
+		value = c.value(args[0], "")
+	} else {
+		if len(args) > 0 {
+			target, _ = args[0].(*variable)
+		}
+		if target == nil || target.prefix == "s" {
+			c.problem(s.pos, "setVariable needs a variable")
+			c.flush()
+			return
+		}
+		if value = c.take(); value == "" {
+			c.problem(s.pos, "setVariable has no input")
+			value = "nil"
+		}
+	}
+	c.flush()
+	c.setPrev(c.assign(target, value), "", false)
+}
+
+// assign writes an assignment to a ScPL variable and returns the Cherri
+// variable.
+func (c *converter) assign(v *variable, value string) string {
+	if v.prefix == "s" {
+		c.problem(v.pos, "cannot assign to s:%s", v.name)
+		c.line(value)
+		return value
+	}
+	name := "@" + c.variableName(v)
+	c.line(name + " = " + value)
+	return name
+}
+
+func (c *converter) variableName(v *variable) string {
+	if name, ok := c.vars[v.name]; ok {
+		return name
+	}
+	name := c.newVar(v.name)
+	c.vars[v.name] = name
+	return name
+}
+
+// arguments splits the arguments of a statement into named ones, by
+// normalized name, and positional ones.
+func (c *converter) arguments(s *statement) (map[string]expression, []expression) {
+	named := map[string]expression{}
+	var positional []expression
+	for _, arg := range s.args {
+		if arg.key != "" {
+			named[normalize(arg.key)] = arg.value
+		} else {
+			positional = append(positional, arg.value)
+		}
+	}
+	return named, positional
+}
+
+// value renders an expression for a parameter of type t, or "" if unknown.
+func (c *converter) value(e expression, t catalog.ParamType) string {
+	switch e := e.(type) {
+	case *stringValue:
+		return c.stringValue(e)
+	case *numberValue:
+		return e.text
+	case *identValue:
+		switch word := strings.ToLower(e.name); {
+		case word == "true" || word == "false":
+			return word
+		case t == catalog.Number:
+			c.problem(e.pos, "expected a number, found %s", e.name)
+		}
+		return quote(e.name)
+	case *variable:
+		return c.variable(e)
+	case *listValue:
+		items := make([]string, len(e.items))
+		for i, item := range e.items {
+			items[i] = spaced(c.value(item, ""))
+		}
+		return "list(" + strings.Join(items, ", ") + ")"
+	case *dictValue:
+		pairs := make([]string, len(e.keys))
+		for i, key := range e.keys {
+			k, ok := literal(key)
+			if !ok {
+				c.problem(key.position(), "dictionary keys must be text")
+			}
+			pairs[i] = quote(k) + ": " + spaced(c.value(e.values[i], ""))
+		}
+		return "{" + strings.Join(pairs, ", ") + "}"
+	case *actionValue:
+		// The previous action's output is not passed to inline actions.
+		prev, pending, prevName := c.prev, c.pending, c.prevName
+		c.prev, c.pending = "", false
+		expr, _ := c.expression(e.statement)
+		c.prev, c.pending, c.prevName = prev, pending, prevName
+		if expr == "" {
+			return "nil"
+		}
+		if e.output != nil {
+			return c.assign(e.output, expr)
+		}
+		return expr
+	}
+	return "nil"
+}
+
+func (c *converter) variable(v *variable) string {
+	if v.aggrandized {
+		c.problem(v.pos, "variable options of %s:%s are not supported; the variable is used as is", v.prefix, v.name)
+	}
+	if v.prefix != "s" {
+		return "@" + c.variableName(v)
+	}
+	if expr, ok := specials[normalize(v.name)]; ok {
+		return expr
+	}
+	c.problem(v.pos, "unknown special variable s:%s", v.name)
+	return "nil"
+}
+
+// builtinConstants are the Cherri constants that can be interpolated.
+var builtinConstants = map[string]bool{
+	"ShortcutInput": true, "CurrentDate": true, "Ask": true,
+	"RepeatItem": true, "RepeatIndex": true, "Device": true,
+}
+
+// stringValue renders a string, storing interpolated values that Cherri
+// cannot interpolate in variables first.
+func (c *converter) stringValue(s *stringValue) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i, part := range s.parts {
+		e, ok := s.exprs[i]
+		if !ok {
+			b.WriteString(escape(part.text))
+			continue
+		}
+		if text, ok := literal(e); ok {
+			b.WriteString(escape(text))
+			continue
+		}
+		expr := c.value(e, "")
+		switch {
+		case strings.HasPrefix(expr, "@") || builtinConstants[expr]:
+		case expr == "getclipboard":
+			expr = c.hoist("clipboard", expr)
+		default:
+			base := "value"
+			if a, ok := e.(*actionValue); ok {
+				base = outputBase(a.statement)
+			}
+			expr = c.hoist(base, expr)
+		}
+		// Interpolations name variables without the @.
+		b.WriteString("{" + strings.TrimPrefix(expr, "@") + "}")
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// literal returns the text of a string without interpolations, a number
+// or a bare word.
+func literal(e expression) (string, bool) {
+	switch e := e.(type) {
+	case *stringValue:
+		if len(e.exprs) > 0 {
+			return "", false
+		}
+		var b strings.Builder
+		for _, part := range e.parts {
+			b.WriteString(part.text)
+		}
+		return b.String(), true
+	case *numberValue:
+		return e.text, true
+	case *identValue:
+		return e.name, true
+	}
+	return "", false
+}
+
+// outputBase returns the base name of a variable holding the output of an
+// action.
+func outputBase(s *statement) string {
+	if a, ok := lookup(normalize(s.name)); ok {
+		return a.name
+	}
+	return s.name
+}
+
+// spaced separates a variable from a following comma, parenthesis or
+// brace, which a variable name would otherwise take in.
+func spaced(expr string) string {
+	if strings.HasPrefix(expr, "@") {
+		return expr + " "
+	}
+	return expr
+}
+
+// normalize returns a ScPL name without case or separators, the way ScPL
+// compares them: "Show_Result" and "showresult" are the same action.
+func normalize(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// quote returns s as a double-quoted Cherri string.
+func quote(s string) string {
+	return `"` + escape(s) + `"`
+}
+
+func escape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, `{`, `\{`, "\n", `\n`, "\t", `\t`).Replace(s)
+}
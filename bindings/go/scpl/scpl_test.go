@@ -0,0 +1,213 @@
+package scpl
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tree-sitter/tree-sitter-cherri/bindings/go/catalog"
+)
+
+func TestConvert(t *testing.T) {
+	source := `text "Hello"
+setVariable v:greeting
+askForInput "What is your name?" -> mv:Name
+if mv:Name Equals "Ada"
+    showResult "\(v:greeting) \(mv:Name)!"
+otherwise
+    showAlert title="Who?" message="I don't know {you}"
+end
+repeat 3
+    vibrateDevice
+end
+getClipboard
+repeatWithEach
+    showResult s:repeatitem
+end
+chooseFromMenu items=["Upper", "Lower"]
+case
+    changeCase UPPERCASE text=s:clipboard
+case "Lower"
+    URL "https://example.com"
+    getContentsOfURL
+    setVariable v:page
+    count
+end
+getCurrentLocation
+matchText "[0-9]+" text=(getClipboard)
+if HasAnyValue
+    comment "found a number"
+end
+if v:greeting
+    comment "greeted"
+end
+text "a"
+if Equals "b"
+end
+v:total = [1, 2]
+showResult "Got \(getCurrentLocation)"
+exitShortcut
+`
+	want := `@greeting = "Hello"
+@name = prompt("What is your name?")
+if @name == "Ada" {
+    show("{greeting} {name}!")
+} else {
+    alert("I don't know \{you}", "Who?")
+}
+repeat 3 {
+    vibrate()
+}
+for each in getclipboard {
+    show(RepeatItem)
+}
+menu "" {
+    item "Upper": {
+        changeCase(getclipboard, "UPPERCASE")
+    }
+    item "Lower": {
+        @page = downloadURL("https://example.com")
+        count(@page )
+    }
+}
+getCurrentLocation()
+if matchText("[0-9]+", getclipboard) {
+    // found a number
+}
+if @greeting {
+    // greeted
+}
+if "a" == "b" {
+}
+@total = list(1, 2)
+@getCurrentLocation = getCurrentLocation()
+show("Got {getCurrentLocation}")
+stop
+`
+	got, problems, err := Convert([]byte(source))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != want {
+		t.Errorf("Convert =\n%s\nwant\n%s", got, want)
+	}
+	if len(problems) > 0 {
+		t.Errorf("problems: %v", problems)
+	}
+}
+
+func TestConvertProblems(t *testing.T) {
+	source := `frobnicate 1
+if Contains "x"
+end
+showResult v:x{as: number}
+nothing
+changeCase sideways
+showAlert title="Hi"
+otherwise
+repeat
+    showResult s:battery
+`
+	got, problems, err := Convert([]byte(source))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Problem{
+		{Position{1, 1}, "unknown action frobnicate"},
+		{Position{2, 4}, "condition Contains has no Cherri equivalent; the translation only checks for a value"},
+		{Position{2, 1}, "if has no input"},
+		{Position{4, 12}, "variable options of v:x are not supported; the variable is used as is"},
+		{Position{6, 12}, `"sideways" is not a value of case`},
+		{Position{6, 1}, "changeCase is missing text"},
+		{Position{7, 1}, "showAlert is missing alert"},
+		{Position{8, 1}, "unexpected otherwise"},
+		{Position{9, 1}, "repeat has no count"},
+		{Position{10, 16}, "unknown special variable s:battery"},
+		{Position{9, 1}, "repeat has no end"},
+	}
+	if !reflect.DeepEqual(problems, want) {
+		t.Errorf("problems:\n%v\nwant\n%v", problems, want)
+	}
+	// Problems are left in the output as comments before the statement
+	// they are in.
+	wantOut := `// scpl 1:1: unknown action frobnicate
+// scpl 2:4: condition Contains has no Cherri equivalent; the translation only checks for a value
+// scpl 2:1: if has no input
+if nil {
+}
+// scpl 4:12: variable options of v:x are not supported; the variable is used as is
+show(@x )
+// scpl 6:12: "sideways" is not a value of case
+// scpl 6:1: changeCase is missing text
+changeCase(nil, "sideways")
+// scpl 7:1: showAlert is missing alert
+alert(nil, "Hi")
+// scpl 8:1: unexpected otherwise
+// scpl 9:1: repeat has no count
+repeat 1 {
+    // scpl 10:16: unknown special variable s:battery
+    show(nil)
+// scpl 9:1: repeat has no end
+}
+`
+	if string(got) != wantOut {
+		t.Errorf("Convert =\n%s\nwant\n%s", got, wantOut)
+	}
+}
+
+func TestConvertConditionNotWord(t *testing.T) {
+	_, problems, err := Convert([]byte("getClipboard\nif v:x v:y\nend\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Problem{{Position{2, 4}, "the condition of if is not a word; the translation only checks for a value"}}
+	if !reflect.DeepEqual(problems, want) {
+		t.Errorf("problems = %v, want %v", problems, want)
+	}
+}
+
+func TestConvertVariableNames(t *testing.T) {
+	got, _, err := Convert([]byte("text \"a\"\nsetVariable v:\"2nd Try\"\nshowResult v:\"2nd Try\"\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// An identifier cannot start with a digit.
+	want := "@try = \"a\"\nshow(@try )\n"
+	if string(got) != want {
+		t.Errorf("Convert =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestConvertSyntaxError(t *testing.T) {
+	_, _, err := Convert([]byte("showResult \"unterminated\n"))
+	if _, ok := err.(*SyntaxError); !ok {
+		t.Errorf("Convert = %v, want a syntax error", err)
+	}
+}
+
+func TestActionsInCatalog(t *testing.T) {
+	for scpl, a := range actions {
+		def, ok := catalog.Lookup(a.name)
+		if !ok {
+			t.Errorf("%s: no action %s in the catalog", scpl, a.name)
+			continue
+		}
+		params := map[string]bool{}
+		for _, p := range def.Parameters {
+			params[p.Name] = true
+		}
+		names := append(append([]string{a.input}, a.params...), values(a.aliases)...)
+		for _, name := range names {
+			if name != "" && !params[name] {
+				t.Errorf("%s: %s has no parameter %s", scpl, a.name, name)
+			}
+		}
+	}
+}
+
+func values(m map[string]string) []string {
+	var out []string
+	for _, v := range m {
+		out = append(out, v)
+	}
+	return out
+}
@@ -1,5 +1,14 @@
 module github.com/tree-sitter/tree-sitter-cherri
 
-go 1.22
+go 1.23
 
-require github.com/tree-sitter/go-tree-sitter v0.24.0
+require (
+	github.com/tree-sitter/go-tree-sitter v0.25.0
+	golang.org/x/term v0.27.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	github.com/mattn/go-pointer v0.0.1 // indirect
+	golang.org/x/sys v0.28.0 // indirect
+)
@@ -43,6 +43,7 @@ module.exports = grammar({
         $.repeat_statement,
         $.menu_statement,
         $.item_statement,
+        $.action_definition, // action name(type arg): type { ... }
         $.block,
         $._expression,
       ),
@@ -165,6 +166,30 @@ module.exports = grammar({
         ),
       ),
 
+    // "action" is also a builtin keyword, so the definition needs a higher
+    // precedence than the keyword used as an expression.
+    action_definition: ($) =>
+      prec(
+        PREC.STATEMENT,
+        seq(
+          "action",
+          field("name", $.identifier),
+          field("parameters", $.parameters),
+          optional(
+            seq(":", field("return_type", choice($.type_keyword, $.identifier))),
+          ),
+          field("body", $.block),
+        ),
+      ),
+
+    parameters: ($) => seq("(", optional(commaSep($.parameter)), ")"),
+
+    parameter: ($) =>
+      seq(
+        field("type", choice($.type_keyword, $.identifier)),
+        field("name", $.identifier),
+      ),
+
     block: ($) => prec(1, seq("{", repeat($._statement), "}")),
 
     // Note: at_variable is now only allowed in expressions for references,
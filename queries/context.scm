@@ -0,0 +1,22 @@
+; Enclosing constructs shown in sticky-scroll and breadcrumb bars.
+
+(menu_statement
+  body: (_) @context.end) @context
+
+(item_statement
+  body: (_) @context.end) @context
+
+(if_statement
+  consequence: (_) @context.end) @context
+
+(if_statement
+  alternative: (_) @context.end) @context
+
+(for_statement
+  body: (_) @context.end) @context
+
+(repeat_statement
+  body: (_) @context.end) @context
+
+(action_definition
+  body: (_) @context.end) @context
@@ -7,16 +7,16 @@
 #endif
 
 #define LANGUAGE_VERSION 15
-#define STATE_COUNT 129
-#define LARGE_STATE_COUNT 47
-#define SYMBOL_COUNT 112
+#define STATE_COUNT 147
+#define LARGE_STATE_COUNT 48
+#define SYMBOL_COUNT 116
 #define ALIAS_COUNT 0
 #define TOKEN_COUNT 77
 #define EXTERNAL_TOKEN_COUNT 0
-#define FIELD_COUNT 14
-#define MAX_ALIAS_SEQUENCE_LENGTH 5
+#define FIELD_COUNT 16
+#define MAX_ALIAS_SEQUENCE_LENGTH 6
 #define MAX_RESERVED_WORD_SET_SIZE 0
-#define PRODUCTION_ID_COUNT 17
+#define PRODUCTION_ID_COUNT 20
 #define SUPERTYPE_COUNT 0
 
 enum ts_symbol_identifiers {
@@ -35,43 +35,43 @@ enum ts_symbol_identifiers {
   anon_sym_repeat = 13,
   anon_sym_menu = 14,
   anon_sym_item = 15,
-  anon_sym_LBRACE = 16,
-  anon_sym_RBRACE = 17,
+  anon_sym_action = 16,
+  anon_sym_LPAREN = 17,
   anon_sym_COMMA = 18,
-  anon_sym_true = 19,
-  anon_sym_false = 20,
-  anon_sym_name = 21,
-  anon_sym_glyph = 22,
-  anon_sym_from = 23,
-  anon_sym_mac = 24,
-  anon_sym_inputs = 25,
-  anon_sym_noinput = 26,
-  anon_sym_askfor = 27,
-  anon_sym_getclipboard = 28,
-  anon_sym_list = 29,
-  anon_sym_nil = 30,
-  anon_sym_action = 31,
-  anon_sym_stop = 32,
-  anon_sym_makeVCard = 33,
-  anon_sym_rawAction = 34,
-  anon_sym_embedFile = 35,
-  anon_sym_nothing = 36,
-  anon_sym_CurrentDate = 37,
-  anon_sym_Device = 38,
-  anon_sym_RepeatIndex = 39,
-  anon_sym_RepeatItem = 40,
-  anon_sym_ShortcutInput = 41,
-  anon_sym_Ask = 42,
-  anon_sym_text = 43,
-  anon_sym_number = 44,
-  anon_sym_bool = 45,
-  anon_sym_dictionary = 46,
-  anon_sym_array = 47,
-  anon_sym_variable = 48,
-  anon_sym_color = 49,
-  anon_sym_float = 50,
-  anon_sym_LPAREN = 51,
-  anon_sym_RPAREN = 52,
+  anon_sym_RPAREN = 19,
+  anon_sym_LBRACE = 20,
+  anon_sym_RBRACE = 21,
+  anon_sym_true = 22,
+  anon_sym_false = 23,
+  anon_sym_name = 24,
+  anon_sym_glyph = 25,
+  anon_sym_from = 26,
+  anon_sym_mac = 27,
+  anon_sym_inputs = 28,
+  anon_sym_noinput = 29,
+  anon_sym_askfor = 30,
+  anon_sym_getclipboard = 31,
+  anon_sym_list = 32,
+  anon_sym_nil = 33,
+  anon_sym_stop = 34,
+  anon_sym_makeVCard = 35,
+  anon_sym_rawAction = 36,
+  anon_sym_embedFile = 37,
+  anon_sym_nothing = 38,
+  anon_sym_CurrentDate = 39,
+  anon_sym_Device = 40,
+  anon_sym_RepeatIndex = 41,
+  anon_sym_RepeatItem = 42,
+  anon_sym_ShortcutInput = 43,
+  anon_sym_Ask = 44,
+  anon_sym_text = 45,
+  anon_sym_number = 46,
+  anon_sym_bool = 47,
+  anon_sym_dictionary = 48,
+  anon_sym_array = 49,
+  anon_sym_variable = 50,
+  anon_sym_color = 51,
+  anon_sym_float = 52,
   anon_sym_STAR = 53,
   anon_sym_SLASH = 54,
   anon_sym_PLUS = 55,
@@ -109,28 +109,32 @@ enum ts_symbol_identifiers {
   sym_repeat_statement = 87,
   sym_menu_statement = 88,
   sym_item_statement = 89,
-  sym_block = 90,
-  sym__expression = 91,
-  sym_dictionary = 92,
-  sym_dictionary_pair = 93,
-  sym_boolean = 94,
-  sym_builtin_keyword = 95,
-  sym_builtin_constant = 96,
-  sym_type_keyword = 97,
-  sym_parenthesized_expression = 98,
-  sym_binary_expression = 99,
-  sym_call = 100,
-  sym_string = 101,
-  sym_single_quoted_string = 102,
-  sym_interpolation = 103,
-  sym_comment = 104,
-  aux_sym_source_file_repeat1 = 105,
-  aux_sym_dictionary_repeat1 = 106,
-  aux_sym_call_repeat1 = 107,
-  aux_sym_string_repeat1 = 108,
-  aux_sym_single_quoted_string_repeat1 = 109,
-  aux_sym_interpolation_repeat1 = 110,
-  aux_sym_comment_repeat1 = 111,
+  sym_action_definition = 90,
+  sym_parameters = 91,
+  sym_parameter = 92,
+  sym_block = 93,
+  sym__expression = 94,
+  sym_dictionary = 95,
+  sym_dictionary_pair = 96,
+  sym_boolean = 97,
+  sym_builtin_keyword = 98,
+  sym_builtin_constant = 99,
+  sym_type_keyword = 100,
+  sym_parenthesized_expression = 101,
+  sym_binary_expression = 102,
+  sym_call = 103,
+  sym_string = 104,
+  sym_single_quoted_string = 105,
+  sym_interpolation = 106,
+  sym_comment = 107,
+  aux_sym_source_file_repeat1 = 108,
+  aux_sym_parameters_repeat1 = 109,
+  aux_sym_dictionary_repeat1 = 110,
+  aux_sym_call_repeat1 = 111,
+  aux_sym_string_repeat1 = 112,
+  aux_sym_single_quoted_string_repeat1 = 113,
+  aux_sym_interpolation_repeat1 = 114,
+  aux_sym_comment_repeat1 = 115,
 };
 
 static const char * const ts_symbol_names[] = {
@@ -150,9 +154,12 @@ static const char * const ts_symbol_names[] = {
   [anon_sym_repeat] = "repeat",
   [anon_sym_menu] = "menu",
   [anon_sym_item] = "item",
+  [anon_sym_action] = "action",
+  [anon_sym_LPAREN] = "(",
+  [anon_sym_COMMA] = ",",
+  [anon_sym_RPAREN] = ")",
   [anon_sym_LBRACE] = "{",
   [anon_sym_RBRACE] = "}",
-  [anon_sym_COMMA] = ",",
   [anon_sym_true] = "true",
   [anon_sym_false] = "false",
   [anon_sym_name] = "name",
@@ -165,7 +172,6 @@ static const char * const ts_symbol_names[] = {
   [anon_sym_getclipboard] = "getclipboard",
   [anon_sym_list] = "list",
   [anon_sym_nil] = "nil",
-  [anon_sym_action] = "action",
   [anon_sym_stop] = "stop",
   [anon_sym_makeVCard] = "makeVCard",
   [anon_sym_rawAction] = "rawAction",
@@ -185,8 +191,6 @@ static const char * const ts_symbol_names[] = {
   [anon_sym_variable] = "variable",
   [anon_sym_color] = "color",
   [anon_sym_float] = "float",
-  [anon_sym_LPAREN] = "(",
-  [anon_sym_RPAREN] = ")",
   [anon_sym_STAR] = "*",
   [anon_sym_SLASH] = "/",
   [anon_sym_PLUS] = "+",
@@ -224,6 +228,9 @@ static const char * const ts_symbol_names[] = {
   [sym_repeat_statement] = "repeat_statement",
   [sym_menu_statement] = "menu_statement",
   [sym_item_statement] = "item_statement",
+  [sym_action_definition] = "action_definition",
+  [sym_parameters] = "parameters",
+  [sym_parameter] = "parameter",
   [sym_block] = "block",
   [sym__expression] = "_expression",
   [sym_dictionary] = "dictionary",
@@ -240,6 +247,7 @@ static const char * const ts_symbol_names[] = {
   [sym_interpolation] = "interpolation",
   [sym_comment] = "comment",
   [aux_sym_source_file_repeat1] = "source_file_repeat1",
+  [aux_sym_parameters_repeat1] = "parameters_repeat1",
   [aux_sym_dictionary_repeat1] = "dictionary_repeat1",
   [aux_sym_call_repeat1] = "call_repeat1",
   [aux_sym_string_repeat1] = "string_repeat1",
@@ -265,9 +273,12 @@ static const TSSymbol ts_symbol_map[] = {
   [anon_sym_repeat] = anon_sym_repeat,
   [anon_sym_menu] = anon_sym_menu,
   [anon_sym_item] = anon_sym_item,
+  [anon_sym_action] = anon_sym_action,
+  [anon_sym_LPAREN] = anon_sym_LPAREN,
+  [anon_sym_COMMA] = anon_sym_COMMA,
+  [anon_sym_RPAREN] = anon_sym_RPAREN,
   [anon_sym_LBRACE] = anon_sym_LBRACE,
   [anon_sym_RBRACE] = anon_sym_RBRACE,
-  [anon_sym_COMMA] = anon_sym_COMMA,
   [anon_sym_true] = anon_sym_true,
   [anon_sym_false] = anon_sym_false,
   [anon_sym_name] = anon_sym_name,
@@ -280,7 +291,6 @@ static const TSSymbol ts_symbol_map[] = {
   [anon_sym_getclipboard] = anon_sym_getclipboard,
   [anon_sym_list] = anon_sym_list,
   [anon_sym_nil] = anon_sym_nil,
-  [anon_sym_action] = anon_sym_action,
   [anon_sym_stop] = anon_sym_stop,
   [anon_sym_makeVCard] = anon_sym_makeVCard,
   [anon_sym_rawAction] = anon_sym_rawAction,
@@ -300,8 +310,6 @@ static const TSSymbol ts_symbol_map[] = {
   [anon_sym_variable] = anon_sym_variable,
   [anon_sym_color] = anon_sym_color,
   [anon_sym_float] = anon_sym_float,
-  [anon_sym_LPAREN] = anon_sym_LPAREN,
-  [anon_sym_RPAREN] = anon_sym_RPAREN,
   [anon_sym_STAR] = anon_sym_STAR,
   [anon_sym_SLASH] = anon_sym_SLASH,
   [anon_sym_PLUS] = anon_sym_PLUS,
@@ -339,6 +347,9 @@ static const TSSymbol ts_symbol_map[] = {
   [sym_repeat_statement] = sym_repeat_statement,
   [sym_menu_statement] = sym_menu_statement,
   [sym_item_statement] = sym_item_statement,
+  [sym_action_definition] = sym_action_definition,
+  [sym_parameters] = sym_parameters,
+  [sym_parameter] = sym_parameter,
   [sym_block] = sym_block,
   [sym__expression] = sym__expression,
   [sym_dictionary] = sym_dictionary,
@@ -355,6 +366,7 @@ static const TSSymbol ts_symbol_map[] = {
   [sym_interpolation] = sym_interpolation,
   [sym_comment] = sym_comment,
   [aux_sym_source_file_repeat1] = aux_sym_source_file_repeat1,
+  [aux_sym_parameters_repeat1] = aux_sym_parameters_repeat1,
   [aux_sym_dictionary_repeat1] = aux_sym_dictionary_repeat1,
   [aux_sym_call_repeat1] = aux_sym_call_repeat1,
   [aux_sym_string_repeat1] = aux_sym_string_repeat1,
@@ -428,11 +440,11 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = true,
     .named = false,
   },
-  [anon_sym_LBRACE] = {
+  [anon_sym_action] = {
     .visible = true,
     .named = false,
   },
-  [anon_sym_RBRACE] = {
+  [anon_sym_LPAREN] = {
     .visible = true,
     .named = false,
   },
@@ -440,6 +452,18 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = true,
     .named = false,
   },
+  [anon_sym_RPAREN] = {
+    .visible = true,
+    .named = false,
+  },
+  [anon_sym_LBRACE] = {
+    .visible = true,
+    .named = false,
+  },
+  [anon_sym_RBRACE] = {
+    .visible = true,
+    .named = false,
+  },
   [anon_sym_true] = {
     .visible = true,
     .named = false,
@@ -488,10 +512,6 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = true,
     .named = false,
   },
-  [anon_sym_action] = {
-    .visible = true,
-    .named = false,
-  },
   [anon_sym_stop] = {
     .visible = true,
     .named = false,
@@ -568,14 +588,6 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = true,
     .named = false,
   },
-  [anon_sym_LPAREN] = {
-    .visible = true,
-    .named = false,
-  },
-  [anon_sym_RPAREN] = {
-    .visible = true,
-    .named = false,
-  },
   [anon_sym_STAR] = {
     .visible = true,
     .named = false,
@@ -724,6 +736,18 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = true,
     .named = true,
   },
+  [sym_action_definition] = {
+    .visible = true,
+    .named = true,
+  },
+  [sym_parameters] = {
+    .visible = true,
+    .named = true,
+  },
+  [sym_parameter] = {
+    .visible = true,
+    .named = true,
+  },
   [sym_block] = {
     .visible = true,
     .named = true,
@@ -788,6 +812,10 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = false,
     .named = false,
   },
+  [aux_sym_parameters_repeat1] = {
+    .visible = false,
+    .named = false,
+  },
   [aux_sym_dictionary_repeat1] = {
     .visible = false,
     .named = false,
@@ -825,10 +853,12 @@ enum ts_field_identifiers {
   field_iterable = 8,
   field_key = 9,
   field_name = 10,
-  field_title = 11,
-  field_type = 12,
-  field_value = 13,
-  field_variable = 14,
+  field_parameters = 11,
+  field_return_type = 12,
+  field_title = 13,
+  field_type = 14,
+  field_value = 15,
+  field_variable = 16,
 };
 
 static const char * const ts_field_names[] = {
@@ -843,6 +873,8 @@ static const char * const ts_field_names[] = {
   [field_iterable] = "iterable",
   [field_key] = "key",
   [field_name] = "name",
+  [field_parameters] = "parameters",
+  [field_return_type] = "return_type",
   [field_title] = "title",
   [field_type] = "type",
   [field_value] = "value",
@@ -860,12 +892,15 @@ static const TSMapSlice ts_field_map_slices[PRODUCTION_ID_COUNT] = {
   [8] = {.index = 12, .length = 1},
   [9] = {.index = 13, .length = 2},
   [10] = {.index = 15, .length = 2},
-  [11] = {.index = 17, .length = 2},
-  [12] = {.index = 19, .length = 2},
-  [13] = {.index = 21, .length = 3},
+  [11] = {.index = 17, .length = 3},
+  [12] = {.index = 20, .length = 2},
+  [13] = {.index = 22, .length = 2},
   [14] = {.index = 24, .length = 3},
   [15] = {.index = 27, .length = 3},
   [16] = {.index = 30, .length = 3},
+  [17] = {.index = 33, .length = 2},
+  [18] = {.index = 35, .length = 3},
+  [19] = {.index = 38, .length = 4},
 };
 
 static const TSFieldMapEntry ts_field_map_entries[] = {
@@ -897,27 +932,39 @@ static const TSFieldMapEntry ts_field_map_entries[] = {
     {field_body, 3},
     {field_title, 1},
   [17] =
+    {field_body, 3},
+    {field_name, 1},
+    {field_parameters, 2},
+  [20] =
     {field_key, 0},
     {field_value, 2},
-  [19] =
+  [22] =
     {field_arguments, 2},
     {field_function, 0},
-  [21] =
+  [24] =
     {field_alternative, 4},
     {field_condition, 1},
     {field_consequence, 2},
-  [24] =
+  [27] =
     {field_body, 4},
     {field_iterable, 3},
     {field_variable, 1},
-  [27] =
+  [30] =
     {field_body, 4},
     {field_count, 3},
     {field_variable, 1},
-  [30] =
+  [33] =
+    {field_name, 1},
+    {field_type, 0},
+  [35] =
     {field_arguments, 2},
     {field_arguments, 3},
     {field_function, 0},
+  [38] =
+    {field_body, 5},
+    {field_name, 1},
+    {field_parameters, 2},
+    {field_return_type, 4},
 };
 
 static const TSSymbol ts_alias_sequences[PRODUCTION_ID_COUNT][MAX_ALIAS_SEQUENCE_LENGTH] = {
@@ -937,7 +984,7 @@ static const TSStateId ts_primary_state_ids[STATE_COUNT] = {
   [5] = 5,
   [6] = 6,
   [7] = 7,
-  [8] = 7,
+  [8] = 6,
   [9] = 9,
   [10] = 10,
   [11] = 11,
@@ -974,8 +1021,8 @@ static const TSStateId ts_primary_state_ids[STATE_COUNT] = {
   [42] = 42,
   [43] = 43,
   [44] = 44,
-  [45] = 40,
-  [46] = 46,
+  [45] = 45,
+  [46] = 41,
   [47] = 47,
   [48] = 48,
   [49] = 49,
@@ -1058,6 +1105,24 @@ static const TSStateId ts_primary_state_ids[STATE_COUNT] = {
   [126] = 126,
   [127] = 127,
   [128] = 128,
+  [129] = 129,
+  [130] = 130,
+  [131] = 131,
+  [132] = 132,
+  [133] = 133,
+  [134] = 134,
+  [135] = 135,
+  [136] = 136,
+  [137] = 137,
+  [138] = 138,
+  [139] = 139,
+  [140] = 140,
+  [141] = 141,
+  [142] = 142,
+  [143] = 143,
+  [144] = 144,
+  [145] = 145,
+  [146] = 146,
 };
 
 static bool ts_lex(TSLexer *lexer, TSStateId state) {
@@ -1071,11 +1136,11 @@ static bool ts_lex(TSLexer *lexer, TSStateId state) {
         '"', 60,
         '#', 9,
         '\'', 61,
-        '(', 46,
-        ')', 47,
+        '(', 43,
+        ')', 45,
         '*', 49,
         '+', 51,
-        ',', 45,
+        ',', 44,
         '-', 52,
         '/', 50,
         ':', 41,
@@ -1084,8 +1149,8 @@ static bool ts_lex(TSLexer *lexer, TSStateId state) {
         '>', 56,
         '@', 32,
         '\\', 33,
-        '{', 43,
-        '}', 44,
+        '{', 46,
+        '}', 47,
       );
       if (('\t' <= lookahead && lookahead <= '\r') ||
           lookahead == ' ') SKIP(34);
@@ -1098,7 +1163,7 @@ static bool ts_lex(TSLexer *lexer, TSStateId state) {
       if (lookahead == '"') ADVANCE(60);
       if (lookahead == '/') ADVANCE(65);
       if (lookahead == '\\') ADVANCE(33);
-      if (lookahead == '{') ADVANCE(43);
+      if (lookahead == '{') ADVANCE(46);
       if (('\t' <= lookahead && lookahead <= '\r') ||
           lookahead == ' ') ADVANCE(66);
       if (lookahead != 0) ADVANCE(67);
@@ -1130,7 +1195,7 @@ static bool ts_lex(TSLexer *lexer, TSStateId state) {
       END_STATE();
     case 6:
       if (lookahead == '/') ADVANCE(70);
-      if (lookahead == '}') ADVANCE(44);
+      if (lookahead == '}') ADVANCE(47);
       if (('\t' <= lookahead && lookahead <= '\r') ||
           lookahead == ' ') ADVANCE(71);
       if (lookahead != 0) ADVANCE(69);
@@ -1233,11 +1298,11 @@ static bool ts_lex(TSLexer *lexer, TSStateId state) {
         '"', 60,
         '#', 9,
         '\'', 61,
-        '(', 46,
-        ')', 47,
+        '(', 43,
+        ')', 45,
         '*', 49,
         '+', 51,
-        ',', 45,
+        ',', 44,
         '-', 52,
         '/', 50,
         ':', 41,
@@ -1245,8 +1310,8 @@ static bool ts_lex(TSLexer *lexer, TSStateId state) {
         '=', 42,
         '>', 56,
         '@', 32,
-        '{', 43,
-        '}', 44,
+        '{', 46,
+        '}', 47,
       );
       if (('\t' <= lookahead && lookahead <= '\r') ||
           lookahead == ' ') SKIP(34);
@@ -1262,11 +1327,11 @@ static bool ts_lex(TSLexer *lexer, TSStateId state) {
         '"', 60,
         '#', 9,
         '\'', 61,
-        '(', 46,
-        ')', 47,
+        '(', 43,
+        ')', 45,
         '*', 48,
         '+', 51,
-        ',', 45,
+        ',', 44,
         '-', 52,
         '/', 50,
         ':', 41,
@@ -1274,8 +1339,8 @@ static bool ts_lex(TSLexer *lexer, TSStateId state) {
         '=', 42,
         '>', 56,
         '@', 32,
-        '{', 43,
-        '}', 44,
+        '{', 46,
+        '}', 47,
       );
       if (('\t' <= lookahead && lookahead <= '\r') ||
           lookahead == ' ') SKIP(35);
@@ -1307,19 +1372,19 @@ static bool ts_lex(TSLexer *lexer, TSStateId state) {
       if (lookahead == '=') ADVANCE(53);
       END_STATE();
     case 43:
-      ACCEPT_TOKEN(anon_sym_LBRACE);
+      ACCEPT_TOKEN(anon_sym_LPAREN);
       END_STATE();
     case 44:
-      ACCEPT_TOKEN(anon_sym_RBRACE);
+      ACCEPT_TOKEN(anon_sym_COMMA);
       END_STATE();
     case 45:
-      ACCEPT_TOKEN(anon_sym_COMMA);
+      ACCEPT_TOKEN(anon_sym_RPAREN);
       END_STATE();
     case 46:
-      ACCEPT_TOKEN(anon_sym_LPAREN);
+      ACCEPT_TOKEN(anon_sym_LBRACE);
       END_STATE();
     case 47:
-      ACCEPT_TOKEN(anon_sym_RPAREN);
+      ACCEPT_TOKEN(anon_sym_RBRACE);
       END_STATE();
     case 48:
       ACCEPT_TOKEN(anon_sym_STAR);
@@ -2250,7 +2315,7 @@ static const TSLexerMode ts_lex_modes[STATE_COUNT] = {
   [44] = {.lex_state = 35},
   [45] = {.lex_state = 35},
   [46] = {.lex_state = 35},
-  [47] = {.lex_state = 0},
+  [47] = {.lex_state = 35},
   [48] = {.lex_state = 0},
   [49] = {.lex_state = 0},
   [50] = {.lex_state = 0},
@@ -2290,48 +2355,66 @@ static const TSLexerMode ts_lex_modes[STATE_COUNT] = {
   [84] = {.lex_state = 0},
   [85] = {.lex_state = 0},
   [86] = {.lex_state = 0},
-  [87] = {.lex_state = 35},
-  [88] = {.lex_state = 35},
-  [89] = {.lex_state = 35},
+  [87] = {.lex_state = 0},
+  [88] = {.lex_state = 0},
+  [89] = {.lex_state = 0},
   [90] = {.lex_state = 35},
   [91] = {.lex_state = 35},
-  [92] = {.lex_state = 35},
-  [93] = {.lex_state = 0},
-  [94] = {.lex_state = 1},
-  [95] = {.lex_state = 1},
-  [96] = {.lex_state = 1},
+  [92] = {.lex_state = 0},
+  [93] = {.lex_state = 35},
+  [94] = {.lex_state = 35},
+  [95] = {.lex_state = 35},
+  [96] = {.lex_state = 35},
   [97] = {.lex_state = 0},
   [98] = {.lex_state = 0},
-  [99] = {.lex_state = 2},
-  [100] = {.lex_state = 3},
+  [99] = {.lex_state = 0},
+  [100] = {.lex_state = 1},
   [101] = {.lex_state = 1},
-  [102] = {.lex_state = 3},
-  [103] = {.lex_state = 1},
-  [104] = {.lex_state = 2},
-  [105] = {.lex_state = 3},
-  [106] = {.lex_state = 1},
-  [107] = {.lex_state = 2},
-  [108] = {.lex_state = 0},
-  [109] = {.lex_state = 6},
+  [102] = {.lex_state = 1},
+  [103] = {.lex_state = 0},
+  [104] = {.lex_state = 3},
+  [105] = {.lex_state = 2},
+  [106] = {.lex_state = 3},
+  [107] = {.lex_state = 1},
+  [108] = {.lex_state = 2},
+  [109] = {.lex_state = 3},
   [110] = {.lex_state = 0},
-  [111] = {.lex_state = 6},
-  [112] = {.lex_state = 0},
-  [113] = {.lex_state = 6},
+  [111] = {.lex_state = 1},
+  [112] = {.lex_state = 2},
+  [113] = {.lex_state = 1},
   [114] = {.lex_state = 3},
   [115] = {.lex_state = 0},
-  [116] = {.lex_state = 2},
-  [117] = {.lex_state = 0},
+  [116] = {.lex_state = 6},
+  [117] = {.lex_state = 2},
   [118] = {.lex_state = 0},
-  [119] = {.lex_state = 6},
-  [120] = {.lex_state = 0},
+  [119] = {.lex_state = 0},
+  [120] = {.lex_state = 6},
   [121] = {.lex_state = 0},
   [122] = {.lex_state = 0},
-  [123] = {.lex_state = 78},
+  [123] = {.lex_state = 6},
   [124] = {.lex_state = 0},
   [125] = {.lex_state = 0},
   [126] = {.lex_state = 0},
-  [127] = {(TSStateId)(-1),},
-  [128] = {(TSStateId)(-1),},
+  [127] = {.lex_state = 0},
+  [128] = {.lex_state = 0},
+  [129] = {.lex_state = 6},
+  [130] = {.lex_state = 0},
+  [131] = {.lex_state = 0},
+  [132] = {.lex_state = 0},
+  [133] = {.lex_state = 0},
+  [134] = {.lex_state = 0},
+  [135] = {.lex_state = 0},
+  [136] = {.lex_state = 0},
+  [137] = {.lex_state = 78},
+  [138] = {.lex_state = 0},
+  [139] = {.lex_state = 0},
+  [140] = {.lex_state = 0},
+  [141] = {.lex_state = 0},
+  [142] = {.lex_state = 0},
+  [143] = {.lex_state = 0},
+  [144] = {.lex_state = 0},
+  [145] = {(TSStateId)(-1),},
+  [146] = {(TSStateId)(-1),},
 };
 
 static const uint16_t ts_parse_table[LARGE_STATE_COUNT][SYMBOL_COUNT] = {
@@ -2353,9 +2436,12 @@ static const uint16_t ts_parse_table[LARGE_STATE_COUNT][SYMBOL_COUNT] = {
     [anon_sym_repeat] = ACTIONS(1),
     [anon_sym_menu] = ACTIONS(1),
     [anon_sym_item] = ACTIONS(1),
+    [anon_sym_action] = ACTIONS(1),
+    [anon_sym_LPAREN] = ACTIONS(1),
+    [anon_sym_COMMA] = ACTIONS(1),
+    [anon_sym_RPAREN] = ACTIONS(1),
     [anon_sym_LBRACE] = ACTIONS(1),
     [anon_sym_RBRACE] = ACTIONS(1),
-    [anon_sym_COMMA] = ACTIONS(1),
     [anon_sym_true] = ACTIONS(1),
     [anon_sym_false] = ACTIONS(1),
     [anon_sym_name] = ACTIONS(1),
@@ -2368,7 +2454,6 @@ static const uint16_t ts_parse_table[LARGE_STATE_COUNT][SYMBOL_COUNT] = {
     [anon_sym_getclipboard] = ACTIONS(1),
     [anon_sym_list] = ACTIONS(1),
     [anon_sym_nil] = ACTIONS(1),
-    [anon_sym_action] = ACTIONS(1),
     [anon_sym_stop] = ACTIONS(1),
     [anon_sym_makeVCard] = ACTIONS(1),
     [anon_sym_rawAction] = ACTIONS(1),
@@ -2388,8 +2473,6 @@ static const uint16_t ts_parse_table[LARGE_STATE_COUNT][SYMBOL_COUNT] = {
     [anon_sym_variable] = ACTIONS(1),
     [anon_sym_color] = ACTIONS(1),
     [anon_sym_float] = ACTIONS(1),
-    [anon_sym_LPAREN] = ACTIONS(1),
-    [anon_sym_RPAREN] = ACTIONS(1),
     [anon_sym_STAR] = ACTIONS(1),
     [anon_sym_SLASH] = ACTIONS(1),
     [anon_sym_PLUS] = ACTIONS(1),
@@ -2410,33 +2493,34 @@ static const uint16_t ts_parse_table[LARGE_STATE_COUNT][SYMBOL_COUNT] = {
     [anon_sym_STAR_SLASH] = ACTIONS(1),
   },
   [STATE(1)] = {
-    [sym_source_file] = STATE(124),
-    [sym__statement] = STATE(69),
-    [sym_pragma] = STATE(52),
-    [sym_pragma_directive] = STATE(85),
-    [sym_declaration] = STATE(52),
-    [sym_variable_assignment] = STATE(52),
-    [sym_constant_assignment] = STATE(52),
-    [sym_identifier_assignment] = STATE(52),
-    [sym_if_statement] = STATE(52),
-    [sym_for_statement] = STATE(52),
-    [sym_repeat_statement] = STATE(52),
-    [sym_menu_statement] = STATE(52),
-    [sym_item_statement] = STATE(52),
-    [sym_block] = STATE(52),
-    [sym__expression] = STATE(39),
-    [sym_dictionary] = STATE(17),
-    [sym_boolean] = STATE(17),
-    [sym_builtin_keyword] = STATE(28),
-    [sym_builtin_constant] = STATE(17),
-    [sym_type_keyword] = STATE(28),
-    [sym_parenthesized_expression] = STATE(17),
-    [sym_binary_expression] = STATE(17),
-    [sym_call] = STATE(17),
-    [sym_string] = STATE(17),
-    [sym_single_quoted_string] = STATE(17),
+    [sym_source_file] = STATE(140),
+    [sym__statement] = STATE(72),
+    [sym_pragma] = STATE(48),
+    [sym_pragma_directive] = STATE(88),
+    [sym_declaration] = STATE(48),
+    [sym_variable_assignment] = STATE(48),
+    [sym_constant_assignment] = STATE(48),
+    [sym_identifier_assignment] = STATE(48),
+    [sym_if_statement] = STATE(48),
+    [sym_for_statement] = STATE(48),
+    [sym_repeat_statement] = STATE(48),
+    [sym_menu_statement] = STATE(48),
+    [sym_item_statement] = STATE(48),
+    [sym_action_definition] = STATE(48),
+    [sym_block] = STATE(48),
+    [sym__expression] = STATE(40),
+    [sym_dictionary] = STATE(19),
+    [sym_boolean] = STATE(19),
+    [sym_builtin_keyword] = STATE(20),
+    [sym_builtin_constant] = STATE(19),
+    [sym_type_keyword] = STATE(20),
+    [sym_parenthesized_expression] = STATE(19),
+    [sym_binary_expression] = STATE(19),
+    [sym_call] = STATE(19),
+    [sym_string] = STATE(19),
+    [sym_single_quoted_string] = STATE(19),
     [sym_comment] = STATE(1),
-    [aux_sym_source_file_repeat1] = STATE(10),
+    [aux_sym_source_file_repeat1] = STATE(9),
     [ts_builtin_sym_end] = ACTIONS(7),
     [sym_identifier] = ACTIONS(9),
     [anon_sym_POUNDinclude] = ACTIONS(11),
@@ -2449,74 +2533,75 @@ static const uint16_t ts_parse_table[LARGE_STATE_COUNT][SYMBOL_COUNT] = {
     [anon_sym_repeat] = ACTIONS(19),
     [anon_sym_menu] = ACTIONS(21),
     [anon_sym_item] = ACTIONS(23),
-    [anon_sym_LBRACE] = ACTIONS(25),
-    [anon_sym_true] = ACTIONS(27),
-    [anon_sym_false] = ACTIONS(27),
-    [anon_sym_name] = ACTIONS(29),
-    [anon_sym_glyph] = ACTIONS(29),
-    [anon_sym_from] = ACTIONS(29),
-    [anon_sym_mac] = ACTIONS(29),
-    [anon_sym_inputs] = ACTIONS(29),
-    [anon_sym_noinput] = ACTIONS(29),
-    [anon_sym_askfor] = ACTIONS(29),
-    [anon_sym_getclipboard] = ACTIONS(29),
-    [anon_sym_list] = ACTIONS(29),
-    [anon_sym_nil] = ACTIONS(29),
-    [anon_sym_action] = ACTIONS(29),
-    [anon_sym_stop] = ACTIONS(29),
-    [anon_sym_makeVCard] = ACTIONS(29),
-    [anon_sym_rawAction] = ACTIONS(29),
-    [anon_sym_embedFile] = ACTIONS(29),
-    [anon_sym_nothing] = ACTIONS(29),
-    [anon_sym_CurrentDate] = ACTIONS(31),
-    [anon_sym_Device] = ACTIONS(31),
-    [anon_sym_RepeatIndex] = ACTIONS(31),
-    [anon_sym_RepeatItem] = ACTIONS(31),
-    [anon_sym_ShortcutInput] = ACTIONS(31),
-    [anon_sym_Ask] = ACTIONS(31),
-    [anon_sym_text] = ACTIONS(33),
-    [anon_sym_number] = ACTIONS(33),
-    [anon_sym_bool] = ACTIONS(33),
-    [anon_sym_dictionary] = ACTIONS(33),
-    [anon_sym_array] = ACTIONS(33),
-    [anon_sym_variable] = ACTIONS(33),
-    [anon_sym_color] = ACTIONS(33),
-    [anon_sym_float] = ACTIONS(33),
-    [anon_sym_LPAREN] = ACTIONS(35),
-    [sym_at_variable] = ACTIONS(37),
-    [anon_sym_DQUOTE] = ACTIONS(39),
-    [anon_sym_SQUOTE] = ACTIONS(41),
-    [sym_number] = ACTIONS(43),
+    [anon_sym_action] = ACTIONS(25),
+    [anon_sym_LPAREN] = ACTIONS(27),
+    [anon_sym_LBRACE] = ACTIONS(29),
+    [anon_sym_true] = ACTIONS(31),
+    [anon_sym_false] = ACTIONS(31),
+    [anon_sym_name] = ACTIONS(33),
+    [anon_sym_glyph] = ACTIONS(33),
+    [anon_sym_from] = ACTIONS(33),
+    [anon_sym_mac] = ACTIONS(33),
+    [anon_sym_inputs] = ACTIONS(33),
+    [anon_sym_noinput] = ACTIONS(33),
+    [anon_sym_askfor] = ACTIONS(33),
+    [anon_sym_getclipboard] = ACTIONS(33),
+    [anon_sym_list] = ACTIONS(33),
+    [anon_sym_nil] = ACTIONS(33),
+    [anon_sym_stop] = ACTIONS(33),
+    [anon_sym_makeVCard] = ACTIONS(33),
+    [anon_sym_rawAction] = ACTIONS(33),
+    [anon_sym_embedFile] = ACTIONS(33),
+    [anon_sym_nothing] = ACTIONS(33),
+    [anon_sym_CurrentDate] = ACTIONS(35),
+    [anon_sym_Device] = ACTIONS(35),
+    [anon_sym_RepeatIndex] = ACTIONS(35),
+    [anon_sym_RepeatItem] = ACTIONS(35),
+    [anon_sym_ShortcutInput] = ACTIONS(35),
+    [anon_sym_Ask] = ACTIONS(35),
+    [anon_sym_text] = ACTIONS(37),
+    [anon_sym_number] = ACTIONS(37),
+    [anon_sym_bool] = ACTIONS(37),
+    [anon_sym_dictionary] = ACTIONS(37),
+    [anon_sym_array] = ACTIONS(37),
+    [anon_sym_variable] = ACTIONS(37),
+    [anon_sym_color] = ACTIONS(37),
+    [anon_sym_float] = ACTIONS(37),
+    [sym_at_variable] = ACTIONS(39),
+    [anon_sym_DQUOTE] = ACTIONS(41),
+    [anon_sym_SQUOTE] = ACTIONS(43),
+    [sym_number] = ACTIONS(45),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(2)] = {
-    [sym__statement] = STATE(54),
-    [sym_pragma] = STATE(52),
-    [sym_pragma_directive] = STATE(85),
-    [sym_declaration] = STATE(52),
-    [sym_variable_assignment] = STATE(52),
-    [sym_constant_assignment] = STATE(52),
-    [sym_identifier_assignment] = STATE(52),
-    [sym_if_statement] = STATE(52),
-    [sym_for_statement] = STATE(52),
-    [sym_repeat_statement] = STATE(52),
-    [sym_menu_statement] = STATE(52),
-    [sym_item_statement] = STATE(52),
-    [sym_block] = STATE(49),
-    [sym__expression] = STATE(39),
-    [sym_dictionary] = STATE(17),
-    [sym_boolean] = STATE(17),
-    [sym_builtin_keyword] = STATE(28),
-    [sym_builtin_constant] = STATE(17),
-    [sym_type_keyword] = STATE(28),
-    [sym_parenthesized_expression] = STATE(17),
-    [sym_binary_expression] = STATE(17),
-    [sym_call] = STATE(17),
-    [sym_string] = STATE(17),
-    [sym_single_quoted_string] = STATE(17),
+    [sym__statement] = STATE(55),
+    [sym_pragma] = STATE(48),
+    [sym_pragma_directive] = STATE(88),
+    [sym_declaration] = STATE(48),
+    [sym_variable_assignment] = STATE(48),
+    [sym_constant_assignment] = STATE(48),
+    [sym_identifier_assignment] = STATE(48),
+    [sym_if_statement] = STATE(48),
+    [sym_for_statement] = STATE(48),
+    [sym_repeat_statement] = STATE(48),
+    [sym_menu_statement] = STATE(48),
+    [sym_item_statement] = STATE(48),
+    [sym_action_definition] = STATE(48),
+    [sym_block] = STATE(56),
+    [sym__expression] = STATE(40),
+    [sym_dictionary] = STATE(19),
+    [sym_boolean] = STATE(19),
+    [sym_builtin_keyword] = STATE(20),
+    [sym_builtin_constant] = STATE(19),
+    [sym_type_keyword] = STATE(20),
+    [sym_parenthesized_expression] = STATE(19),
+    [sym_binary_expression] = STATE(19),
+    [sym_call] = STATE(19),
+    [sym_string] = STATE(19),
+    [sym_single_quoted_string] = STATE(19),
     [sym_comment] = STATE(2),
-    [ts_builtin_sym_end] = ACTIONS(45),
+    [ts_builtin_sym_end] = ACTIONS(47),
     [sym_identifier] = ACTIONS(9),
     [anon_sym_POUNDinclude] = ACTIONS(11),
     [anon_sym_POUNDdefine] = ACTIONS(11),
@@ -2524,88 +2609,89 @@ static const uint16_t ts_parse_table[LARGE_STATE_COUNT][SYMBOL_COUNT] = {
     [anon_sym_POUNDquestion] = ACTIONS(11),
     [anon_sym_const] = ACTIONS(13),
     [anon_sym_if] = ACTIONS(15),
-    [anon_sym_else] = ACTIONS(47),
+    [anon_sym_else] = ACTIONS(49),
     [anon_sym_for] = ACTIONS(17),
     [anon_sym_repeat] = ACTIONS(19),
     [anon_sym_menu] = ACTIONS(21),
     [anon_sym_item] = ACTIONS(23),
-    [anon_sym_LBRACE] = ACTIONS(25),
-    [anon_sym_RBRACE] = ACTIONS(45),
-    [anon_sym_true] = ACTIONS(27),
-    [anon_sym_false] = ACTIONS(27),
-    [anon_sym_name] = ACTIONS(29),
-    [anon_sym_glyph] = ACTIONS(29),
-    [anon_sym_from] = ACTIONS(29),
-    [anon_sym_mac] = ACTIONS(29),
-    [anon_sym_inputs] = ACTIONS(29),
-    [anon_sym_noinput] = ACTIONS(29),
-    [anon_sym_askfor] = ACTIONS(29),
-    [anon_sym_getclipboard] = ACTIONS(29),
-    [anon_sym_list] = ACTIONS(29),
-    [anon_sym_nil] = ACTIONS(29),
-    [anon_sym_action] = ACTIONS(29),
-    [anon_sym_stop] = ACTIONS(29),
-    [anon_sym_makeVCard] = ACTIONS(29),
-    [anon_sym_rawAction] = ACTIONS(29),
-    [anon_sym_embedFile] = ACTIONS(29),
-    [anon_sym_nothing] = ACTIONS(29),
-    [anon_sym_CurrentDate] = ACTIONS(31),
-    [anon_sym_Device] = ACTIONS(31),
-    [anon_sym_RepeatIndex] = ACTIONS(31),
-    [anon_sym_RepeatItem] = ACTIONS(31),
-    [anon_sym_ShortcutInput] = ACTIONS(31),
-    [anon_sym_Ask] = ACTIONS(31),
-    [anon_sym_text] = ACTIONS(33),
-    [anon_sym_number] = ACTIONS(33),
-    [anon_sym_bool] = ACTIONS(33),
-    [anon_sym_dictionary] = ACTIONS(33),
-    [anon_sym_array] = ACTIONS(33),
-    [anon_sym_variable] = ACTIONS(33),
-    [anon_sym_color] = ACTIONS(33),
-    [anon_sym_float] = ACTIONS(33),
-    [anon_sym_LPAREN] = ACTIONS(35),
-    [anon_sym_STAR] = ACTIONS(49),
-    [anon_sym_SLASH] = ACTIONS(51),
-    [anon_sym_PLUS] = ACTIONS(53),
-    [anon_sym_DASH] = ACTIONS(53),
-    [anon_sym_EQ_EQ] = ACTIONS(55),
-    [anon_sym_BANG_EQ] = ACTIONS(55),
-    [anon_sym_LT] = ACTIONS(57),
-    [anon_sym_GT] = ACTIONS(57),
-    [anon_sym_LT_EQ] = ACTIONS(59),
-    [anon_sym_GT_EQ] = ACTIONS(59),
-    [sym_at_variable] = ACTIONS(37),
-    [anon_sym_DQUOTE] = ACTIONS(39),
-    [anon_sym_SQUOTE] = ACTIONS(41),
-    [sym_number] = ACTIONS(43),
+    [anon_sym_action] = ACTIONS(25),
+    [anon_sym_LPAREN] = ACTIONS(27),
+    [anon_sym_LBRACE] = ACTIONS(29),
+    [anon_sym_RBRACE] = ACTIONS(47),
+    [anon_sym_true] = ACTIONS(31),
+    [anon_sym_false] = ACTIONS(31),
+    [anon_sym_name] = ACTIONS(33),
+    [anon_sym_glyph] = ACTIONS(33),
+    [anon_sym_from] = ACTIONS(33),
+    [anon_sym_mac] = ACTIONS(33),
+    [anon_sym_inputs] = ACTIONS(33),
+    [anon_sym_noinput] = ACTIONS(33),
+    [anon_sym_askfor] = ACTIONS(33),
+    [anon_sym_getclipboard] = ACTIONS(33),
+    [anon_sym_list] = ACTIONS(33),
+    [anon_sym_nil] = ACTIONS(33),
+    [anon_sym_stop] = ACTIONS(33),
+    [anon_sym_makeVCard] = ACTIONS(33),
+    [anon_sym_rawAction] = ACTIONS(33),
+    [anon_sym_embedFile] = ACTIONS(33),
+    [anon_sym_nothing] = ACTIONS(33),
+    [anon_sym_CurrentDate] = ACTIONS(35),
+    [anon_sym_Device] = ACTIONS(35),
+    [anon_sym_RepeatIndex] = ACTIONS(35),
+    [anon_sym_RepeatItem] = ACTIONS(35),
+    [anon_sym_ShortcutInput] = ACTIONS(35),
+    [anon_sym_Ask] = ACTIONS(35),
+    [anon_sym_text] = ACTIONS(37),
+    [anon_sym_number] = ACTIONS(37),
+    [anon_sym_bool] = ACTIONS(37),
+    [anon_sym_dictionary] = ACTIONS(37),
+    [anon_sym_array] = ACTIONS(37),
+    [anon_sym_variable] = ACTIONS(37),
+    [anon_sym_color] = ACTIONS(37),
+    [anon_sym_float] = ACTIONS(37),
+    [anon_sym_STAR] = ACTIONS(51),
+    [anon_sym_SLASH] = ACTIONS(53),
+    [anon_sym_PLUS] = ACTIONS(55),
+    [anon_sym_DASH] = ACTIONS(55),
+    [anon_sym_EQ_EQ] = ACTIONS(57),
+    [anon_sym_BANG_EQ] = ACTIONS(57),
+    [anon_sym_LT] = ACTIONS(59),
+    [anon_sym_GT] = ACTIONS(59),
+    [anon_sym_LT_EQ] = ACTIONS(61),
+    [anon_sym_GT_EQ] = ACTIONS(61),
+    [sym_at_variable] = ACTIONS(39),
+    [anon_sym_DQUOTE] = ACTIONS(41),
+    [anon_sym_SQUOTE] = ACTIONS(43),
+    [sym_number] = ACTIONS(45),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(3)] = {
-    [sym__statement] = STATE(65),
-    [sym_pragma] = STATE(52),
-    [sym_pragma_directive] = STATE(85),
-    [sym_declaration] = STATE(52),
-    [sym_variable_assignment] = STATE(52),
-    [sym_constant_assignment] = STATE(52),
-    [sym_identifier_assignment] = STATE(52),
-    [sym_if_statement] = STATE(52),
-    [sym_for_statement] = STATE(52),
-    [sym_repeat_statement] = STATE(52),
-    [sym_menu_statement] = STATE(52),
-    [sym_item_statement] = STATE(52),
-    [sym_block] = STATE(66),
-    [sym__expression] = STATE(39),
-    [sym_dictionary] = STATE(17),
-    [sym_boolean] = STATE(17),
-    [sym_builtin_keyword] = STATE(28),
-    [sym_builtin_constant] = STATE(17),
-    [sym_type_keyword] = STATE(28),
-    [sym_parenthesized_expression] = STATE(17),
-    [sym_binary_expression] = STATE(17),
-    [sym_call] = STATE(17),
-    [sym_string] = STATE(17),
-    [sym_single_quoted_string] = STATE(17),
+    [sym__statement] = STATE(53),
+    [sym_pragma] = STATE(48),
+    [sym_pragma_directive] = STATE(88),
+    [sym_declaration] = STATE(48),
+    [sym_variable_assignment] = STATE(48),
+    [sym_constant_assignment] = STATE(48),
+    [sym_identifier_assignment] = STATE(48),
+    [sym_if_statement] = STATE(48),
+    [sym_for_statement] = STATE(48),
+    [sym_repeat_statement] = STATE(48),
+    [sym_menu_statement] = STATE(48),
+    [sym_item_statement] = STATE(48),
+    [sym_action_definition] = STATE(48),
+    [sym_block] = STATE(54),
+    [sym__expression] = STATE(40),
+    [sym_dictionary] = STATE(19),
+    [sym_boolean] = STATE(19),
+    [sym_builtin_keyword] = STATE(20),
+    [sym_builtin_constant] = STATE(19),
+    [sym_type_keyword] = STATE(20),
+    [sym_parenthesized_expression] = STATE(19),
+    [sym_binary_expression] = STATE(19),
+    [sym_call] = STATE(19),
+    [sym_string] = STATE(19),
+    [sym_single_quoted_string] = STATE(19),
     [sym_comment] = STATE(3),
     [sym_identifier] = ACTIONS(9),
     [anon_sym_POUNDinclude] = ACTIONS(11),
@@ -2618,82 +2704,83 @@ static const uint16_t ts_parse_table[LARGE_STATE_COUNT][SYMBOL_COUNT] = {
     [anon_sym_repeat] = ACTIONS(19),
     [anon_sym_menu] = ACTIONS(21),
     [anon_sym_item] = ACTIONS(23),
-    [anon_sym_LBRACE] = ACTIONS(25),
-    [anon_sym_true] = ACTIONS(27),
-    [anon_sym_false] = ACTIONS(27),
-    [anon_sym_name] = ACTIONS(29),
-    [anon_sym_glyph] = ACTIONS(29),
-    [anon_sym_from] = ACTIONS(29),
-    [anon_sym_mac] = ACTIONS(29),
-    [anon_sym_inputs] = ACTIONS(29),
-    [anon_sym_noinput] = ACTIONS(29),
-    [anon_sym_askfor] = ACTIONS(29),
-    [anon_sym_getclipboard] = ACTIONS(29),
-    [anon_sym_list] = ACTIONS(29),
-    [anon_sym_nil] = ACTIONS(29),
-    [anon_sym_action] = ACTIONS(29),
-    [anon_sym_stop] = ACTIONS(29),
-    [anon_sym_makeVCard] = ACTIONS(29),
-    [anon_sym_rawAction] = ACTIONS(29),
-    [anon_sym_embedFile] = ACTIONS(29),
-    [anon_sym_nothing] = ACTIONS(29),
-    [anon_sym_CurrentDate] = ACTIONS(31),
-    [anon_sym_Device] = ACTIONS(31),
-    [anon_sym_RepeatIndex] = ACTIONS(31),
-    [anon_sym_RepeatItem] = ACTIONS(31),
-    [anon_sym_ShortcutInput] = ACTIONS(31),
-    [anon_sym_Ask] = ACTIONS(31),
-    [anon_sym_text] = ACTIONS(33),
-    [anon_sym_number] = ACTIONS(33),
-    [anon_sym_bool] = ACTIONS(33),
-    [anon_sym_dictionary] = ACTIONS(33),
-    [anon_sym_array] = ACTIONS(33),
-    [anon_sym_variable] = ACTIONS(33),
-    [anon_sym_color] = ACTIONS(33),
-    [anon_sym_float] = ACTIONS(33),
-    [anon_sym_LPAREN] = ACTIONS(35),
-    [anon_sym_STAR] = ACTIONS(49),
-    [anon_sym_SLASH] = ACTIONS(51),
-    [anon_sym_PLUS] = ACTIONS(53),
-    [anon_sym_DASH] = ACTIONS(53),
-    [anon_sym_EQ_EQ] = ACTIONS(55),
-    [anon_sym_BANG_EQ] = ACTIONS(55),
-    [anon_sym_LT] = ACTIONS(57),
-    [anon_sym_GT] = ACTIONS(57),
-    [anon_sym_LT_EQ] = ACTIONS(59),
-    [anon_sym_GT_EQ] = ACTIONS(59),
-    [sym_at_variable] = ACTIONS(37),
-    [anon_sym_DQUOTE] = ACTIONS(39),
-    [anon_sym_SQUOTE] = ACTIONS(41),
-    [sym_number] = ACTIONS(43),
+    [anon_sym_action] = ACTIONS(25),
+    [anon_sym_LPAREN] = ACTIONS(27),
+    [anon_sym_LBRACE] = ACTIONS(29),
+    [anon_sym_true] = ACTIONS(31),
+    [anon_sym_false] = ACTIONS(31),
+    [anon_sym_name] = ACTIONS(33),
+    [anon_sym_glyph] = ACTIONS(33),
+    [anon_sym_from] = ACTIONS(33),
+    [anon_sym_mac] = ACTIONS(33),
+    [anon_sym_inputs] = ACTIONS(33),
+    [anon_sym_noinput] = ACTIONS(33),
+    [anon_sym_askfor] = ACTIONS(33),
+    [anon_sym_getclipboard] = ACTIONS(33),
+    [anon_sym_list] = ACTIONS(33),
+    [anon_sym_nil] = ACTIONS(33),
+    [anon_sym_stop] = ACTIONS(33),
+    [anon_sym_makeVCard] = ACTIONS(33),
+    [anon_sym_rawAction] = ACTIONS(33),
+    [anon_sym_embedFile] = ACTIONS(33),
+    [anon_sym_nothing] = ACTIONS(33),
+    [anon_sym_CurrentDate] = ACTIONS(35),
+    [anon_sym_Device] = ACTIONS(35),
+    [anon_sym_RepeatIndex] = ACTIONS(35),
+    [anon_sym_RepeatItem] = ACTIONS(35),
+    [anon_sym_ShortcutInput] = ACTIONS(35),
+    [anon_sym_Ask] = ACTIONS(35),
+    [anon_sym_text] = ACTIONS(37),
+    [anon_sym_number] = ACTIONS(37),
+    [anon_sym_bool] = ACTIONS(37),
+    [anon_sym_dictionary] = ACTIONS(37),
+    [anon_sym_array] = ACTIONS(37),
+    [anon_sym_variable] = ACTIONS(37),
+    [anon_sym_color] = ACTIONS(37),
+    [anon_sym_float] = ACTIONS(37),
+    [anon_sym_STAR] = ACTIONS(51),
+    [anon_sym_SLASH] = ACTIONS(53),
+    [anon_sym_PLUS] = ACTIONS(55),
+    [anon_sym_DASH] = ACTIONS(55),
+    [anon_sym_EQ_EQ] = ACTIONS(57),
+    [anon_sym_BANG_EQ] = ACTIONS(57),
+    [anon_sym_LT] = ACTIONS(59),
+    [anon_sym_GT] = ACTIONS(59),
+    [anon_sym_LT_EQ] = ACTIONS(61),
+    [anon_sym_GT_EQ] = ACTIONS(61),
+    [sym_at_variable] = ACTIONS(39),
+    [anon_sym_DQUOTE] = ACTIONS(41),
+    [anon_sym_SQUOTE] = ACTIONS(43),
+    [sym_number] = ACTIONS(45),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(4)] = {
-    [sym__statement] = STATE(53),
-    [sym_pragma] = STATE(52),
-    [sym_pragma_directive] = STATE(85),
-    [sym_declaration] = STATE(52),
-    [sym_variable_assignment] = STATE(52),
-    [sym_constant_assignment] = STATE(52),
-    [sym_identifier_assignment] = STATE(52),
-    [sym_if_statement] = STATE(52),
-    [sym_for_statement] = STATE(52),
-    [sym_repeat_statement] = STATE(52),
-    [sym_menu_statement] = STATE(52),
-    [sym_item_statement] = STATE(52),
-    [sym_block] = STATE(47),
-    [sym__expression] = STATE(39),
-    [sym_dictionary] = STATE(17),
-    [sym_boolean] = STATE(17),
-    [sym_builtin_keyword] = STATE(28),
-    [sym_builtin_constant] = STATE(17),
-    [sym_type_keyword] = STATE(28),
-    [sym_parenthesized_expression] = STATE(17),
-    [sym_binary_expression] = STATE(17),
-    [sym_call] = STATE(17),
-    [sym_string] = STATE(17),
-    [sym_single_quoted_string] = STATE(17),
+    [sym__statement] = STATE(66),
+    [sym_pragma] = STATE(48),
+    [sym_pragma_directive] = STATE(88),
+    [sym_declaration] = STATE(48),
+    [sym_variable_assignment] = STATE(48),
+    [sym_constant_assignment] = STATE(48),
+    [sym_identifier_assignment] = STATE(48),
+    [sym_if_statement] = STATE(48),
+    [sym_for_statement] = STATE(48),
+    [sym_repeat_statement] = STATE(48),
+    [sym_menu_statement] = STATE(48),
+    [sym_item_statement] = STATE(48),
+    [sym_action_definition] = STATE(48),
+    [sym_block] = STATE(67),
+    [sym__expression] = STATE(40),
+    [sym_dictionary] = STATE(19),
+    [sym_boolean] = STATE(19),
+    [sym_builtin_keyword] = STATE(20),
+    [sym_builtin_constant] = STATE(19),
+    [sym_type_keyword] = STATE(20),
+    [sym_parenthesized_expression] = STATE(19),
+    [sym_binary_expression] = STATE(19),
+    [sym_call] = STATE(19),
+    [sym_string] = STATE(19),
+    [sym_single_quoted_string] = STATE(19),
     [sym_comment] = STATE(4),
     [sym_identifier] = ACTIONS(9),
     [anon_sym_POUNDinclude] = ACTIONS(11),
@@ -2706,82 +2793,83 @@ static const uint16_t ts_parse_table[LARGE_STATE_COUNT][SYMBOL_COUNT] = {
     [anon_sym_repeat] = ACTIONS(19),
     [anon_sym_menu] = ACTIONS(21),
     [anon_sym_item] = ACTIONS(23),
-    [anon_sym_LBRACE] = ACTIONS(25),
-    [anon_sym_true] = ACTIONS(27),
-    [anon_sym_false] = ACTIONS(27),
-    [anon_sym_name] = ACTIONS(29),
-    [anon_sym_glyph] = ACTIONS(29),
-    [anon_sym_from] = ACTIONS(29),
-    [anon_sym_mac] = ACTIONS(29),
-    [anon_sym_inputs] = ACTIONS(29),
-    [anon_sym_noinput] = ACTIONS(29),
-    [anon_sym_askfor] = ACTIONS(29),
-    [anon_sym_getclipboard] = ACTIONS(29),
-    [anon_sym_list] = ACTIONS(29),
-    [anon_sym_nil] = ACTIONS(29),
-    [anon_sym_action] = ACTIONS(29),
-    [anon_sym_stop] = ACTIONS(29),
-    [anon_sym_makeVCard] = ACTIONS(29),
-    [anon_sym_rawAction] = ACTIONS(29),
-    [anon_sym_embedFile] = ACTIONS(29),
-    [anon_sym_nothing] = ACTIONS(29),
-    [anon_sym_CurrentDate] = ACTIONS(31),
-    [anon_sym_Device] = ACTIONS(31),
-    [anon_sym_RepeatIndex] = ACTIONS(31),
-    [anon_sym_RepeatItem] = ACTIONS(31),
-    [anon_sym_ShortcutInput] = ACTIONS(31),
-    [anon_sym_Ask] = ACTIONS(31),
-    [anon_sym_text] = ACTIONS(33),
-    [anon_sym_number] = ACTIONS(33),
-    [anon_sym_bool] = ACTIONS(33),
-    [anon_sym_dictionary] = ACTIONS(33),
-    [anon_sym_array] = ACTIONS(33),
-    [anon_sym_variable] = ACTIONS(33),
-    [anon_sym_color] = ACTIONS(33),
-    [anon_sym_float] = ACTIONS(33),
-    [anon_sym_LPAREN] = ACTIONS(35),
-    [anon_sym_STAR] = ACTIONS(49),
-    [anon_sym_SLASH] = ACTIONS(51),
-    [anon_sym_PLUS] = ACTIONS(53),
-    [anon_sym_DASH] = ACTIONS(53),
-    [anon_sym_EQ_EQ] = ACTIONS(55),
-    [anon_sym_BANG_EQ] = ACTIONS(55),
-    [anon_sym_LT] = ACTIONS(57),
-    [anon_sym_GT] = ACTIONS(57),
-    [anon_sym_LT_EQ] = ACTIONS(59),
-    [anon_sym_GT_EQ] = ACTIONS(59),
-    [sym_at_variable] = ACTIONS(37),
-    [anon_sym_DQUOTE] = ACTIONS(39),
-    [anon_sym_SQUOTE] = ACTIONS(41),
-    [sym_number] = ACTIONS(43),
+    [anon_sym_action] = ACTIONS(25),
+    [anon_sym_LPAREN] = ACTIONS(27),
+    [anon_sym_LBRACE] = ACTIONS(29),
+    [anon_sym_true] = ACTIONS(31),
+    [anon_sym_false] = ACTIONS(31),
+    [anon_sym_name] = ACTIONS(33),
+    [anon_sym_glyph] = ACTIONS(33),
+    [anon_sym_from] = ACTIONS(33),
+    [anon_sym_mac] = ACTIONS(33),
+    [anon_sym_inputs] = ACTIONS(33),
+    [anon_sym_noinput] = ACTIONS(33),
+    [anon_sym_askfor] = ACTIONS(33),
+    [anon_sym_getclipboard] = ACTIONS(33),
+    [anon_sym_list] = ACTIONS(33),
+    [anon_sym_nil] = ACTIONS(33),
+    [anon_sym_stop] = ACTIONS(33),
+    [anon_sym_makeVCard] = ACTIONS(33),
+    [anon_sym_rawAction] = ACTIONS(33),
+    [anon_sym_embedFile] = ACTIONS(33),
+    [anon_sym_nothing] = ACTIONS(33),
+    [anon_sym_CurrentDate] = ACTIONS(35),
+    [anon_sym_Device] = ACTIONS(35),
+    [anon_sym_RepeatIndex] = ACTIONS(35),
+    [anon_sym_RepeatItem] = ACTIONS(35),
+    [anon_sym_ShortcutInput] = ACTIONS(35),
+    [anon_sym_Ask] = ACTIONS(35),
+    [anon_sym_text] = ACTIONS(37),
+    [anon_sym_number] = ACTIONS(37),
+    [anon_sym_bool] = ACTIONS(37),
+    [anon_sym_dictionary] = ACTIONS(37),
+    [anon_sym_array] = ACTIONS(37),
+    [anon_sym_variable] = ACTIONS(37),
+    [anon_sym_color] = ACTIONS(37),
+    [anon_sym_float] = ACTIONS(37),
+    [anon_sym_STAR] = ACTIONS(51),
+    [anon_sym_SLASH] = ACTIONS(53),
+    [anon_sym_PLUS] = ACTIONS(55),
+    [anon_sym_DASH] = ACTIONS(55),
+    [anon_sym_EQ_EQ] = ACTIONS(57),
+    [anon_sym_BANG_EQ] = ACTIONS(57),
+    [anon_sym_LT] = ACTIONS(59),
+    [anon_sym_GT] = ACTIONS(59),
+    [anon_sym_LT_EQ] = ACTIONS(61),
+    [anon_sym_GT_EQ] = ACTIONS(61),
+    [sym_at_variable] = ACTIONS(39),
+    [anon_sym_DQUOTE] = ACTIONS(41),
+    [anon_sym_SQUOTE] = ACTIONS(43),
+    [sym_number] = ACTIONS(45),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(5)] = {
-    [sym__statement] = STATE(67),
-    [sym_pragma] = STATE(52),
-    [sym_pragma_directive] = STATE(85),
-    [sym_declaration] = STATE(52),
-    [sym_variable_assignment] = STATE(52),
-    [sym_constant_assignment] = STATE(52),
-    [sym_identifier_assignment] = STATE(52),
-    [sym_if_statement] = STATE(52),
-    [sym_for_statement] = STATE(52),
-    [sym_repeat_statement] = STATE(52),
-    [sym_menu_statement] = STATE(52),
-    [sym_item_statement] = STATE(52),
-    [sym_block] = STATE(48),
-    [sym__expression] = STATE(39),
-    [sym_dictionary] = STATE(17),
-    [sym_boolean] = STATE(17),
-    [sym_builtin_keyword] = STATE(28),
-    [sym_builtin_constant] = STATE(17),
-    [sym_type_keyword] = STATE(28),
-    [sym_parenthesized_expression] = STATE(17),
-    [sym_binary_expression] = STATE(17),
-    [sym_call] = STATE(17),
-    [sym_string] = STATE(17),
-    [sym_single_quoted_string] = STATE(17),
+    [sym__statement] = STATE(68),
+    [sym_pragma] = STATE(48),
+    [sym_pragma_directive] = STATE(88),
+    [sym_declaration] = STATE(48),
+    [sym_variable_assignment] = STATE(48),
+    [sym_constant_assignment] = STATE(48),
+    [sym_identifier_assignment] = STATE(48),
+    [sym_if_statement] = STATE(48),
+    [sym_for_statement] = STATE(48),
+    [sym_repeat_statement] = STATE(48),
+    [sym_menu_statement] = STATE(48),
+    [sym_item_statement] = STATE(48),
+    [sym_action_definition] = STATE(48),
+    [sym_block] = STATE(69),
+    [sym__expression] = STATE(40),
+    [sym_dictionary] = STATE(19),
+    [sym_boolean] = STATE(19),
+    [sym_builtin_keyword] = STATE(20),
+    [sym_builtin_constant] = STATE(19),
+    [sym_type_keyword] = STATE(20),
+    [sym_parenthesized_expression] = STATE(19),
+    [sym_binary_expression] = STATE(19),
+    [sym_call] = STATE(19),
+    [sym_string] = STATE(19),
+    [sym_single_quoted_string] = STATE(19),
     [sym_comment] = STATE(5),
     [sym_identifier] = ACTIONS(9),
     [anon_sym_POUNDinclude] = ACTIONS(11),
@@ -2794,167 +2882,87 @@ static const uint16_t ts_parse_table[LARGE_STATE_COUNT][SYMBOL_COUNT] = {
     [anon_sym_repeat] = ACTIONS(19),
     [anon_sym_menu] = ACTIONS(21),
     [anon_sym_item] = ACTIONS(23),
-    [anon_sym_LBRACE] = ACTIONS(25),
-    [anon_sym_true] = ACTIONS(27),
-    [anon_sym_false] = ACTIONS(27),
-    [anon_sym_name] = ACTIONS(29),
-    [anon_sym_glyph] = ACTIONS(29),
-    [anon_sym_from] = ACTIONS(29),
-    [anon_sym_mac] = ACTIONS(29),
-    [anon_sym_inputs] = ACTIONS(29),
-    [anon_sym_noinput] = ACTIONS(29),
-    [anon_sym_askfor] = ACTIONS(29),
-    [anon_sym_getclipboard] = ACTIONS(29),
-    [anon_sym_list] = ACTIONS(29),
-    [anon_sym_nil] = ACTIONS(29),
-    [anon_sym_action] = ACTIONS(29),
-    [anon_sym_stop] = ACTIONS(29),
-    [anon_sym_makeVCard] = ACTIONS(29),
-    [anon_sym_rawAction] = ACTIONS(29),
-    [anon_sym_embedFile] = ACTIONS(29),
-    [anon_sym_nothing] = ACTIONS(29),
-    [anon_sym_CurrentDate] = ACTIONS(31),
-    [anon_sym_Device] = ACTIONS(31),
-    [anon_sym_RepeatIndex] = ACTIONS(31),
-    [anon_sym_RepeatItem] = ACTIONS(31),
-    [anon_sym_ShortcutInput] = ACTIONS(31),
-    [anon_sym_Ask] = ACTIONS(31),
-    [anon_sym_text] = ACTIONS(33),
-    [anon_sym_number] = ACTIONS(33),
-    [anon_sym_bool] = ACTIONS(33),
-    [anon_sym_dictionary] = ACTIONS(33),
-    [anon_sym_array] = ACTIONS(33),
-    [anon_sym_variable] = ACTIONS(33),
-    [anon_sym_color] = ACTIONS(33),
-    [anon_sym_float] = ACTIONS(33),
-    [anon_sym_LPAREN] = ACTIONS(35),
-    [anon_sym_STAR] = ACTIONS(49),
-    [anon_sym_SLASH] = ACTIONS(51),
-    [anon_sym_PLUS] = ACTIONS(53),
-    [anon_sym_DASH] = ACTIONS(53),
-    [anon_sym_EQ_EQ] = ACTIONS(55),
-    [anon_sym_BANG_EQ] = ACTIONS(55),
-    [anon_sym_LT] = ACTIONS(57),
-    [anon_sym_GT] = ACTIONS(57),
-    [anon_sym_LT_EQ] = ACTIONS(59),
-    [anon_sym_GT_EQ] = ACTIONS(59),
-    [sym_at_variable] = ACTIONS(37),
-    [anon_sym_DQUOTE] = ACTIONS(39),
-    [anon_sym_SQUOTE] = ACTIONS(41),
-    [sym_number] = ACTIONS(43),
+    [anon_sym_action] = ACTIONS(25),
+    [anon_sym_LPAREN] = ACTIONS(27),
+    [anon_sym_LBRACE] = ACTIONS(29),
+    [anon_sym_true] = ACTIONS(31),
+    [anon_sym_false] = ACTIONS(31),
+    [anon_sym_name] = ACTIONS(33),
+    [anon_sym_glyph] = ACTIONS(33),
+    [anon_sym_from] = ACTIONS(33),
+    [anon_sym_mac] = ACTIONS(33),
+    [anon_sym_inputs] = ACTIONS(33),
+    [anon_sym_noinput] = ACTIONS(33),
+    [anon_sym_askfor] = ACTIONS(33),
+    [anon_sym_getclipboard] = ACTIONS(33),
+    [anon_sym_list] = ACTIONS(33),
+    [anon_sym_nil] = ACTIONS(33),
+    [anon_sym_stop] = ACTIONS(33),
+    [anon_sym_makeVCard] = ACTIONS(33),
+    [anon_sym_rawAction] = ACTIONS(33),
+    [anon_sym_embedFile] = ACTIONS(33),
+    [anon_sym_nothing] = ACTIONS(33),
+    [anon_sym_CurrentDate] = ACTIONS(35),
+    [anon_sym_Device] = ACTIONS(35),
+    [anon_sym_RepeatIndex] = ACTIONS(35),
+    [anon_sym_RepeatItem] = ACTIONS(35),
+    [anon_sym_ShortcutInput] = ACTIONS(35),
+    [anon_sym_Ask] = ACTIONS(35),
+    [anon_sym_text] = ACTIONS(37),
+    [anon_sym_number] = ACTIONS(37),
+    [anon_sym_bool] = ACTIONS(37),
+    [anon_sym_dictionary] = ACTIONS(37),
+    [anon_sym_array] = ACTIONS(37),
+    [anon_sym_variable] = ACTIONS(37),
+    [anon_sym_color] = ACTIONS(37),
+    [anon_sym_float] = ACTIONS(37),
+    [anon_sym_STAR] = ACTIONS(51),
+    [anon_sym_SLASH] = ACTIONS(53),
+    [anon_sym_PLUS] = ACTIONS(55),
+    [anon_sym_DASH] = ACTIONS(55),
+    [anon_sym_EQ_EQ] = ACTIONS(57),
+    [anon_sym_BANG_EQ] = ACTIONS(57),
+    [anon_sym_LT] = ACTIONS(59),
+    [anon_sym_GT] = ACTIONS(59),
+    [anon_sym_LT_EQ] = ACTIONS(61),
+    [anon_sym_GT_EQ] = ACTIONS(61),
+    [sym_at_variable] = ACTIONS(39),
+    [anon_sym_DQUOTE] = ACTIONS(41),
+    [anon_sym_SQUOTE] = ACTIONS(43),
+    [sym_number] = ACTIONS(45),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(6)] = {
-    [sym__statement] = STATE(69),
-    [sym_pragma] = STATE(52),
-    [sym_pragma_directive] = STATE(85),
-    [sym_declaration] = STATE(52),
-    [sym_variable_assignment] = STATE(52),
-    [sym_constant_assignment] = STATE(52),
-    [sym_identifier_assignment] = STATE(52),
-    [sym_if_statement] = STATE(52),
-    [sym_for_statement] = STATE(52),
-    [sym_repeat_statement] = STATE(52),
-    [sym_menu_statement] = STATE(52),
-    [sym_item_statement] = STATE(52),
-    [sym_block] = STATE(52),
-    [sym__expression] = STATE(39),
-    [sym_dictionary] = STATE(17),
-    [sym_boolean] = STATE(17),
-    [sym_builtin_keyword] = STATE(28),
-    [sym_builtin_constant] = STATE(17),
-    [sym_type_keyword] = STATE(28),
-    [sym_parenthesized_expression] = STATE(17),
-    [sym_binary_expression] = STATE(17),
-    [sym_call] = STATE(17),
-    [sym_string] = STATE(17),
-    [sym_single_quoted_string] = STATE(17),
+    [sym__statement] = STATE(72),
+    [sym_pragma] = STATE(48),
+    [sym_pragma_directive] = STATE(88),
+    [sym_declaration] = STATE(48),
+    [sym_variable_assignment] = STATE(48),
+    [sym_constant_assignment] = STATE(48),
+    [sym_identifier_assignment] = STATE(48),
+    [sym_if_statement] = STATE(48),
+    [sym_for_statement] = STATE(48),
+    [sym_repeat_statement] = STATE(48),
+    [sym_menu_statement] = STATE(48),
+    [sym_item_statement] = STATE(48),
+    [sym_action_definition] = STATE(48),
+    [sym_block] = STATE(48),
+    [sym__expression] = STATE(40),
+    [sym_dictionary] = STATE(19),
+    [sym_dictionary_pair] = STATE(115),
+    [sym_boolean] = STATE(19),
+    [sym_builtin_keyword] = STATE(20),
+    [sym_builtin_constant] = STATE(19),
+    [sym_type_keyword] = STATE(20),
+    [sym_parenthesized_expression] = STATE(19),
+    [sym_binary_expression] = STATE(19),
+    [sym_call] = STATE(19),
+    [sym_string] = STATE(47),
+    [sym_single_quoted_string] = STATE(19),
     [sym_comment] = STATE(6),
-    [aux_sym_source_file_repeat1] = STATE(6),
-    [ts_builtin_sym_end] = ACTIONS(61),
+    [aux_sym_source_file_repeat1] = STATE(10),
     [sym_identifier] = ACTIONS(63),
-    [anon_sym_POUNDinclude] = ACTIONS(66),
-    [anon_sym_POUNDdefine] = ACTIONS(66),
-    [anon_sym_POUNDimport] = ACTIONS(66),
-    [anon_sym_POUNDquestion] = ACTIONS(66),
-    [anon_sym_const] = ACTIONS(69),
-    [anon_sym_if] = ACTIONS(72),
-    [anon_sym_for] = ACTIONS(75),
-    [anon_sym_repeat] = ACTIONS(78),
-    [anon_sym_menu] = ACTIONS(81),
-    [anon_sym_item] = ACTIONS(84),
-    [anon_sym_LBRACE] = ACTIONS(87),
-    [anon_sym_RBRACE] = ACTIONS(61),
-    [anon_sym_true] = ACTIONS(90),
-    [anon_sym_false] = ACTIONS(90),
-    [anon_sym_name] = ACTIONS(93),
-    [anon_sym_glyph] = ACTIONS(93),
-    [anon_sym_from] = ACTIONS(93),
-    [anon_sym_mac] = ACTIONS(93),
-    [anon_sym_inputs] = ACTIONS(93),
-    [anon_sym_noinput] = ACTIONS(93),
-    [anon_sym_askfor] = ACTIONS(93),
-    [anon_sym_getclipboard] = ACTIONS(93),
-    [anon_sym_list] = ACTIONS(93),
-    [anon_sym_nil] = ACTIONS(93),
-    [anon_sym_action] = ACTIONS(93),
-    [anon_sym_stop] = ACTIONS(93),
-    [anon_sym_makeVCard] = ACTIONS(93),
-    [anon_sym_rawAction] = ACTIONS(93),
-    [anon_sym_embedFile] = ACTIONS(93),
-    [anon_sym_nothing] = ACTIONS(93),
-    [anon_sym_CurrentDate] = ACTIONS(96),
-    [anon_sym_Device] = ACTIONS(96),
-    [anon_sym_RepeatIndex] = ACTIONS(96),
-    [anon_sym_RepeatItem] = ACTIONS(96),
-    [anon_sym_ShortcutInput] = ACTIONS(96),
-    [anon_sym_Ask] = ACTIONS(96),
-    [anon_sym_text] = ACTIONS(99),
-    [anon_sym_number] = ACTIONS(99),
-    [anon_sym_bool] = ACTIONS(99),
-    [anon_sym_dictionary] = ACTIONS(99),
-    [anon_sym_array] = ACTIONS(99),
-    [anon_sym_variable] = ACTIONS(99),
-    [anon_sym_color] = ACTIONS(99),
-    [anon_sym_float] = ACTIONS(99),
-    [anon_sym_LPAREN] = ACTIONS(102),
-    [sym_at_variable] = ACTIONS(105),
-    [anon_sym_DQUOTE] = ACTIONS(108),
-    [anon_sym_SQUOTE] = ACTIONS(111),
-    [sym_number] = ACTIONS(114),
-    [anon_sym_SLASH_SLASH] = ACTIONS(3),
-    [anon_sym_SLASH_STAR] = ACTIONS(5),
-  },
-  [STATE(7)] = {
-    [sym__statement] = STATE(69),
-    [sym_pragma] = STATE(52),
-    [sym_pragma_directive] = STATE(85),
-    [sym_declaration] = STATE(52),
-    [sym_variable_assignment] = STATE(52),
-    [sym_constant_assignment] = STATE(52),
-    [sym_identifier_assignment] = STATE(52),
-    [sym_if_statement] = STATE(52),
-    [sym_for_statement] = STATE(52),
-    [sym_repeat_statement] = STATE(52),
-    [sym_menu_statement] = STATE(52),
-    [sym_item_statement] = STATE(52),
-    [sym_block] = STATE(52),
-    [sym__expression] = STATE(39),
-    [sym_dictionary] = STATE(17),
-    [sym_dictionary_pair] = STATE(112),
-    [sym_boolean] = STATE(17),
-    [sym_builtin_keyword] = STATE(28),
-    [sym_builtin_constant] = STATE(17),
-    [sym_type_keyword] = STATE(28),
-    [sym_parenthesized_expression] = STATE(17),
-    [sym_binary_expression] = STATE(17),
-    [sym_call] = STATE(17),
-    [sym_string] = STATE(46),
-    [sym_single_quoted_string] = STATE(17),
-    [sym_comment] = STATE(7),
-    [aux_sym_source_file_repeat1] = STATE(9),
-    [sym_identifier] = ACTIONS(117),
     [anon_sym_POUNDinclude] = ACTIONS(11),
     [anon_sym_POUNDdefine] = ACTIONS(11),
     [anon_sym_POUNDimport] = ACTIONS(11),
@@ -2965,77 +2973,160 @@ static const uint16_t ts_parse_table[LARGE_STATE_COUNT][SYMBOL_COUNT] = {
     [anon_sym_repeat] = ACTIONS(19),
     [anon_sym_menu] = ACTIONS(21),
     [anon_sym_item] = ACTIONS(23),
-    [anon_sym_LBRACE] = ACTIONS(25),
-    [anon_sym_RBRACE] = ACTIONS(119),
-    [anon_sym_true] = ACTIONS(27),
-    [anon_sym_false] = ACTIONS(27),
-    [anon_sym_name] = ACTIONS(29),
-    [anon_sym_glyph] = ACTIONS(29),
-    [anon_sym_from] = ACTIONS(29),
-    [anon_sym_mac] = ACTIONS(29),
-    [anon_sym_inputs] = ACTIONS(29),
-    [anon_sym_noinput] = ACTIONS(29),
-    [anon_sym_askfor] = ACTIONS(29),
-    [anon_sym_getclipboard] = ACTIONS(29),
-    [anon_sym_list] = ACTIONS(29),
-    [anon_sym_nil] = ACTIONS(29),
-    [anon_sym_action] = ACTIONS(29),
-    [anon_sym_stop] = ACTIONS(29),
-    [anon_sym_makeVCard] = ACTIONS(29),
-    [anon_sym_rawAction] = ACTIONS(29),
-    [anon_sym_embedFile] = ACTIONS(29),
-    [anon_sym_nothing] = ACTIONS(29),
-    [anon_sym_CurrentDate] = ACTIONS(31),
-    [anon_sym_Device] = ACTIONS(31),
-    [anon_sym_RepeatIndex] = ACTIONS(31),
-    [anon_sym_RepeatItem] = ACTIONS(31),
-    [anon_sym_ShortcutInput] = ACTIONS(31),
-    [anon_sym_Ask] = ACTIONS(31),
-    [anon_sym_text] = ACTIONS(33),
-    [anon_sym_number] = ACTIONS(33),
-    [anon_sym_bool] = ACTIONS(33),
-    [anon_sym_dictionary] = ACTIONS(33),
-    [anon_sym_array] = ACTIONS(33),
-    [anon_sym_variable] = ACTIONS(33),
-    [anon_sym_color] = ACTIONS(33),
-    [anon_sym_float] = ACTIONS(33),
-    [anon_sym_LPAREN] = ACTIONS(35),
-    [sym_at_variable] = ACTIONS(37),
-    [anon_sym_DQUOTE] = ACTIONS(39),
-    [anon_sym_SQUOTE] = ACTIONS(41),
-    [sym_number] = ACTIONS(43),
+    [anon_sym_action] = ACTIONS(25),
+    [anon_sym_LPAREN] = ACTIONS(27),
+    [anon_sym_LBRACE] = ACTIONS(29),
+    [anon_sym_RBRACE] = ACTIONS(65),
+    [anon_sym_true] = ACTIONS(31),
+    [anon_sym_false] = ACTIONS(31),
+    [anon_sym_name] = ACTIONS(33),
+    [anon_sym_glyph] = ACTIONS(33),
+    [anon_sym_from] = ACTIONS(33),
+    [anon_sym_mac] = ACTIONS(33),
+    [anon_sym_inputs] = ACTIONS(33),
+    [anon_sym_noinput] = ACTIONS(33),
+    [anon_sym_askfor] = ACTIONS(33),
+    [anon_sym_getclipboard] = ACTIONS(33),
+    [anon_sym_list] = ACTIONS(33),
+    [anon_sym_nil] = ACTIONS(33),
+    [anon_sym_stop] = ACTIONS(33),
+    [anon_sym_makeVCard] = ACTIONS(33),
+    [anon_sym_rawAction] = ACTIONS(33),
+    [anon_sym_embedFile] = ACTIONS(33),
+    [anon_sym_nothing] = ACTIONS(33),
+    [anon_sym_CurrentDate] = ACTIONS(35),
+    [anon_sym_Device] = ACTIONS(35),
+    [anon_sym_RepeatIndex] = ACTIONS(35),
+    [anon_sym_RepeatItem] = ACTIONS(35),
+    [anon_sym_ShortcutInput] = ACTIONS(35),
+    [anon_sym_Ask] = ACTIONS(35),
+    [anon_sym_text] = ACTIONS(37),
+    [anon_sym_number] = ACTIONS(37),
+    [anon_sym_bool] = ACTIONS(37),
+    [anon_sym_dictionary] = ACTIONS(37),
+    [anon_sym_array] = ACTIONS(37),
+    [anon_sym_variable] = ACTIONS(37),
+    [anon_sym_color] = ACTIONS(37),
+    [anon_sym_float] = ACTIONS(37),
+    [sym_at_variable] = ACTIONS(39),
+    [anon_sym_DQUOTE] = ACTIONS(41),
+    [anon_sym_SQUOTE] = ACTIONS(43),
+    [sym_number] = ACTIONS(45),
+    [anon_sym_SLASH_SLASH] = ACTIONS(3),
+    [anon_sym_SLASH_STAR] = ACTIONS(5),
+  },
+  [STATE(7)] = {
+    [sym__statement] = STATE(72),
+    [sym_pragma] = STATE(48),
+    [sym_pragma_directive] = STATE(88),
+    [sym_declaration] = STATE(48),
+    [sym_variable_assignment] = STATE(48),
+    [sym_constant_assignment] = STATE(48),
+    [sym_identifier_assignment] = STATE(48),
+    [sym_if_statement] = STATE(48),
+    [sym_for_statement] = STATE(48),
+    [sym_repeat_statement] = STATE(48),
+    [sym_menu_statement] = STATE(48),
+    [sym_item_statement] = STATE(48),
+    [sym_action_definition] = STATE(48),
+    [sym_block] = STATE(48),
+    [sym__expression] = STATE(40),
+    [sym_dictionary] = STATE(19),
+    [sym_boolean] = STATE(19),
+    [sym_builtin_keyword] = STATE(20),
+    [sym_builtin_constant] = STATE(19),
+    [sym_type_keyword] = STATE(20),
+    [sym_parenthesized_expression] = STATE(19),
+    [sym_binary_expression] = STATE(19),
+    [sym_call] = STATE(19),
+    [sym_string] = STATE(19),
+    [sym_single_quoted_string] = STATE(19),
+    [sym_comment] = STATE(7),
+    [aux_sym_source_file_repeat1] = STATE(7),
+    [ts_builtin_sym_end] = ACTIONS(67),
+    [sym_identifier] = ACTIONS(69),
+    [anon_sym_POUNDinclude] = ACTIONS(72),
+    [anon_sym_POUNDdefine] = ACTIONS(72),
+    [anon_sym_POUNDimport] = ACTIONS(72),
+    [anon_sym_POUNDquestion] = ACTIONS(72),
+    [anon_sym_const] = ACTIONS(75),
+    [anon_sym_if] = ACTIONS(78),
+    [anon_sym_for] = ACTIONS(81),
+    [anon_sym_repeat] = ACTIONS(84),
+    [anon_sym_menu] = ACTIONS(87),
+    [anon_sym_item] = ACTIONS(90),
+    [anon_sym_action] = ACTIONS(93),
+    [anon_sym_LPAREN] = ACTIONS(96),
+    [anon_sym_LBRACE] = ACTIONS(99),
+    [anon_sym_RBRACE] = ACTIONS(67),
+    [anon_sym_true] = ACTIONS(102),
+    [anon_sym_false] = ACTIONS(102),
+    [anon_sym_name] = ACTIONS(105),
+    [anon_sym_glyph] = ACTIONS(105),
+    [anon_sym_from] = ACTIONS(105),
+    [anon_sym_mac] = ACTIONS(105),
+    [anon_sym_inputs] = ACTIONS(105),
+    [anon_sym_noinput] = ACTIONS(105),
+    [anon_sym_askfor] = ACTIONS(105),
+    [anon_sym_getclipboard] = ACTIONS(105),
+    [anon_sym_list] = ACTIONS(105),
+    [anon_sym_nil] = ACTIONS(105),
+    [anon_sym_stop] = ACTIONS(105),
+    [anon_sym_makeVCard] = ACTIONS(105),
+    [anon_sym_rawAction] = ACTIONS(105),
+    [anon_sym_embedFile] = ACTIONS(105),
+    [anon_sym_nothing] = ACTIONS(105),
+    [anon_sym_CurrentDate] = ACTIONS(108),
+    [anon_sym_Device] = ACTIONS(108),
+    [anon_sym_RepeatIndex] = ACTIONS(108),
+    [anon_sym_RepeatItem] = ACTIONS(108),
+    [anon_sym_ShortcutInput] = ACTIONS(108),
+    [anon_sym_Ask] = ACTIONS(108),
+    [anon_sym_text] = ACTIONS(111),
+    [anon_sym_number] = ACTIONS(111),
+    [anon_sym_bool] = ACTIONS(111),
+    [anon_sym_dictionary] = ACTIONS(111),
+    [anon_sym_array] = ACTIONS(111),
+    [anon_sym_variable] = ACTIONS(111),
+    [anon_sym_color] = ACTIONS(111),
+    [anon_sym_float] = ACTIONS(111),
+    [sym_at_variable] = ACTIONS(114),
+    [anon_sym_DQUOTE] = ACTIONS(117),
+    [anon_sym_SQUOTE] = ACTIONS(120),
+    [sym_number] = ACTIONS(123),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(8)] = {
-    [sym__statement] = STATE(69),
-    [sym_pragma] = STATE(52),
-    [sym_pragma_directive] = STATE(85),
-    [sym_declaration] = STATE(52),
-    [sym_variable_assignment] = STATE(52),
-    [sym_constant_assignment] = STATE(52),
-    [sym_identifier_assignment] = STATE(52),
-    [sym_if_statement] = STATE(52),
-    [sym_for_statement] = STATE(52),
-    [sym_repeat_statement] = STATE(52),
-    [sym_menu_statement] = STATE(52),
-    [sym_item_statement] = STATE(52),
-    [sym_block] = STATE(52),
-    [sym__expression] = STATE(39),
-    [sym_dictionary] = STATE(17),
-    [sym_dictionary_pair] = STATE(112),
-    [sym_boolean] = STATE(17),
-    [sym_builtin_keyword] = STATE(28),
-    [sym_builtin_constant] = STATE(17),
-    [sym_type_keyword] = STATE(28),
-    [sym_parenthesized_expression] = STATE(17),
-    [sym_binary_expression] = STATE(17),
-    [sym_call] = STATE(17),
-    [sym_string] = STATE(46),
-    [sym_single_quoted_string] = STATE(17),
+    [sym__statement] = STATE(72),
+    [sym_pragma] = STATE(48),
+    [sym_pragma_directive] = STATE(88),
+    [sym_declaration] = STATE(48),
+    [sym_variable_assignment] = STATE(48),
+    [sym_constant_assignment] = STATE(48),
+    [sym_identifier_assignment] = STATE(48),
+    [sym_if_statement] = STATE(48),
+    [sym_for_statement] = STATE(48),
+    [sym_repeat_statement] = STATE(48),
+    [sym_menu_statement] = STATE(48),
+    [sym_item_statement] = STATE(48),
+    [sym_action_definition] = STATE(48),
+    [sym_block] = STATE(48),
+    [sym__expression] = STATE(40),
+    [sym_dictionary] = STATE(19),
+    [sym_dictionary_pair] = STATE(115),
+    [sym_boolean] = STATE(19),
+    [sym_builtin_keyword] = STATE(20),
+    [sym_builtin_constant] = STATE(19),
+    [sym_type_keyword] = STATE(20),
+    [sym_parenthesized_expression] = STATE(19),
+    [sym_binary_expression] = STATE(19),
+    [sym_call] = STATE(19),
+    [sym_string] = STATE(47),
+    [sym_single_quoted_string] = STATE(19),
     [sym_comment] = STATE(8),
-    [aux_sym_source_file_repeat1] = STATE(9),
-    [sym_identifier] = ACTIONS(117),
+    [aux_sym_source_file_repeat1] = STATE(10),
+    [sym_identifier] = ACTIONS(63),
     [anon_sym_POUNDinclude] = ACTIONS(11),
     [anon_sym_POUNDdefine] = ACTIONS(11),
     [anon_sym_POUNDimport] = ACTIONS(11),
@@ -3046,75 +3137,77 @@ static const uint16_t ts_parse_table[LARGE_STATE_COUNT][SYMBOL_COUNT] = {
     [anon_sym_repeat] = ACTIONS(19),
     [anon_sym_menu] = ACTIONS(21),
     [anon_sym_item] = ACTIONS(23),
-    [anon_sym_LBRACE] = ACTIONS(25),
-    [anon_sym_RBRACE] = ACTIONS(121),
-    [anon_sym_true] = ACTIONS(27),
-    [anon_sym_false] = ACTIONS(27),
-    [anon_sym_name] = ACTIONS(29),
-    [anon_sym_glyph] = ACTIONS(29),
-    [anon_sym_from] = ACTIONS(29),
-    [anon_sym_mac] = ACTIONS(29),
-    [anon_sym_inputs] = ACTIONS(29),
-    [anon_sym_noinput] = ACTIONS(29),
-    [anon_sym_askfor] = ACTIONS(29),
-    [anon_sym_getclipboard] = ACTIONS(29),
-    [anon_sym_list] = ACTIONS(29),
-    [anon_sym_nil] = ACTIONS(29),
-    [anon_sym_action] = ACTIONS(29),
-    [anon_sym_stop] = ACTIONS(29),
-    [anon_sym_makeVCard] = ACTIONS(29),
-    [anon_sym_rawAction] = ACTIONS(29),
-    [anon_sym_embedFile] = ACTIONS(29),
-    [anon_sym_nothing] = ACTIONS(29),
-    [anon_sym_CurrentDate] = ACTIONS(31),
-    [anon_sym_Device] = ACTIONS(31),
-    [anon_sym_RepeatIndex] = ACTIONS(31),
-    [anon_sym_RepeatItem] = ACTIONS(31),
-    [anon_sym_ShortcutInput] = ACTIONS(31),
-    [anon_sym_Ask] = ACTIONS(31),
-    [anon_sym_text] = ACTIONS(33),
-    [anon_sym_number] = ACTIONS(33),
-    [anon_sym_bool] = ACTIONS(33),
-    [anon_sym_dictionary] = ACTIONS(33),
-    [anon_sym_array] = ACTIONS(33),
-    [anon_sym_variable] = ACTIONS(33),
-    [anon_sym_color] = ACTIONS(33),
-    [anon_sym_float] = ACTIONS(33),
-    [anon_sym_LPAREN] = ACTIONS(35),
-    [sym_at_variable] = ACTIONS(37),
-    [anon_sym_DQUOTE] = ACTIONS(39),
-    [anon_sym_SQUOTE] = ACTIONS(41),
-    [sym_number] = ACTIONS(43),
+    [anon_sym_action] = ACTIONS(25),
+    [anon_sym_LPAREN] = ACTIONS(27),
+    [anon_sym_LBRACE] = ACTIONS(29),
+    [anon_sym_RBRACE] = ACTIONS(126),
+    [anon_sym_true] = ACTIONS(31),
+    [anon_sym_false] = ACTIONS(31),
+    [anon_sym_name] = ACTIONS(33),
+    [anon_sym_glyph] = ACTIONS(33),
+    [anon_sym_from] = ACTIONS(33),
+    [anon_sym_mac] = ACTIONS(33),
+    [anon_sym_inputs] = ACTIONS(33),
+    [anon_sym_noinput] = ACTIONS(33),
+    [anon_sym_askfor] = ACTIONS(33),
+    [anon_sym_getclipboard] = ACTIONS(33),
+    [anon_sym_list] = ACTIONS(33),
+    [anon_sym_nil] = ACTIONS(33),
+    [anon_sym_stop] = ACTIONS(33),
+    [anon_sym_makeVCard] = ACTIONS(33),
+    [anon_sym_rawAction] = ACTIONS(33),
+    [anon_sym_embedFile] = ACTIONS(33),
+    [anon_sym_nothing] = ACTIONS(33),
+    [anon_sym_CurrentDate] = ACTIONS(35),
+    [anon_sym_Device] = ACTIONS(35),
+    [anon_sym_RepeatIndex] = ACTIONS(35),
+    [anon_sym_RepeatItem] = ACTIONS(35),
+    [anon_sym_ShortcutInput] = ACTIONS(35),
+    [anon_sym_Ask] = ACTIONS(35),
+    [anon_sym_text] = ACTIONS(37),
+    [anon_sym_number] = ACTIONS(37),
+    [anon_sym_bool] = ACTIONS(37),
+    [anon_sym_dictionary] = ACTIONS(37),
+    [anon_sym_array] = ACTIONS(37),
+    [anon_sym_variable] = ACTIONS(37),
+    [anon_sym_color] = ACTIONS(37),
+    [anon_sym_float] = ACTIONS(37),
+    [sym_at_variable] = ACTIONS(39),
+    [anon_sym_DQUOTE] = ACTIONS(41),
+    [anon_sym_SQUOTE] = ACTIONS(43),
+    [sym_number] = ACTIONS(45),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(9)] = {
-    [sym__statement] = STATE(69),
-    [sym_pragma] = STATE(52),
-    [sym_pragma_directive] = STATE(85),
-    [sym_declaration] = STATE(52),
-    [sym_variable_assignment] = STATE(52),
-    [sym_constant_assignment] = STATE(52),
-    [sym_identifier_assignment] = STATE(52),
-    [sym_if_statement] = STATE(52),
-    [sym_for_statement] = STATE(52),
-    [sym_repeat_statement] = STATE(52),
-    [sym_menu_statement] = STATE(52),
-    [sym_item_statement] = STATE(52),
-    [sym_block] = STATE(52),
-    [sym__expression] = STATE(39),
-    [sym_dictionary] = STATE(17),
-    [sym_boolean] = STATE(17),
-    [sym_builtin_keyword] = STATE(28),
-    [sym_builtin_constant] = STATE(17),
-    [sym_type_keyword] = STATE(28),
-    [sym_parenthesized_expression] = STATE(17),
-    [sym_binary_expression] = STATE(17),
-    [sym_call] = STATE(17),
-    [sym_string] = STATE(17),
-    [sym_single_quoted_string] = STATE(17),
+    [sym__statement] = STATE(72),
+    [sym_pragma] = STATE(48),
+    [sym_pragma_directive] = STATE(88),
+    [sym_declaration] = STATE(48),
+    [sym_variable_assignment] = STATE(48),
+    [sym_constant_assignment] = STATE(48),
+    [sym_identifier_assignment] = STATE(48),
+    [sym_if_statement] = STATE(48),
+    [sym_for_statement] = STATE(48),
+    [sym_repeat_statement] = STATE(48),
+    [sym_menu_statement] = STATE(48),
+    [sym_item_statement] = STATE(48),
+    [sym_action_definition] = STATE(48),
+    [sym_block] = STATE(48),
+    [sym__expression] = STATE(40),
+    [sym_dictionary] = STATE(19),
+    [sym_boolean] = STATE(19),
+    [sym_builtin_keyword] = STATE(20),
+    [sym_builtin_constant] = STATE(19),
+    [sym_type_keyword] = STATE(20),
+    [sym_parenthesized_expression] = STATE(19),
+    [sym_binary_expression] = STATE(19),
+    [sym_call] = STATE(19),
+    [sym_string] = STATE(19),
+    [sym_single_quoted_string] = STATE(19),
     [sym_comment] = STATE(9),
-    [aux_sym_source_file_repeat1] = STATE(6),
+    [aux_sym_source_file_repeat1] = STATE(7),
+    [ts_builtin_sym_end] = ACTIONS(128),
     [sym_identifier] = ACTIONS(9),
     [anon_sym_POUNDinclude] = ACTIONS(11),
     [anon_sym_POUNDdefine] = ACTIONS(11),
@@ -3126,76 +3219,75 @@ static const uint16_t ts_parse_table[LARGE_STATE_COUNT][SYMBOL_COUNT] = {
     [anon_sym_repeat] = ACTIONS(19),
     [anon_sym_menu] = ACTIONS(21),
     [anon_sym_item] = ACTIONS(23),
-    [anon_sym_LBRACE] = ACTIONS(25),
-    [anon_sym_RBRACE] = ACTIONS(123),
-    [anon_sym_true] = ACTIONS(27),
-    [anon_sym_false] = ACTIONS(27),
-    [anon_sym_name] = ACTIONS(29),
-    [anon_sym_glyph] = ACTIONS(29),
-    [anon_sym_from] = ACTIONS(29),
-    [anon_sym_mac] = ACTIONS(29),
-    [anon_sym_inputs] = ACTIONS(29),
-    [anon_sym_noinput] = ACTIONS(29),
-    [anon_sym_askfor] = ACTIONS(29),
-    [anon_sym_getclipboard] = ACTIONS(29),
-    [anon_sym_list] = ACTIONS(29),
-    [anon_sym_nil] = ACTIONS(29),
-    [anon_sym_action] = ACTIONS(29),
-    [anon_sym_stop] = ACTIONS(29),
-    [anon_sym_makeVCard] = ACTIONS(29),
-    [anon_sym_rawAction] = ACTIONS(29),
-    [anon_sym_embedFile] = ACTIONS(29),
-    [anon_sym_nothing] = ACTIONS(29),
-    [anon_sym_CurrentDate] = ACTIONS(31),
-    [anon_sym_Device] = ACTIONS(31),
-    [anon_sym_RepeatIndex] = ACTIONS(31),
-    [anon_sym_RepeatItem] = ACTIONS(31),
-    [anon_sym_ShortcutInput] = ACTIONS(31),
-    [anon_sym_Ask] = ACTIONS(31),
-    [anon_sym_text] = ACTIONS(33),
-    [anon_sym_number] = ACTIONS(33),
-    [anon_sym_bool] = ACTIONS(33),
-    [anon_sym_dictionary] = ACTIONS(33),
-    [anon_sym_array] = ACTIONS(33),
-    [anon_sym_variable] = ACTIONS(33),
-    [anon_sym_color] = ACTIONS(33),
-    [anon_sym_float] = ACTIONS(33),
-    [anon_sym_LPAREN] = ACTIONS(35),
-    [sym_at_variable] = ACTIONS(37),
-    [anon_sym_DQUOTE] = ACTIONS(39),
-    [anon_sym_SQUOTE] = ACTIONS(41),
-    [sym_number] = ACTIONS(43),
+    [anon_sym_action] = ACTIONS(25),
+    [anon_sym_LPAREN] = ACTIONS(27),
+    [anon_sym_LBRACE] = ACTIONS(29),
+    [anon_sym_true] = ACTIONS(31),
+    [anon_sym_false] = ACTIONS(31),
+    [anon_sym_name] = ACTIONS(33),
+    [anon_sym_glyph] = ACTIONS(33),
+    [anon_sym_from] = ACTIONS(33),
+    [anon_sym_mac] = ACTIONS(33),
+    [anon_sym_inputs] = ACTIONS(33),
+    [anon_sym_noinput] = ACTIONS(33),
+    [anon_sym_askfor] = ACTIONS(33),
+    [anon_sym_getclipboard] = ACTIONS(33),
+    [anon_sym_list] = ACTIONS(33),
+    [anon_sym_nil] = ACTIONS(33),
+    [anon_sym_stop] = ACTIONS(33),
+    [anon_sym_makeVCard] = ACTIONS(33),
+    [anon_sym_rawAction] = ACTIONS(33),
+    [anon_sym_embedFile] = ACTIONS(33),
+    [anon_sym_nothing] = ACTIONS(33),
+    [anon_sym_CurrentDate] = ACTIONS(35),
+    [anon_sym_Device] = ACTIONS(35),
+    [anon_sym_RepeatIndex] = ACTIONS(35),
+    [anon_sym_RepeatItem] = ACTIONS(35),
+    [anon_sym_ShortcutInput] = ACTIONS(35),
+    [anon_sym_Ask] = ACTIONS(35),
+    [anon_sym_text] = ACTIONS(37),
+    [anon_sym_number] = ACTIONS(37),
+    [anon_sym_bool] = ACTIONS(37),
+    [anon_sym_dictionary] = ACTIONS(37),
+    [anon_sym_array] = ACTIONS(37),
+    [anon_sym_variable] = ACTIONS(37),
+    [anon_sym_color] = ACTIONS(37),
+    [anon_sym_float] = ACTIONS(37),
+    [sym_at_variable] = ACTIONS(39),
+    [anon_sym_DQUOTE] = ACTIONS(41),
+    [anon_sym_SQUOTE] = ACTIONS(43),
+    [sym_number] = ACTIONS(45),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(10)] = {
-    [sym__statement] = STATE(69),
-    [sym_pragma] = STATE(52),
-    [sym_pragma_directive] = STATE(85),
-    [sym_declaration] = STATE(52),
-    [sym_variable_assignment] = STATE(52),
-    [sym_constant_assignment] = STATE(52),
-    [sym_identifier_assignment] = STATE(52),
-    [sym_if_statement] = STATE(52),
-    [sym_for_statement] = STATE(52),
-    [sym_repeat_statement] = STATE(52),
-    [sym_menu_statement] = STATE(52),
-    [sym_item_statement] = STATE(52),
-    [sym_block] = STATE(52),
-    [sym__expression] = STATE(39),
-    [sym_dictionary] = STATE(17),
-    [sym_boolean] = STATE(17),
-    [sym_builtin_keyword] = STATE(28),
-    [sym_builtin_constant] = STATE(17),
-    [sym_type_keyword] = STATE(28),
-    [sym_parenthesized_expression] = STATE(17),
-    [sym_binary_expression] = STATE(17),
-    [sym_call] = STATE(17),
-    [sym_string] = STATE(17),
-    [sym_single_quoted_string] = STATE(17),
+    [sym__statement] = STATE(72),
+    [sym_pragma] = STATE(48),
+    [sym_pragma_directive] = STATE(88),
+    [sym_declaration] = STATE(48),
+    [sym_variable_assignment] = STATE(48),
+    [sym_constant_assignment] = STATE(48),
+    [sym_identifier_assignment] = STATE(48),
+    [sym_if_statement] = STATE(48),
+    [sym_for_statement] = STATE(48),
+    [sym_repeat_statement] = STATE(48),
+    [sym_menu_statement] = STATE(48),
+    [sym_item_statement] = STATE(48),
+    [sym_action_definition] = STATE(48),
+    [sym_block] = STATE(48),
+    [sym__expression] = STATE(40),
+    [sym_dictionary] = STATE(19),
+    [sym_boolean] = STATE(19),
+    [sym_builtin_keyword] = STATE(20),
+    [sym_builtin_constant] = STATE(19),
+    [sym_type_keyword] = STATE(20),
+    [sym_parenthesized_expression] = STATE(19),
+    [sym_binary_expression] = STATE(19),
+    [sym_call] = STATE(19),
+    [sym_string] = STATE(19),
+    [sym_single_quoted_string] = STATE(19),
     [sym_comment] = STATE(10),
-    [aux_sym_source_file_repeat1] = STATE(6),
-    [ts_builtin_sym_end] = ACTIONS(125),
+    [aux_sym_source_file_repeat1] = STATE(7),
     [sym_identifier] = ACTIONS(9),
     [anon_sym_POUNDinclude] = ACTIONS(11),
     [anon_sym_POUNDdefine] = ACTIONS(11),
@@ -3207,74 +3299,76 @@ static const uint16_t ts_parse_table[LARGE_STATE_COUNT][SYMBOL_COUNT] = {
     [anon_sym_repeat] = ACTIONS(19),
     [anon_sym_menu] = ACTIONS(21),
     [anon_sym_item] = ACTIONS(23),
-    [anon_sym_LBRACE] = ACTIONS(25),
-    [anon_sym_true] = ACTIONS(27),
-    [anon_sym_false] = ACTIONS(27),
-    [anon_sym_name] = ACTIONS(29),
-    [anon_sym_glyph] = ACTIONS(29),
-    [anon_sym_from] = ACTIONS(29),
-    [anon_sym_mac] = ACTIONS(29),
-    [anon_sym_inputs] = ACTIONS(29),
-    [anon_sym_noinput] = ACTIONS(29),
-    [anon_sym_askfor] = ACTIONS(29),
-    [anon_sym_getclipboard] = ACTIONS(29),
-    [anon_sym_list] = ACTIONS(29),
-    [anon_sym_nil] = ACTIONS(29),
-    [anon_sym_action] = ACTIONS(29),
-    [anon_sym_stop] = ACTIONS(29),
-    [anon_sym_makeVCard] = ACTIONS(29),
-    [anon_sym_rawAction] = ACTIONS(29),
-    [anon_sym_embedFile] = ACTIONS(29),
-    [anon_sym_nothing] = ACTIONS(29),
-    [anon_sym_CurrentDate] = ACTIONS(31),
-    [anon_sym_Device] = ACTIONS(31),
-    [anon_sym_RepeatIndex] = ACTIONS(31),
-    [anon_sym_RepeatItem] = ACTIONS(31),
-    [anon_sym_ShortcutInput] = ACTIONS(31),
-    [anon_sym_Ask] = ACTIONS(31),
-    [anon_sym_text] = ACTIONS(33),
-    [anon_sym_number] = ACTIONS(33),
-    [anon_sym_bool] = ACTIONS(33),
-    [anon_sym_dictionary] = ACTIONS(33),
-    [anon_sym_array] = ACTIONS(33),
-    [anon_sym_variable] = ACTIONS(33),
-    [anon_sym_color] = ACTIONS(33),
-    [anon_sym_float] = ACTIONS(33),
-    [anon_sym_LPAREN] = ACTIONS(35),
-    [sym_at_variable] = ACTIONS(37),
-    [anon_sym_DQUOTE] = ACTIONS(39),
-    [anon_sym_SQUOTE] = ACTIONS(41),
-    [sym_number] = ACTIONS(43),
+    [anon_sym_action] = ACTIONS(25),
+    [anon_sym_LPAREN] = ACTIONS(27),
+    [anon_sym_LBRACE] = ACTIONS(29),
+    [anon_sym_RBRACE] = ACTIONS(130),
+    [anon_sym_true] = ACTIONS(31),
+    [anon_sym_false] = ACTIONS(31),
+    [anon_sym_name] = ACTIONS(33),
+    [anon_sym_glyph] = ACTIONS(33),
+    [anon_sym_from] = ACTIONS(33),
+    [anon_sym_mac] = ACTIONS(33),
+    [anon_sym_inputs] = ACTIONS(33),
+    [anon_sym_noinput] = ACTIONS(33),
+    [anon_sym_askfor] = ACTIONS(33),
+    [anon_sym_getclipboard] = ACTIONS(33),
+    [anon_sym_list] = ACTIONS(33),
+    [anon_sym_nil] = ACTIONS(33),
+    [anon_sym_stop] = ACTIONS(33),
+    [anon_sym_makeVCard] = ACTIONS(33),
+    [anon_sym_rawAction] = ACTIONS(33),
+    [anon_sym_embedFile] = ACTIONS(33),
+    [anon_sym_nothing] = ACTIONS(33),
+    [anon_sym_CurrentDate] = ACTIONS(35),
+    [anon_sym_Device] = ACTIONS(35),
+    [anon_sym_RepeatIndex] = ACTIONS(35),
+    [anon_sym_RepeatItem] = ACTIONS(35),
+    [anon_sym_ShortcutInput] = ACTIONS(35),
+    [anon_sym_Ask] = ACTIONS(35),
+    [anon_sym_text] = ACTIONS(37),
+    [anon_sym_number] = ACTIONS(37),
+    [anon_sym_bool] = ACTIONS(37),
+    [anon_sym_dictionary] = ACTIONS(37),
+    [anon_sym_array] = ACTIONS(37),
+    [anon_sym_variable] = ACTIONS(37),
+    [anon_sym_color] = ACTIONS(37),
+    [anon_sym_float] = ACTIONS(37),
+    [sym_at_variable] = ACTIONS(39),
+    [anon_sym_DQUOTE] = ACTIONS(41),
+    [anon_sym_SQUOTE] = ACTIONS(43),
+    [sym_number] = ACTIONS(45),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(11)] = {
-    [sym__statement] = STATE(69),
-    [sym_pragma] = STATE(52),
-    [sym_pragma_directive] = STATE(85),
-    [sym_declaration] = STATE(52),
-    [sym_variable_assignment] = STATE(52),
-    [sym_constant_assignment] = STATE(52),
-    [sym_identifier_assignment] = STATE(52),
-    [sym_if_statement] = STATE(52),
-    [sym_for_statement] = STATE(52),
-    [sym_repeat_statement] = STATE(52),
-    [sym_menu_statement] = STATE(52),
-    [sym_item_statement] = STATE(52),
-    [sym_block] = STATE(52),
-    [sym__expression] = STATE(39),
-    [sym_dictionary] = STATE(17),
-    [sym_boolean] = STATE(17),
-    [sym_builtin_keyword] = STATE(28),
-    [sym_builtin_constant] = STATE(17),
-    [sym_type_keyword] = STATE(28),
-    [sym_parenthesized_expression] = STATE(17),
-    [sym_binary_expression] = STATE(17),
-    [sym_call] = STATE(17),
-    [sym_string] = STATE(17),
-    [sym_single_quoted_string] = STATE(17),
+    [sym__statement] = STATE(72),
+    [sym_pragma] = STATE(48),
+    [sym_pragma_directive] = STATE(88),
+    [sym_declaration] = STATE(48),
+    [sym_variable_assignment] = STATE(48),
+    [sym_constant_assignment] = STATE(48),
+    [sym_identifier_assignment] = STATE(48),
+    [sym_if_statement] = STATE(48),
+    [sym_for_statement] = STATE(48),
+    [sym_repeat_statement] = STATE(48),
+    [sym_menu_statement] = STATE(48),
+    [sym_item_statement] = STATE(48),
+    [sym_action_definition] = STATE(48),
+    [sym_block] = STATE(48),
+    [sym__expression] = STATE(40),
+    [sym_dictionary] = STATE(19),
+    [sym_boolean] = STATE(19),
+    [sym_builtin_keyword] = STATE(20),
+    [sym_builtin_constant] = STATE(19),
+    [sym_type_keyword] = STATE(20),
+    [sym_parenthesized_expression] = STATE(19),
+    [sym_binary_expression] = STATE(19),
+    [sym_call] = STATE(19),
+    [sym_string] = STATE(19),
+    [sym_single_quoted_string] = STATE(19),
     [sym_comment] = STATE(11),
-    [aux_sym_source_file_repeat1] = STATE(9),
+    [aux_sym_source_file_repeat1] = STATE(10),
     [sym_identifier] = ACTIONS(9),
     [anon_sym_POUNDinclude] = ACTIONS(11),
     [anon_sym_POUNDdefine] = ACTIONS(11),
@@ -3286,75 +3380,76 @@ static const uint16_t ts_parse_table[LARGE_STATE_COUNT][SYMBOL_COUNT] = {
     [anon_sym_repeat] = ACTIONS(19),
     [anon_sym_menu] = ACTIONS(21),
     [anon_sym_item] = ACTIONS(23),
-    [anon_sym_LBRACE] = ACTIONS(25),
-    [anon_sym_RBRACE] = ACTIONS(127),
-    [anon_sym_true] = ACTIONS(27),
-    [anon_sym_false] = ACTIONS(27),
-    [anon_sym_name] = ACTIONS(29),
-    [anon_sym_glyph] = ACTIONS(29),
-    [anon_sym_from] = ACTIONS(29),
-    [anon_sym_mac] = ACTIONS(29),
-    [anon_sym_inputs] = ACTIONS(29),
-    [anon_sym_noinput] = ACTIONS(29),
-    [anon_sym_askfor] = ACTIONS(29),
-    [anon_sym_getclipboard] = ACTIONS(29),
-    [anon_sym_list] = ACTIONS(29),
-    [anon_sym_nil] = ACTIONS(29),
-    [anon_sym_action] = ACTIONS(29),
-    [anon_sym_stop] = ACTIONS(29),
-    [anon_sym_makeVCard] = ACTIONS(29),
-    [anon_sym_rawAction] = ACTIONS(29),
-    [anon_sym_embedFile] = ACTIONS(29),
-    [anon_sym_nothing] = ACTIONS(29),
-    [anon_sym_CurrentDate] = ACTIONS(31),
-    [anon_sym_Device] = ACTIONS(31),
-    [anon_sym_RepeatIndex] = ACTIONS(31),
-    [anon_sym_RepeatItem] = ACTIONS(31),
-    [anon_sym_ShortcutInput] = ACTIONS(31),
-    [anon_sym_Ask] = ACTIONS(31),
-    [anon_sym_text] = ACTIONS(33),
-    [anon_sym_number] = ACTIONS(33),
-    [anon_sym_bool] = ACTIONS(33),
-    [anon_sym_dictionary] = ACTIONS(33),
-    [anon_sym_array] = ACTIONS(33),
-    [anon_sym_variable] = ACTIONS(33),
-    [anon_sym_color] = ACTIONS(33),
-    [anon_sym_float] = ACTIONS(33),
-    [anon_sym_LPAREN] = ACTIONS(35),
-    [sym_at_variable] = ACTIONS(37),
-    [anon_sym_DQUOTE] = ACTIONS(39),
-    [anon_sym_SQUOTE] = ACTIONS(41),
-    [sym_number] = ACTIONS(43),
+    [anon_sym_action] = ACTIONS(25),
+    [anon_sym_LPAREN] = ACTIONS(27),
+    [anon_sym_LBRACE] = ACTIONS(29),
+    [anon_sym_RBRACE] = ACTIONS(132),
+    [anon_sym_true] = ACTIONS(31),
+    [anon_sym_false] = ACTIONS(31),
+    [anon_sym_name] = ACTIONS(33),
+    [anon_sym_glyph] = ACTIONS(33),
+    [anon_sym_from] = ACTIONS(33),
+    [anon_sym_mac] = ACTIONS(33),
+    [anon_sym_inputs] = ACTIONS(33),
+    [anon_sym_noinput] = ACTIONS(33),
+    [anon_sym_askfor] = ACTIONS(33),
+    [anon_sym_getclipboard] = ACTIONS(33),
+    [anon_sym_list] = ACTIONS(33),
+    [anon_sym_nil] = ACTIONS(33),
+    [anon_sym_stop] = ACTIONS(33),
+    [anon_sym_makeVCard] = ACTIONS(33),
+    [anon_sym_rawAction] = ACTIONS(33),
+    [anon_sym_embedFile] = ACTIONS(33),
+    [anon_sym_nothing] = ACTIONS(33),
+    [anon_sym_CurrentDate] = ACTIONS(35),
+    [anon_sym_Device] = ACTIONS(35),
+    [anon_sym_RepeatIndex] = ACTIONS(35),
+    [anon_sym_RepeatItem] = ACTIONS(35),
+    [anon_sym_ShortcutInput] = ACTIONS(35),
+    [anon_sym_Ask] = ACTIONS(35),
+    [anon_sym_text] = ACTIONS(37),
+    [anon_sym_number] = ACTIONS(37),
+    [anon_sym_bool] = ACTIONS(37),
+    [anon_sym_dictionary] = ACTIONS(37),
+    [anon_sym_array] = ACTIONS(37),
+    [anon_sym_variable] = ACTIONS(37),
+    [anon_sym_color] = ACTIONS(37),
+    [anon_sym_float] = ACTIONS(37),
+    [sym_at_variable] = ACTIONS(39),
+    [anon_sym_DQUOTE] = ACTIONS(41),
+    [anon_sym_SQUOTE] = ACTIONS(43),
+    [sym_number] = ACTIONS(45),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(12)] = {
-    [sym__statement] = STATE(61),
-    [sym_pragma] = STATE(52),
-    [sym_pragma_directive] = STATE(85),
-    [sym_declaration] = STATE(52),
-    [sym_variable_assignment] = STATE(52),
-    [sym_constant_assignment] = STATE(52),
-    [sym_identifier_assignment] = STATE(52),
-    [sym_if_statement] = STATE(52),
-    [sym_for_statement] = STATE(52),
-    [sym_repeat_statement] = STATE(52),
-    [sym_menu_statement] = STATE(52),
-    [sym_item_statement] = STATE(52),
-    [sym_block] = STATE(62),
-    [sym__expression] = STATE(39),
-    [sym_dictionary] = STATE(17),
-    [sym_boolean] = STATE(17),
-    [sym_builtin_keyword] = STATE(28),
-    [sym_builtin_constant] = STATE(17),
-    [sym_type_keyword] = STATE(28),
-    [sym_parenthesized_expression] = STATE(17),
-    [sym_binary_expression] = STATE(17),
-    [sym_call] = STATE(17),
-    [sym_string] = STATE(17),
-    [sym_single_quoted_string] = STATE(17),
+    [sym__statement] = STATE(49),
+    [sym_pragma] = STATE(48),
+    [sym_pragma_directive] = STATE(88),
+    [sym_declaration] = STATE(48),
+    [sym_variable_assignment] = STATE(48),
+    [sym_constant_assignment] = STATE(48),
+    [sym_identifier_assignment] = STATE(48),
+    [sym_if_statement] = STATE(48),
+    [sym_for_statement] = STATE(48),
+    [sym_repeat_statement] = STATE(48),
+    [sym_menu_statement] = STATE(48),
+    [sym_item_statement] = STATE(48),
+    [sym_action_definition] = STATE(48),
+    [sym_block] = STATE(50),
+    [sym__expression] = STATE(2),
+    [sym_dictionary] = STATE(19),
+    [sym_boolean] = STATE(19),
+    [sym_builtin_keyword] = STATE(20),
+    [sym_builtin_constant] = STATE(19),
+    [sym_type_keyword] = STATE(20),
+    [sym_parenthesized_expression] = STATE(19),
+    [sym_binary_expression] = STATE(19),
+    [sym_call] = STATE(19),
+    [sym_string] = STATE(19),
+    [sym_single_quoted_string] = STATE(19),
     [sym_comment] = STATE(12),
-    [sym_identifier] = ACTIONS(9),
+    [sym_identifier] = ACTIONS(134),
     [anon_sym_POUNDinclude] = ACTIONS(11),
     [anon_sym_POUNDdefine] = ACTIONS(11),
     [anon_sym_POUNDimport] = ACTIONS(11),
@@ -3365,72 +3460,73 @@ static const uint16_t ts_parse_table[LARGE_STATE_COUNT][SYMBOL_COUNT] = {
     [anon_sym_repeat] = ACTIONS(19),
     [anon_sym_menu] = ACTIONS(21),
     [anon_sym_item] = ACTIONS(23),
-    [anon_sym_LBRACE] = ACTIONS(25),
-    [anon_sym_true] = ACTIONS(27),
-    [anon_sym_false] = ACTIONS(27),
-    [anon_sym_name] = ACTIONS(29),
-    [anon_sym_glyph] = ACTIONS(29),
-    [anon_sym_from] = ACTIONS(29),
-    [anon_sym_mac] = ACTIONS(29),
-    [anon_sym_inputs] = ACTIONS(29),
-    [anon_sym_noinput] = ACTIONS(29),
-    [anon_sym_askfor] = ACTIONS(29),
-    [anon_sym_getclipboard] = ACTIONS(29),
-    [anon_sym_list] = ACTIONS(29),
-    [anon_sym_nil] = ACTIONS(29),
-    [anon_sym_action] = ACTIONS(29),
-    [anon_sym_stop] = ACTIONS(29),
-    [anon_sym_makeVCard] = ACTIONS(29),
-    [anon_sym_rawAction] = ACTIONS(29),
-    [anon_sym_embedFile] = ACTIONS(29),
-    [anon_sym_nothing] = ACTIONS(29),
-    [anon_sym_CurrentDate] = ACTIONS(31),
-    [anon_sym_Device] = ACTIONS(31),
-    [anon_sym_RepeatIndex] = ACTIONS(31),
-    [anon_sym_RepeatItem] = ACTIONS(31),
-    [anon_sym_ShortcutInput] = ACTIONS(31),
-    [anon_sym_Ask] = ACTIONS(31),
-    [anon_sym_text] = ACTIONS(33),
-    [anon_sym_number] = ACTIONS(33),
-    [anon_sym_bool] = ACTIONS(33),
-    [anon_sym_dictionary] = ACTIONS(33),
-    [anon_sym_array] = ACTIONS(33),
-    [anon_sym_variable] = ACTIONS(33),
-    [anon_sym_color] = ACTIONS(33),
-    [anon_sym_float] = ACTIONS(33),
-    [anon_sym_LPAREN] = ACTIONS(35),
-    [sym_at_variable] = ACTIONS(37),
-    [anon_sym_DQUOTE] = ACTIONS(39),
-    [anon_sym_SQUOTE] = ACTIONS(41),
-    [sym_number] = ACTIONS(43),
+    [anon_sym_action] = ACTIONS(25),
+    [anon_sym_LPAREN] = ACTIONS(27),
+    [anon_sym_LBRACE] = ACTIONS(29),
+    [anon_sym_true] = ACTIONS(31),
+    [anon_sym_false] = ACTIONS(31),
+    [anon_sym_name] = ACTIONS(33),
+    [anon_sym_glyph] = ACTIONS(33),
+    [anon_sym_from] = ACTIONS(33),
+    [anon_sym_mac] = ACTIONS(33),
+    [anon_sym_inputs] = ACTIONS(33),
+    [anon_sym_noinput] = ACTIONS(33),
+    [anon_sym_askfor] = ACTIONS(33),
+    [anon_sym_getclipboard] = ACTIONS(33),
+    [anon_sym_list] = ACTIONS(33),
+    [anon_sym_nil] = ACTIONS(33),
+    [anon_sym_stop] = ACTIONS(33),
+    [anon_sym_makeVCard] = ACTIONS(33),
+    [anon_sym_rawAction] = ACTIONS(33),
+    [anon_sym_embedFile] = ACTIONS(33),
+    [anon_sym_nothing] = ACTIONS(33),
+    [anon_sym_CurrentDate] = ACTIONS(35),
+    [anon_sym_Device] = ACTIONS(35),
+    [anon_sym_RepeatIndex] = ACTIONS(35),
+    [anon_sym_RepeatItem] = ACTIONS(35),
+    [anon_sym_ShortcutInput] = ACTIONS(35),
+    [anon_sym_Ask] = ACTIONS(35),
+    [anon_sym_text] = ACTIONS(37),
+    [anon_sym_number] = ACTIONS(37),
+    [anon_sym_bool] = ACTIONS(37),
+    [anon_sym_dictionary] = ACTIONS(37),
+    [anon_sym_array] = ACTIONS(37),
+    [anon_sym_variable] = ACTIONS(37),
+    [anon_sym_color] = ACTIONS(37),
+    [anon_sym_float] = ACTIONS(37),
+    [sym_at_variable] = ACTIONS(39),
+    [anon_sym_DQUOTE] = ACTIONS(41),
+    [anon_sym_SQUOTE] = ACTIONS(43),
+    [sym_number] = ACTIONS(45),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(13)] = {
-    [sym__statement] = STATE(64),
-    [sym_pragma] = STATE(52),
-    [sym_pragma_directive] = STATE(85),
-    [sym_declaration] = STATE(52),
-    [sym_variable_assignment] = STATE(52),
-    [sym_constant_assignment] = STATE(52),
-    [sym_identifier_assignment] = STATE(52),
-    [sym_if_statement] = STATE(52),
-    [sym_for_statement] = STATE(52),
-    [sym_repeat_statement] = STATE(52),
-    [sym_menu_statement] = STATE(52),
-    [sym_item_statement] = STATE(52),
-    [sym_block] = STATE(63),
-    [sym__expression] = STATE(39),
-    [sym_dictionary] = STATE(17),
-    [sym_boolean] = STATE(17),
-    [sym_builtin_keyword] = STATE(28),
-    [sym_builtin_constant] = STATE(17),
-    [sym_type_keyword] = STATE(28),
-    [sym_parenthesized_expression] = STATE(17),
-    [sym_binary_expression] = STATE(17),
-    [sym_call] = STATE(17),
-    [sym_string] = STATE(17),
-    [sym_single_quoted_string] = STATE(17),
+    [sym__statement] = STATE(61),
+    [sym_pragma] = STATE(48),
+    [sym_pragma_directive] = STATE(88),
+    [sym_declaration] = STATE(48),
+    [sym_variable_assignment] = STATE(48),
+    [sym_constant_assignment] = STATE(48),
+    [sym_identifier_assignment] = STATE(48),
+    [sym_if_statement] = STATE(48),
+    [sym_for_statement] = STATE(48),
+    [sym_repeat_statement] = STATE(48),
+    [sym_menu_statement] = STATE(48),
+    [sym_item_statement] = STATE(48),
+    [sym_action_definition] = STATE(48),
+    [sym_block] = STATE(62),
+    [sym__expression] = STATE(40),
+    [sym_dictionary] = STATE(19),
+    [sym_boolean] = STATE(19),
+    [sym_builtin_keyword] = STATE(20),
+    [sym_builtin_constant] = STATE(19),
+    [sym_type_keyword] = STATE(20),
+    [sym_parenthesized_expression] = STATE(19),
+    [sym_binary_expression] = STATE(19),
+    [sym_call] = STATE(19),
+    [sym_string] = STATE(19),
+    [sym_single_quoted_string] = STATE(19),
     [sym_comment] = STATE(13),
     [sym_identifier] = ACTIONS(9),
     [anon_sym_POUNDinclude] = ACTIONS(11),
@@ -3443,74 +3539,75 @@ static const uint16_t ts_parse_table[LARGE_STATE_COUNT][SYMBOL_COUNT] = {
     [anon_sym_repeat] = ACTIONS(19),
     [anon_sym_menu] = ACTIONS(21),
     [anon_sym_item] = ACTIONS(23),
-    [anon_sym_LBRACE] = ACTIONS(25),
-    [anon_sym_true] = ACTIONS(27),
-    [anon_sym_false] = ACTIONS(27),
-    [anon_sym_name] = ACTIONS(29),
-    [anon_sym_glyph] = ACTIONS(29),
-    [anon_sym_from] = ACTIONS(29),
-    [anon_sym_mac] = ACTIONS(29),
-    [anon_sym_inputs] = ACTIONS(29),
-    [anon_sym_noinput] = ACTIONS(29),
-    [anon_sym_askfor] = ACTIONS(29),
-    [anon_sym_getclipboard] = ACTIONS(29),
-    [anon_sym_list] = ACTIONS(29),
-    [anon_sym_nil] = ACTIONS(29),
-    [anon_sym_action] = ACTIONS(29),
-    [anon_sym_stop] = ACTIONS(29),
-    [anon_sym_makeVCard] = ACTIONS(29),
-    [anon_sym_rawAction] = ACTIONS(29),
-    [anon_sym_embedFile] = ACTIONS(29),
-    [anon_sym_nothing] = ACTIONS(29),
-    [anon_sym_CurrentDate] = ACTIONS(31),
-    [anon_sym_Device] = ACTIONS(31),
-    [anon_sym_RepeatIndex] = ACTIONS(31),
-    [anon_sym_RepeatItem] = ACTIONS(31),
-    [anon_sym_ShortcutInput] = ACTIONS(31),
-    [anon_sym_Ask] = ACTIONS(31),
-    [anon_sym_text] = ACTIONS(33),
-    [anon_sym_number] = ACTIONS(33),
-    [anon_sym_bool] = ACTIONS(33),
-    [anon_sym_dictionary] = ACTIONS(33),
-    [anon_sym_array] = ACTIONS(33),
-    [anon_sym_variable] = ACTIONS(33),
-    [anon_sym_color] = ACTIONS(33),
-    [anon_sym_float] = ACTIONS(33),
-    [anon_sym_LPAREN] = ACTIONS(35),
-    [sym_at_variable] = ACTIONS(37),
-    [anon_sym_DQUOTE] = ACTIONS(39),
-    [anon_sym_SQUOTE] = ACTIONS(41),
-    [sym_number] = ACTIONS(43),
+    [anon_sym_action] = ACTIONS(25),
+    [anon_sym_LPAREN] = ACTIONS(27),
+    [anon_sym_LBRACE] = ACTIONS(29),
+    [anon_sym_true] = ACTIONS(31),
+    [anon_sym_false] = ACTIONS(31),
+    [anon_sym_name] = ACTIONS(33),
+    [anon_sym_glyph] = ACTIONS(33),
+    [anon_sym_from] = ACTIONS(33),
+    [anon_sym_mac] = ACTIONS(33),
+    [anon_sym_inputs] = ACTIONS(33),
+    [anon_sym_noinput] = ACTIONS(33),
+    [anon_sym_askfor] = ACTIONS(33),
+    [anon_sym_getclipboard] = ACTIONS(33),
+    [anon_sym_list] = ACTIONS(33),
+    [anon_sym_nil] = ACTIONS(33),
+    [anon_sym_stop] = ACTIONS(33),
+    [anon_sym_makeVCard] = ACTIONS(33),
+    [anon_sym_rawAction] = ACTIONS(33),
+    [anon_sym_embedFile] = ACTIONS(33),
+    [anon_sym_nothing] = ACTIONS(33),
+    [anon_sym_CurrentDate] = ACTIONS(35),
+    [anon_sym_Device] = ACTIONS(35),
+    [anon_sym_RepeatIndex] = ACTIONS(35),
+    [anon_sym_RepeatItem] = ACTIONS(35),
+    [anon_sym_ShortcutInput] = ACTIONS(35),
+    [anon_sym_Ask] = ACTIONS(35),
+    [anon_sym_text] = ACTIONS(37),
+    [anon_sym_number] = ACTIONS(37),
+    [anon_sym_bool] = ACTIONS(37),
+    [anon_sym_dictionary] = ACTIONS(37),
+    [anon_sym_array] = ACTIONS(37),
+    [anon_sym_variable] = ACTIONS(37),
+    [anon_sym_color] = ACTIONS(37),
+    [anon_sym_float] = ACTIONS(37),
+    [sym_at_variable] = ACTIONS(39),
+    [anon_sym_DQUOTE] = ACTIONS(41),
+    [anon_sym_SQUOTE] = ACTIONS(43),
+    [sym_number] = ACTIONS(45),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(14)] = {
-    [sym__statement] = STATE(57),
-    [sym_pragma] = STATE(52),
-    [sym_pragma_directive] = STATE(85),
-    [sym_declaration] = STATE(52),
-    [sym_variable_assignment] = STATE(52),
-    [sym_constant_assignment] = STATE(52),
-    [sym_identifier_assignment] = STATE(52),
-    [sym_if_statement] = STATE(52),
-    [sym_for_statement] = STATE(52),
-    [sym_repeat_statement] = STATE(52),
-    [sym_menu_statement] = STATE(52),
-    [sym_item_statement] = STATE(52),
-    [sym_block] = STATE(58),
-    [sym__expression] = STATE(2),
-    [sym_dictionary] = STATE(17),
-    [sym_boolean] = STATE(17),
-    [sym_builtin_keyword] = STATE(28),
-    [sym_builtin_constant] = STATE(17),
-    [sym_type_keyword] = STATE(28),
-    [sym_parenthesized_expression] = STATE(17),
-    [sym_binary_expression] = STATE(17),
-    [sym_call] = STATE(17),
-    [sym_string] = STATE(17),
-    [sym_single_quoted_string] = STATE(17),
+    [sym__statement] = STATE(64),
+    [sym_pragma] = STATE(48),
+    [sym_pragma_directive] = STATE(88),
+    [sym_declaration] = STATE(48),
+    [sym_variable_assignment] = STATE(48),
+    [sym_constant_assignment] = STATE(48),
+    [sym_identifier_assignment] = STATE(48),
+    [sym_if_statement] = STATE(48),
+    [sym_for_statement] = STATE(48),
+    [sym_repeat_statement] = STATE(48),
+    [sym_menu_statement] = STATE(48),
+    [sym_item_statement] = STATE(48),
+    [sym_action_definition] = STATE(48),
+    [sym_block] = STATE(65),
+    [sym__expression] = STATE(40),
+    [sym_dictionary] = STATE(19),
+    [sym_boolean] = STATE(19),
+    [sym_builtin_keyword] = STATE(20),
+    [sym_builtin_constant] = STATE(19),
+    [sym_type_keyword] = STATE(20),
+    [sym_parenthesized_expression] = STATE(19),
+    [sym_binary_expression] = STATE(19),
+    [sym_call] = STATE(19),
+    [sym_string] = STATE(19),
+    [sym_single_quoted_string] = STATE(19),
     [sym_comment] = STATE(14),
-    [sym_identifier] = ACTIONS(129),
+    [sym_identifier] = ACTIONS(9),
     [anon_sym_POUNDinclude] = ACTIONS(11),
     [anon_sym_POUNDdefine] = ACTIONS(11),
     [anon_sym_POUNDimport] = ACTIONS(11),
@@ -3521,2289 +3618,2356 @@ static const uint16_t ts_parse_table[LARGE_STATE_COUNT][SYMBOL_COUNT] = {
     [anon_sym_repeat] = ACTIONS(19),
     [anon_sym_menu] = ACTIONS(21),
     [anon_sym_item] = ACTIONS(23),
-    [anon_sym_LBRACE] = ACTIONS(25),
-    [anon_sym_true] = ACTIONS(27),
-    [anon_sym_false] = ACTIONS(27),
-    [anon_sym_name] = ACTIONS(29),
-    [anon_sym_glyph] = ACTIONS(29),
-    [anon_sym_from] = ACTIONS(29),
-    [anon_sym_mac] = ACTIONS(29),
-    [anon_sym_inputs] = ACTIONS(29),
-    [anon_sym_noinput] = ACTIONS(29),
-    [anon_sym_askfor] = ACTIONS(29),
-    [anon_sym_getclipboard] = ACTIONS(29),
-    [anon_sym_list] = ACTIONS(29),
-    [anon_sym_nil] = ACTIONS(29),
-    [anon_sym_action] = ACTIONS(29),
-    [anon_sym_stop] = ACTIONS(29),
-    [anon_sym_makeVCard] = ACTIONS(29),
-    [anon_sym_rawAction] = ACTIONS(29),
-    [anon_sym_embedFile] = ACTIONS(29),
-    [anon_sym_nothing] = ACTIONS(29),
-    [anon_sym_CurrentDate] = ACTIONS(31),
-    [anon_sym_Device] = ACTIONS(31),
-    [anon_sym_RepeatIndex] = ACTIONS(31),
-    [anon_sym_RepeatItem] = ACTIONS(31),
-    [anon_sym_ShortcutInput] = ACTIONS(31),
-    [anon_sym_Ask] = ACTIONS(31),
-    [anon_sym_text] = ACTIONS(33),
-    [anon_sym_number] = ACTIONS(33),
-    [anon_sym_bool] = ACTIONS(33),
-    [anon_sym_dictionary] = ACTIONS(33),
-    [anon_sym_array] = ACTIONS(33),
-    [anon_sym_variable] = ACTIONS(33),
-    [anon_sym_color] = ACTIONS(33),
-    [anon_sym_float] = ACTIONS(33),
-    [anon_sym_LPAREN] = ACTIONS(35),
-    [sym_at_variable] = ACTIONS(37),
-    [anon_sym_DQUOTE] = ACTIONS(39),
-    [anon_sym_SQUOTE] = ACTIONS(41),
-    [sym_number] = ACTIONS(43),
+    [anon_sym_action] = ACTIONS(25),
+    [anon_sym_LPAREN] = ACTIONS(27),
+    [anon_sym_LBRACE] = ACTIONS(29),
+    [anon_sym_true] = ACTIONS(31),
+    [anon_sym_false] = ACTIONS(31),
+    [anon_sym_name] = ACTIONS(33),
+    [anon_sym_glyph] = ACTIONS(33),
+    [anon_sym_from] = ACTIONS(33),
+    [anon_sym_mac] = ACTIONS(33),
+    [anon_sym_inputs] = ACTIONS(33),
+    [anon_sym_noinput] = ACTIONS(33),
+    [anon_sym_askfor] = ACTIONS(33),
+    [anon_sym_getclipboard] = ACTIONS(33),
+    [anon_sym_list] = ACTIONS(33),
+    [anon_sym_nil] = ACTIONS(33),
+    [anon_sym_stop] = ACTIONS(33),
+    [anon_sym_makeVCard] = ACTIONS(33),
+    [anon_sym_rawAction] = ACTIONS(33),
+    [anon_sym_embedFile] = ACTIONS(33),
+    [anon_sym_nothing] = ACTIONS(33),
+    [anon_sym_CurrentDate] = ACTIONS(35),
+    [anon_sym_Device] = ACTIONS(35),
+    [anon_sym_RepeatIndex] = ACTIONS(35),
+    [anon_sym_RepeatItem] = ACTIONS(35),
+    [anon_sym_ShortcutInput] = ACTIONS(35),
+    [anon_sym_Ask] = ACTIONS(35),
+    [anon_sym_text] = ACTIONS(37),
+    [anon_sym_number] = ACTIONS(37),
+    [anon_sym_bool] = ACTIONS(37),
+    [anon_sym_dictionary] = ACTIONS(37),
+    [anon_sym_array] = ACTIONS(37),
+    [anon_sym_variable] = ACTIONS(37),
+    [anon_sym_color] = ACTIONS(37),
+    [anon_sym_float] = ACTIONS(37),
+    [sym_at_variable] = ACTIONS(39),
+    [anon_sym_DQUOTE] = ACTIONS(41),
+    [anon_sym_SQUOTE] = ACTIONS(43),
+    [sym_number] = ACTIONS(45),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(15)] = {
     [sym_comment] = STATE(15),
-    [ts_builtin_sym_end] = ACTIONS(131),
-    [sym_identifier] = ACTIONS(133),
-    [anon_sym_POUNDinclude] = ACTIONS(131),
-    [anon_sym_POUNDdefine] = ACTIONS(131),
-    [anon_sym_POUNDimport] = ACTIONS(131),
-    [anon_sym_POUNDquestion] = ACTIONS(131),
-    [anon_sym_COLON] = ACTIONS(131),
-    [anon_sym_const] = ACTIONS(133),
-    [anon_sym_if] = ACTIONS(133),
-    [anon_sym_else] = ACTIONS(133),
-    [anon_sym_for] = ACTIONS(133),
-    [anon_sym_repeat] = ACTIONS(133),
-    [anon_sym_menu] = ACTIONS(133),
-    [anon_sym_item] = ACTIONS(133),
-    [anon_sym_LBRACE] = ACTIONS(131),
-    [anon_sym_RBRACE] = ACTIONS(131),
-    [anon_sym_COMMA] = ACTIONS(131),
-    [anon_sym_true] = ACTIONS(133),
-    [anon_sym_false] = ACTIONS(133),
-    [anon_sym_name] = ACTIONS(133),
-    [anon_sym_glyph] = ACTIONS(133),
-    [anon_sym_from] = ACTIONS(133),
-    [anon_sym_mac] = ACTIONS(133),
-    [anon_sym_inputs] = ACTIONS(133),
-    [anon_sym_noinput] = ACTIONS(133),
-    [anon_sym_askfor] = ACTIONS(133),
-    [anon_sym_getclipboard] = ACTIONS(133),
-    [anon_sym_list] = ACTIONS(133),
-    [anon_sym_nil] = ACTIONS(133),
-    [anon_sym_action] = ACTIONS(133),
-    [anon_sym_stop] = ACTIONS(133),
-    [anon_sym_makeVCard] = ACTIONS(133),
-    [anon_sym_rawAction] = ACTIONS(133),
-    [anon_sym_embedFile] = ACTIONS(133),
-    [anon_sym_nothing] = ACTIONS(133),
-    [anon_sym_CurrentDate] = ACTIONS(133),
-    [anon_sym_Device] = ACTIONS(133),
-    [anon_sym_RepeatIndex] = ACTIONS(133),
-    [anon_sym_RepeatItem] = ACTIONS(133),
-    [anon_sym_ShortcutInput] = ACTIONS(133),
-    [anon_sym_Ask] = ACTIONS(133),
-    [anon_sym_text] = ACTIONS(133),
-    [anon_sym_number] = ACTIONS(133),
-    [anon_sym_bool] = ACTIONS(133),
-    [anon_sym_dictionary] = ACTIONS(133),
-    [anon_sym_array] = ACTIONS(133),
-    [anon_sym_variable] = ACTIONS(133),
-    [anon_sym_color] = ACTIONS(133),
-    [anon_sym_float] = ACTIONS(133),
-    [anon_sym_LPAREN] = ACTIONS(131),
-    [anon_sym_RPAREN] = ACTIONS(131),
-    [anon_sym_STAR] = ACTIONS(49),
-    [anon_sym_SLASH] = ACTIONS(51),
-    [anon_sym_PLUS] = ACTIONS(131),
-    [anon_sym_DASH] = ACTIONS(131),
-    [anon_sym_EQ_EQ] = ACTIONS(131),
-    [anon_sym_BANG_EQ] = ACTIONS(131),
-    [anon_sym_LT] = ACTIONS(133),
-    [anon_sym_GT] = ACTIONS(133),
-    [anon_sym_LT_EQ] = ACTIONS(131),
-    [anon_sym_GT_EQ] = ACTIONS(131),
-    [sym_at_variable] = ACTIONS(131),
-    [anon_sym_DQUOTE] = ACTIONS(131),
-    [anon_sym_SQUOTE] = ACTIONS(131),
-    [sym_number] = ACTIONS(131),
+    [ts_builtin_sym_end] = ACTIONS(136),
+    [sym_identifier] = ACTIONS(138),
+    [anon_sym_POUNDinclude] = ACTIONS(136),
+    [anon_sym_POUNDdefine] = ACTIONS(136),
+    [anon_sym_POUNDimport] = ACTIONS(136),
+    [anon_sym_POUNDquestion] = ACTIONS(136),
+    [anon_sym_COLON] = ACTIONS(136),
+    [anon_sym_const] = ACTIONS(138),
+    [anon_sym_if] = ACTIONS(138),
+    [anon_sym_else] = ACTIONS(138),
+    [anon_sym_for] = ACTIONS(138),
+    [anon_sym_repeat] = ACTIONS(138),
+    [anon_sym_menu] = ACTIONS(138),
+    [anon_sym_item] = ACTIONS(138),
+    [anon_sym_action] = ACTIONS(138),
+    [anon_sym_LPAREN] = ACTIONS(136),
+    [anon_sym_COMMA] = ACTIONS(136),
+    [anon_sym_RPAREN] = ACTIONS(136),
+    [anon_sym_LBRACE] = ACTIONS(136),
+    [anon_sym_RBRACE] = ACTIONS(136),
+    [anon_sym_true] = ACTIONS(138),
+    [anon_sym_false] = ACTIONS(138),
+    [anon_sym_name] = ACTIONS(138),
+    [anon_sym_glyph] = ACTIONS(138),
+    [anon_sym_from] = ACTIONS(138),
+    [anon_sym_mac] = ACTIONS(138),
+    [anon_sym_inputs] = ACTIONS(138),
+    [anon_sym_noinput] = ACTIONS(138),
+    [anon_sym_askfor] = ACTIONS(138),
+    [anon_sym_getclipboard] = ACTIONS(138),
+    [anon_sym_list] = ACTIONS(138),
+    [anon_sym_nil] = ACTIONS(138),
+    [anon_sym_stop] = ACTIONS(138),
+    [anon_sym_makeVCard] = ACTIONS(138),
+    [anon_sym_rawAction] = ACTIONS(138),
+    [anon_sym_embedFile] = ACTIONS(138),
+    [anon_sym_nothing] = ACTIONS(138),
+    [anon_sym_CurrentDate] = ACTIONS(138),
+    [anon_sym_Device] = ACTIONS(138),
+    [anon_sym_RepeatIndex] = ACTIONS(138),
+    [anon_sym_RepeatItem] = ACTIONS(138),
+    [anon_sym_ShortcutInput] = ACTIONS(138),
+    [anon_sym_Ask] = ACTIONS(138),
+    [anon_sym_text] = ACTIONS(138),
+    [anon_sym_number] = ACTIONS(138),
+    [anon_sym_bool] = ACTIONS(138),
+    [anon_sym_dictionary] = ACTIONS(138),
+    [anon_sym_array] = ACTIONS(138),
+    [anon_sym_variable] = ACTIONS(138),
+    [anon_sym_color] = ACTIONS(138),
+    [anon_sym_float] = ACTIONS(138),
+    [anon_sym_STAR] = ACTIONS(136),
+    [anon_sym_SLASH] = ACTIONS(138),
+    [anon_sym_PLUS] = ACTIONS(136),
+    [anon_sym_DASH] = ACTIONS(136),
+    [anon_sym_EQ_EQ] = ACTIONS(136),
+    [anon_sym_BANG_EQ] = ACTIONS(136),
+    [anon_sym_LT] = ACTIONS(138),
+    [anon_sym_GT] = ACTIONS(138),
+    [anon_sym_LT_EQ] = ACTIONS(136),
+    [anon_sym_GT_EQ] = ACTIONS(136),
+    [sym_at_variable] = ACTIONS(136),
+    [anon_sym_DQUOTE] = ACTIONS(136),
+    [anon_sym_SQUOTE] = ACTIONS(136),
+    [sym_number] = ACTIONS(136),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(16)] = {
     [sym_comment] = STATE(16),
-    [ts_builtin_sym_end] = ACTIONS(135),
-    [sym_identifier] = ACTIONS(137),
-    [anon_sym_POUNDinclude] = ACTIONS(135),
-    [anon_sym_POUNDdefine] = ACTIONS(135),
-    [anon_sym_POUNDimport] = ACTIONS(135),
-    [anon_sym_POUNDquestion] = ACTIONS(135),
-    [anon_sym_COLON] = ACTIONS(135),
-    [anon_sym_const] = ACTIONS(137),
-    [anon_sym_if] = ACTIONS(137),
-    [anon_sym_else] = ACTIONS(137),
-    [anon_sym_for] = ACTIONS(137),
-    [anon_sym_repeat] = ACTIONS(137),
-    [anon_sym_menu] = ACTIONS(137),
-    [anon_sym_item] = ACTIONS(137),
-    [anon_sym_LBRACE] = ACTIONS(135),
-    [anon_sym_RBRACE] = ACTIONS(135),
-    [anon_sym_COMMA] = ACTIONS(135),
-    [anon_sym_true] = ACTIONS(137),
-    [anon_sym_false] = ACTIONS(137),
-    [anon_sym_name] = ACTIONS(137),
-    [anon_sym_glyph] = ACTIONS(137),
-    [anon_sym_from] = ACTIONS(137),
-    [anon_sym_mac] = ACTIONS(137),
-    [anon_sym_inputs] = ACTIONS(137),
-    [anon_sym_noinput] = ACTIONS(137),
-    [anon_sym_askfor] = ACTIONS(137),
-    [anon_sym_getclipboard] = ACTIONS(137),
-    [anon_sym_list] = ACTIONS(137),
-    [anon_sym_nil] = ACTIONS(137),
-    [anon_sym_action] = ACTIONS(137),
-    [anon_sym_stop] = ACTIONS(137),
-    [anon_sym_makeVCard] = ACTIONS(137),
-    [anon_sym_rawAction] = ACTIONS(137),
-    [anon_sym_embedFile] = ACTIONS(137),
-    [anon_sym_nothing] = ACTIONS(137),
-    [anon_sym_CurrentDate] = ACTIONS(137),
-    [anon_sym_Device] = ACTIONS(137),
-    [anon_sym_RepeatIndex] = ACTIONS(137),
-    [anon_sym_RepeatItem] = ACTIONS(137),
-    [anon_sym_ShortcutInput] = ACTIONS(137),
-    [anon_sym_Ask] = ACTIONS(137),
-    [anon_sym_text] = ACTIONS(137),
-    [anon_sym_number] = ACTIONS(137),
-    [anon_sym_bool] = ACTIONS(137),
-    [anon_sym_dictionary] = ACTIONS(137),
-    [anon_sym_array] = ACTIONS(137),
-    [anon_sym_variable] = ACTIONS(137),
-    [anon_sym_color] = ACTIONS(137),
-    [anon_sym_float] = ACTIONS(137),
-    [anon_sym_LPAREN] = ACTIONS(135),
-    [anon_sym_RPAREN] = ACTIONS(135),
-    [anon_sym_STAR] = ACTIONS(135),
-    [anon_sym_SLASH] = ACTIONS(137),
-    [anon_sym_PLUS] = ACTIONS(135),
-    [anon_sym_DASH] = ACTIONS(135),
-    [anon_sym_EQ_EQ] = ACTIONS(135),
-    [anon_sym_BANG_EQ] = ACTIONS(135),
-    [anon_sym_LT] = ACTIONS(137),
-    [anon_sym_GT] = ACTIONS(137),
-    [anon_sym_LT_EQ] = ACTIONS(135),
-    [anon_sym_GT_EQ] = ACTIONS(135),
-    [sym_at_variable] = ACTIONS(135),
-    [anon_sym_DQUOTE] = ACTIONS(135),
-    [anon_sym_SQUOTE] = ACTIONS(135),
-    [sym_number] = ACTIONS(135),
+    [ts_builtin_sym_end] = ACTIONS(140),
+    [sym_identifier] = ACTIONS(142),
+    [anon_sym_POUNDinclude] = ACTIONS(140),
+    [anon_sym_POUNDdefine] = ACTIONS(140),
+    [anon_sym_POUNDimport] = ACTIONS(140),
+    [anon_sym_POUNDquestion] = ACTIONS(140),
+    [anon_sym_COLON] = ACTIONS(140),
+    [anon_sym_const] = ACTIONS(142),
+    [anon_sym_if] = ACTIONS(142),
+    [anon_sym_else] = ACTIONS(142),
+    [anon_sym_for] = ACTIONS(142),
+    [anon_sym_repeat] = ACTIONS(142),
+    [anon_sym_menu] = ACTIONS(142),
+    [anon_sym_item] = ACTIONS(142),
+    [anon_sym_action] = ACTIONS(142),
+    [anon_sym_LPAREN] = ACTIONS(140),
+    [anon_sym_COMMA] = ACTIONS(140),
+    [anon_sym_RPAREN] = ACTIONS(140),
+    [anon_sym_LBRACE] = ACTIONS(140),
+    [anon_sym_RBRACE] = ACTIONS(140),
+    [anon_sym_true] = ACTIONS(142),
+    [anon_sym_false] = ACTIONS(142),
+    [anon_sym_name] = ACTIONS(142),
+    [anon_sym_glyph] = ACTIONS(142),
+    [anon_sym_from] = ACTIONS(142),
+    [anon_sym_mac] = ACTIONS(142),
+    [anon_sym_inputs] = ACTIONS(142),
+    [anon_sym_noinput] = ACTIONS(142),
+    [anon_sym_askfor] = ACTIONS(142),
+    [anon_sym_getclipboard] = ACTIONS(142),
+    [anon_sym_list] = ACTIONS(142),
+    [anon_sym_nil] = ACTIONS(142),
+    [anon_sym_stop] = ACTIONS(142),
+    [anon_sym_makeVCard] = ACTIONS(142),
+    [anon_sym_rawAction] = ACTIONS(142),
+    [anon_sym_embedFile] = ACTIONS(142),
+    [anon_sym_nothing] = ACTIONS(142),
+    [anon_sym_CurrentDate] = ACTIONS(142),
+    [anon_sym_Device] = ACTIONS(142),
+    [anon_sym_RepeatIndex] = ACTIONS(142),
+    [anon_sym_RepeatItem] = ACTIONS(142),
+    [anon_sym_ShortcutInput] = ACTIONS(142),
+    [anon_sym_Ask] = ACTIONS(142),
+    [anon_sym_text] = ACTIONS(142),
+    [anon_sym_number] = ACTIONS(142),
+    [anon_sym_bool] = ACTIONS(142),
+    [anon_sym_dictionary] = ACTIONS(142),
+    [anon_sym_array] = ACTIONS(142),
+    [anon_sym_variable] = ACTIONS(142),
+    [anon_sym_color] = ACTIONS(142),
+    [anon_sym_float] = ACTIONS(142),
+    [anon_sym_STAR] = ACTIONS(140),
+    [anon_sym_SLASH] = ACTIONS(142),
+    [anon_sym_PLUS] = ACTIONS(140),
+    [anon_sym_DASH] = ACTIONS(140),
+    [anon_sym_EQ_EQ] = ACTIONS(140),
+    [anon_sym_BANG_EQ] = ACTIONS(140),
+    [anon_sym_LT] = ACTIONS(142),
+    [anon_sym_GT] = ACTIONS(142),
+    [anon_sym_LT_EQ] = ACTIONS(140),
+    [anon_sym_GT_EQ] = ACTIONS(140),
+    [sym_at_variable] = ACTIONS(140),
+    [anon_sym_DQUOTE] = ACTIONS(140),
+    [anon_sym_SQUOTE] = ACTIONS(140),
+    [sym_number] = ACTIONS(140),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(17)] = {
     [sym_comment] = STATE(17),
-    [ts_builtin_sym_end] = ACTIONS(139),
-    [sym_identifier] = ACTIONS(141),
-    [anon_sym_POUNDinclude] = ACTIONS(139),
-    [anon_sym_POUNDdefine] = ACTIONS(139),
-    [anon_sym_POUNDimport] = ACTIONS(139),
-    [anon_sym_POUNDquestion] = ACTIONS(139),
-    [anon_sym_COLON] = ACTIONS(139),
-    [anon_sym_const] = ACTIONS(141),
-    [anon_sym_if] = ACTIONS(141),
-    [anon_sym_else] = ACTIONS(141),
-    [anon_sym_for] = ACTIONS(141),
-    [anon_sym_repeat] = ACTIONS(141),
-    [anon_sym_menu] = ACTIONS(141),
-    [anon_sym_item] = ACTIONS(141),
-    [anon_sym_LBRACE] = ACTIONS(139),
-    [anon_sym_RBRACE] = ACTIONS(139),
-    [anon_sym_COMMA] = ACTIONS(139),
-    [anon_sym_true] = ACTIONS(141),
-    [anon_sym_false] = ACTIONS(141),
-    [anon_sym_name] = ACTIONS(141),
-    [anon_sym_glyph] = ACTIONS(141),
-    [anon_sym_from] = ACTIONS(141),
-    [anon_sym_mac] = ACTIONS(141),
-    [anon_sym_inputs] = ACTIONS(141),
-    [anon_sym_noinput] = ACTIONS(141),
-    [anon_sym_askfor] = ACTIONS(141),
-    [anon_sym_getclipboard] = ACTIONS(141),
-    [anon_sym_list] = ACTIONS(141),
-    [anon_sym_nil] = ACTIONS(141),
-    [anon_sym_action] = ACTIONS(141),
-    [anon_sym_stop] = ACTIONS(141),
-    [anon_sym_makeVCard] = ACTIONS(141),
-    [anon_sym_rawAction] = ACTIONS(141),
-    [anon_sym_embedFile] = ACTIONS(141),
-    [anon_sym_nothing] = ACTIONS(141),
-    [anon_sym_CurrentDate] = ACTIONS(141),
-    [anon_sym_Device] = ACTIONS(141),
-    [anon_sym_RepeatIndex] = ACTIONS(141),
-    [anon_sym_RepeatItem] = ACTIONS(141),
-    [anon_sym_ShortcutInput] = ACTIONS(141),
-    [anon_sym_Ask] = ACTIONS(141),
-    [anon_sym_text] = ACTIONS(141),
-    [anon_sym_number] = ACTIONS(141),
-    [anon_sym_bool] = ACTIONS(141),
-    [anon_sym_dictionary] = ACTIONS(141),
-    [anon_sym_array] = ACTIONS(141),
-    [anon_sym_variable] = ACTIONS(141),
-    [anon_sym_color] = ACTIONS(141),
-    [anon_sym_float] = ACTIONS(141),
-    [anon_sym_LPAREN] = ACTIONS(139),
-    [anon_sym_RPAREN] = ACTIONS(139),
-    [anon_sym_STAR] = ACTIONS(139),
-    [anon_sym_SLASH] = ACTIONS(141),
-    [anon_sym_PLUS] = ACTIONS(139),
-    [anon_sym_DASH] = ACTIONS(139),
-    [anon_sym_EQ_EQ] = ACTIONS(139),
-    [anon_sym_BANG_EQ] = ACTIONS(139),
-    [anon_sym_LT] = ACTIONS(141),
-    [anon_sym_GT] = ACTIONS(141),
-    [anon_sym_LT_EQ] = ACTIONS(139),
-    [anon_sym_GT_EQ] = ACTIONS(139),
-    [sym_at_variable] = ACTIONS(139),
-    [anon_sym_DQUOTE] = ACTIONS(139),
-    [anon_sym_SQUOTE] = ACTIONS(139),
-    [sym_number] = ACTIONS(139),
+    [ts_builtin_sym_end] = ACTIONS(144),
+    [sym_identifier] = ACTIONS(146),
+    [anon_sym_POUNDinclude] = ACTIONS(144),
+    [anon_sym_POUNDdefine] = ACTIONS(144),
+    [anon_sym_POUNDimport] = ACTIONS(144),
+    [anon_sym_POUNDquestion] = ACTIONS(144),
+    [anon_sym_COLON] = ACTIONS(144),
+    [anon_sym_const] = ACTIONS(146),
+    [anon_sym_if] = ACTIONS(146),
+    [anon_sym_else] = ACTIONS(146),
+    [anon_sym_for] = ACTIONS(146),
+    [anon_sym_repeat] = ACTIONS(146),
+    [anon_sym_menu] = ACTIONS(146),
+    [anon_sym_item] = ACTIONS(146),
+    [anon_sym_action] = ACTIONS(146),
+    [anon_sym_LPAREN] = ACTIONS(144),
+    [anon_sym_COMMA] = ACTIONS(144),
+    [anon_sym_RPAREN] = ACTIONS(144),
+    [anon_sym_LBRACE] = ACTIONS(144),
+    [anon_sym_RBRACE] = ACTIONS(144),
+    [anon_sym_true] = ACTIONS(146),
+    [anon_sym_false] = ACTIONS(146),
+    [anon_sym_name] = ACTIONS(146),
+    [anon_sym_glyph] = ACTIONS(146),
+    [anon_sym_from] = ACTIONS(146),
+    [anon_sym_mac] = ACTIONS(146),
+    [anon_sym_inputs] = ACTIONS(146),
+    [anon_sym_noinput] = ACTIONS(146),
+    [anon_sym_askfor] = ACTIONS(146),
+    [anon_sym_getclipboard] = ACTIONS(146),
+    [anon_sym_list] = ACTIONS(146),
+    [anon_sym_nil] = ACTIONS(146),
+    [anon_sym_stop] = ACTIONS(146),
+    [anon_sym_makeVCard] = ACTIONS(146),
+    [anon_sym_rawAction] = ACTIONS(146),
+    [anon_sym_embedFile] = ACTIONS(146),
+    [anon_sym_nothing] = ACTIONS(146),
+    [anon_sym_CurrentDate] = ACTIONS(146),
+    [anon_sym_Device] = ACTIONS(146),
+    [anon_sym_RepeatIndex] = ACTIONS(146),
+    [anon_sym_RepeatItem] = ACTIONS(146),
+    [anon_sym_ShortcutInput] = ACTIONS(146),
+    [anon_sym_Ask] = ACTIONS(146),
+    [anon_sym_text] = ACTIONS(146),
+    [anon_sym_number] = ACTIONS(146),
+    [anon_sym_bool] = ACTIONS(146),
+    [anon_sym_dictionary] = ACTIONS(146),
+    [anon_sym_array] = ACTIONS(146),
+    [anon_sym_variable] = ACTIONS(146),
+    [anon_sym_color] = ACTIONS(146),
+    [anon_sym_float] = ACTIONS(146),
+    [anon_sym_STAR] = ACTIONS(144),
+    [anon_sym_SLASH] = ACTIONS(146),
+    [anon_sym_PLUS] = ACTIONS(144),
+    [anon_sym_DASH] = ACTIONS(144),
+    [anon_sym_EQ_EQ] = ACTIONS(144),
+    [anon_sym_BANG_EQ] = ACTIONS(144),
+    [anon_sym_LT] = ACTIONS(146),
+    [anon_sym_GT] = ACTIONS(146),
+    [anon_sym_LT_EQ] = ACTIONS(144),
+    [anon_sym_GT_EQ] = ACTIONS(144),
+    [sym_at_variable] = ACTIONS(144),
+    [anon_sym_DQUOTE] = ACTIONS(144),
+    [anon_sym_SQUOTE] = ACTIONS(144),
+    [sym_number] = ACTIONS(144),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(18)] = {
     [sym_comment] = STATE(18),
-    [ts_builtin_sym_end] = ACTIONS(143),
-    [sym_identifier] = ACTIONS(145),
-    [anon_sym_POUNDinclude] = ACTIONS(143),
-    [anon_sym_POUNDdefine] = ACTIONS(143),
-    [anon_sym_POUNDimport] = ACTIONS(143),
-    [anon_sym_POUNDquestion] = ACTIONS(143),
-    [anon_sym_COLON] = ACTIONS(143),
-    [anon_sym_const] = ACTIONS(145),
-    [anon_sym_if] = ACTIONS(145),
-    [anon_sym_else] = ACTIONS(145),
-    [anon_sym_for] = ACTIONS(145),
-    [anon_sym_repeat] = ACTIONS(145),
-    [anon_sym_menu] = ACTIONS(145),
-    [anon_sym_item] = ACTIONS(145),
-    [anon_sym_LBRACE] = ACTIONS(143),
-    [anon_sym_RBRACE] = ACTIONS(143),
-    [anon_sym_COMMA] = ACTIONS(143),
-    [anon_sym_true] = ACTIONS(145),
-    [anon_sym_false] = ACTIONS(145),
-    [anon_sym_name] = ACTIONS(145),
-    [anon_sym_glyph] = ACTIONS(145),
-    [anon_sym_from] = ACTIONS(145),
-    [anon_sym_mac] = ACTIONS(145),
-    [anon_sym_inputs] = ACTIONS(145),
-    [anon_sym_noinput] = ACTIONS(145),
-    [anon_sym_askfor] = ACTIONS(145),
-    [anon_sym_getclipboard] = ACTIONS(145),
-    [anon_sym_list] = ACTIONS(145),
-    [anon_sym_nil] = ACTIONS(145),
-    [anon_sym_action] = ACTIONS(145),
-    [anon_sym_stop] = ACTIONS(145),
-    [anon_sym_makeVCard] = ACTIONS(145),
-    [anon_sym_rawAction] = ACTIONS(145),
-    [anon_sym_embedFile] = ACTIONS(145),
-    [anon_sym_nothing] = ACTIONS(145),
-    [anon_sym_CurrentDate] = ACTIONS(145),
-    [anon_sym_Device] = ACTIONS(145),
-    [anon_sym_RepeatIndex] = ACTIONS(145),
-    [anon_sym_RepeatItem] = ACTIONS(145),
-    [anon_sym_ShortcutInput] = ACTIONS(145),
-    [anon_sym_Ask] = ACTIONS(145),
-    [anon_sym_text] = ACTIONS(145),
-    [anon_sym_number] = ACTIONS(145),
-    [anon_sym_bool] = ACTIONS(145),
-    [anon_sym_dictionary] = ACTIONS(145),
-    [anon_sym_array] = ACTIONS(145),
-    [anon_sym_variable] = ACTIONS(145),
-    [anon_sym_color] = ACTIONS(145),
-    [anon_sym_float] = ACTIONS(145),
-    [anon_sym_LPAREN] = ACTIONS(143),
-    [anon_sym_RPAREN] = ACTIONS(143),
-    [anon_sym_STAR] = ACTIONS(143),
-    [anon_sym_SLASH] = ACTIONS(145),
-    [anon_sym_PLUS] = ACTIONS(143),
-    [anon_sym_DASH] = ACTIONS(143),
-    [anon_sym_EQ_EQ] = ACTIONS(143),
-    [anon_sym_BANG_EQ] = ACTIONS(143),
-    [anon_sym_LT] = ACTIONS(145),
-    [anon_sym_GT] = ACTIONS(145),
-    [anon_sym_LT_EQ] = ACTIONS(143),
-    [anon_sym_GT_EQ] = ACTIONS(143),
-    [sym_at_variable] = ACTIONS(143),
-    [anon_sym_DQUOTE] = ACTIONS(143),
-    [anon_sym_SQUOTE] = ACTIONS(143),
-    [sym_number] = ACTIONS(143),
+    [ts_builtin_sym_end] = ACTIONS(148),
+    [sym_identifier] = ACTIONS(150),
+    [anon_sym_POUNDinclude] = ACTIONS(148),
+    [anon_sym_POUNDdefine] = ACTIONS(148),
+    [anon_sym_POUNDimport] = ACTIONS(148),
+    [anon_sym_POUNDquestion] = ACTIONS(148),
+    [anon_sym_COLON] = ACTIONS(148),
+    [anon_sym_const] = ACTIONS(150),
+    [anon_sym_if] = ACTIONS(150),
+    [anon_sym_else] = ACTIONS(150),
+    [anon_sym_for] = ACTIONS(150),
+    [anon_sym_repeat] = ACTIONS(150),
+    [anon_sym_menu] = ACTIONS(150),
+    [anon_sym_item] = ACTIONS(150),
+    [anon_sym_action] = ACTIONS(150),
+    [anon_sym_LPAREN] = ACTIONS(148),
+    [anon_sym_COMMA] = ACTIONS(148),
+    [anon_sym_RPAREN] = ACTIONS(148),
+    [anon_sym_LBRACE] = ACTIONS(148),
+    [anon_sym_RBRACE] = ACTIONS(148),
+    [anon_sym_true] = ACTIONS(150),
+    [anon_sym_false] = ACTIONS(150),
+    [anon_sym_name] = ACTIONS(150),
+    [anon_sym_glyph] = ACTIONS(150),
+    [anon_sym_from] = ACTIONS(150),
+    [anon_sym_mac] = ACTIONS(150),
+    [anon_sym_inputs] = ACTIONS(150),
+    [anon_sym_noinput] = ACTIONS(150),
+    [anon_sym_askfor] = ACTIONS(150),
+    [anon_sym_getclipboard] = ACTIONS(150),
+    [anon_sym_list] = ACTIONS(150),
+    [anon_sym_nil] = ACTIONS(150),
+    [anon_sym_stop] = ACTIONS(150),
+    [anon_sym_makeVCard] = ACTIONS(150),
+    [anon_sym_rawAction] = ACTIONS(150),
+    [anon_sym_embedFile] = ACTIONS(150),
+    [anon_sym_nothing] = ACTIONS(150),
+    [anon_sym_CurrentDate] = ACTIONS(150),
+    [anon_sym_Device] = ACTIONS(150),
+    [anon_sym_RepeatIndex] = ACTIONS(150),
+    [anon_sym_RepeatItem] = ACTIONS(150),
+    [anon_sym_ShortcutInput] = ACTIONS(150),
+    [anon_sym_Ask] = ACTIONS(150),
+    [anon_sym_text] = ACTIONS(150),
+    [anon_sym_number] = ACTIONS(150),
+    [anon_sym_bool] = ACTIONS(150),
+    [anon_sym_dictionary] = ACTIONS(150),
+    [anon_sym_array] = ACTIONS(150),
+    [anon_sym_variable] = ACTIONS(150),
+    [anon_sym_color] = ACTIONS(150),
+    [anon_sym_float] = ACTIONS(150),
+    [anon_sym_STAR] = ACTIONS(148),
+    [anon_sym_SLASH] = ACTIONS(150),
+    [anon_sym_PLUS] = ACTIONS(148),
+    [anon_sym_DASH] = ACTIONS(148),
+    [anon_sym_EQ_EQ] = ACTIONS(148),
+    [anon_sym_BANG_EQ] = ACTIONS(148),
+    [anon_sym_LT] = ACTIONS(150),
+    [anon_sym_GT] = ACTIONS(150),
+    [anon_sym_LT_EQ] = ACTIONS(148),
+    [anon_sym_GT_EQ] = ACTIONS(148),
+    [sym_at_variable] = ACTIONS(148),
+    [anon_sym_DQUOTE] = ACTIONS(148),
+    [anon_sym_SQUOTE] = ACTIONS(148),
+    [sym_number] = ACTIONS(148),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(19)] = {
     [sym_comment] = STATE(19),
-    [ts_builtin_sym_end] = ACTIONS(147),
-    [sym_identifier] = ACTIONS(149),
-    [anon_sym_POUNDinclude] = ACTIONS(147),
-    [anon_sym_POUNDdefine] = ACTIONS(147),
-    [anon_sym_POUNDimport] = ACTIONS(147),
-    [anon_sym_POUNDquestion] = ACTIONS(147),
-    [anon_sym_COLON] = ACTIONS(147),
-    [anon_sym_const] = ACTIONS(149),
-    [anon_sym_if] = ACTIONS(149),
-    [anon_sym_else] = ACTIONS(149),
-    [anon_sym_for] = ACTIONS(149),
-    [anon_sym_repeat] = ACTIONS(149),
-    [anon_sym_menu] = ACTIONS(149),
-    [anon_sym_item] = ACTIONS(149),
-    [anon_sym_LBRACE] = ACTIONS(147),
-    [anon_sym_RBRACE] = ACTIONS(147),
-    [anon_sym_COMMA] = ACTIONS(147),
-    [anon_sym_true] = ACTIONS(149),
-    [anon_sym_false] = ACTIONS(149),
-    [anon_sym_name] = ACTIONS(149),
-    [anon_sym_glyph] = ACTIONS(149),
-    [anon_sym_from] = ACTIONS(149),
-    [anon_sym_mac] = ACTIONS(149),
-    [anon_sym_inputs] = ACTIONS(149),
-    [anon_sym_noinput] = ACTIONS(149),
-    [anon_sym_askfor] = ACTIONS(149),
-    [anon_sym_getclipboard] = ACTIONS(149),
-    [anon_sym_list] = ACTIONS(149),
-    [anon_sym_nil] = ACTIONS(149),
-    [anon_sym_action] = ACTIONS(149),
-    [anon_sym_stop] = ACTIONS(149),
-    [anon_sym_makeVCard] = ACTIONS(149),
-    [anon_sym_rawAction] = ACTIONS(149),
-    [anon_sym_embedFile] = ACTIONS(149),
-    [anon_sym_nothing] = ACTIONS(149),
-    [anon_sym_CurrentDate] = ACTIONS(149),
-    [anon_sym_Device] = ACTIONS(149),
-    [anon_sym_RepeatIndex] = ACTIONS(149),
-    [anon_sym_RepeatItem] = ACTIONS(149),
-    [anon_sym_ShortcutInput] = ACTIONS(149),
-    [anon_sym_Ask] = ACTIONS(149),
-    [anon_sym_text] = ACTIONS(149),
-    [anon_sym_number] = ACTIONS(149),
-    [anon_sym_bool] = ACTIONS(149),
-    [anon_sym_dictionary] = ACTIONS(149),
-    [anon_sym_array] = ACTIONS(149),
-    [anon_sym_variable] = ACTIONS(149),
-    [anon_sym_color] = ACTIONS(149),
-    [anon_sym_float] = ACTIONS(149),
-    [anon_sym_LPAREN] = ACTIONS(147),
-    [anon_sym_RPAREN] = ACTIONS(147),
-    [anon_sym_STAR] = ACTIONS(147),
-    [anon_sym_SLASH] = ACTIONS(149),
-    [anon_sym_PLUS] = ACTIONS(147),
-    [anon_sym_DASH] = ACTIONS(147),
-    [anon_sym_EQ_EQ] = ACTIONS(147),
-    [anon_sym_BANG_EQ] = ACTIONS(147),
-    [anon_sym_LT] = ACTIONS(149),
-    [anon_sym_GT] = ACTIONS(149),
-    [anon_sym_LT_EQ] = ACTIONS(147),
-    [anon_sym_GT_EQ] = ACTIONS(147),
-    [sym_at_variable] = ACTIONS(147),
-    [anon_sym_DQUOTE] = ACTIONS(147),
-    [anon_sym_SQUOTE] = ACTIONS(147),
-    [sym_number] = ACTIONS(147),
+    [ts_builtin_sym_end] = ACTIONS(152),
+    [sym_identifier] = ACTIONS(154),
+    [anon_sym_POUNDinclude] = ACTIONS(152),
+    [anon_sym_POUNDdefine] = ACTIONS(152),
+    [anon_sym_POUNDimport] = ACTIONS(152),
+    [anon_sym_POUNDquestion] = ACTIONS(152),
+    [anon_sym_COLON] = ACTIONS(152),
+    [anon_sym_const] = ACTIONS(154),
+    [anon_sym_if] = ACTIONS(154),
+    [anon_sym_else] = ACTIONS(154),
+    [anon_sym_for] = ACTIONS(154),
+    [anon_sym_repeat] = ACTIONS(154),
+    [anon_sym_menu] = ACTIONS(154),
+    [anon_sym_item] = ACTIONS(154),
+    [anon_sym_action] = ACTIONS(154),
+    [anon_sym_LPAREN] = ACTIONS(152),
+    [anon_sym_COMMA] = ACTIONS(152),
+    [anon_sym_RPAREN] = ACTIONS(152),
+    [anon_sym_LBRACE] = ACTIONS(152),
+    [anon_sym_RBRACE] = ACTIONS(152),
+    [anon_sym_true] = ACTIONS(154),
+    [anon_sym_false] = ACTIONS(154),
+    [anon_sym_name] = ACTIONS(154),
+    [anon_sym_glyph] = ACTIONS(154),
+    [anon_sym_from] = ACTIONS(154),
+    [anon_sym_mac] = ACTIONS(154),
+    [anon_sym_inputs] = ACTIONS(154),
+    [anon_sym_noinput] = ACTIONS(154),
+    [anon_sym_askfor] = ACTIONS(154),
+    [anon_sym_getclipboard] = ACTIONS(154),
+    [anon_sym_list] = ACTIONS(154),
+    [anon_sym_nil] = ACTIONS(154),
+    [anon_sym_stop] = ACTIONS(154),
+    [anon_sym_makeVCard] = ACTIONS(154),
+    [anon_sym_rawAction] = ACTIONS(154),
+    [anon_sym_embedFile] = ACTIONS(154),
+    [anon_sym_nothing] = ACTIONS(154),
+    [anon_sym_CurrentDate] = ACTIONS(154),
+    [anon_sym_Device] = ACTIONS(154),
+    [anon_sym_RepeatIndex] = ACTIONS(154),
+    [anon_sym_RepeatItem] = ACTIONS(154),
+    [anon_sym_ShortcutInput] = ACTIONS(154),
+    [anon_sym_Ask] = ACTIONS(154),
+    [anon_sym_text] = ACTIONS(154),
+    [anon_sym_number] = ACTIONS(154),
+    [anon_sym_bool] = ACTIONS(154),
+    [anon_sym_dictionary] = ACTIONS(154),
+    [anon_sym_array] = ACTIONS(154),
+    [anon_sym_variable] = ACTIONS(154),
+    [anon_sym_color] = ACTIONS(154),
+    [anon_sym_float] = ACTIONS(154),
+    [anon_sym_STAR] = ACTIONS(152),
+    [anon_sym_SLASH] = ACTIONS(154),
+    [anon_sym_PLUS] = ACTIONS(152),
+    [anon_sym_DASH] = ACTIONS(152),
+    [anon_sym_EQ_EQ] = ACTIONS(152),
+    [anon_sym_BANG_EQ] = ACTIONS(152),
+    [anon_sym_LT] = ACTIONS(154),
+    [anon_sym_GT] = ACTIONS(154),
+    [anon_sym_LT_EQ] = ACTIONS(152),
+    [anon_sym_GT_EQ] = ACTIONS(152),
+    [sym_at_variable] = ACTIONS(152),
+    [anon_sym_DQUOTE] = ACTIONS(152),
+    [anon_sym_SQUOTE] = ACTIONS(152),
+    [sym_number] = ACTIONS(152),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(20)] = {
     [sym_comment] = STATE(20),
-    [ts_builtin_sym_end] = ACTIONS(151),
-    [sym_identifier] = ACTIONS(153),
-    [anon_sym_POUNDinclude] = ACTIONS(151),
-    [anon_sym_POUNDdefine] = ACTIONS(151),
-    [anon_sym_POUNDimport] = ACTIONS(151),
-    [anon_sym_POUNDquestion] = ACTIONS(151),
-    [anon_sym_COLON] = ACTIONS(151),
-    [anon_sym_const] = ACTIONS(153),
-    [anon_sym_if] = ACTIONS(153),
-    [anon_sym_else] = ACTIONS(153),
-    [anon_sym_for] = ACTIONS(153),
-    [anon_sym_repeat] = ACTIONS(153),
-    [anon_sym_menu] = ACTIONS(153),
-    [anon_sym_item] = ACTIONS(153),
-    [anon_sym_LBRACE] = ACTIONS(151),
-    [anon_sym_RBRACE] = ACTIONS(151),
-    [anon_sym_COMMA] = ACTIONS(151),
-    [anon_sym_true] = ACTIONS(153),
-    [anon_sym_false] = ACTIONS(153),
-    [anon_sym_name] = ACTIONS(153),
-    [anon_sym_glyph] = ACTIONS(153),
-    [anon_sym_from] = ACTIONS(153),
-    [anon_sym_mac] = ACTIONS(153),
-    [anon_sym_inputs] = ACTIONS(153),
-    [anon_sym_noinput] = ACTIONS(153),
-    [anon_sym_askfor] = ACTIONS(153),
-    [anon_sym_getclipboard] = ACTIONS(153),
-    [anon_sym_list] = ACTIONS(153),
-    [anon_sym_nil] = ACTIONS(153),
-    [anon_sym_action] = ACTIONS(153),
-    [anon_sym_stop] = ACTIONS(153),
-    [anon_sym_makeVCard] = ACTIONS(153),
-    [anon_sym_rawAction] = ACTIONS(153),
-    [anon_sym_embedFile] = ACTIONS(153),
-    [anon_sym_nothing] = ACTIONS(153),
-    [anon_sym_CurrentDate] = ACTIONS(153),
-    [anon_sym_Device] = ACTIONS(153),
-    [anon_sym_RepeatIndex] = ACTIONS(153),
-    [anon_sym_RepeatItem] = ACTIONS(153),
-    [anon_sym_ShortcutInput] = ACTIONS(153),
-    [anon_sym_Ask] = ACTIONS(153),
-    [anon_sym_text] = ACTIONS(153),
-    [anon_sym_number] = ACTIONS(153),
-    [anon_sym_bool] = ACTIONS(153),
-    [anon_sym_dictionary] = ACTIONS(153),
-    [anon_sym_array] = ACTIONS(153),
-    [anon_sym_variable] = ACTIONS(153),
-    [anon_sym_color] = ACTIONS(153),
-    [anon_sym_float] = ACTIONS(153),
-    [anon_sym_LPAREN] = ACTIONS(151),
-    [anon_sym_RPAREN] = ACTIONS(151),
-    [anon_sym_STAR] = ACTIONS(151),
-    [anon_sym_SLASH] = ACTIONS(153),
-    [anon_sym_PLUS] = ACTIONS(151),
-    [anon_sym_DASH] = ACTIONS(151),
-    [anon_sym_EQ_EQ] = ACTIONS(151),
-    [anon_sym_BANG_EQ] = ACTIONS(151),
-    [anon_sym_LT] = ACTIONS(153),
-    [anon_sym_GT] = ACTIONS(153),
-    [anon_sym_LT_EQ] = ACTIONS(151),
-    [anon_sym_GT_EQ] = ACTIONS(151),
-    [sym_at_variable] = ACTIONS(151),
-    [anon_sym_DQUOTE] = ACTIONS(151),
-    [anon_sym_SQUOTE] = ACTIONS(151),
-    [sym_number] = ACTIONS(151),
+    [ts_builtin_sym_end] = ACTIONS(152),
+    [sym_identifier] = ACTIONS(154),
+    [anon_sym_POUNDinclude] = ACTIONS(152),
+    [anon_sym_POUNDdefine] = ACTIONS(152),
+    [anon_sym_POUNDimport] = ACTIONS(152),
+    [anon_sym_POUNDquestion] = ACTIONS(152),
+    [anon_sym_COLON] = ACTIONS(152),
+    [anon_sym_const] = ACTIONS(154),
+    [anon_sym_if] = ACTIONS(154),
+    [anon_sym_else] = ACTIONS(154),
+    [anon_sym_for] = ACTIONS(154),
+    [anon_sym_repeat] = ACTIONS(154),
+    [anon_sym_menu] = ACTIONS(154),
+    [anon_sym_item] = ACTIONS(154),
+    [anon_sym_action] = ACTIONS(154),
+    [anon_sym_LPAREN] = ACTIONS(156),
+    [anon_sym_COMMA] = ACTIONS(152),
+    [anon_sym_RPAREN] = ACTIONS(152),
+    [anon_sym_LBRACE] = ACTIONS(152),
+    [anon_sym_RBRACE] = ACTIONS(152),
+    [anon_sym_true] = ACTIONS(154),
+    [anon_sym_false] = ACTIONS(154),
+    [anon_sym_name] = ACTIONS(154),
+    [anon_sym_glyph] = ACTIONS(154),
+    [anon_sym_from] = ACTIONS(154),
+    [anon_sym_mac] = ACTIONS(154),
+    [anon_sym_inputs] = ACTIONS(154),
+    [anon_sym_noinput] = ACTIONS(154),
+    [anon_sym_askfor] = ACTIONS(154),
+    [anon_sym_getclipboard] = ACTIONS(154),
+    [anon_sym_list] = ACTIONS(154),
+    [anon_sym_nil] = ACTIONS(154),
+    [anon_sym_stop] = ACTIONS(154),
+    [anon_sym_makeVCard] = ACTIONS(154),
+    [anon_sym_rawAction] = ACTIONS(154),
+    [anon_sym_embedFile] = ACTIONS(154),
+    [anon_sym_nothing] = ACTIONS(154),
+    [anon_sym_CurrentDate] = ACTIONS(154),
+    [anon_sym_Device] = ACTIONS(154),
+    [anon_sym_RepeatIndex] = ACTIONS(154),
+    [anon_sym_RepeatItem] = ACTIONS(154),
+    [anon_sym_ShortcutInput] = ACTIONS(154),
+    [anon_sym_Ask] = ACTIONS(154),
+    [anon_sym_text] = ACTIONS(154),
+    [anon_sym_number] = ACTIONS(154),
+    [anon_sym_bool] = ACTIONS(154),
+    [anon_sym_dictionary] = ACTIONS(154),
+    [anon_sym_array] = ACTIONS(154),
+    [anon_sym_variable] = ACTIONS(154),
+    [anon_sym_color] = ACTIONS(154),
+    [anon_sym_float] = ACTIONS(154),
+    [anon_sym_STAR] = ACTIONS(152),
+    [anon_sym_SLASH] = ACTIONS(154),
+    [anon_sym_PLUS] = ACTIONS(152),
+    [anon_sym_DASH] = ACTIONS(152),
+    [anon_sym_EQ_EQ] = ACTIONS(152),
+    [anon_sym_BANG_EQ] = ACTIONS(152),
+    [anon_sym_LT] = ACTIONS(154),
+    [anon_sym_GT] = ACTIONS(154),
+    [anon_sym_LT_EQ] = ACTIONS(152),
+    [anon_sym_GT_EQ] = ACTIONS(152),
+    [sym_at_variable] = ACTIONS(152),
+    [anon_sym_DQUOTE] = ACTIONS(152),
+    [anon_sym_SQUOTE] = ACTIONS(152),
+    [sym_number] = ACTIONS(152),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(21)] = {
     [sym_comment] = STATE(21),
-    [ts_builtin_sym_end] = ACTIONS(155),
-    [sym_identifier] = ACTIONS(157),
-    [anon_sym_POUNDinclude] = ACTIONS(155),
-    [anon_sym_POUNDdefine] = ACTIONS(155),
-    [anon_sym_POUNDimport] = ACTIONS(155),
-    [anon_sym_POUNDquestion] = ACTIONS(155),
-    [anon_sym_COLON] = ACTIONS(155),
-    [anon_sym_const] = ACTIONS(157),
-    [anon_sym_if] = ACTIONS(157),
-    [anon_sym_else] = ACTIONS(157),
-    [anon_sym_for] = ACTIONS(157),
-    [anon_sym_repeat] = ACTIONS(157),
-    [anon_sym_menu] = ACTIONS(157),
-    [anon_sym_item] = ACTIONS(157),
-    [anon_sym_LBRACE] = ACTIONS(155),
-    [anon_sym_RBRACE] = ACTIONS(155),
-    [anon_sym_COMMA] = ACTIONS(155),
-    [anon_sym_true] = ACTIONS(157),
-    [anon_sym_false] = ACTIONS(157),
-    [anon_sym_name] = ACTIONS(157),
-    [anon_sym_glyph] = ACTIONS(157),
-    [anon_sym_from] = ACTIONS(157),
-    [anon_sym_mac] = ACTIONS(157),
-    [anon_sym_inputs] = ACTIONS(157),
-    [anon_sym_noinput] = ACTIONS(157),
-    [anon_sym_askfor] = ACTIONS(157),
-    [anon_sym_getclipboard] = ACTIONS(157),
-    [anon_sym_list] = ACTIONS(157),
-    [anon_sym_nil] = ACTIONS(157),
-    [anon_sym_action] = ACTIONS(157),
-    [anon_sym_stop] = ACTIONS(157),
-    [anon_sym_makeVCard] = ACTIONS(157),
-    [anon_sym_rawAction] = ACTIONS(157),
-    [anon_sym_embedFile] = ACTIONS(157),
-    [anon_sym_nothing] = ACTIONS(157),
-    [anon_sym_CurrentDate] = ACTIONS(157),
-    [anon_sym_Device] = ACTIONS(157),
-    [anon_sym_RepeatIndex] = ACTIONS(157),
-    [anon_sym_RepeatItem] = ACTIONS(157),
-    [anon_sym_ShortcutInput] = ACTIONS(157),
-    [anon_sym_Ask] = ACTIONS(157),
-    [anon_sym_text] = ACTIONS(157),
-    [anon_sym_number] = ACTIONS(157),
-    [anon_sym_bool] = ACTIONS(157),
-    [anon_sym_dictionary] = ACTIONS(157),
-    [anon_sym_array] = ACTIONS(157),
-    [anon_sym_variable] = ACTIONS(157),
-    [anon_sym_color] = ACTIONS(157),
-    [anon_sym_float] = ACTIONS(157),
-    [anon_sym_LPAREN] = ACTIONS(155),
-    [anon_sym_RPAREN] = ACTIONS(155),
-    [anon_sym_STAR] = ACTIONS(155),
-    [anon_sym_SLASH] = ACTIONS(157),
-    [anon_sym_PLUS] = ACTIONS(155),
-    [anon_sym_DASH] = ACTIONS(155),
-    [anon_sym_EQ_EQ] = ACTIONS(155),
-    [anon_sym_BANG_EQ] = ACTIONS(155),
-    [anon_sym_LT] = ACTIONS(157),
-    [anon_sym_GT] = ACTIONS(157),
-    [anon_sym_LT_EQ] = ACTIONS(155),
-    [anon_sym_GT_EQ] = ACTIONS(155),
-    [sym_at_variable] = ACTIONS(155),
-    [anon_sym_DQUOTE] = ACTIONS(155),
-    [anon_sym_SQUOTE] = ACTIONS(155),
-    [sym_number] = ACTIONS(155),
+    [ts_builtin_sym_end] = ACTIONS(158),
+    [sym_identifier] = ACTIONS(160),
+    [anon_sym_POUNDinclude] = ACTIONS(158),
+    [anon_sym_POUNDdefine] = ACTIONS(158),
+    [anon_sym_POUNDimport] = ACTIONS(158),
+    [anon_sym_POUNDquestion] = ACTIONS(158),
+    [anon_sym_COLON] = ACTIONS(158),
+    [anon_sym_const] = ACTIONS(160),
+    [anon_sym_if] = ACTIONS(160),
+    [anon_sym_else] = ACTIONS(160),
+    [anon_sym_for] = ACTIONS(160),
+    [anon_sym_repeat] = ACTIONS(160),
+    [anon_sym_menu] = ACTIONS(160),
+    [anon_sym_item] = ACTIONS(160),
+    [anon_sym_action] = ACTIONS(160),
+    [anon_sym_LPAREN] = ACTIONS(158),
+    [anon_sym_COMMA] = ACTIONS(158),
+    [anon_sym_RPAREN] = ACTIONS(158),
+    [anon_sym_LBRACE] = ACTIONS(158),
+    [anon_sym_RBRACE] = ACTIONS(158),
+    [anon_sym_true] = ACTIONS(160),
+    [anon_sym_false] = ACTIONS(160),
+    [anon_sym_name] = ACTIONS(160),
+    [anon_sym_glyph] = ACTIONS(160),
+    [anon_sym_from] = ACTIONS(160),
+    [anon_sym_mac] = ACTIONS(160),
+    [anon_sym_inputs] = ACTIONS(160),
+    [anon_sym_noinput] = ACTIONS(160),
+    [anon_sym_askfor] = ACTIONS(160),
+    [anon_sym_getclipboard] = ACTIONS(160),
+    [anon_sym_list] = ACTIONS(160),
+    [anon_sym_nil] = ACTIONS(160),
+    [anon_sym_stop] = ACTIONS(160),
+    [anon_sym_makeVCard] = ACTIONS(160),
+    [anon_sym_rawAction] = ACTIONS(160),
+    [anon_sym_embedFile] = ACTIONS(160),
+    [anon_sym_nothing] = ACTIONS(160),
+    [anon_sym_CurrentDate] = ACTIONS(160),
+    [anon_sym_Device] = ACTIONS(160),
+    [anon_sym_RepeatIndex] = ACTIONS(160),
+    [anon_sym_RepeatItem] = ACTIONS(160),
+    [anon_sym_ShortcutInput] = ACTIONS(160),
+    [anon_sym_Ask] = ACTIONS(160),
+    [anon_sym_text] = ACTIONS(160),
+    [anon_sym_number] = ACTIONS(160),
+    [anon_sym_bool] = ACTIONS(160),
+    [anon_sym_dictionary] = ACTIONS(160),
+    [anon_sym_array] = ACTIONS(160),
+    [anon_sym_variable] = ACTIONS(160),
+    [anon_sym_color] = ACTIONS(160),
+    [anon_sym_float] = ACTIONS(160),
+    [anon_sym_STAR] = ACTIONS(158),
+    [anon_sym_SLASH] = ACTIONS(160),
+    [anon_sym_PLUS] = ACTIONS(158),
+    [anon_sym_DASH] = ACTIONS(158),
+    [anon_sym_EQ_EQ] = ACTIONS(158),
+    [anon_sym_BANG_EQ] = ACTIONS(158),
+    [anon_sym_LT] = ACTIONS(160),
+    [anon_sym_GT] = ACTIONS(160),
+    [anon_sym_LT_EQ] = ACTIONS(158),
+    [anon_sym_GT_EQ] = ACTIONS(158),
+    [sym_at_variable] = ACTIONS(158),
+    [anon_sym_DQUOTE] = ACTIONS(158),
+    [anon_sym_SQUOTE] = ACTIONS(158),
+    [sym_number] = ACTIONS(158),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(22)] = {
     [sym_comment] = STATE(22),
-    [ts_builtin_sym_end] = ACTIONS(159),
-    [sym_identifier] = ACTIONS(161),
-    [anon_sym_POUNDinclude] = ACTIONS(159),
-    [anon_sym_POUNDdefine] = ACTIONS(159),
-    [anon_sym_POUNDimport] = ACTIONS(159),
-    [anon_sym_POUNDquestion] = ACTIONS(159),
-    [anon_sym_COLON] = ACTIONS(159),
-    [anon_sym_const] = ACTIONS(161),
-    [anon_sym_if] = ACTIONS(161),
-    [anon_sym_else] = ACTIONS(161),
-    [anon_sym_for] = ACTIONS(161),
-    [anon_sym_repeat] = ACTIONS(161),
-    [anon_sym_menu] = ACTIONS(161),
-    [anon_sym_item] = ACTIONS(161),
-    [anon_sym_LBRACE] = ACTIONS(159),
-    [anon_sym_RBRACE] = ACTIONS(159),
-    [anon_sym_COMMA] = ACTIONS(159),
-    [anon_sym_true] = ACTIONS(161),
-    [anon_sym_false] = ACTIONS(161),
-    [anon_sym_name] = ACTIONS(161),
-    [anon_sym_glyph] = ACTIONS(161),
-    [anon_sym_from] = ACTIONS(161),
-    [anon_sym_mac] = ACTIONS(161),
-    [anon_sym_inputs] = ACTIONS(161),
-    [anon_sym_noinput] = ACTIONS(161),
-    [anon_sym_askfor] = ACTIONS(161),
-    [anon_sym_getclipboard] = ACTIONS(161),
-    [anon_sym_list] = ACTIONS(161),
-    [anon_sym_nil] = ACTIONS(161),
-    [anon_sym_action] = ACTIONS(161),
-    [anon_sym_stop] = ACTIONS(161),
-    [anon_sym_makeVCard] = ACTIONS(161),
-    [anon_sym_rawAction] = ACTIONS(161),
-    [anon_sym_embedFile] = ACTIONS(161),
-    [anon_sym_nothing] = ACTIONS(161),
-    [anon_sym_CurrentDate] = ACTIONS(161),
-    [anon_sym_Device] = ACTIONS(161),
-    [anon_sym_RepeatIndex] = ACTIONS(161),
-    [anon_sym_RepeatItem] = ACTIONS(161),
-    [anon_sym_ShortcutInput] = ACTIONS(161),
-    [anon_sym_Ask] = ACTIONS(161),
-    [anon_sym_text] = ACTIONS(161),
-    [anon_sym_number] = ACTIONS(161),
-    [anon_sym_bool] = ACTIONS(161),
-    [anon_sym_dictionary] = ACTIONS(161),
-    [anon_sym_array] = ACTIONS(161),
-    [anon_sym_variable] = ACTIONS(161),
-    [anon_sym_color] = ACTIONS(161),
-    [anon_sym_float] = ACTIONS(161),
-    [anon_sym_LPAREN] = ACTIONS(159),
-    [anon_sym_RPAREN] = ACTIONS(159),
-    [anon_sym_STAR] = ACTIONS(159),
-    [anon_sym_SLASH] = ACTIONS(161),
-    [anon_sym_PLUS] = ACTIONS(159),
-    [anon_sym_DASH] = ACTIONS(159),
-    [anon_sym_EQ_EQ] = ACTIONS(159),
-    [anon_sym_BANG_EQ] = ACTIONS(159),
-    [anon_sym_LT] = ACTIONS(161),
-    [anon_sym_GT] = ACTIONS(161),
-    [anon_sym_LT_EQ] = ACTIONS(159),
-    [anon_sym_GT_EQ] = ACTIONS(159),
-    [sym_at_variable] = ACTIONS(159),
-    [anon_sym_DQUOTE] = ACTIONS(159),
-    [anon_sym_SQUOTE] = ACTIONS(159),
-    [sym_number] = ACTIONS(159),
+    [ts_builtin_sym_end] = ACTIONS(162),
+    [sym_identifier] = ACTIONS(164),
+    [anon_sym_POUNDinclude] = ACTIONS(162),
+    [anon_sym_POUNDdefine] = ACTIONS(162),
+    [anon_sym_POUNDimport] = ACTIONS(162),
+    [anon_sym_POUNDquestion] = ACTIONS(162),
+    [anon_sym_COLON] = ACTIONS(162),
+    [anon_sym_const] = ACTIONS(164),
+    [anon_sym_if] = ACTIONS(164),
+    [anon_sym_else] = ACTIONS(164),
+    [anon_sym_for] = ACTIONS(164),
+    [anon_sym_repeat] = ACTIONS(164),
+    [anon_sym_menu] = ACTIONS(164),
+    [anon_sym_item] = ACTIONS(164),
+    [anon_sym_action] = ACTIONS(164),
+    [anon_sym_LPAREN] = ACTIONS(162),
+    [anon_sym_COMMA] = ACTIONS(162),
+    [anon_sym_RPAREN] = ACTIONS(162),
+    [anon_sym_LBRACE] = ACTIONS(162),
+    [anon_sym_RBRACE] = ACTIONS(162),
+    [anon_sym_true] = ACTIONS(164),
+    [anon_sym_false] = ACTIONS(164),
+    [anon_sym_name] = ACTIONS(164),
+    [anon_sym_glyph] = ACTIONS(164),
+    [anon_sym_from] = ACTIONS(164),
+    [anon_sym_mac] = ACTIONS(164),
+    [anon_sym_inputs] = ACTIONS(164),
+    [anon_sym_noinput] = ACTIONS(164),
+    [anon_sym_askfor] = ACTIONS(164),
+    [anon_sym_getclipboard] = ACTIONS(164),
+    [anon_sym_list] = ACTIONS(164),
+    [anon_sym_nil] = ACTIONS(164),
+    [anon_sym_stop] = ACTIONS(164),
+    [anon_sym_makeVCard] = ACTIONS(164),
+    [anon_sym_rawAction] = ACTIONS(164),
+    [anon_sym_embedFile] = ACTIONS(164),
+    [anon_sym_nothing] = ACTIONS(164),
+    [anon_sym_CurrentDate] = ACTIONS(164),
+    [anon_sym_Device] = ACTIONS(164),
+    [anon_sym_RepeatIndex] = ACTIONS(164),
+    [anon_sym_RepeatItem] = ACTIONS(164),
+    [anon_sym_ShortcutInput] = ACTIONS(164),
+    [anon_sym_Ask] = ACTIONS(164),
+    [anon_sym_text] = ACTIONS(164),
+    [anon_sym_number] = ACTIONS(164),
+    [anon_sym_bool] = ACTIONS(164),
+    [anon_sym_dictionary] = ACTIONS(164),
+    [anon_sym_array] = ACTIONS(164),
+    [anon_sym_variable] = ACTIONS(164),
+    [anon_sym_color] = ACTIONS(164),
+    [anon_sym_float] = ACTIONS(164),
+    [anon_sym_STAR] = ACTIONS(162),
+    [anon_sym_SLASH] = ACTIONS(164),
+    [anon_sym_PLUS] = ACTIONS(162),
+    [anon_sym_DASH] = ACTIONS(162),
+    [anon_sym_EQ_EQ] = ACTIONS(162),
+    [anon_sym_BANG_EQ] = ACTIONS(162),
+    [anon_sym_LT] = ACTIONS(164),
+    [anon_sym_GT] = ACTIONS(164),
+    [anon_sym_LT_EQ] = ACTIONS(162),
+    [anon_sym_GT_EQ] = ACTIONS(162),
+    [sym_at_variable] = ACTIONS(162),
+    [anon_sym_DQUOTE] = ACTIONS(162),
+    [anon_sym_SQUOTE] = ACTIONS(162),
+    [sym_number] = ACTIONS(162),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(23)] = {
     [sym_comment] = STATE(23),
-    [ts_builtin_sym_end] = ACTIONS(163),
-    [sym_identifier] = ACTIONS(165),
-    [anon_sym_POUNDinclude] = ACTIONS(163),
-    [anon_sym_POUNDdefine] = ACTIONS(163),
-    [anon_sym_POUNDimport] = ACTIONS(163),
-    [anon_sym_POUNDquestion] = ACTIONS(163),
-    [anon_sym_COLON] = ACTIONS(163),
-    [anon_sym_const] = ACTIONS(165),
-    [anon_sym_if] = ACTIONS(165),
-    [anon_sym_else] = ACTIONS(165),
-    [anon_sym_for] = ACTIONS(165),
-    [anon_sym_repeat] = ACTIONS(165),
-    [anon_sym_menu] = ACTIONS(165),
-    [anon_sym_item] = ACTIONS(165),
-    [anon_sym_LBRACE] = ACTIONS(163),
-    [anon_sym_RBRACE] = ACTIONS(163),
-    [anon_sym_COMMA] = ACTIONS(163),
-    [anon_sym_true] = ACTIONS(165),
-    [anon_sym_false] = ACTIONS(165),
-    [anon_sym_name] = ACTIONS(165),
-    [anon_sym_glyph] = ACTIONS(165),
-    [anon_sym_from] = ACTIONS(165),
-    [anon_sym_mac] = ACTIONS(165),
-    [anon_sym_inputs] = ACTIONS(165),
-    [anon_sym_noinput] = ACTIONS(165),
-    [anon_sym_askfor] = ACTIONS(165),
-    [anon_sym_getclipboard] = ACTIONS(165),
-    [anon_sym_list] = ACTIONS(165),
-    [anon_sym_nil] = ACTIONS(165),
-    [anon_sym_action] = ACTIONS(165),
-    [anon_sym_stop] = ACTIONS(165),
-    [anon_sym_makeVCard] = ACTIONS(165),
-    [anon_sym_rawAction] = ACTIONS(165),
-    [anon_sym_embedFile] = ACTIONS(165),
-    [anon_sym_nothing] = ACTIONS(165),
-    [anon_sym_CurrentDate] = ACTIONS(165),
-    [anon_sym_Device] = ACTIONS(165),
-    [anon_sym_RepeatIndex] = ACTIONS(165),
-    [anon_sym_RepeatItem] = ACTIONS(165),
-    [anon_sym_ShortcutInput] = ACTIONS(165),
-    [anon_sym_Ask] = ACTIONS(165),
-    [anon_sym_text] = ACTIONS(165),
-    [anon_sym_number] = ACTIONS(165),
-    [anon_sym_bool] = ACTIONS(165),
-    [anon_sym_dictionary] = ACTIONS(165),
-    [anon_sym_array] = ACTIONS(165),
-    [anon_sym_variable] = ACTIONS(165),
-    [anon_sym_color] = ACTIONS(165),
-    [anon_sym_float] = ACTIONS(165),
-    [anon_sym_LPAREN] = ACTIONS(163),
-    [anon_sym_RPAREN] = ACTIONS(163),
-    [anon_sym_STAR] = ACTIONS(163),
-    [anon_sym_SLASH] = ACTIONS(165),
-    [anon_sym_PLUS] = ACTIONS(163),
-    [anon_sym_DASH] = ACTIONS(163),
-    [anon_sym_EQ_EQ] = ACTIONS(163),
-    [anon_sym_BANG_EQ] = ACTIONS(163),
-    [anon_sym_LT] = ACTIONS(165),
-    [anon_sym_GT] = ACTIONS(165),
-    [anon_sym_LT_EQ] = ACTIONS(163),
-    [anon_sym_GT_EQ] = ACTIONS(163),
-    [sym_at_variable] = ACTIONS(163),
-    [anon_sym_DQUOTE] = ACTIONS(163),
-    [anon_sym_SQUOTE] = ACTIONS(163),
-    [sym_number] = ACTIONS(163),
+    [ts_builtin_sym_end] = ACTIONS(166),
+    [sym_identifier] = ACTIONS(168),
+    [anon_sym_POUNDinclude] = ACTIONS(166),
+    [anon_sym_POUNDdefine] = ACTIONS(166),
+    [anon_sym_POUNDimport] = ACTIONS(166),
+    [anon_sym_POUNDquestion] = ACTIONS(166),
+    [anon_sym_COLON] = ACTIONS(166),
+    [anon_sym_const] = ACTIONS(168),
+    [anon_sym_if] = ACTIONS(168),
+    [anon_sym_else] = ACTIONS(168),
+    [anon_sym_for] = ACTIONS(168),
+    [anon_sym_repeat] = ACTIONS(168),
+    [anon_sym_menu] = ACTIONS(168),
+    [anon_sym_item] = ACTIONS(168),
+    [anon_sym_action] = ACTIONS(168),
+    [anon_sym_LPAREN] = ACTIONS(166),
+    [anon_sym_COMMA] = ACTIONS(166),
+    [anon_sym_RPAREN] = ACTIONS(166),
+    [anon_sym_LBRACE] = ACTIONS(166),
+    [anon_sym_RBRACE] = ACTIONS(166),
+    [anon_sym_true] = ACTIONS(168),
+    [anon_sym_false] = ACTIONS(168),
+    [anon_sym_name] = ACTIONS(168),
+    [anon_sym_glyph] = ACTIONS(168),
+    [anon_sym_from] = ACTIONS(168),
+    [anon_sym_mac] = ACTIONS(168),
+    [anon_sym_inputs] = ACTIONS(168),
+    [anon_sym_noinput] = ACTIONS(168),
+    [anon_sym_askfor] = ACTIONS(168),
+    [anon_sym_getclipboard] = ACTIONS(168),
+    [anon_sym_list] = ACTIONS(168),
+    [anon_sym_nil] = ACTIONS(168),
+    [anon_sym_stop] = ACTIONS(168),
+    [anon_sym_makeVCard] = ACTIONS(168),
+    [anon_sym_rawAction] = ACTIONS(168),
+    [anon_sym_embedFile] = ACTIONS(168),
+    [anon_sym_nothing] = ACTIONS(168),
+    [anon_sym_CurrentDate] = ACTIONS(168),
+    [anon_sym_Device] = ACTIONS(168),
+    [anon_sym_RepeatIndex] = ACTIONS(168),
+    [anon_sym_RepeatItem] = ACTIONS(168),
+    [anon_sym_ShortcutInput] = ACTIONS(168),
+    [anon_sym_Ask] = ACTIONS(168),
+    [anon_sym_text] = ACTIONS(168),
+    [anon_sym_number] = ACTIONS(168),
+    [anon_sym_bool] = ACTIONS(168),
+    [anon_sym_dictionary] = ACTIONS(168),
+    [anon_sym_array] = ACTIONS(168),
+    [anon_sym_variable] = ACTIONS(168),
+    [anon_sym_color] = ACTIONS(168),
+    [anon_sym_float] = ACTIONS(168),
+    [anon_sym_STAR] = ACTIONS(166),
+    [anon_sym_SLASH] = ACTIONS(168),
+    [anon_sym_PLUS] = ACTIONS(166),
+    [anon_sym_DASH] = ACTIONS(166),
+    [anon_sym_EQ_EQ] = ACTIONS(166),
+    [anon_sym_BANG_EQ] = ACTIONS(166),
+    [anon_sym_LT] = ACTIONS(168),
+    [anon_sym_GT] = ACTIONS(168),
+    [anon_sym_LT_EQ] = ACTIONS(166),
+    [anon_sym_GT_EQ] = ACTIONS(166),
+    [sym_at_variable] = ACTIONS(166),
+    [anon_sym_DQUOTE] = ACTIONS(166),
+    [anon_sym_SQUOTE] = ACTIONS(166),
+    [sym_number] = ACTIONS(166),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(24)] = {
     [sym_comment] = STATE(24),
-    [ts_builtin_sym_end] = ACTIONS(167),
-    [sym_identifier] = ACTIONS(169),
-    [anon_sym_POUNDinclude] = ACTIONS(167),
-    [anon_sym_POUNDdefine] = ACTIONS(167),
-    [anon_sym_POUNDimport] = ACTIONS(167),
-    [anon_sym_POUNDquestion] = ACTIONS(167),
-    [anon_sym_COLON] = ACTIONS(167),
-    [anon_sym_const] = ACTIONS(169),
-    [anon_sym_if] = ACTIONS(169),
-    [anon_sym_else] = ACTIONS(169),
-    [anon_sym_for] = ACTIONS(169),
-    [anon_sym_repeat] = ACTIONS(169),
-    [anon_sym_menu] = ACTIONS(169),
-    [anon_sym_item] = ACTIONS(169),
-    [anon_sym_LBRACE] = ACTIONS(167),
-    [anon_sym_RBRACE] = ACTIONS(167),
-    [anon_sym_COMMA] = ACTIONS(167),
-    [anon_sym_true] = ACTIONS(169),
-    [anon_sym_false] = ACTIONS(169),
-    [anon_sym_name] = ACTIONS(169),
-    [anon_sym_glyph] = ACTIONS(169),
-    [anon_sym_from] = ACTIONS(169),
-    [anon_sym_mac] = ACTIONS(169),
-    [anon_sym_inputs] = ACTIONS(169),
-    [anon_sym_noinput] = ACTIONS(169),
-    [anon_sym_askfor] = ACTIONS(169),
-    [anon_sym_getclipboard] = ACTIONS(169),
-    [anon_sym_list] = ACTIONS(169),
-    [anon_sym_nil] = ACTIONS(169),
-    [anon_sym_action] = ACTIONS(169),
-    [anon_sym_stop] = ACTIONS(169),
-    [anon_sym_makeVCard] = ACTIONS(169),
-    [anon_sym_rawAction] = ACTIONS(169),
-    [anon_sym_embedFile] = ACTIONS(169),
-    [anon_sym_nothing] = ACTIONS(169),
-    [anon_sym_CurrentDate] = ACTIONS(169),
-    [anon_sym_Device] = ACTIONS(169),
-    [anon_sym_RepeatIndex] = ACTIONS(169),
-    [anon_sym_RepeatItem] = ACTIONS(169),
-    [anon_sym_ShortcutInput] = ACTIONS(169),
-    [anon_sym_Ask] = ACTIONS(169),
-    [anon_sym_text] = ACTIONS(169),
-    [anon_sym_number] = ACTIONS(169),
-    [anon_sym_bool] = ACTIONS(169),
-    [anon_sym_dictionary] = ACTIONS(169),
-    [anon_sym_array] = ACTIONS(169),
-    [anon_sym_variable] = ACTIONS(169),
-    [anon_sym_color] = ACTIONS(169),
-    [anon_sym_float] = ACTIONS(169),
-    [anon_sym_LPAREN] = ACTIONS(167),
-    [anon_sym_RPAREN] = ACTIONS(167),
-    [anon_sym_STAR] = ACTIONS(167),
-    [anon_sym_SLASH] = ACTIONS(169),
-    [anon_sym_PLUS] = ACTIONS(167),
-    [anon_sym_DASH] = ACTIONS(167),
-    [anon_sym_EQ_EQ] = ACTIONS(167),
-    [anon_sym_BANG_EQ] = ACTIONS(167),
-    [anon_sym_LT] = ACTIONS(169),
-    [anon_sym_GT] = ACTIONS(169),
-    [anon_sym_LT_EQ] = ACTIONS(167),
-    [anon_sym_GT_EQ] = ACTIONS(167),
-    [sym_at_variable] = ACTIONS(167),
-    [anon_sym_DQUOTE] = ACTIONS(167),
-    [anon_sym_SQUOTE] = ACTIONS(167),
-    [sym_number] = ACTIONS(167),
+    [ts_builtin_sym_end] = ACTIONS(170),
+    [sym_identifier] = ACTIONS(172),
+    [anon_sym_POUNDinclude] = ACTIONS(170),
+    [anon_sym_POUNDdefine] = ACTIONS(170),
+    [anon_sym_POUNDimport] = ACTIONS(170),
+    [anon_sym_POUNDquestion] = ACTIONS(170),
+    [anon_sym_COLON] = ACTIONS(170),
+    [anon_sym_const] = ACTIONS(172),
+    [anon_sym_if] = ACTIONS(172),
+    [anon_sym_else] = ACTIONS(172),
+    [anon_sym_for] = ACTIONS(172),
+    [anon_sym_repeat] = ACTIONS(172),
+    [anon_sym_menu] = ACTIONS(172),
+    [anon_sym_item] = ACTIONS(172),
+    [anon_sym_action] = ACTIONS(172),
+    [anon_sym_LPAREN] = ACTIONS(170),
+    [anon_sym_COMMA] = ACTIONS(170),
+    [anon_sym_RPAREN] = ACTIONS(170),
+    [anon_sym_LBRACE] = ACTIONS(170),
+    [anon_sym_RBRACE] = ACTIONS(170),
+    [anon_sym_true] = ACTIONS(172),
+    [anon_sym_false] = ACTIONS(172),
+    [anon_sym_name] = ACTIONS(172),
+    [anon_sym_glyph] = ACTIONS(172),
+    [anon_sym_from] = ACTIONS(172),
+    [anon_sym_mac] = ACTIONS(172),
+    [anon_sym_inputs] = ACTIONS(172),
+    [anon_sym_noinput] = ACTIONS(172),
+    [anon_sym_askfor] = ACTIONS(172),
+    [anon_sym_getclipboard] = ACTIONS(172),
+    [anon_sym_list] = ACTIONS(172),
+    [anon_sym_nil] = ACTIONS(172),
+    [anon_sym_stop] = ACTIONS(172),
+    [anon_sym_makeVCard] = ACTIONS(172),
+    [anon_sym_rawAction] = ACTIONS(172),
+    [anon_sym_embedFile] = ACTIONS(172),
+    [anon_sym_nothing] = ACTIONS(172),
+    [anon_sym_CurrentDate] = ACTIONS(172),
+    [anon_sym_Device] = ACTIONS(172),
+    [anon_sym_RepeatIndex] = ACTIONS(172),
+    [anon_sym_RepeatItem] = ACTIONS(172),
+    [anon_sym_ShortcutInput] = ACTIONS(172),
+    [anon_sym_Ask] = ACTIONS(172),
+    [anon_sym_text] = ACTIONS(172),
+    [anon_sym_number] = ACTIONS(172),
+    [anon_sym_bool] = ACTIONS(172),
+    [anon_sym_dictionary] = ACTIONS(172),
+    [anon_sym_array] = ACTIONS(172),
+    [anon_sym_variable] = ACTIONS(172),
+    [anon_sym_color] = ACTIONS(172),
+    [anon_sym_float] = ACTIONS(172),
+    [anon_sym_STAR] = ACTIONS(170),
+    [anon_sym_SLASH] = ACTIONS(172),
+    [anon_sym_PLUS] = ACTIONS(170),
+    [anon_sym_DASH] = ACTIONS(170),
+    [anon_sym_EQ_EQ] = ACTIONS(170),
+    [anon_sym_BANG_EQ] = ACTIONS(170),
+    [anon_sym_LT] = ACTIONS(172),
+    [anon_sym_GT] = ACTIONS(172),
+    [anon_sym_LT_EQ] = ACTIONS(170),
+    [anon_sym_GT_EQ] = ACTIONS(170),
+    [sym_at_variable] = ACTIONS(170),
+    [anon_sym_DQUOTE] = ACTIONS(170),
+    [anon_sym_SQUOTE] = ACTIONS(170),
+    [sym_number] = ACTIONS(170),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(25)] = {
     [sym_comment] = STATE(25),
-    [ts_builtin_sym_end] = ACTIONS(131),
-    [sym_identifier] = ACTIONS(133),
-    [anon_sym_POUNDinclude] = ACTIONS(131),
-    [anon_sym_POUNDdefine] = ACTIONS(131),
-    [anon_sym_POUNDimport] = ACTIONS(131),
-    [anon_sym_POUNDquestion] = ACTIONS(131),
-    [anon_sym_COLON] = ACTIONS(131),
-    [anon_sym_const] = ACTIONS(133),
-    [anon_sym_if] = ACTIONS(133),
-    [anon_sym_else] = ACTIONS(133),
-    [anon_sym_for] = ACTIONS(133),
-    [anon_sym_repeat] = ACTIONS(133),
-    [anon_sym_menu] = ACTIONS(133),
-    [anon_sym_item] = ACTIONS(133),
-    [anon_sym_LBRACE] = ACTIONS(131),
-    [anon_sym_RBRACE] = ACTIONS(131),
-    [anon_sym_COMMA] = ACTIONS(131),
-    [anon_sym_true] = ACTIONS(133),
-    [anon_sym_false] = ACTIONS(133),
-    [anon_sym_name] = ACTIONS(133),
-    [anon_sym_glyph] = ACTIONS(133),
-    [anon_sym_from] = ACTIONS(133),
-    [anon_sym_mac] = ACTIONS(133),
-    [anon_sym_inputs] = ACTIONS(133),
-    [anon_sym_noinput] = ACTIONS(133),
-    [anon_sym_askfor] = ACTIONS(133),
-    [anon_sym_getclipboard] = ACTIONS(133),
-    [anon_sym_list] = ACTIONS(133),
-    [anon_sym_nil] = ACTIONS(133),
-    [anon_sym_action] = ACTIONS(133),
-    [anon_sym_stop] = ACTIONS(133),
-    [anon_sym_makeVCard] = ACTIONS(133),
-    [anon_sym_rawAction] = ACTIONS(133),
-    [anon_sym_embedFile] = ACTIONS(133),
-    [anon_sym_nothing] = ACTIONS(133),
-    [anon_sym_CurrentDate] = ACTIONS(133),
-    [anon_sym_Device] = ACTIONS(133),
-    [anon_sym_RepeatIndex] = ACTIONS(133),
-    [anon_sym_RepeatItem] = ACTIONS(133),
-    [anon_sym_ShortcutInput] = ACTIONS(133),
-    [anon_sym_Ask] = ACTIONS(133),
-    [anon_sym_text] = ACTIONS(133),
-    [anon_sym_number] = ACTIONS(133),
-    [anon_sym_bool] = ACTIONS(133),
-    [anon_sym_dictionary] = ACTIONS(133),
-    [anon_sym_array] = ACTIONS(133),
-    [anon_sym_variable] = ACTIONS(133),
-    [anon_sym_color] = ACTIONS(133),
-    [anon_sym_float] = ACTIONS(133),
-    [anon_sym_LPAREN] = ACTIONS(131),
-    [anon_sym_RPAREN] = ACTIONS(131),
-    [anon_sym_STAR] = ACTIONS(49),
-    [anon_sym_SLASH] = ACTIONS(51),
-    [anon_sym_PLUS] = ACTIONS(53),
-    [anon_sym_DASH] = ACTIONS(53),
-    [anon_sym_EQ_EQ] = ACTIONS(131),
-    [anon_sym_BANG_EQ] = ACTIONS(131),
-    [anon_sym_LT] = ACTIONS(133),
-    [anon_sym_GT] = ACTIONS(133),
-    [anon_sym_LT_EQ] = ACTIONS(131),
-    [anon_sym_GT_EQ] = ACTIONS(131),
-    [sym_at_variable] = ACTIONS(131),
-    [anon_sym_DQUOTE] = ACTIONS(131),
-    [anon_sym_SQUOTE] = ACTIONS(131),
-    [sym_number] = ACTIONS(131),
+    [ts_builtin_sym_end] = ACTIONS(174),
+    [sym_identifier] = ACTIONS(176),
+    [anon_sym_POUNDinclude] = ACTIONS(174),
+    [anon_sym_POUNDdefine] = ACTIONS(174),
+    [anon_sym_POUNDimport] = ACTIONS(174),
+    [anon_sym_POUNDquestion] = ACTIONS(174),
+    [anon_sym_COLON] = ACTIONS(174),
+    [anon_sym_const] = ACTIONS(176),
+    [anon_sym_if] = ACTIONS(176),
+    [anon_sym_else] = ACTIONS(176),
+    [anon_sym_for] = ACTIONS(176),
+    [anon_sym_repeat] = ACTIONS(176),
+    [anon_sym_menu] = ACTIONS(176),
+    [anon_sym_item] = ACTIONS(176),
+    [anon_sym_action] = ACTIONS(176),
+    [anon_sym_LPAREN] = ACTIONS(174),
+    [anon_sym_COMMA] = ACTIONS(174),
+    [anon_sym_RPAREN] = ACTIONS(174),
+    [anon_sym_LBRACE] = ACTIONS(174),
+    [anon_sym_RBRACE] = ACTIONS(174),
+    [anon_sym_true] = ACTIONS(176),
+    [anon_sym_false] = ACTIONS(176),
+    [anon_sym_name] = ACTIONS(176),
+    [anon_sym_glyph] = ACTIONS(176),
+    [anon_sym_from] = ACTIONS(176),
+    [anon_sym_mac] = ACTIONS(176),
+    [anon_sym_inputs] = ACTIONS(176),
+    [anon_sym_noinput] = ACTIONS(176),
+    [anon_sym_askfor] = ACTIONS(176),
+    [anon_sym_getclipboard] = ACTIONS(176),
+    [anon_sym_list] = ACTIONS(176),
+    [anon_sym_nil] = ACTIONS(176),
+    [anon_sym_stop] = ACTIONS(176),
+    [anon_sym_makeVCard] = ACTIONS(176),
+    [anon_sym_rawAction] = ACTIONS(176),
+    [anon_sym_embedFile] = ACTIONS(176),
+    [anon_sym_nothing] = ACTIONS(176),
+    [anon_sym_CurrentDate] = ACTIONS(176),
+    [anon_sym_Device] = ACTIONS(176),
+    [anon_sym_RepeatIndex] = ACTIONS(176),
+    [anon_sym_RepeatItem] = ACTIONS(176),
+    [anon_sym_ShortcutInput] = ACTIONS(176),
+    [anon_sym_Ask] = ACTIONS(176),
+    [anon_sym_text] = ACTIONS(176),
+    [anon_sym_number] = ACTIONS(176),
+    [anon_sym_bool] = ACTIONS(176),
+    [anon_sym_dictionary] = ACTIONS(176),
+    [anon_sym_array] = ACTIONS(176),
+    [anon_sym_variable] = ACTIONS(176),
+    [anon_sym_color] = ACTIONS(176),
+    [anon_sym_float] = ACTIONS(176),
+    [anon_sym_STAR] = ACTIONS(174),
+    [anon_sym_SLASH] = ACTIONS(176),
+    [anon_sym_PLUS] = ACTIONS(174),
+    [anon_sym_DASH] = ACTIONS(174),
+    [anon_sym_EQ_EQ] = ACTIONS(174),
+    [anon_sym_BANG_EQ] = ACTIONS(174),
+    [anon_sym_LT] = ACTIONS(176),
+    [anon_sym_GT] = ACTIONS(176),
+    [anon_sym_LT_EQ] = ACTIONS(174),
+    [anon_sym_GT_EQ] = ACTIONS(174),
+    [sym_at_variable] = ACTIONS(174),
+    [anon_sym_DQUOTE] = ACTIONS(174),
+    [anon_sym_SQUOTE] = ACTIONS(174),
+    [sym_number] = ACTIONS(174),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(26)] = {
     [sym_comment] = STATE(26),
-    [ts_builtin_sym_end] = ACTIONS(171),
-    [sym_identifier] = ACTIONS(173),
-    [anon_sym_POUNDinclude] = ACTIONS(171),
-    [anon_sym_POUNDdefine] = ACTIONS(171),
-    [anon_sym_POUNDimport] = ACTIONS(171),
-    [anon_sym_POUNDquestion] = ACTIONS(171),
-    [anon_sym_COLON] = ACTIONS(171),
-    [anon_sym_const] = ACTIONS(173),
-    [anon_sym_if] = ACTIONS(173),
-    [anon_sym_else] = ACTIONS(173),
-    [anon_sym_for] = ACTIONS(173),
-    [anon_sym_repeat] = ACTIONS(173),
-    [anon_sym_menu] = ACTIONS(173),
-    [anon_sym_item] = ACTIONS(173),
-    [anon_sym_LBRACE] = ACTIONS(171),
-    [anon_sym_RBRACE] = ACTIONS(171),
-    [anon_sym_COMMA] = ACTIONS(171),
-    [anon_sym_true] = ACTIONS(173),
-    [anon_sym_false] = ACTIONS(173),
-    [anon_sym_name] = ACTIONS(173),
-    [anon_sym_glyph] = ACTIONS(173),
-    [anon_sym_from] = ACTIONS(173),
-    [anon_sym_mac] = ACTIONS(173),
-    [anon_sym_inputs] = ACTIONS(173),
-    [anon_sym_noinput] = ACTIONS(173),
-    [anon_sym_askfor] = ACTIONS(173),
-    [anon_sym_getclipboard] = ACTIONS(173),
-    [anon_sym_list] = ACTIONS(173),
-    [anon_sym_nil] = ACTIONS(173),
-    [anon_sym_action] = ACTIONS(173),
-    [anon_sym_stop] = ACTIONS(173),
-    [anon_sym_makeVCard] = ACTIONS(173),
-    [anon_sym_rawAction] = ACTIONS(173),
-    [anon_sym_embedFile] = ACTIONS(173),
-    [anon_sym_nothing] = ACTIONS(173),
-    [anon_sym_CurrentDate] = ACTIONS(173),
-    [anon_sym_Device] = ACTIONS(173),
-    [anon_sym_RepeatIndex] = ACTIONS(173),
-    [anon_sym_RepeatItem] = ACTIONS(173),
-    [anon_sym_ShortcutInput] = ACTIONS(173),
-    [anon_sym_Ask] = ACTIONS(173),
-    [anon_sym_text] = ACTIONS(173),
-    [anon_sym_number] = ACTIONS(173),
-    [anon_sym_bool] = ACTIONS(173),
-    [anon_sym_dictionary] = ACTIONS(173),
-    [anon_sym_array] = ACTIONS(173),
-    [anon_sym_variable] = ACTIONS(173),
-    [anon_sym_color] = ACTIONS(173),
-    [anon_sym_float] = ACTIONS(173),
-    [anon_sym_LPAREN] = ACTIONS(171),
-    [anon_sym_RPAREN] = ACTIONS(171),
-    [anon_sym_STAR] = ACTIONS(171),
-    [anon_sym_SLASH] = ACTIONS(173),
-    [anon_sym_PLUS] = ACTIONS(171),
-    [anon_sym_DASH] = ACTIONS(171),
-    [anon_sym_EQ_EQ] = ACTIONS(171),
-    [anon_sym_BANG_EQ] = ACTIONS(171),
-    [anon_sym_LT] = ACTIONS(173),
-    [anon_sym_GT] = ACTIONS(173),
-    [anon_sym_LT_EQ] = ACTIONS(171),
-    [anon_sym_GT_EQ] = ACTIONS(171),
-    [sym_at_variable] = ACTIONS(171),
-    [anon_sym_DQUOTE] = ACTIONS(171),
-    [anon_sym_SQUOTE] = ACTIONS(171),
-    [sym_number] = ACTIONS(171),
+    [ts_builtin_sym_end] = ACTIONS(178),
+    [sym_identifier] = ACTIONS(180),
+    [anon_sym_POUNDinclude] = ACTIONS(178),
+    [anon_sym_POUNDdefine] = ACTIONS(178),
+    [anon_sym_POUNDimport] = ACTIONS(178),
+    [anon_sym_POUNDquestion] = ACTIONS(178),
+    [anon_sym_COLON] = ACTIONS(178),
+    [anon_sym_const] = ACTIONS(180),
+    [anon_sym_if] = ACTIONS(180),
+    [anon_sym_else] = ACTIONS(180),
+    [anon_sym_for] = ACTIONS(180),
+    [anon_sym_repeat] = ACTIONS(180),
+    [anon_sym_menu] = ACTIONS(180),
+    [anon_sym_item] = ACTIONS(180),
+    [anon_sym_action] = ACTIONS(180),
+    [anon_sym_LPAREN] = ACTIONS(178),
+    [anon_sym_COMMA] = ACTIONS(178),
+    [anon_sym_RPAREN] = ACTIONS(178),
+    [anon_sym_LBRACE] = ACTIONS(178),
+    [anon_sym_RBRACE] = ACTIONS(178),
+    [anon_sym_true] = ACTIONS(180),
+    [anon_sym_false] = ACTIONS(180),
+    [anon_sym_name] = ACTIONS(180),
+    [anon_sym_glyph] = ACTIONS(180),
+    [anon_sym_from] = ACTIONS(180),
+    [anon_sym_mac] = ACTIONS(180),
+    [anon_sym_inputs] = ACTIONS(180),
+    [anon_sym_noinput] = ACTIONS(180),
+    [anon_sym_askfor] = ACTIONS(180),
+    [anon_sym_getclipboard] = ACTIONS(180),
+    [anon_sym_list] = ACTIONS(180),
+    [anon_sym_nil] = ACTIONS(180),
+    [anon_sym_stop] = ACTIONS(180),
+    [anon_sym_makeVCard] = ACTIONS(180),
+    [anon_sym_rawAction] = ACTIONS(180),
+    [anon_sym_embedFile] = ACTIONS(180),
+    [anon_sym_nothing] = ACTIONS(180),
+    [anon_sym_CurrentDate] = ACTIONS(180),
+    [anon_sym_Device] = ACTIONS(180),
+    [anon_sym_RepeatIndex] = ACTIONS(180),
+    [anon_sym_RepeatItem] = ACTIONS(180),
+    [anon_sym_ShortcutInput] = ACTIONS(180),
+    [anon_sym_Ask] = ACTIONS(180),
+    [anon_sym_text] = ACTIONS(180),
+    [anon_sym_number] = ACTIONS(180),
+    [anon_sym_bool] = ACTIONS(180),
+    [anon_sym_dictionary] = ACTIONS(180),
+    [anon_sym_array] = ACTIONS(180),
+    [anon_sym_variable] = ACTIONS(180),
+    [anon_sym_color] = ACTIONS(180),
+    [anon_sym_float] = ACTIONS(180),
+    [anon_sym_STAR] = ACTIONS(178),
+    [anon_sym_SLASH] = ACTIONS(180),
+    [anon_sym_PLUS] = ACTIONS(178),
+    [anon_sym_DASH] = ACTIONS(178),
+    [anon_sym_EQ_EQ] = ACTIONS(178),
+    [anon_sym_BANG_EQ] = ACTIONS(178),
+    [anon_sym_LT] = ACTIONS(180),
+    [anon_sym_GT] = ACTIONS(180),
+    [anon_sym_LT_EQ] = ACTIONS(178),
+    [anon_sym_GT_EQ] = ACTIONS(178),
+    [sym_at_variable] = ACTIONS(178),
+    [anon_sym_DQUOTE] = ACTIONS(178),
+    [anon_sym_SQUOTE] = ACTIONS(178),
+    [sym_number] = ACTIONS(178),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(27)] = {
     [sym_comment] = STATE(27),
-    [ts_builtin_sym_end] = ACTIONS(131),
-    [sym_identifier] = ACTIONS(133),
-    [anon_sym_POUNDinclude] = ACTIONS(131),
-    [anon_sym_POUNDdefine] = ACTIONS(131),
-    [anon_sym_POUNDimport] = ACTIONS(131),
-    [anon_sym_POUNDquestion] = ACTIONS(131),
-    [anon_sym_COLON] = ACTIONS(131),
-    [anon_sym_const] = ACTIONS(133),
-    [anon_sym_if] = ACTIONS(133),
-    [anon_sym_else] = ACTIONS(133),
-    [anon_sym_for] = ACTIONS(133),
-    [anon_sym_repeat] = ACTIONS(133),
-    [anon_sym_menu] = ACTIONS(133),
-    [anon_sym_item] = ACTIONS(133),
-    [anon_sym_LBRACE] = ACTIONS(131),
-    [anon_sym_RBRACE] = ACTIONS(131),
-    [anon_sym_COMMA] = ACTIONS(131),
-    [anon_sym_true] = ACTIONS(133),
-    [anon_sym_false] = ACTIONS(133),
-    [anon_sym_name] = ACTIONS(133),
-    [anon_sym_glyph] = ACTIONS(133),
-    [anon_sym_from] = ACTIONS(133),
-    [anon_sym_mac] = ACTIONS(133),
-    [anon_sym_inputs] = ACTIONS(133),
-    [anon_sym_noinput] = ACTIONS(133),
-    [anon_sym_askfor] = ACTIONS(133),
-    [anon_sym_getclipboard] = ACTIONS(133),
-    [anon_sym_list] = ACTIONS(133),
-    [anon_sym_nil] = ACTIONS(133),
-    [anon_sym_action] = ACTIONS(133),
-    [anon_sym_stop] = ACTIONS(133),
-    [anon_sym_makeVCard] = ACTIONS(133),
-    [anon_sym_rawAction] = ACTIONS(133),
-    [anon_sym_embedFile] = ACTIONS(133),
-    [anon_sym_nothing] = ACTIONS(133),
-    [anon_sym_CurrentDate] = ACTIONS(133),
-    [anon_sym_Device] = ACTIONS(133),
-    [anon_sym_RepeatIndex] = ACTIONS(133),
-    [anon_sym_RepeatItem] = ACTIONS(133),
-    [anon_sym_ShortcutInput] = ACTIONS(133),
-    [anon_sym_Ask] = ACTIONS(133),
-    [anon_sym_text] = ACTIONS(133),
-    [anon_sym_number] = ACTIONS(133),
-    [anon_sym_bool] = ACTIONS(133),
-    [anon_sym_dictionary] = ACTIONS(133),
-    [anon_sym_array] = ACTIONS(133),
-    [anon_sym_variable] = ACTIONS(133),
-    [anon_sym_color] = ACTIONS(133),
-    [anon_sym_float] = ACTIONS(133),
-    [anon_sym_LPAREN] = ACTIONS(131),
-    [anon_sym_RPAREN] = ACTIONS(131),
-    [anon_sym_STAR] = ACTIONS(131),
-    [anon_sym_SLASH] = ACTIONS(133),
-    [anon_sym_PLUS] = ACTIONS(131),
-    [anon_sym_DASH] = ACTIONS(131),
-    [anon_sym_EQ_EQ] = ACTIONS(131),
-    [anon_sym_BANG_EQ] = ACTIONS(131),
-    [anon_sym_LT] = ACTIONS(133),
-    [anon_sym_GT] = ACTIONS(133),
-    [anon_sym_LT_EQ] = ACTIONS(131),
-    [anon_sym_GT_EQ] = ACTIONS(131),
-    [sym_at_variable] = ACTIONS(131),
-    [anon_sym_DQUOTE] = ACTIONS(131),
-    [anon_sym_SQUOTE] = ACTIONS(131),
-    [sym_number] = ACTIONS(131),
+    [ts_builtin_sym_end] = ACTIONS(182),
+    [sym_identifier] = ACTIONS(184),
+    [anon_sym_POUNDinclude] = ACTIONS(182),
+    [anon_sym_POUNDdefine] = ACTIONS(182),
+    [anon_sym_POUNDimport] = ACTIONS(182),
+    [anon_sym_POUNDquestion] = ACTIONS(182),
+    [anon_sym_COLON] = ACTIONS(182),
+    [anon_sym_const] = ACTIONS(184),
+    [anon_sym_if] = ACTIONS(184),
+    [anon_sym_else] = ACTIONS(184),
+    [anon_sym_for] = ACTIONS(184),
+    [anon_sym_repeat] = ACTIONS(184),
+    [anon_sym_menu] = ACTIONS(184),
+    [anon_sym_item] = ACTIONS(184),
+    [anon_sym_action] = ACTIONS(184),
+    [anon_sym_LPAREN] = ACTIONS(182),
+    [anon_sym_COMMA] = ACTIONS(182),
+    [anon_sym_RPAREN] = ACTIONS(182),
+    [anon_sym_LBRACE] = ACTIONS(182),
+    [anon_sym_RBRACE] = ACTIONS(182),
+    [anon_sym_true] = ACTIONS(184),
+    [anon_sym_false] = ACTIONS(184),
+    [anon_sym_name] = ACTIONS(184),
+    [anon_sym_glyph] = ACTIONS(184),
+    [anon_sym_from] = ACTIONS(184),
+    [anon_sym_mac] = ACTIONS(184),
+    [anon_sym_inputs] = ACTIONS(184),
+    [anon_sym_noinput] = ACTIONS(184),
+    [anon_sym_askfor] = ACTIONS(184),
+    [anon_sym_getclipboard] = ACTIONS(184),
+    [anon_sym_list] = ACTIONS(184),
+    [anon_sym_nil] = ACTIONS(184),
+    [anon_sym_stop] = ACTIONS(184),
+    [anon_sym_makeVCard] = ACTIONS(184),
+    [anon_sym_rawAction] = ACTIONS(184),
+    [anon_sym_embedFile] = ACTIONS(184),
+    [anon_sym_nothing] = ACTIONS(184),
+    [anon_sym_CurrentDate] = ACTIONS(184),
+    [anon_sym_Device] = ACTIONS(184),
+    [anon_sym_RepeatIndex] = ACTIONS(184),
+    [anon_sym_RepeatItem] = ACTIONS(184),
+    [anon_sym_ShortcutInput] = ACTIONS(184),
+    [anon_sym_Ask] = ACTIONS(184),
+    [anon_sym_text] = ACTIONS(184),
+    [anon_sym_number] = ACTIONS(184),
+    [anon_sym_bool] = ACTIONS(184),
+    [anon_sym_dictionary] = ACTIONS(184),
+    [anon_sym_array] = ACTIONS(184),
+    [anon_sym_variable] = ACTIONS(184),
+    [anon_sym_color] = ACTIONS(184),
+    [anon_sym_float] = ACTIONS(184),
+    [anon_sym_STAR] = ACTIONS(182),
+    [anon_sym_SLASH] = ACTIONS(184),
+    [anon_sym_PLUS] = ACTIONS(182),
+    [anon_sym_DASH] = ACTIONS(182),
+    [anon_sym_EQ_EQ] = ACTIONS(182),
+    [anon_sym_BANG_EQ] = ACTIONS(182),
+    [anon_sym_LT] = ACTIONS(184),
+    [anon_sym_GT] = ACTIONS(184),
+    [anon_sym_LT_EQ] = ACTIONS(182),
+    [anon_sym_GT_EQ] = ACTIONS(182),
+    [sym_at_variable] = ACTIONS(182),
+    [anon_sym_DQUOTE] = ACTIONS(182),
+    [anon_sym_SQUOTE] = ACTIONS(182),
+    [sym_number] = ACTIONS(182),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(28)] = {
     [sym_comment] = STATE(28),
-    [ts_builtin_sym_end] = ACTIONS(139),
-    [sym_identifier] = ACTIONS(141),
-    [anon_sym_POUNDinclude] = ACTIONS(139),
-    [anon_sym_POUNDdefine] = ACTIONS(139),
-    [anon_sym_POUNDimport] = ACTIONS(139),
-    [anon_sym_POUNDquestion] = ACTIONS(139),
-    [anon_sym_COLON] = ACTIONS(139),
-    [anon_sym_const] = ACTIONS(141),
-    [anon_sym_if] = ACTIONS(141),
-    [anon_sym_else] = ACTIONS(141),
-    [anon_sym_for] = ACTIONS(141),
-    [anon_sym_repeat] = ACTIONS(141),
-    [anon_sym_menu] = ACTIONS(141),
-    [anon_sym_item] = ACTIONS(141),
-    [anon_sym_LBRACE] = ACTIONS(139),
-    [anon_sym_RBRACE] = ACTIONS(139),
-    [anon_sym_COMMA] = ACTIONS(139),
-    [anon_sym_true] = ACTIONS(141),
-    [anon_sym_false] = ACTIONS(141),
-    [anon_sym_name] = ACTIONS(141),
-    [anon_sym_glyph] = ACTIONS(141),
-    [anon_sym_from] = ACTIONS(141),
-    [anon_sym_mac] = ACTIONS(141),
-    [anon_sym_inputs] = ACTIONS(141),
-    [anon_sym_noinput] = ACTIONS(141),
-    [anon_sym_askfor] = ACTIONS(141),
-    [anon_sym_getclipboard] = ACTIONS(141),
-    [anon_sym_list] = ACTIONS(141),
-    [anon_sym_nil] = ACTIONS(141),
-    [anon_sym_action] = ACTIONS(141),
-    [anon_sym_stop] = ACTIONS(141),
-    [anon_sym_makeVCard] = ACTIONS(141),
-    [anon_sym_rawAction] = ACTIONS(141),
-    [anon_sym_embedFile] = ACTIONS(141),
-    [anon_sym_nothing] = ACTIONS(141),
-    [anon_sym_CurrentDate] = ACTIONS(141),
-    [anon_sym_Device] = ACTIONS(141),
-    [anon_sym_RepeatIndex] = ACTIONS(141),
-    [anon_sym_RepeatItem] = ACTIONS(141),
-    [anon_sym_ShortcutInput] = ACTIONS(141),
-    [anon_sym_Ask] = ACTIONS(141),
-    [anon_sym_text] = ACTIONS(141),
-    [anon_sym_number] = ACTIONS(141),
-    [anon_sym_bool] = ACTIONS(141),
-    [anon_sym_dictionary] = ACTIONS(141),
-    [anon_sym_array] = ACTIONS(141),
-    [anon_sym_variable] = ACTIONS(141),
-    [anon_sym_color] = ACTIONS(141),
-    [anon_sym_float] = ACTIONS(141),
-    [anon_sym_LPAREN] = ACTIONS(175),
-    [anon_sym_RPAREN] = ACTIONS(139),
-    [anon_sym_STAR] = ACTIONS(139),
-    [anon_sym_SLASH] = ACTIONS(141),
-    [anon_sym_PLUS] = ACTIONS(139),
-    [anon_sym_DASH] = ACTIONS(139),
-    [anon_sym_EQ_EQ] = ACTIONS(139),
-    [anon_sym_BANG_EQ] = ACTIONS(139),
-    [anon_sym_LT] = ACTIONS(141),
-    [anon_sym_GT] = ACTIONS(141),
-    [anon_sym_LT_EQ] = ACTIONS(139),
-    [anon_sym_GT_EQ] = ACTIONS(139),
-    [sym_at_variable] = ACTIONS(139),
-    [anon_sym_DQUOTE] = ACTIONS(139),
-    [anon_sym_SQUOTE] = ACTIONS(139),
-    [sym_number] = ACTIONS(139),
+    [ts_builtin_sym_end] = ACTIONS(186),
+    [sym_identifier] = ACTIONS(188),
+    [anon_sym_POUNDinclude] = ACTIONS(186),
+    [anon_sym_POUNDdefine] = ACTIONS(186),
+    [anon_sym_POUNDimport] = ACTIONS(186),
+    [anon_sym_POUNDquestion] = ACTIONS(186),
+    [anon_sym_COLON] = ACTIONS(186),
+    [anon_sym_const] = ACTIONS(188),
+    [anon_sym_if] = ACTIONS(188),
+    [anon_sym_else] = ACTIONS(188),
+    [anon_sym_for] = ACTIONS(188),
+    [anon_sym_repeat] = ACTIONS(188),
+    [anon_sym_menu] = ACTIONS(188),
+    [anon_sym_item] = ACTIONS(188),
+    [anon_sym_action] = ACTIONS(188),
+    [anon_sym_LPAREN] = ACTIONS(186),
+    [anon_sym_COMMA] = ACTIONS(186),
+    [anon_sym_RPAREN] = ACTIONS(186),
+    [anon_sym_LBRACE] = ACTIONS(186),
+    [anon_sym_RBRACE] = ACTIONS(186),
+    [anon_sym_true] = ACTIONS(188),
+    [anon_sym_false] = ACTIONS(188),
+    [anon_sym_name] = ACTIONS(188),
+    [anon_sym_glyph] = ACTIONS(188),
+    [anon_sym_from] = ACTIONS(188),
+    [anon_sym_mac] = ACTIONS(188),
+    [anon_sym_inputs] = ACTIONS(188),
+    [anon_sym_noinput] = ACTIONS(188),
+    [anon_sym_askfor] = ACTIONS(188),
+    [anon_sym_getclipboard] = ACTIONS(188),
+    [anon_sym_list] = ACTIONS(188),
+    [anon_sym_nil] = ACTIONS(188),
+    [anon_sym_stop] = ACTIONS(188),
+    [anon_sym_makeVCard] = ACTIONS(188),
+    [anon_sym_rawAction] = ACTIONS(188),
+    [anon_sym_embedFile] = ACTIONS(188),
+    [anon_sym_nothing] = ACTIONS(188),
+    [anon_sym_CurrentDate] = ACTIONS(188),
+    [anon_sym_Device] = ACTIONS(188),
+    [anon_sym_RepeatIndex] = ACTIONS(188),
+    [anon_sym_RepeatItem] = ACTIONS(188),
+    [anon_sym_ShortcutInput] = ACTIONS(188),
+    [anon_sym_Ask] = ACTIONS(188),
+    [anon_sym_text] = ACTIONS(188),
+    [anon_sym_number] = ACTIONS(188),
+    [anon_sym_bool] = ACTIONS(188),
+    [anon_sym_dictionary] = ACTIONS(188),
+    [anon_sym_array] = ACTIONS(188),
+    [anon_sym_variable] = ACTIONS(188),
+    [anon_sym_color] = ACTIONS(188),
+    [anon_sym_float] = ACTIONS(188),
+    [anon_sym_STAR] = ACTIONS(186),
+    [anon_sym_SLASH] = ACTIONS(188),
+    [anon_sym_PLUS] = ACTIONS(186),
+    [anon_sym_DASH] = ACTIONS(186),
+    [anon_sym_EQ_EQ] = ACTIONS(186),
+    [anon_sym_BANG_EQ] = ACTIONS(186),
+    [anon_sym_LT] = ACTIONS(188),
+    [anon_sym_GT] = ACTIONS(188),
+    [anon_sym_LT_EQ] = ACTIONS(186),
+    [anon_sym_GT_EQ] = ACTIONS(186),
+    [sym_at_variable] = ACTIONS(186),
+    [anon_sym_DQUOTE] = ACTIONS(186),
+    [anon_sym_SQUOTE] = ACTIONS(186),
+    [sym_number] = ACTIONS(186),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(29)] = {
     [sym_comment] = STATE(29),
-    [ts_builtin_sym_end] = ACTIONS(131),
-    [sym_identifier] = ACTIONS(133),
-    [anon_sym_POUNDinclude] = ACTIONS(131),
-    [anon_sym_POUNDdefine] = ACTIONS(131),
-    [anon_sym_POUNDimport] = ACTIONS(131),
-    [anon_sym_POUNDquestion] = ACTIONS(131),
-    [anon_sym_COLON] = ACTIONS(131),
-    [anon_sym_const] = ACTIONS(133),
-    [anon_sym_if] = ACTIONS(133),
-    [anon_sym_else] = ACTIONS(133),
-    [anon_sym_for] = ACTIONS(133),
-    [anon_sym_repeat] = ACTIONS(133),
-    [anon_sym_menu] = ACTIONS(133),
-    [anon_sym_item] = ACTIONS(133),
-    [anon_sym_LBRACE] = ACTIONS(131),
-    [anon_sym_RBRACE] = ACTIONS(131),
-    [anon_sym_COMMA] = ACTIONS(131),
-    [anon_sym_true] = ACTIONS(133),
-    [anon_sym_false] = ACTIONS(133),
-    [anon_sym_name] = ACTIONS(133),
-    [anon_sym_glyph] = ACTIONS(133),
-    [anon_sym_from] = ACTIONS(133),
-    [anon_sym_mac] = ACTIONS(133),
-    [anon_sym_inputs] = ACTIONS(133),
-    [anon_sym_noinput] = ACTIONS(133),
-    [anon_sym_askfor] = ACTIONS(133),
-    [anon_sym_getclipboard] = ACTIONS(133),
-    [anon_sym_list] = ACTIONS(133),
-    [anon_sym_nil] = ACTIONS(133),
-    [anon_sym_action] = ACTIONS(133),
-    [anon_sym_stop] = ACTIONS(133),
-    [anon_sym_makeVCard] = ACTIONS(133),
-    [anon_sym_rawAction] = ACTIONS(133),
-    [anon_sym_embedFile] = ACTIONS(133),
-    [anon_sym_nothing] = ACTIONS(133),
-    [anon_sym_CurrentDate] = ACTIONS(133),
-    [anon_sym_Device] = ACTIONS(133),
-    [anon_sym_RepeatIndex] = ACTIONS(133),
-    [anon_sym_RepeatItem] = ACTIONS(133),
-    [anon_sym_ShortcutInput] = ACTIONS(133),
-    [anon_sym_Ask] = ACTIONS(133),
-    [anon_sym_text] = ACTIONS(133),
-    [anon_sym_number] = ACTIONS(133),
-    [anon_sym_bool] = ACTIONS(133),
-    [anon_sym_dictionary] = ACTIONS(133),
-    [anon_sym_array] = ACTIONS(133),
-    [anon_sym_variable] = ACTIONS(133),
-    [anon_sym_color] = ACTIONS(133),
-    [anon_sym_float] = ACTIONS(133),
-    [anon_sym_LPAREN] = ACTIONS(131),
-    [anon_sym_RPAREN] = ACTIONS(131),
-    [anon_sym_STAR] = ACTIONS(49),
-    [anon_sym_SLASH] = ACTIONS(51),
-    [anon_sym_PLUS] = ACTIONS(53),
-    [anon_sym_DASH] = ACTIONS(53),
-    [anon_sym_EQ_EQ] = ACTIONS(131),
-    [anon_sym_BANG_EQ] = ACTIONS(131),
-    [anon_sym_LT] = ACTIONS(57),
-    [anon_sym_GT] = ACTIONS(57),
-    [anon_sym_LT_EQ] = ACTIONS(59),
-    [anon_sym_GT_EQ] = ACTIONS(59),
-    [sym_at_variable] = ACTIONS(131),
-    [anon_sym_DQUOTE] = ACTIONS(131),
-    [anon_sym_SQUOTE] = ACTIONS(131),
-    [sym_number] = ACTIONS(131),
+    [ts_builtin_sym_end] = ACTIONS(190),
+    [sym_identifier] = ACTIONS(192),
+    [anon_sym_POUNDinclude] = ACTIONS(190),
+    [anon_sym_POUNDdefine] = ACTIONS(190),
+    [anon_sym_POUNDimport] = ACTIONS(190),
+    [anon_sym_POUNDquestion] = ACTIONS(190),
+    [anon_sym_COLON] = ACTIONS(190),
+    [anon_sym_const] = ACTIONS(192),
+    [anon_sym_if] = ACTIONS(192),
+    [anon_sym_else] = ACTIONS(192),
+    [anon_sym_for] = ACTIONS(192),
+    [anon_sym_repeat] = ACTIONS(192),
+    [anon_sym_menu] = ACTIONS(192),
+    [anon_sym_item] = ACTIONS(192),
+    [anon_sym_action] = ACTIONS(192),
+    [anon_sym_LPAREN] = ACTIONS(190),
+    [anon_sym_COMMA] = ACTIONS(190),
+    [anon_sym_RPAREN] = ACTIONS(190),
+    [anon_sym_LBRACE] = ACTIONS(190),
+    [anon_sym_RBRACE] = ACTIONS(190),
+    [anon_sym_true] = ACTIONS(192),
+    [anon_sym_false] = ACTIONS(192),
+    [anon_sym_name] = ACTIONS(192),
+    [anon_sym_glyph] = ACTIONS(192),
+    [anon_sym_from] = ACTIONS(192),
+    [anon_sym_mac] = ACTIONS(192),
+    [anon_sym_inputs] = ACTIONS(192),
+    [anon_sym_noinput] = ACTIONS(192),
+    [anon_sym_askfor] = ACTIONS(192),
+    [anon_sym_getclipboard] = ACTIONS(192),
+    [anon_sym_list] = ACTIONS(192),
+    [anon_sym_nil] = ACTIONS(192),
+    [anon_sym_stop] = ACTIONS(192),
+    [anon_sym_makeVCard] = ACTIONS(192),
+    [anon_sym_rawAction] = ACTIONS(192),
+    [anon_sym_embedFile] = ACTIONS(192),
+    [anon_sym_nothing] = ACTIONS(192),
+    [anon_sym_CurrentDate] = ACTIONS(192),
+    [anon_sym_Device] = ACTIONS(192),
+    [anon_sym_RepeatIndex] = ACTIONS(192),
+    [anon_sym_RepeatItem] = ACTIONS(192),
+    [anon_sym_ShortcutInput] = ACTIONS(192),
+    [anon_sym_Ask] = ACTIONS(192),
+    [anon_sym_text] = ACTIONS(192),
+    [anon_sym_number] = ACTIONS(192),
+    [anon_sym_bool] = ACTIONS(192),
+    [anon_sym_dictionary] = ACTIONS(192),
+    [anon_sym_array] = ACTIONS(192),
+    [anon_sym_variable] = ACTIONS(192),
+    [anon_sym_color] = ACTIONS(192),
+    [anon_sym_float] = ACTIONS(192),
+    [anon_sym_STAR] = ACTIONS(190),
+    [anon_sym_SLASH] = ACTIONS(192),
+    [anon_sym_PLUS] = ACTIONS(190),
+    [anon_sym_DASH] = ACTIONS(190),
+    [anon_sym_EQ_EQ] = ACTIONS(190),
+    [anon_sym_BANG_EQ] = ACTIONS(190),
+    [anon_sym_LT] = ACTIONS(192),
+    [anon_sym_GT] = ACTIONS(192),
+    [anon_sym_LT_EQ] = ACTIONS(190),
+    [anon_sym_GT_EQ] = ACTIONS(190),
+    [sym_at_variable] = ACTIONS(190),
+    [anon_sym_DQUOTE] = ACTIONS(190),
+    [anon_sym_SQUOTE] = ACTIONS(190),
+    [sym_number] = ACTIONS(190),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(30)] = {
     [sym_comment] = STATE(30),
-    [ts_builtin_sym_end] = ACTIONS(177),
-    [sym_identifier] = ACTIONS(179),
-    [anon_sym_POUNDinclude] = ACTIONS(177),
-    [anon_sym_POUNDdefine] = ACTIONS(177),
-    [anon_sym_POUNDimport] = ACTIONS(177),
-    [anon_sym_POUNDquestion] = ACTIONS(177),
-    [anon_sym_COLON] = ACTIONS(177),
-    [anon_sym_const] = ACTIONS(179),
-    [anon_sym_if] = ACTIONS(179),
-    [anon_sym_else] = ACTIONS(179),
-    [anon_sym_for] = ACTIONS(179),
-    [anon_sym_repeat] = ACTIONS(179),
-    [anon_sym_menu] = ACTIONS(179),
-    [anon_sym_item] = ACTIONS(179),
-    [anon_sym_LBRACE] = ACTIONS(177),
-    [anon_sym_RBRACE] = ACTIONS(177),
-    [anon_sym_COMMA] = ACTIONS(177),
-    [anon_sym_true] = ACTIONS(179),
-    [anon_sym_false] = ACTIONS(179),
-    [anon_sym_name] = ACTIONS(179),
-    [anon_sym_glyph] = ACTIONS(179),
-    [anon_sym_from] = ACTIONS(179),
-    [anon_sym_mac] = ACTIONS(179),
-    [anon_sym_inputs] = ACTIONS(179),
-    [anon_sym_noinput] = ACTIONS(179),
-    [anon_sym_askfor] = ACTIONS(179),
-    [anon_sym_getclipboard] = ACTIONS(179),
-    [anon_sym_list] = ACTIONS(179),
-    [anon_sym_nil] = ACTIONS(179),
-    [anon_sym_action] = ACTIONS(179),
-    [anon_sym_stop] = ACTIONS(179),
-    [anon_sym_makeVCard] = ACTIONS(179),
-    [anon_sym_rawAction] = ACTIONS(179),
-    [anon_sym_embedFile] = ACTIONS(179),
-    [anon_sym_nothing] = ACTIONS(179),
-    [anon_sym_CurrentDate] = ACTIONS(179),
-    [anon_sym_Device] = ACTIONS(179),
-    [anon_sym_RepeatIndex] = ACTIONS(179),
-    [anon_sym_RepeatItem] = ACTIONS(179),
-    [anon_sym_ShortcutInput] = ACTIONS(179),
-    [anon_sym_Ask] = ACTIONS(179),
-    [anon_sym_text] = ACTIONS(179),
-    [anon_sym_number] = ACTIONS(179),
-    [anon_sym_bool] = ACTIONS(179),
-    [anon_sym_dictionary] = ACTIONS(179),
-    [anon_sym_array] = ACTIONS(179),
-    [anon_sym_variable] = ACTIONS(179),
-    [anon_sym_color] = ACTIONS(179),
-    [anon_sym_float] = ACTIONS(179),
-    [anon_sym_LPAREN] = ACTIONS(177),
-    [anon_sym_RPAREN] = ACTIONS(177),
-    [anon_sym_STAR] = ACTIONS(177),
-    [anon_sym_SLASH] = ACTIONS(179),
-    [anon_sym_PLUS] = ACTIONS(177),
-    [anon_sym_DASH] = ACTIONS(177),
-    [anon_sym_EQ_EQ] = ACTIONS(177),
-    [anon_sym_BANG_EQ] = ACTIONS(177),
-    [anon_sym_LT] = ACTIONS(179),
-    [anon_sym_GT] = ACTIONS(179),
-    [anon_sym_LT_EQ] = ACTIONS(177),
-    [anon_sym_GT_EQ] = ACTIONS(177),
-    [sym_at_variable] = ACTIONS(177),
-    [anon_sym_DQUOTE] = ACTIONS(177),
-    [anon_sym_SQUOTE] = ACTIONS(177),
-    [sym_number] = ACTIONS(177),
+    [ts_builtin_sym_end] = ACTIONS(190),
+    [sym_identifier] = ACTIONS(192),
+    [anon_sym_POUNDinclude] = ACTIONS(190),
+    [anon_sym_POUNDdefine] = ACTIONS(190),
+    [anon_sym_POUNDimport] = ACTIONS(190),
+    [anon_sym_POUNDquestion] = ACTIONS(190),
+    [anon_sym_COLON] = ACTIONS(190),
+    [anon_sym_const] = ACTIONS(192),
+    [anon_sym_if] = ACTIONS(192),
+    [anon_sym_else] = ACTIONS(192),
+    [anon_sym_for] = ACTIONS(192),
+    [anon_sym_repeat] = ACTIONS(192),
+    [anon_sym_menu] = ACTIONS(192),
+    [anon_sym_item] = ACTIONS(192),
+    [anon_sym_action] = ACTIONS(192),
+    [anon_sym_LPAREN] = ACTIONS(190),
+    [anon_sym_COMMA] = ACTIONS(190),
+    [anon_sym_RPAREN] = ACTIONS(190),
+    [anon_sym_LBRACE] = ACTIONS(190),
+    [anon_sym_RBRACE] = ACTIONS(190),
+    [anon_sym_true] = ACTIONS(192),
+    [anon_sym_false] = ACTIONS(192),
+    [anon_sym_name] = ACTIONS(192),
+    [anon_sym_glyph] = ACTIONS(192),
+    [anon_sym_from] = ACTIONS(192),
+    [anon_sym_mac] = ACTIONS(192),
+    [anon_sym_inputs] = ACTIONS(192),
+    [anon_sym_noinput] = ACTIONS(192),
+    [anon_sym_askfor] = ACTIONS(192),
+    [anon_sym_getclipboard] = ACTIONS(192),
+    [anon_sym_list] = ACTIONS(192),
+    [anon_sym_nil] = ACTIONS(192),
+    [anon_sym_stop] = ACTIONS(192),
+    [anon_sym_makeVCard] = ACTIONS(192),
+    [anon_sym_rawAction] = ACTIONS(192),
+    [anon_sym_embedFile] = ACTIONS(192),
+    [anon_sym_nothing] = ACTIONS(192),
+    [anon_sym_CurrentDate] = ACTIONS(192),
+    [anon_sym_Device] = ACTIONS(192),
+    [anon_sym_RepeatIndex] = ACTIONS(192),
+    [anon_sym_RepeatItem] = ACTIONS(192),
+    [anon_sym_ShortcutInput] = ACTIONS(192),
+    [anon_sym_Ask] = ACTIONS(192),
+    [anon_sym_text] = ACTIONS(192),
+    [anon_sym_number] = ACTIONS(192),
+    [anon_sym_bool] = ACTIONS(192),
+    [anon_sym_dictionary] = ACTIONS(192),
+    [anon_sym_array] = ACTIONS(192),
+    [anon_sym_variable] = ACTIONS(192),
+    [anon_sym_color] = ACTIONS(192),
+    [anon_sym_float] = ACTIONS(192),
+    [anon_sym_STAR] = ACTIONS(51),
+    [anon_sym_SLASH] = ACTIONS(53),
+    [anon_sym_PLUS] = ACTIONS(190),
+    [anon_sym_DASH] = ACTIONS(190),
+    [anon_sym_EQ_EQ] = ACTIONS(190),
+    [anon_sym_BANG_EQ] = ACTIONS(190),
+    [anon_sym_LT] = ACTIONS(192),
+    [anon_sym_GT] = ACTIONS(192),
+    [anon_sym_LT_EQ] = ACTIONS(190),
+    [anon_sym_GT_EQ] = ACTIONS(190),
+    [sym_at_variable] = ACTIONS(190),
+    [anon_sym_DQUOTE] = ACTIONS(190),
+    [anon_sym_SQUOTE] = ACTIONS(190),
+    [sym_number] = ACTIONS(190),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(31)] = {
     [sym_comment] = STATE(31),
-    [ts_builtin_sym_end] = ACTIONS(181),
-    [sym_identifier] = ACTIONS(183),
-    [anon_sym_POUNDinclude] = ACTIONS(181),
-    [anon_sym_POUNDdefine] = ACTIONS(181),
-    [anon_sym_POUNDimport] = ACTIONS(181),
-    [anon_sym_POUNDquestion] = ACTIONS(181),
-    [anon_sym_COLON] = ACTIONS(181),
-    [anon_sym_const] = ACTIONS(183),
-    [anon_sym_if] = ACTIONS(183),
-    [anon_sym_else] = ACTIONS(183),
-    [anon_sym_for] = ACTIONS(183),
-    [anon_sym_repeat] = ACTIONS(183),
-    [anon_sym_menu] = ACTIONS(183),
-    [anon_sym_item] = ACTIONS(183),
-    [anon_sym_LBRACE] = ACTIONS(181),
-    [anon_sym_RBRACE] = ACTIONS(181),
-    [anon_sym_COMMA] = ACTIONS(181),
-    [anon_sym_true] = ACTIONS(183),
-    [anon_sym_false] = ACTIONS(183),
-    [anon_sym_name] = ACTIONS(183),
-    [anon_sym_glyph] = ACTIONS(183),
-    [anon_sym_from] = ACTIONS(183),
-    [anon_sym_mac] = ACTIONS(183),
-    [anon_sym_inputs] = ACTIONS(183),
-    [anon_sym_noinput] = ACTIONS(183),
-    [anon_sym_askfor] = ACTIONS(183),
-    [anon_sym_getclipboard] = ACTIONS(183),
-    [anon_sym_list] = ACTIONS(183),
-    [anon_sym_nil] = ACTIONS(183),
-    [anon_sym_action] = ACTIONS(183),
-    [anon_sym_stop] = ACTIONS(183),
-    [anon_sym_makeVCard] = ACTIONS(183),
-    [anon_sym_rawAction] = ACTIONS(183),
-    [anon_sym_embedFile] = ACTIONS(183),
-    [anon_sym_nothing] = ACTIONS(183),
-    [anon_sym_CurrentDate] = ACTIONS(183),
-    [anon_sym_Device] = ACTIONS(183),
-    [anon_sym_RepeatIndex] = ACTIONS(183),
-    [anon_sym_RepeatItem] = ACTIONS(183),
-    [anon_sym_ShortcutInput] = ACTIONS(183),
-    [anon_sym_Ask] = ACTIONS(183),
-    [anon_sym_text] = ACTIONS(183),
-    [anon_sym_number] = ACTIONS(183),
-    [anon_sym_bool] = ACTIONS(183),
-    [anon_sym_dictionary] = ACTIONS(183),
-    [anon_sym_array] = ACTIONS(183),
-    [anon_sym_variable] = ACTIONS(183),
-    [anon_sym_color] = ACTIONS(183),
-    [anon_sym_float] = ACTIONS(183),
-    [anon_sym_LPAREN] = ACTIONS(181),
-    [anon_sym_RPAREN] = ACTIONS(181),
-    [anon_sym_STAR] = ACTIONS(181),
-    [anon_sym_SLASH] = ACTIONS(183),
-    [anon_sym_PLUS] = ACTIONS(181),
-    [anon_sym_DASH] = ACTIONS(181),
-    [anon_sym_EQ_EQ] = ACTIONS(181),
-    [anon_sym_BANG_EQ] = ACTIONS(181),
-    [anon_sym_LT] = ACTIONS(183),
-    [anon_sym_GT] = ACTIONS(183),
-    [anon_sym_LT_EQ] = ACTIONS(181),
-    [anon_sym_GT_EQ] = ACTIONS(181),
-    [sym_at_variable] = ACTIONS(181),
-    [anon_sym_DQUOTE] = ACTIONS(181),
-    [anon_sym_SQUOTE] = ACTIONS(181),
-    [sym_number] = ACTIONS(181),
+    [ts_builtin_sym_end] = ACTIONS(190),
+    [sym_identifier] = ACTIONS(192),
+    [anon_sym_POUNDinclude] = ACTIONS(190),
+    [anon_sym_POUNDdefine] = ACTIONS(190),
+    [anon_sym_POUNDimport] = ACTIONS(190),
+    [anon_sym_POUNDquestion] = ACTIONS(190),
+    [anon_sym_COLON] = ACTIONS(190),
+    [anon_sym_const] = ACTIONS(192),
+    [anon_sym_if] = ACTIONS(192),
+    [anon_sym_else] = ACTIONS(192),
+    [anon_sym_for] = ACTIONS(192),
+    [anon_sym_repeat] = ACTIONS(192),
+    [anon_sym_menu] = ACTIONS(192),
+    [anon_sym_item] = ACTIONS(192),
+    [anon_sym_action] = ACTIONS(192),
+    [anon_sym_LPAREN] = ACTIONS(190),
+    [anon_sym_COMMA] = ACTIONS(190),
+    [anon_sym_RPAREN] = ACTIONS(190),
+    [anon_sym_LBRACE] = ACTIONS(190),
+    [anon_sym_RBRACE] = ACTIONS(190),
+    [anon_sym_true] = ACTIONS(192),
+    [anon_sym_false] = ACTIONS(192),
+    [anon_sym_name] = ACTIONS(192),
+    [anon_sym_glyph] = ACTIONS(192),
+    [anon_sym_from] = ACTIONS(192),
+    [anon_sym_mac] = ACTIONS(192),
+    [anon_sym_inputs] = ACTIONS(192),
+    [anon_sym_noinput] = ACTIONS(192),
+    [anon_sym_askfor] = ACTIONS(192),
+    [anon_sym_getclipboard] = ACTIONS(192),
+    [anon_sym_list] = ACTIONS(192),
+    [anon_sym_nil] = ACTIONS(192),
+    [anon_sym_stop] = ACTIONS(192),
+    [anon_sym_makeVCard] = ACTIONS(192),
+    [anon_sym_rawAction] = ACTIONS(192),
+    [anon_sym_embedFile] = ACTIONS(192),
+    [anon_sym_nothing] = ACTIONS(192),
+    [anon_sym_CurrentDate] = ACTIONS(192),
+    [anon_sym_Device] = ACTIONS(192),
+    [anon_sym_RepeatIndex] = ACTIONS(192),
+    [anon_sym_RepeatItem] = ACTIONS(192),
+    [anon_sym_ShortcutInput] = ACTIONS(192),
+    [anon_sym_Ask] = ACTIONS(192),
+    [anon_sym_text] = ACTIONS(192),
+    [anon_sym_number] = ACTIONS(192),
+    [anon_sym_bool] = ACTIONS(192),
+    [anon_sym_dictionary] = ACTIONS(192),
+    [anon_sym_array] = ACTIONS(192),
+    [anon_sym_variable] = ACTIONS(192),
+    [anon_sym_color] = ACTIONS(192),
+    [anon_sym_float] = ACTIONS(192),
+    [anon_sym_STAR] = ACTIONS(51),
+    [anon_sym_SLASH] = ACTIONS(53),
+    [anon_sym_PLUS] = ACTIONS(55),
+    [anon_sym_DASH] = ACTIONS(55),
+    [anon_sym_EQ_EQ] = ACTIONS(190),
+    [anon_sym_BANG_EQ] = ACTIONS(190),
+    [anon_sym_LT] = ACTIONS(59),
+    [anon_sym_GT] = ACTIONS(59),
+    [anon_sym_LT_EQ] = ACTIONS(61),
+    [anon_sym_GT_EQ] = ACTIONS(61),
+    [sym_at_variable] = ACTIONS(190),
+    [anon_sym_DQUOTE] = ACTIONS(190),
+    [anon_sym_SQUOTE] = ACTIONS(190),
+    [sym_number] = ACTIONS(190),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(32)] = {
     [sym_comment] = STATE(32),
-    [ts_builtin_sym_end] = ACTIONS(185),
-    [sym_identifier] = ACTIONS(187),
-    [anon_sym_POUNDinclude] = ACTIONS(185),
-    [anon_sym_POUNDdefine] = ACTIONS(185),
-    [anon_sym_POUNDimport] = ACTIONS(185),
-    [anon_sym_POUNDquestion] = ACTIONS(185),
-    [anon_sym_COLON] = ACTIONS(185),
-    [anon_sym_const] = ACTIONS(187),
-    [anon_sym_if] = ACTIONS(187),
-    [anon_sym_else] = ACTIONS(187),
-    [anon_sym_for] = ACTIONS(187),
-    [anon_sym_repeat] = ACTIONS(187),
-    [anon_sym_menu] = ACTIONS(187),
-    [anon_sym_item] = ACTIONS(187),
-    [anon_sym_LBRACE] = ACTIONS(185),
-    [anon_sym_RBRACE] = ACTIONS(185),
-    [anon_sym_COMMA] = ACTIONS(185),
-    [anon_sym_true] = ACTIONS(187),
-    [anon_sym_false] = ACTIONS(187),
-    [anon_sym_name] = ACTIONS(187),
-    [anon_sym_glyph] = ACTIONS(187),
-    [anon_sym_from] = ACTIONS(187),
-    [anon_sym_mac] = ACTIONS(187),
-    [anon_sym_inputs] = ACTIONS(187),
-    [anon_sym_noinput] = ACTIONS(187),
-    [anon_sym_askfor] = ACTIONS(187),
-    [anon_sym_getclipboard] = ACTIONS(187),
-    [anon_sym_list] = ACTIONS(187),
-    [anon_sym_nil] = ACTIONS(187),
-    [anon_sym_action] = ACTIONS(187),
-    [anon_sym_stop] = ACTIONS(187),
-    [anon_sym_makeVCard] = ACTIONS(187),
-    [anon_sym_rawAction] = ACTIONS(187),
-    [anon_sym_embedFile] = ACTIONS(187),
-    [anon_sym_nothing] = ACTIONS(187),
-    [anon_sym_CurrentDate] = ACTIONS(187),
-    [anon_sym_Device] = ACTIONS(187),
-    [anon_sym_RepeatIndex] = ACTIONS(187),
-    [anon_sym_RepeatItem] = ACTIONS(187),
-    [anon_sym_ShortcutInput] = ACTIONS(187),
-    [anon_sym_Ask] = ACTIONS(187),
-    [anon_sym_text] = ACTIONS(187),
-    [anon_sym_number] = ACTIONS(187),
-    [anon_sym_bool] = ACTIONS(187),
-    [anon_sym_dictionary] = ACTIONS(187),
-    [anon_sym_array] = ACTIONS(187),
-    [anon_sym_variable] = ACTIONS(187),
-    [anon_sym_color] = ACTIONS(187),
-    [anon_sym_float] = ACTIONS(187),
-    [anon_sym_LPAREN] = ACTIONS(185),
-    [anon_sym_RPAREN] = ACTIONS(185),
-    [anon_sym_STAR] = ACTIONS(185),
-    [anon_sym_SLASH] = ACTIONS(187),
-    [anon_sym_PLUS] = ACTIONS(185),
-    [anon_sym_DASH] = ACTIONS(185),
-    [anon_sym_EQ_EQ] = ACTIONS(185),
-    [anon_sym_BANG_EQ] = ACTIONS(185),
-    [anon_sym_LT] = ACTIONS(187),
-    [anon_sym_GT] = ACTIONS(187),
-    [anon_sym_LT_EQ] = ACTIONS(185),
-    [anon_sym_GT_EQ] = ACTIONS(185),
-    [sym_at_variable] = ACTIONS(185),
-    [anon_sym_DQUOTE] = ACTIONS(185),
-    [anon_sym_SQUOTE] = ACTIONS(185),
-    [sym_number] = ACTIONS(185),
+    [ts_builtin_sym_end] = ACTIONS(190),
+    [sym_identifier] = ACTIONS(192),
+    [anon_sym_POUNDinclude] = ACTIONS(190),
+    [anon_sym_POUNDdefine] = ACTIONS(190),
+    [anon_sym_POUNDimport] = ACTIONS(190),
+    [anon_sym_POUNDquestion] = ACTIONS(190),
+    [anon_sym_COLON] = ACTIONS(190),
+    [anon_sym_const] = ACTIONS(192),
+    [anon_sym_if] = ACTIONS(192),
+    [anon_sym_else] = ACTIONS(192),
+    [anon_sym_for] = ACTIONS(192),
+    [anon_sym_repeat] = ACTIONS(192),
+    [anon_sym_menu] = ACTIONS(192),
+    [anon_sym_item] = ACTIONS(192),
+    [anon_sym_action] = ACTIONS(192),
+    [anon_sym_LPAREN] = ACTIONS(190),
+    [anon_sym_COMMA] = ACTIONS(190),
+    [anon_sym_RPAREN] = ACTIONS(190),
+    [anon_sym_LBRACE] = ACTIONS(190),
+    [anon_sym_RBRACE] = ACTIONS(190),
+    [anon_sym_true] = ACTIONS(192),
+    [anon_sym_false] = ACTIONS(192),
+    [anon_sym_name] = ACTIONS(192),
+    [anon_sym_glyph] = ACTIONS(192),
+    [anon_sym_from] = ACTIONS(192),
+    [anon_sym_mac] = ACTIONS(192),
+    [anon_sym_inputs] = ACTIONS(192),
+    [anon_sym_noinput] = ACTIONS(192),
+    [anon_sym_askfor] = ACTIONS(192),
+    [anon_sym_getclipboard] = ACTIONS(192),
+    [anon_sym_list] = ACTIONS(192),
+    [anon_sym_nil] = ACTIONS(192),
+    [anon_sym_stop] = ACTIONS(192),
+    [anon_sym_makeVCard] = ACTIONS(192),
+    [anon_sym_rawAction] = ACTIONS(192),
+    [anon_sym_embedFile] = ACTIONS(192),
+    [anon_sym_nothing] = ACTIONS(192),
+    [anon_sym_CurrentDate] = ACTIONS(192),
+    [anon_sym_Device] = ACTIONS(192),
+    [anon_sym_RepeatIndex] = ACTIONS(192),
+    [anon_sym_RepeatItem] = ACTIONS(192),
+    [anon_sym_ShortcutInput] = ACTIONS(192),
+    [anon_sym_Ask] = ACTIONS(192),
+    [anon_sym_text] = ACTIONS(192),
+    [anon_sym_number] = ACTIONS(192),
+    [anon_sym_bool] = ACTIONS(192),
+    [anon_sym_dictionary] = ACTIONS(192),
+    [anon_sym_array] = ACTIONS(192),
+    [anon_sym_variable] = ACTIONS(192),
+    [anon_sym_color] = ACTIONS(192),
+    [anon_sym_float] = ACTIONS(192),
+    [anon_sym_STAR] = ACTIONS(51),
+    [anon_sym_SLASH] = ACTIONS(53),
+    [anon_sym_PLUS] = ACTIONS(55),
+    [anon_sym_DASH] = ACTIONS(55),
+    [anon_sym_EQ_EQ] = ACTIONS(190),
+    [anon_sym_BANG_EQ] = ACTIONS(190),
+    [anon_sym_LT] = ACTIONS(192),
+    [anon_sym_GT] = ACTIONS(192),
+    [anon_sym_LT_EQ] = ACTIONS(190),
+    [anon_sym_GT_EQ] = ACTIONS(190),
+    [sym_at_variable] = ACTIONS(190),
+    [anon_sym_DQUOTE] = ACTIONS(190),
+    [anon_sym_SQUOTE] = ACTIONS(190),
+    [sym_number] = ACTIONS(190),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(33)] = {
     [sym_comment] = STATE(33),
-    [ts_builtin_sym_end] = ACTIONS(189),
-    [sym_identifier] = ACTIONS(191),
-    [anon_sym_POUNDinclude] = ACTIONS(189),
-    [anon_sym_POUNDdefine] = ACTIONS(189),
-    [anon_sym_POUNDimport] = ACTIONS(189),
-    [anon_sym_POUNDquestion] = ACTIONS(189),
-    [anon_sym_COLON] = ACTIONS(189),
-    [anon_sym_const] = ACTIONS(191),
-    [anon_sym_if] = ACTIONS(191),
-    [anon_sym_else] = ACTIONS(191),
-    [anon_sym_for] = ACTIONS(191),
-    [anon_sym_repeat] = ACTIONS(191),
-    [anon_sym_menu] = ACTIONS(191),
-    [anon_sym_item] = ACTIONS(191),
-    [anon_sym_LBRACE] = ACTIONS(189),
-    [anon_sym_RBRACE] = ACTIONS(189),
-    [anon_sym_COMMA] = ACTIONS(189),
-    [anon_sym_true] = ACTIONS(191),
-    [anon_sym_false] = ACTIONS(191),
-    [anon_sym_name] = ACTIONS(191),
-    [anon_sym_glyph] = ACTIONS(191),
-    [anon_sym_from] = ACTIONS(191),
-    [anon_sym_mac] = ACTIONS(191),
-    [anon_sym_inputs] = ACTIONS(191),
-    [anon_sym_noinput] = ACTIONS(191),
-    [anon_sym_askfor] = ACTIONS(191),
-    [anon_sym_getclipboard] = ACTIONS(191),
-    [anon_sym_list] = ACTIONS(191),
-    [anon_sym_nil] = ACTIONS(191),
-    [anon_sym_action] = ACTIONS(191),
-    [anon_sym_stop] = ACTIONS(191),
-    [anon_sym_makeVCard] = ACTIONS(191),
-    [anon_sym_rawAction] = ACTIONS(191),
-    [anon_sym_embedFile] = ACTIONS(191),
-    [anon_sym_nothing] = ACTIONS(191),
-    [anon_sym_CurrentDate] = ACTIONS(191),
-    [anon_sym_Device] = ACTIONS(191),
-    [anon_sym_RepeatIndex] = ACTIONS(191),
-    [anon_sym_RepeatItem] = ACTIONS(191),
-    [anon_sym_ShortcutInput] = ACTIONS(191),
-    [anon_sym_Ask] = ACTIONS(191),
-    [anon_sym_text] = ACTIONS(191),
-    [anon_sym_number] = ACTIONS(191),
-    [anon_sym_bool] = ACTIONS(191),
-    [anon_sym_dictionary] = ACTIONS(191),
-    [anon_sym_array] = ACTIONS(191),
-    [anon_sym_variable] = ACTIONS(191),
-    [anon_sym_color] = ACTIONS(191),
-    [anon_sym_float] = ACTIONS(191),
-    [anon_sym_LPAREN] = ACTIONS(189),
-    [anon_sym_RPAREN] = ACTIONS(189),
-    [anon_sym_STAR] = ACTIONS(189),
-    [anon_sym_SLASH] = ACTIONS(191),
-    [anon_sym_PLUS] = ACTIONS(189),
-    [anon_sym_DASH] = ACTIONS(189),
-    [anon_sym_EQ_EQ] = ACTIONS(189),
-    [anon_sym_BANG_EQ] = ACTIONS(189),
-    [anon_sym_LT] = ACTIONS(191),
-    [anon_sym_GT] = ACTIONS(191),
-    [anon_sym_LT_EQ] = ACTIONS(189),
-    [anon_sym_GT_EQ] = ACTIONS(189),
-    [sym_at_variable] = ACTIONS(189),
-    [anon_sym_DQUOTE] = ACTIONS(189),
-    [anon_sym_SQUOTE] = ACTIONS(189),
-    [sym_number] = ACTIONS(189),
+    [ts_builtin_sym_end] = ACTIONS(194),
+    [sym_identifier] = ACTIONS(196),
+    [anon_sym_POUNDinclude] = ACTIONS(194),
+    [anon_sym_POUNDdefine] = ACTIONS(194),
+    [anon_sym_POUNDimport] = ACTIONS(194),
+    [anon_sym_POUNDquestion] = ACTIONS(194),
+    [anon_sym_COLON] = ACTIONS(194),
+    [anon_sym_const] = ACTIONS(196),
+    [anon_sym_if] = ACTIONS(196),
+    [anon_sym_else] = ACTIONS(196),
+    [anon_sym_for] = ACTIONS(196),
+    [anon_sym_repeat] = ACTIONS(196),
+    [anon_sym_menu] = ACTIONS(196),
+    [anon_sym_item] = ACTIONS(196),
+    [anon_sym_action] = ACTIONS(196),
+    [anon_sym_LPAREN] = ACTIONS(194),
+    [anon_sym_COMMA] = ACTIONS(194),
+    [anon_sym_RPAREN] = ACTIONS(194),
+    [anon_sym_LBRACE] = ACTIONS(194),
+    [anon_sym_RBRACE] = ACTIONS(194),
+    [anon_sym_true] = ACTIONS(196),
+    [anon_sym_false] = ACTIONS(196),
+    [anon_sym_name] = ACTIONS(196),
+    [anon_sym_glyph] = ACTIONS(196),
+    [anon_sym_from] = ACTIONS(196),
+    [anon_sym_mac] = ACTIONS(196),
+    [anon_sym_inputs] = ACTIONS(196),
+    [anon_sym_noinput] = ACTIONS(196),
+    [anon_sym_askfor] = ACTIONS(196),
+    [anon_sym_getclipboard] = ACTIONS(196),
+    [anon_sym_list] = ACTIONS(196),
+    [anon_sym_nil] = ACTIONS(196),
+    [anon_sym_stop] = ACTIONS(196),
+    [anon_sym_makeVCard] = ACTIONS(196),
+    [anon_sym_rawAction] = ACTIONS(196),
+    [anon_sym_embedFile] = ACTIONS(196),
+    [anon_sym_nothing] = ACTIONS(196),
+    [anon_sym_CurrentDate] = ACTIONS(196),
+    [anon_sym_Device] = ACTIONS(196),
+    [anon_sym_RepeatIndex] = ACTIONS(196),
+    [anon_sym_RepeatItem] = ACTIONS(196),
+    [anon_sym_ShortcutInput] = ACTIONS(196),
+    [anon_sym_Ask] = ACTIONS(196),
+    [anon_sym_text] = ACTIONS(196),
+    [anon_sym_number] = ACTIONS(196),
+    [anon_sym_bool] = ACTIONS(196),
+    [anon_sym_dictionary] = ACTIONS(196),
+    [anon_sym_array] = ACTIONS(196),
+    [anon_sym_variable] = ACTIONS(196),
+    [anon_sym_color] = ACTIONS(196),
+    [anon_sym_float] = ACTIONS(196),
+    [anon_sym_STAR] = ACTIONS(194),
+    [anon_sym_SLASH] = ACTIONS(196),
+    [anon_sym_PLUS] = ACTIONS(194),
+    [anon_sym_DASH] = ACTIONS(194),
+    [anon_sym_EQ_EQ] = ACTIONS(194),
+    [anon_sym_BANG_EQ] = ACTIONS(194),
+    [anon_sym_LT] = ACTIONS(196),
+    [anon_sym_GT] = ACTIONS(196),
+    [anon_sym_LT_EQ] = ACTIONS(194),
+    [anon_sym_GT_EQ] = ACTIONS(194),
+    [sym_at_variable] = ACTIONS(194),
+    [anon_sym_DQUOTE] = ACTIONS(194),
+    [anon_sym_SQUOTE] = ACTIONS(194),
+    [sym_number] = ACTIONS(194),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(34)] = {
     [sym_comment] = STATE(34),
-    [ts_builtin_sym_end] = ACTIONS(193),
-    [sym_identifier] = ACTIONS(195),
-    [anon_sym_POUNDinclude] = ACTIONS(193),
-    [anon_sym_POUNDdefine] = ACTIONS(193),
-    [anon_sym_POUNDimport] = ACTIONS(193),
-    [anon_sym_POUNDquestion] = ACTIONS(193),
-    [anon_sym_COLON] = ACTIONS(193),
-    [anon_sym_const] = ACTIONS(195),
-    [anon_sym_if] = ACTIONS(195),
-    [anon_sym_else] = ACTIONS(195),
-    [anon_sym_for] = ACTIONS(195),
-    [anon_sym_repeat] = ACTIONS(195),
-    [anon_sym_menu] = ACTIONS(195),
-    [anon_sym_item] = ACTIONS(195),
-    [anon_sym_LBRACE] = ACTIONS(193),
-    [anon_sym_RBRACE] = ACTIONS(193),
-    [anon_sym_COMMA] = ACTIONS(193),
-    [anon_sym_true] = ACTIONS(195),
-    [anon_sym_false] = ACTIONS(195),
-    [anon_sym_name] = ACTIONS(195),
-    [anon_sym_glyph] = ACTIONS(195),
-    [anon_sym_from] = ACTIONS(195),
-    [anon_sym_mac] = ACTIONS(195),
-    [anon_sym_inputs] = ACTIONS(195),
-    [anon_sym_noinput] = ACTIONS(195),
-    [anon_sym_askfor] = ACTIONS(195),
-    [anon_sym_getclipboard] = ACTIONS(195),
-    [anon_sym_list] = ACTIONS(195),
-    [anon_sym_nil] = ACTIONS(195),
-    [anon_sym_action] = ACTIONS(195),
-    [anon_sym_stop] = ACTIONS(195),
-    [anon_sym_makeVCard] = ACTIONS(195),
-    [anon_sym_rawAction] = ACTIONS(195),
-    [anon_sym_embedFile] = ACTIONS(195),
-    [anon_sym_nothing] = ACTIONS(195),
-    [anon_sym_CurrentDate] = ACTIONS(195),
-    [anon_sym_Device] = ACTIONS(195),
-    [anon_sym_RepeatIndex] = ACTIONS(195),
-    [anon_sym_RepeatItem] = ACTIONS(195),
-    [anon_sym_ShortcutInput] = ACTIONS(195),
-    [anon_sym_Ask] = ACTIONS(195),
-    [anon_sym_text] = ACTIONS(195),
-    [anon_sym_number] = ACTIONS(195),
-    [anon_sym_bool] = ACTIONS(195),
-    [anon_sym_dictionary] = ACTIONS(195),
-    [anon_sym_array] = ACTIONS(195),
-    [anon_sym_variable] = ACTIONS(195),
-    [anon_sym_color] = ACTIONS(195),
-    [anon_sym_float] = ACTIONS(195),
-    [anon_sym_LPAREN] = ACTIONS(193),
-    [anon_sym_RPAREN] = ACTIONS(193),
-    [anon_sym_STAR] = ACTIONS(193),
-    [anon_sym_SLASH] = ACTIONS(195),
-    [anon_sym_PLUS] = ACTIONS(193),
-    [anon_sym_DASH] = ACTIONS(193),
-    [anon_sym_EQ_EQ] = ACTIONS(193),
-    [anon_sym_BANG_EQ] = ACTIONS(193),
-    [anon_sym_LT] = ACTIONS(195),
-    [anon_sym_GT] = ACTIONS(195),
-    [anon_sym_LT_EQ] = ACTIONS(193),
-    [anon_sym_GT_EQ] = ACTIONS(193),
-    [sym_at_variable] = ACTIONS(193),
-    [anon_sym_DQUOTE] = ACTIONS(193),
-    [anon_sym_SQUOTE] = ACTIONS(193),
-    [sym_number] = ACTIONS(193),
+    [ts_builtin_sym_end] = ACTIONS(198),
+    [sym_identifier] = ACTIONS(200),
+    [anon_sym_POUNDinclude] = ACTIONS(198),
+    [anon_sym_POUNDdefine] = ACTIONS(198),
+    [anon_sym_POUNDimport] = ACTIONS(198),
+    [anon_sym_POUNDquestion] = ACTIONS(198),
+    [anon_sym_COLON] = ACTIONS(198),
+    [anon_sym_const] = ACTIONS(200),
+    [anon_sym_if] = ACTIONS(200),
+    [anon_sym_else] = ACTIONS(200),
+    [anon_sym_for] = ACTIONS(200),
+    [anon_sym_repeat] = ACTIONS(200),
+    [anon_sym_menu] = ACTIONS(200),
+    [anon_sym_item] = ACTIONS(200),
+    [anon_sym_action] = ACTIONS(200),
+    [anon_sym_LPAREN] = ACTIONS(198),
+    [anon_sym_COMMA] = ACTIONS(198),
+    [anon_sym_RPAREN] = ACTIONS(198),
+    [anon_sym_LBRACE] = ACTIONS(198),
+    [anon_sym_RBRACE] = ACTIONS(198),
+    [anon_sym_true] = ACTIONS(200),
+    [anon_sym_false] = ACTIONS(200),
+    [anon_sym_name] = ACTIONS(200),
+    [anon_sym_glyph] = ACTIONS(200),
+    [anon_sym_from] = ACTIONS(200),
+    [anon_sym_mac] = ACTIONS(200),
+    [anon_sym_inputs] = ACTIONS(200),
+    [anon_sym_noinput] = ACTIONS(200),
+    [anon_sym_askfor] = ACTIONS(200),
+    [anon_sym_getclipboard] = ACTIONS(200),
+    [anon_sym_list] = ACTIONS(200),
+    [anon_sym_nil] = ACTIONS(200),
+    [anon_sym_stop] = ACTIONS(200),
+    [anon_sym_makeVCard] = ACTIONS(200),
+    [anon_sym_rawAction] = ACTIONS(200),
+    [anon_sym_embedFile] = ACTIONS(200),
+    [anon_sym_nothing] = ACTIONS(200),
+    [anon_sym_CurrentDate] = ACTIONS(200),
+    [anon_sym_Device] = ACTIONS(200),
+    [anon_sym_RepeatIndex] = ACTIONS(200),
+    [anon_sym_RepeatItem] = ACTIONS(200),
+    [anon_sym_ShortcutInput] = ACTIONS(200),
+    [anon_sym_Ask] = ACTIONS(200),
+    [anon_sym_text] = ACTIONS(200),
+    [anon_sym_number] = ACTIONS(200),
+    [anon_sym_bool] = ACTIONS(200),
+    [anon_sym_dictionary] = ACTIONS(200),
+    [anon_sym_array] = ACTIONS(200),
+    [anon_sym_variable] = ACTIONS(200),
+    [anon_sym_color] = ACTIONS(200),
+    [anon_sym_float] = ACTIONS(200),
+    [anon_sym_STAR] = ACTIONS(198),
+    [anon_sym_SLASH] = ACTIONS(200),
+    [anon_sym_PLUS] = ACTIONS(198),
+    [anon_sym_DASH] = ACTIONS(198),
+    [anon_sym_EQ_EQ] = ACTIONS(198),
+    [anon_sym_BANG_EQ] = ACTIONS(198),
+    [anon_sym_LT] = ACTIONS(200),
+    [anon_sym_GT] = ACTIONS(200),
+    [anon_sym_LT_EQ] = ACTIONS(198),
+    [anon_sym_GT_EQ] = ACTIONS(198),
+    [sym_at_variable] = ACTIONS(198),
+    [anon_sym_DQUOTE] = ACTIONS(198),
+    [anon_sym_SQUOTE] = ACTIONS(198),
+    [sym_number] = ACTIONS(198),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(35)] = {
     [sym_comment] = STATE(35),
-    [ts_builtin_sym_end] = ACTIONS(197),
-    [sym_identifier] = ACTIONS(199),
-    [anon_sym_POUNDinclude] = ACTIONS(197),
-    [anon_sym_POUNDdefine] = ACTIONS(197),
-    [anon_sym_POUNDimport] = ACTIONS(197),
-    [anon_sym_POUNDquestion] = ACTIONS(197),
-    [anon_sym_COLON] = ACTIONS(197),
-    [anon_sym_const] = ACTIONS(199),
-    [anon_sym_if] = ACTIONS(199),
-    [anon_sym_else] = ACTIONS(199),
-    [anon_sym_for] = ACTIONS(199),
-    [anon_sym_repeat] = ACTIONS(199),
-    [anon_sym_menu] = ACTIONS(199),
-    [anon_sym_item] = ACTIONS(199),
-    [anon_sym_LBRACE] = ACTIONS(197),
-    [anon_sym_RBRACE] = ACTIONS(197),
-    [anon_sym_COMMA] = ACTIONS(197),
-    [anon_sym_true] = ACTIONS(199),
-    [anon_sym_false] = ACTIONS(199),
-    [anon_sym_name] = ACTIONS(199),
-    [anon_sym_glyph] = ACTIONS(199),
-    [anon_sym_from] = ACTIONS(199),
-    [anon_sym_mac] = ACTIONS(199),
-    [anon_sym_inputs] = ACTIONS(199),
-    [anon_sym_noinput] = ACTIONS(199),
-    [anon_sym_askfor] = ACTIONS(199),
-    [anon_sym_getclipboard] = ACTIONS(199),
-    [anon_sym_list] = ACTIONS(199),
-    [anon_sym_nil] = ACTIONS(199),
-    [anon_sym_action] = ACTIONS(199),
-    [anon_sym_stop] = ACTIONS(199),
-    [anon_sym_makeVCard] = ACTIONS(199),
-    [anon_sym_rawAction] = ACTIONS(199),
-    [anon_sym_embedFile] = ACTIONS(199),
-    [anon_sym_nothing] = ACTIONS(199),
-    [anon_sym_CurrentDate] = ACTIONS(199),
-    [anon_sym_Device] = ACTIONS(199),
-    [anon_sym_RepeatIndex] = ACTIONS(199),
-    [anon_sym_RepeatItem] = ACTIONS(199),
-    [anon_sym_ShortcutInput] = ACTIONS(199),
-    [anon_sym_Ask] = ACTIONS(199),
-    [anon_sym_text] = ACTIONS(199),
-    [anon_sym_number] = ACTIONS(199),
-    [anon_sym_bool] = ACTIONS(199),
-    [anon_sym_dictionary] = ACTIONS(199),
-    [anon_sym_array] = ACTIONS(199),
-    [anon_sym_variable] = ACTIONS(199),
-    [anon_sym_color] = ACTIONS(199),
-    [anon_sym_float] = ACTIONS(199),
-    [anon_sym_LPAREN] = ACTIONS(197),
-    [anon_sym_RPAREN] = ACTIONS(197),
-    [anon_sym_STAR] = ACTIONS(197),
-    [anon_sym_SLASH] = ACTIONS(199),
-    [anon_sym_PLUS] = ACTIONS(197),
-    [anon_sym_DASH] = ACTIONS(197),
-    [anon_sym_EQ_EQ] = ACTIONS(197),
-    [anon_sym_BANG_EQ] = ACTIONS(197),
-    [anon_sym_LT] = ACTIONS(199),
-    [anon_sym_GT] = ACTIONS(199),
-    [anon_sym_LT_EQ] = ACTIONS(197),
-    [anon_sym_GT_EQ] = ACTIONS(197),
-    [sym_at_variable] = ACTIONS(197),
-    [anon_sym_DQUOTE] = ACTIONS(197),
-    [anon_sym_SQUOTE] = ACTIONS(197),
-    [sym_number] = ACTIONS(197),
+    [ts_builtin_sym_end] = ACTIONS(202),
+    [sym_identifier] = ACTIONS(204),
+    [anon_sym_POUNDinclude] = ACTIONS(202),
+    [anon_sym_POUNDdefine] = ACTIONS(202),
+    [anon_sym_POUNDimport] = ACTIONS(202),
+    [anon_sym_POUNDquestion] = ACTIONS(202),
+    [anon_sym_COLON] = ACTIONS(202),
+    [anon_sym_const] = ACTIONS(204),
+    [anon_sym_if] = ACTIONS(204),
+    [anon_sym_else] = ACTIONS(204),
+    [anon_sym_for] = ACTIONS(204),
+    [anon_sym_repeat] = ACTIONS(204),
+    [anon_sym_menu] = ACTIONS(204),
+    [anon_sym_item] = ACTIONS(204),
+    [anon_sym_action] = ACTIONS(204),
+    [anon_sym_LPAREN] = ACTIONS(202),
+    [anon_sym_COMMA] = ACTIONS(202),
+    [anon_sym_RPAREN] = ACTIONS(202),
+    [anon_sym_LBRACE] = ACTIONS(202),
+    [anon_sym_RBRACE] = ACTIONS(202),
+    [anon_sym_true] = ACTIONS(204),
+    [anon_sym_false] = ACTIONS(204),
+    [anon_sym_name] = ACTIONS(204),
+    [anon_sym_glyph] = ACTIONS(204),
+    [anon_sym_from] = ACTIONS(204),
+    [anon_sym_mac] = ACTIONS(204),
+    [anon_sym_inputs] = ACTIONS(204),
+    [anon_sym_noinput] = ACTIONS(204),
+    [anon_sym_askfor] = ACTIONS(204),
+    [anon_sym_getclipboard] = ACTIONS(204),
+    [anon_sym_list] = ACTIONS(204),
+    [anon_sym_nil] = ACTIONS(204),
+    [anon_sym_stop] = ACTIONS(204),
+    [anon_sym_makeVCard] = ACTIONS(204),
+    [anon_sym_rawAction] = ACTIONS(204),
+    [anon_sym_embedFile] = ACTIONS(204),
+    [anon_sym_nothing] = ACTIONS(204),
+    [anon_sym_CurrentDate] = ACTIONS(204),
+    [anon_sym_Device] = ACTIONS(204),
+    [anon_sym_RepeatIndex] = ACTIONS(204),
+    [anon_sym_RepeatItem] = ACTIONS(204),
+    [anon_sym_ShortcutInput] = ACTIONS(204),
+    [anon_sym_Ask] = ACTIONS(204),
+    [anon_sym_text] = ACTIONS(204),
+    [anon_sym_number] = ACTIONS(204),
+    [anon_sym_bool] = ACTIONS(204),
+    [anon_sym_dictionary] = ACTIONS(204),
+    [anon_sym_array] = ACTIONS(204),
+    [anon_sym_variable] = ACTIONS(204),
+    [anon_sym_color] = ACTIONS(204),
+    [anon_sym_float] = ACTIONS(204),
+    [anon_sym_STAR] = ACTIONS(202),
+    [anon_sym_SLASH] = ACTIONS(204),
+    [anon_sym_PLUS] = ACTIONS(202),
+    [anon_sym_DASH] = ACTIONS(202),
+    [anon_sym_EQ_EQ] = ACTIONS(202),
+    [anon_sym_BANG_EQ] = ACTIONS(202),
+    [anon_sym_LT] = ACTIONS(204),
+    [anon_sym_GT] = ACTIONS(204),
+    [anon_sym_LT_EQ] = ACTIONS(202),
+    [anon_sym_GT_EQ] = ACTIONS(202),
+    [sym_at_variable] = ACTIONS(202),
+    [anon_sym_DQUOTE] = ACTIONS(202),
+    [anon_sym_SQUOTE] = ACTIONS(202),
+    [sym_number] = ACTIONS(202),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(36)] = {
     [sym_comment] = STATE(36),
-    [ts_builtin_sym_end] = ACTIONS(139),
-    [sym_identifier] = ACTIONS(141),
-    [anon_sym_POUNDinclude] = ACTIONS(139),
-    [anon_sym_POUNDdefine] = ACTIONS(139),
-    [anon_sym_POUNDimport] = ACTIONS(139),
-    [anon_sym_POUNDquestion] = ACTIONS(139),
-    [anon_sym_COLON] = ACTIONS(201),
-    [anon_sym_EQ] = ACTIONS(203),
-    [anon_sym_const] = ACTIONS(141),
-    [anon_sym_if] = ACTIONS(141),
-    [anon_sym_else] = ACTIONS(141),
-    [anon_sym_for] = ACTIONS(141),
-    [anon_sym_repeat] = ACTIONS(141),
-    [anon_sym_menu] = ACTIONS(141),
-    [anon_sym_item] = ACTIONS(141),
-    [anon_sym_LBRACE] = ACTIONS(139),
-    [anon_sym_RBRACE] = ACTIONS(139),
-    [anon_sym_true] = ACTIONS(141),
-    [anon_sym_false] = ACTIONS(141),
-    [anon_sym_name] = ACTIONS(141),
-    [anon_sym_glyph] = ACTIONS(141),
-    [anon_sym_from] = ACTIONS(141),
-    [anon_sym_mac] = ACTIONS(141),
-    [anon_sym_inputs] = ACTIONS(141),
-    [anon_sym_noinput] = ACTIONS(141),
-    [anon_sym_askfor] = ACTIONS(141),
-    [anon_sym_getclipboard] = ACTIONS(141),
-    [anon_sym_list] = ACTIONS(141),
-    [anon_sym_nil] = ACTIONS(141),
-    [anon_sym_action] = ACTIONS(141),
-    [anon_sym_stop] = ACTIONS(141),
-    [anon_sym_makeVCard] = ACTIONS(141),
-    [anon_sym_rawAction] = ACTIONS(141),
-    [anon_sym_embedFile] = ACTIONS(141),
-    [anon_sym_nothing] = ACTIONS(141),
-    [anon_sym_CurrentDate] = ACTIONS(141),
-    [anon_sym_Device] = ACTIONS(141),
-    [anon_sym_RepeatIndex] = ACTIONS(141),
-    [anon_sym_RepeatItem] = ACTIONS(141),
-    [anon_sym_ShortcutInput] = ACTIONS(141),
-    [anon_sym_Ask] = ACTIONS(141),
-    [anon_sym_text] = ACTIONS(141),
-    [anon_sym_number] = ACTIONS(141),
-    [anon_sym_bool] = ACTIONS(141),
-    [anon_sym_dictionary] = ACTIONS(141),
-    [anon_sym_array] = ACTIONS(141),
-    [anon_sym_variable] = ACTIONS(141),
-    [anon_sym_color] = ACTIONS(141),
-    [anon_sym_float] = ACTIONS(141),
-    [anon_sym_LPAREN] = ACTIONS(139),
-    [anon_sym_STAR] = ACTIONS(139),
-    [anon_sym_SLASH] = ACTIONS(141),
-    [anon_sym_PLUS] = ACTIONS(139),
-    [anon_sym_DASH] = ACTIONS(139),
-    [anon_sym_EQ_EQ] = ACTIONS(139),
-    [anon_sym_BANG_EQ] = ACTIONS(139),
-    [anon_sym_LT] = ACTIONS(141),
-    [anon_sym_GT] = ACTIONS(141),
-    [anon_sym_LT_EQ] = ACTIONS(139),
-    [anon_sym_GT_EQ] = ACTIONS(139),
-    [sym_at_variable] = ACTIONS(139),
-    [anon_sym_DQUOTE] = ACTIONS(139),
-    [anon_sym_SQUOTE] = ACTIONS(139),
-    [sym_number] = ACTIONS(139),
+    [ts_builtin_sym_end] = ACTIONS(152),
+    [sym_identifier] = ACTIONS(154),
+    [anon_sym_POUNDinclude] = ACTIONS(152),
+    [anon_sym_POUNDdefine] = ACTIONS(152),
+    [anon_sym_POUNDimport] = ACTIONS(152),
+    [anon_sym_POUNDquestion] = ACTIONS(152),
+    [anon_sym_COLON] = ACTIONS(206),
+    [anon_sym_EQ] = ACTIONS(208),
+    [anon_sym_const] = ACTIONS(154),
+    [anon_sym_if] = ACTIONS(154),
+    [anon_sym_else] = ACTIONS(154),
+    [anon_sym_for] = ACTIONS(154),
+    [anon_sym_repeat] = ACTIONS(154),
+    [anon_sym_menu] = ACTIONS(154),
+    [anon_sym_item] = ACTIONS(154),
+    [anon_sym_action] = ACTIONS(154),
+    [anon_sym_LPAREN] = ACTIONS(152),
+    [anon_sym_LBRACE] = ACTIONS(152),
+    [anon_sym_RBRACE] = ACTIONS(152),
+    [anon_sym_true] = ACTIONS(154),
+    [anon_sym_false] = ACTIONS(154),
+    [anon_sym_name] = ACTIONS(154),
+    [anon_sym_glyph] = ACTIONS(154),
+    [anon_sym_from] = ACTIONS(154),
+    [anon_sym_mac] = ACTIONS(154),
+    [anon_sym_inputs] = ACTIONS(154),
+    [anon_sym_noinput] = ACTIONS(154),
+    [anon_sym_askfor] = ACTIONS(154),
+    [anon_sym_getclipboard] = ACTIONS(154),
+    [anon_sym_list] = ACTIONS(154),
+    [anon_sym_nil] = ACTIONS(154),
+    [anon_sym_stop] = ACTIONS(154),
+    [anon_sym_makeVCard] = ACTIONS(154),
+    [anon_sym_rawAction] = ACTIONS(154),
+    [anon_sym_embedFile] = ACTIONS(154),
+    [anon_sym_nothing] = ACTIONS(154),
+    [anon_sym_CurrentDate] = ACTIONS(154),
+    [anon_sym_Device] = ACTIONS(154),
+    [anon_sym_RepeatIndex] = ACTIONS(154),
+    [anon_sym_RepeatItem] = ACTIONS(154),
+    [anon_sym_ShortcutInput] = ACTIONS(154),
+    [anon_sym_Ask] = ACTIONS(154),
+    [anon_sym_text] = ACTIONS(154),
+    [anon_sym_number] = ACTIONS(154),
+    [anon_sym_bool] = ACTIONS(154),
+    [anon_sym_dictionary] = ACTIONS(154),
+    [anon_sym_array] = ACTIONS(154),
+    [anon_sym_variable] = ACTIONS(154),
+    [anon_sym_color] = ACTIONS(154),
+    [anon_sym_float] = ACTIONS(154),
+    [anon_sym_STAR] = ACTIONS(152),
+    [anon_sym_SLASH] = ACTIONS(154),
+    [anon_sym_PLUS] = ACTIONS(152),
+    [anon_sym_DASH] = ACTIONS(152),
+    [anon_sym_EQ_EQ] = ACTIONS(152),
+    [anon_sym_BANG_EQ] = ACTIONS(152),
+    [anon_sym_LT] = ACTIONS(154),
+    [anon_sym_GT] = ACTIONS(154),
+    [anon_sym_LT_EQ] = ACTIONS(152),
+    [anon_sym_GT_EQ] = ACTIONS(152),
+    [sym_at_variable] = ACTIONS(152),
+    [anon_sym_DQUOTE] = ACTIONS(152),
+    [anon_sym_SQUOTE] = ACTIONS(152),
+    [sym_number] = ACTIONS(152),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(37)] = {
     [sym_comment] = STATE(37),
-    [ts_builtin_sym_end] = ACTIONS(139),
-    [sym_identifier] = ACTIONS(141),
-    [anon_sym_POUNDinclude] = ACTIONS(139),
-    [anon_sym_POUNDdefine] = ACTIONS(139),
-    [anon_sym_POUNDimport] = ACTIONS(139),
-    [anon_sym_POUNDquestion] = ACTIONS(139),
-    [anon_sym_EQ] = ACTIONS(205),
-    [anon_sym_const] = ACTIONS(141),
-    [anon_sym_if] = ACTIONS(141),
-    [anon_sym_else] = ACTIONS(141),
-    [anon_sym_for] = ACTIONS(141),
-    [anon_sym_repeat] = ACTIONS(141),
-    [anon_sym_menu] = ACTIONS(141),
-    [anon_sym_item] = ACTIONS(141),
-    [anon_sym_LBRACE] = ACTIONS(139),
-    [anon_sym_RBRACE] = ACTIONS(139),
-    [anon_sym_true] = ACTIONS(141),
-    [anon_sym_false] = ACTIONS(141),
-    [anon_sym_name] = ACTIONS(141),
-    [anon_sym_glyph] = ACTIONS(141),
-    [anon_sym_from] = ACTIONS(141),
-    [anon_sym_mac] = ACTIONS(141),
-    [anon_sym_inputs] = ACTIONS(141),
-    [anon_sym_noinput] = ACTIONS(141),
-    [anon_sym_askfor] = ACTIONS(141),
-    [anon_sym_getclipboard] = ACTIONS(141),
-    [anon_sym_list] = ACTIONS(141),
-    [anon_sym_nil] = ACTIONS(141),
-    [anon_sym_action] = ACTIONS(141),
-    [anon_sym_stop] = ACTIONS(141),
-    [anon_sym_makeVCard] = ACTIONS(141),
-    [anon_sym_rawAction] = ACTIONS(141),
-    [anon_sym_embedFile] = ACTIONS(141),
-    [anon_sym_nothing] = ACTIONS(141),
-    [anon_sym_CurrentDate] = ACTIONS(141),
-    [anon_sym_Device] = ACTIONS(141),
-    [anon_sym_RepeatIndex] = ACTIONS(141),
-    [anon_sym_RepeatItem] = ACTIONS(141),
-    [anon_sym_ShortcutInput] = ACTIONS(141),
-    [anon_sym_Ask] = ACTIONS(141),
-    [anon_sym_text] = ACTIONS(141),
-    [anon_sym_number] = ACTIONS(141),
-    [anon_sym_bool] = ACTIONS(141),
-    [anon_sym_dictionary] = ACTIONS(141),
-    [anon_sym_array] = ACTIONS(141),
-    [anon_sym_variable] = ACTIONS(141),
-    [anon_sym_color] = ACTIONS(141),
-    [anon_sym_float] = ACTIONS(141),
-    [anon_sym_LPAREN] = ACTIONS(175),
-    [anon_sym_STAR] = ACTIONS(139),
-    [anon_sym_SLASH] = ACTIONS(141),
-    [anon_sym_PLUS] = ACTIONS(139),
-    [anon_sym_DASH] = ACTIONS(139),
-    [anon_sym_EQ_EQ] = ACTIONS(139),
-    [anon_sym_BANG_EQ] = ACTIONS(139),
-    [anon_sym_LT] = ACTIONS(141),
-    [anon_sym_GT] = ACTIONS(141),
-    [anon_sym_LT_EQ] = ACTIONS(139),
-    [anon_sym_GT_EQ] = ACTIONS(139),
-    [sym_at_variable] = ACTIONS(139),
-    [anon_sym_DQUOTE] = ACTIONS(139),
-    [anon_sym_SQUOTE] = ACTIONS(139),
-    [sym_number] = ACTIONS(139),
+    [ts_builtin_sym_end] = ACTIONS(152),
+    [sym_identifier] = ACTIONS(154),
+    [anon_sym_POUNDinclude] = ACTIONS(152),
+    [anon_sym_POUNDdefine] = ACTIONS(152),
+    [anon_sym_POUNDimport] = ACTIONS(152),
+    [anon_sym_POUNDquestion] = ACTIONS(152),
+    [anon_sym_EQ] = ACTIONS(210),
+    [anon_sym_const] = ACTIONS(154),
+    [anon_sym_if] = ACTIONS(154),
+    [anon_sym_else] = ACTIONS(154),
+    [anon_sym_for] = ACTIONS(154),
+    [anon_sym_repeat] = ACTIONS(154),
+    [anon_sym_menu] = ACTIONS(154),
+    [anon_sym_item] = ACTIONS(154),
+    [anon_sym_action] = ACTIONS(154),
+    [anon_sym_LPAREN] = ACTIONS(156),
+    [anon_sym_LBRACE] = ACTIONS(152),
+    [anon_sym_RBRACE] = ACTIONS(152),
+    [anon_sym_true] = ACTIONS(154),
+    [anon_sym_false] = ACTIONS(154),
+    [anon_sym_name] = ACTIONS(154),
+    [anon_sym_glyph] = ACTIONS(154),
+    [anon_sym_from] = ACTIONS(154),
+    [anon_sym_mac] = ACTIONS(154),
+    [anon_sym_inputs] = ACTIONS(154),
+    [anon_sym_noinput] = ACTIONS(154),
+    [anon_sym_askfor] = ACTIONS(154),
+    [anon_sym_getclipboard] = ACTIONS(154),
+    [anon_sym_list] = ACTIONS(154),
+    [anon_sym_nil] = ACTIONS(154),
+    [anon_sym_stop] = ACTIONS(154),
+    [anon_sym_makeVCard] = ACTIONS(154),
+    [anon_sym_rawAction] = ACTIONS(154),
+    [anon_sym_embedFile] = ACTIONS(154),
+    [anon_sym_nothing] = ACTIONS(154),
+    [anon_sym_CurrentDate] = ACTIONS(154),
+    [anon_sym_Device] = ACTIONS(154),
+    [anon_sym_RepeatIndex] = ACTIONS(154),
+    [anon_sym_RepeatItem] = ACTIONS(154),
+    [anon_sym_ShortcutInput] = ACTIONS(154),
+    [anon_sym_Ask] = ACTIONS(154),
+    [anon_sym_text] = ACTIONS(154),
+    [anon_sym_number] = ACTIONS(154),
+    [anon_sym_bool] = ACTIONS(154),
+    [anon_sym_dictionary] = ACTIONS(154),
+    [anon_sym_array] = ACTIONS(154),
+    [anon_sym_variable] = ACTIONS(154),
+    [anon_sym_color] = ACTIONS(154),
+    [anon_sym_float] = ACTIONS(154),
+    [anon_sym_STAR] = ACTIONS(152),
+    [anon_sym_SLASH] = ACTIONS(154),
+    [anon_sym_PLUS] = ACTIONS(152),
+    [anon_sym_DASH] = ACTIONS(152),
+    [anon_sym_EQ_EQ] = ACTIONS(152),
+    [anon_sym_BANG_EQ] = ACTIONS(152),
+    [anon_sym_LT] = ACTIONS(154),
+    [anon_sym_GT] = ACTIONS(154),
+    [anon_sym_LT_EQ] = ACTIONS(152),
+    [anon_sym_GT_EQ] = ACTIONS(152),
+    [sym_at_variable] = ACTIONS(152),
+    [anon_sym_DQUOTE] = ACTIONS(152),
+    [anon_sym_SQUOTE] = ACTIONS(152),
+    [sym_number] = ACTIONS(152),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(38)] = {
     [sym_comment] = STATE(38),
-    [ts_builtin_sym_end] = ACTIONS(139),
-    [sym_identifier] = ACTIONS(141),
-    [anon_sym_POUNDinclude] = ACTIONS(139),
-    [anon_sym_POUNDdefine] = ACTIONS(139),
-    [anon_sym_POUNDimport] = ACTIONS(139),
-    [anon_sym_POUNDquestion] = ACTIONS(139),
-    [anon_sym_EQ] = ACTIONS(205),
-    [anon_sym_const] = ACTIONS(141),
-    [anon_sym_if] = ACTIONS(141),
-    [anon_sym_else] = ACTIONS(141),
-    [anon_sym_for] = ACTIONS(207),
-    [anon_sym_repeat] = ACTIONS(141),
-    [anon_sym_menu] = ACTIONS(141),
-    [anon_sym_item] = ACTIONS(141),
-    [anon_sym_LBRACE] = ACTIONS(139),
-    [anon_sym_RBRACE] = ACTIONS(139),
-    [anon_sym_true] = ACTIONS(141),
-    [anon_sym_false] = ACTIONS(141),
-    [anon_sym_name] = ACTIONS(141),
-    [anon_sym_glyph] = ACTIONS(141),
-    [anon_sym_from] = ACTIONS(141),
-    [anon_sym_mac] = ACTIONS(141),
-    [anon_sym_inputs] = ACTIONS(141),
-    [anon_sym_noinput] = ACTIONS(141),
-    [anon_sym_askfor] = ACTIONS(141),
-    [anon_sym_getclipboard] = ACTIONS(141),
-    [anon_sym_list] = ACTIONS(141),
-    [anon_sym_nil] = ACTIONS(141),
-    [anon_sym_action] = ACTIONS(141),
-    [anon_sym_stop] = ACTIONS(141),
-    [anon_sym_makeVCard] = ACTIONS(141),
-    [anon_sym_rawAction] = ACTIONS(141),
-    [anon_sym_embedFile] = ACTIONS(141),
-    [anon_sym_nothing] = ACTIONS(141),
-    [anon_sym_CurrentDate] = ACTIONS(141),
-    [anon_sym_Device] = ACTIONS(141),
-    [anon_sym_RepeatIndex] = ACTIONS(141),
-    [anon_sym_RepeatItem] = ACTIONS(141),
-    [anon_sym_ShortcutInput] = ACTIONS(141),
-    [anon_sym_Ask] = ACTIONS(141),
-    [anon_sym_text] = ACTIONS(141),
-    [anon_sym_number] = ACTIONS(141),
-    [anon_sym_bool] = ACTIONS(141),
-    [anon_sym_dictionary] = ACTIONS(141),
-    [anon_sym_array] = ACTIONS(141),
-    [anon_sym_variable] = ACTIONS(141),
-    [anon_sym_color] = ACTIONS(141),
-    [anon_sym_float] = ACTIONS(141),
-    [anon_sym_LPAREN] = ACTIONS(175),
-    [anon_sym_STAR] = ACTIONS(139),
-    [anon_sym_SLASH] = ACTIONS(141),
-    [anon_sym_PLUS] = ACTIONS(139),
-    [anon_sym_DASH] = ACTIONS(139),
-    [anon_sym_EQ_EQ] = ACTIONS(139),
-    [anon_sym_BANG_EQ] = ACTIONS(139),
-    [anon_sym_LT] = ACTIONS(141),
-    [anon_sym_GT] = ACTIONS(141),
-    [anon_sym_LT_EQ] = ACTIONS(139),
-    [anon_sym_GT_EQ] = ACTIONS(139),
-    [sym_at_variable] = ACTIONS(139),
-    [anon_sym_DQUOTE] = ACTIONS(139),
-    [anon_sym_SQUOTE] = ACTIONS(139),
-    [sym_number] = ACTIONS(139),
+    [ts_builtin_sym_end] = ACTIONS(152),
+    [sym_identifier] = ACTIONS(154),
+    [anon_sym_POUNDinclude] = ACTIONS(152),
+    [anon_sym_POUNDdefine] = ACTIONS(152),
+    [anon_sym_POUNDimport] = ACTIONS(152),
+    [anon_sym_POUNDquestion] = ACTIONS(152),
+    [anon_sym_EQ] = ACTIONS(210),
+    [anon_sym_const] = ACTIONS(154),
+    [anon_sym_if] = ACTIONS(154),
+    [anon_sym_else] = ACTIONS(154),
+    [anon_sym_for] = ACTIONS(212),
+    [anon_sym_repeat] = ACTIONS(154),
+    [anon_sym_menu] = ACTIONS(154),
+    [anon_sym_item] = ACTIONS(154),
+    [anon_sym_action] = ACTIONS(154),
+    [anon_sym_LPAREN] = ACTIONS(156),
+    [anon_sym_LBRACE] = ACTIONS(152),
+    [anon_sym_RBRACE] = ACTIONS(152),
+    [anon_sym_true] = ACTIONS(154),
+    [anon_sym_false] = ACTIONS(154),
+    [anon_sym_name] = ACTIONS(154),
+    [anon_sym_glyph] = ACTIONS(154),
+    [anon_sym_from] = ACTIONS(154),
+    [anon_sym_mac] = ACTIONS(154),
+    [anon_sym_inputs] = ACTIONS(154),
+    [anon_sym_noinput] = ACTIONS(154),
+    [anon_sym_askfor] = ACTIONS(154),
+    [anon_sym_getclipboard] = ACTIONS(154),
+    [anon_sym_list] = ACTIONS(154),
+    [anon_sym_nil] = ACTIONS(154),
+    [anon_sym_stop] = ACTIONS(154),
+    [anon_sym_makeVCard] = ACTIONS(154),
+    [anon_sym_rawAction] = ACTIONS(154),
+    [anon_sym_embedFile] = ACTIONS(154),
+    [anon_sym_nothing] = ACTIONS(154),
+    [anon_sym_CurrentDate] = ACTIONS(154),
+    [anon_sym_Device] = ACTIONS(154),
+    [anon_sym_RepeatIndex] = ACTIONS(154),
+    [anon_sym_RepeatItem] = ACTIONS(154),
+    [anon_sym_ShortcutInput] = ACTIONS(154),
+    [anon_sym_Ask] = ACTIONS(154),
+    [anon_sym_text] = ACTIONS(154),
+    [anon_sym_number] = ACTIONS(154),
+    [anon_sym_bool] = ACTIONS(154),
+    [anon_sym_dictionary] = ACTIONS(154),
+    [anon_sym_array] = ACTIONS(154),
+    [anon_sym_variable] = ACTIONS(154),
+    [anon_sym_color] = ACTIONS(154),
+    [anon_sym_float] = ACTIONS(154),
+    [anon_sym_STAR] = ACTIONS(152),
+    [anon_sym_SLASH] = ACTIONS(154),
+    [anon_sym_PLUS] = ACTIONS(152),
+    [anon_sym_DASH] = ACTIONS(152),
+    [anon_sym_EQ_EQ] = ACTIONS(152),
+    [anon_sym_BANG_EQ] = ACTIONS(152),
+    [anon_sym_LT] = ACTIONS(154),
+    [anon_sym_GT] = ACTIONS(154),
+    [anon_sym_LT_EQ] = ACTIONS(152),
+    [anon_sym_GT_EQ] = ACTIONS(152),
+    [sym_at_variable] = ACTIONS(152),
+    [anon_sym_DQUOTE] = ACTIONS(152),
+    [anon_sym_SQUOTE] = ACTIONS(152),
+    [sym_number] = ACTIONS(152),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(39)] = {
     [sym_comment] = STATE(39),
-    [ts_builtin_sym_end] = ACTIONS(45),
-    [sym_identifier] = ACTIONS(47),
-    [anon_sym_POUNDinclude] = ACTIONS(45),
-    [anon_sym_POUNDdefine] = ACTIONS(45),
-    [anon_sym_POUNDimport] = ACTIONS(45),
-    [anon_sym_POUNDquestion] = ACTIONS(45),
-    [anon_sym_const] = ACTIONS(47),
-    [anon_sym_if] = ACTIONS(47),
-    [anon_sym_else] = ACTIONS(47),
-    [anon_sym_for] = ACTIONS(47),
-    [anon_sym_repeat] = ACTIONS(47),
-    [anon_sym_menu] = ACTIONS(47),
-    [anon_sym_item] = ACTIONS(47),
-    [anon_sym_LBRACE] = ACTIONS(45),
-    [anon_sym_RBRACE] = ACTIONS(45),
-    [anon_sym_true] = ACTIONS(47),
-    [anon_sym_false] = ACTIONS(47),
-    [anon_sym_name] = ACTIONS(47),
-    [anon_sym_glyph] = ACTIONS(47),
-    [anon_sym_from] = ACTIONS(47),
-    [anon_sym_mac] = ACTIONS(47),
-    [anon_sym_inputs] = ACTIONS(47),
-    [anon_sym_noinput] = ACTIONS(47),
-    [anon_sym_askfor] = ACTIONS(47),
-    [anon_sym_getclipboard] = ACTIONS(47),
-    [anon_sym_list] = ACTIONS(47),
-    [anon_sym_nil] = ACTIONS(47),
-    [anon_sym_action] = ACTIONS(47),
-    [anon_sym_stop] = ACTIONS(47),
-    [anon_sym_makeVCard] = ACTIONS(47),
-    [anon_sym_rawAction] = ACTIONS(47),
-    [anon_sym_embedFile] = ACTIONS(47),
-    [anon_sym_nothing] = ACTIONS(47),
-    [anon_sym_CurrentDate] = ACTIONS(47),
-    [anon_sym_Device] = ACTIONS(47),
-    [anon_sym_RepeatIndex] = ACTIONS(47),
-    [anon_sym_RepeatItem] = ACTIONS(47),
-    [anon_sym_ShortcutInput] = ACTIONS(47),
-    [anon_sym_Ask] = ACTIONS(47),
-    [anon_sym_text] = ACTIONS(47),
-    [anon_sym_number] = ACTIONS(47),
-    [anon_sym_bool] = ACTIONS(47),
-    [anon_sym_dictionary] = ACTIONS(47),
-    [anon_sym_array] = ACTIONS(47),
-    [anon_sym_variable] = ACTIONS(47),
-    [anon_sym_color] = ACTIONS(47),
-    [anon_sym_float] = ACTIONS(47),
-    [anon_sym_LPAREN] = ACTIONS(45),
-    [anon_sym_STAR] = ACTIONS(49),
-    [anon_sym_SLASH] = ACTIONS(51),
-    [anon_sym_PLUS] = ACTIONS(53),
-    [anon_sym_DASH] = ACTIONS(53),
-    [anon_sym_EQ_EQ] = ACTIONS(55),
-    [anon_sym_BANG_EQ] = ACTIONS(55),
-    [anon_sym_LT] = ACTIONS(57),
-    [anon_sym_GT] = ACTIONS(57),
-    [anon_sym_LT_EQ] = ACTIONS(59),
-    [anon_sym_GT_EQ] = ACTIONS(59),
-    [sym_at_variable] = ACTIONS(45),
-    [anon_sym_DQUOTE] = ACTIONS(45),
-    [anon_sym_SQUOTE] = ACTIONS(45),
-    [sym_number] = ACTIONS(45),
+    [ts_builtin_sym_end] = ACTIONS(140),
+    [sym_identifier] = ACTIONS(214),
+    [anon_sym_POUNDinclude] = ACTIONS(140),
+    [anon_sym_POUNDdefine] = ACTIONS(140),
+    [anon_sym_POUNDimport] = ACTIONS(140),
+    [anon_sym_POUNDquestion] = ACTIONS(140),
+    [anon_sym_const] = ACTIONS(142),
+    [anon_sym_if] = ACTIONS(142),
+    [anon_sym_else] = ACTIONS(142),
+    [anon_sym_for] = ACTIONS(142),
+    [anon_sym_repeat] = ACTIONS(142),
+    [anon_sym_menu] = ACTIONS(142),
+    [anon_sym_item] = ACTIONS(142),
+    [anon_sym_action] = ACTIONS(142),
+    [anon_sym_LPAREN] = ACTIONS(140),
+    [anon_sym_LBRACE] = ACTIONS(140),
+    [anon_sym_RBRACE] = ACTIONS(140),
+    [anon_sym_true] = ACTIONS(142),
+    [anon_sym_false] = ACTIONS(142),
+    [anon_sym_name] = ACTIONS(142),
+    [anon_sym_glyph] = ACTIONS(142),
+    [anon_sym_from] = ACTIONS(142),
+    [anon_sym_mac] = ACTIONS(142),
+    [anon_sym_inputs] = ACTIONS(142),
+    [anon_sym_noinput] = ACTIONS(142),
+    [anon_sym_askfor] = ACTIONS(142),
+    [anon_sym_getclipboard] = ACTIONS(142),
+    [anon_sym_list] = ACTIONS(142),
+    [anon_sym_nil] = ACTIONS(142),
+    [anon_sym_stop] = ACTIONS(142),
+    [anon_sym_makeVCard] = ACTIONS(142),
+    [anon_sym_rawAction] = ACTIONS(142),
+    [anon_sym_embedFile] = ACTIONS(142),
+    [anon_sym_nothing] = ACTIONS(142),
+    [anon_sym_CurrentDate] = ACTIONS(142),
+    [anon_sym_Device] = ACTIONS(142),
+    [anon_sym_RepeatIndex] = ACTIONS(142),
+    [anon_sym_RepeatItem] = ACTIONS(142),
+    [anon_sym_ShortcutInput] = ACTIONS(142),
+    [anon_sym_Ask] = ACTIONS(142),
+    [anon_sym_text] = ACTIONS(142),
+    [anon_sym_number] = ACTIONS(142),
+    [anon_sym_bool] = ACTIONS(142),
+    [anon_sym_dictionary] = ACTIONS(142),
+    [anon_sym_array] = ACTIONS(142),
+    [anon_sym_variable] = ACTIONS(142),
+    [anon_sym_color] = ACTIONS(142),
+    [anon_sym_float] = ACTIONS(142),
+    [anon_sym_STAR] = ACTIONS(140),
+    [anon_sym_SLASH] = ACTIONS(142),
+    [anon_sym_PLUS] = ACTIONS(140),
+    [anon_sym_DASH] = ACTIONS(140),
+    [anon_sym_EQ_EQ] = ACTIONS(140),
+    [anon_sym_BANG_EQ] = ACTIONS(140),
+    [anon_sym_LT] = ACTIONS(142),
+    [anon_sym_GT] = ACTIONS(142),
+    [anon_sym_LT_EQ] = ACTIONS(140),
+    [anon_sym_GT_EQ] = ACTIONS(140),
+    [sym_at_variable] = ACTIONS(140),
+    [anon_sym_DQUOTE] = ACTIONS(140),
+    [anon_sym_SQUOTE] = ACTIONS(140),
+    [sym_number] = ACTIONS(140),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(40)] = {
     [sym_comment] = STATE(40),
-    [ts_builtin_sym_end] = ACTIONS(159),
-    [sym_identifier] = ACTIONS(161),
-    [anon_sym_POUNDinclude] = ACTIONS(159),
-    [anon_sym_POUNDdefine] = ACTIONS(159),
-    [anon_sym_POUNDimport] = ACTIONS(159),
-    [anon_sym_POUNDquestion] = ACTIONS(159),
-    [anon_sym_const] = ACTIONS(161),
-    [anon_sym_if] = ACTIONS(161),
-    [anon_sym_else] = ACTIONS(161),
-    [anon_sym_for] = ACTIONS(161),
-    [anon_sym_repeat] = ACTIONS(161),
-    [anon_sym_menu] = ACTIONS(161),
-    [anon_sym_item] = ACTIONS(161),
-    [anon_sym_LBRACE] = ACTIONS(159),
-    [anon_sym_RBRACE] = ACTIONS(159),
-    [anon_sym_true] = ACTIONS(161),
-    [anon_sym_false] = ACTIONS(161),
-    [anon_sym_name] = ACTIONS(161),
-    [anon_sym_glyph] = ACTIONS(161),
-    [anon_sym_from] = ACTIONS(161),
-    [anon_sym_mac] = ACTIONS(161),
-    [anon_sym_inputs] = ACTIONS(161),
-    [anon_sym_noinput] = ACTIONS(161),
-    [anon_sym_askfor] = ACTIONS(161),
-    [anon_sym_getclipboard] = ACTIONS(161),
-    [anon_sym_list] = ACTIONS(161),
-    [anon_sym_nil] = ACTIONS(161),
-    [anon_sym_action] = ACTIONS(161),
-    [anon_sym_stop] = ACTIONS(161),
-    [anon_sym_makeVCard] = ACTIONS(161),
-    [anon_sym_rawAction] = ACTIONS(161),
-    [anon_sym_embedFile] = ACTIONS(161),
-    [anon_sym_nothing] = ACTIONS(161),
-    [anon_sym_CurrentDate] = ACTIONS(161),
-    [anon_sym_Device] = ACTIONS(161),
-    [anon_sym_RepeatIndex] = ACTIONS(161),
-    [anon_sym_RepeatItem] = ACTIONS(161),
-    [anon_sym_ShortcutInput] = ACTIONS(161),
-    [anon_sym_Ask] = ACTIONS(161),
-    [anon_sym_text] = ACTIONS(161),
-    [anon_sym_number] = ACTIONS(161),
-    [anon_sym_bool] = ACTIONS(161),
-    [anon_sym_dictionary] = ACTIONS(161),
-    [anon_sym_array] = ACTIONS(161),
-    [anon_sym_variable] = ACTIONS(161),
-    [anon_sym_color] = ACTIONS(161),
-    [anon_sym_float] = ACTIONS(161),
-    [anon_sym_LPAREN] = ACTIONS(159),
-    [anon_sym_STAR] = ACTIONS(159),
-    [anon_sym_SLASH] = ACTIONS(161),
-    [anon_sym_PLUS] = ACTIONS(159),
-    [anon_sym_DASH] = ACTIONS(159),
-    [anon_sym_EQ_EQ] = ACTIONS(159),
-    [anon_sym_BANG_EQ] = ACTIONS(159),
-    [anon_sym_LT] = ACTIONS(161),
-    [anon_sym_GT] = ACTIONS(161),
-    [anon_sym_LT_EQ] = ACTIONS(159),
-    [anon_sym_GT_EQ] = ACTIONS(159),
-    [sym_at_variable] = ACTIONS(159),
-    [anon_sym_DQUOTE] = ACTIONS(159),
-    [anon_sym_SQUOTE] = ACTIONS(159),
-    [sym_number] = ACTIONS(159),
+    [ts_builtin_sym_end] = ACTIONS(47),
+    [sym_identifier] = ACTIONS(49),
+    [anon_sym_POUNDinclude] = ACTIONS(47),
+    [anon_sym_POUNDdefine] = ACTIONS(47),
+    [anon_sym_POUNDimport] = ACTIONS(47),
+    [anon_sym_POUNDquestion] = ACTIONS(47),
+    [anon_sym_const] = ACTIONS(49),
+    [anon_sym_if] = ACTIONS(49),
+    [anon_sym_else] = ACTIONS(49),
+    [anon_sym_for] = ACTIONS(49),
+    [anon_sym_repeat] = ACTIONS(49),
+    [anon_sym_menu] = ACTIONS(49),
+    [anon_sym_item] = ACTIONS(49),
+    [anon_sym_action] = ACTIONS(49),
+    [anon_sym_LPAREN] = ACTIONS(47),
+    [anon_sym_LBRACE] = ACTIONS(47),
+    [anon_sym_RBRACE] = ACTIONS(47),
+    [anon_sym_true] = ACTIONS(49),
+    [anon_sym_false] = ACTIONS(49),
+    [anon_sym_name] = ACTIONS(49),
+    [anon_sym_glyph] = ACTIONS(49),
+    [anon_sym_from] = ACTIONS(49),
+    [anon_sym_mac] = ACTIONS(49),
+    [anon_sym_inputs] = ACTIONS(49),
+    [anon_sym_noinput] = ACTIONS(49),
+    [anon_sym_askfor] = ACTIONS(49),
+    [anon_sym_getclipboard] = ACTIONS(49),
+    [anon_sym_list] = ACTIONS(49),
+    [anon_sym_nil] = ACTIONS(49),
+    [anon_sym_stop] = ACTIONS(49),
+    [anon_sym_makeVCard] = ACTIONS(49),
+    [anon_sym_rawAction] = ACTIONS(49),
+    [anon_sym_embedFile] = ACTIONS(49),
+    [anon_sym_nothing] = ACTIONS(49),
+    [anon_sym_CurrentDate] = ACTIONS(49),
+    [anon_sym_Device] = ACTIONS(49),
+    [anon_sym_RepeatIndex] = ACTIONS(49),
+    [anon_sym_RepeatItem] = ACTIONS(49),
+    [anon_sym_ShortcutInput] = ACTIONS(49),
+    [anon_sym_Ask] = ACTIONS(49),
+    [anon_sym_text] = ACTIONS(49),
+    [anon_sym_number] = ACTIONS(49),
+    [anon_sym_bool] = ACTIONS(49),
+    [anon_sym_dictionary] = ACTIONS(49),
+    [anon_sym_array] = ACTIONS(49),
+    [anon_sym_variable] = ACTIONS(49),
+    [anon_sym_color] = ACTIONS(49),
+    [anon_sym_float] = ACTIONS(49),
+    [anon_sym_STAR] = ACTIONS(51),
+    [anon_sym_SLASH] = ACTIONS(53),
+    [anon_sym_PLUS] = ACTIONS(55),
+    [anon_sym_DASH] = ACTIONS(55),
+    [anon_sym_EQ_EQ] = ACTIONS(57),
+    [anon_sym_BANG_EQ] = ACTIONS(57),
+    [anon_sym_LT] = ACTIONS(59),
+    [anon_sym_GT] = ACTIONS(59),
+    [anon_sym_LT_EQ] = ACTIONS(61),
+    [anon_sym_GT_EQ] = ACTIONS(61),
+    [sym_at_variable] = ACTIONS(47),
+    [anon_sym_DQUOTE] = ACTIONS(47),
+    [anon_sym_SQUOTE] = ACTIONS(47),
+    [sym_number] = ACTIONS(47),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(41)] = {
     [sym_comment] = STATE(41),
-    [sym_identifier] = ACTIONS(141),
-    [anon_sym_POUNDinclude] = ACTIONS(139),
-    [anon_sym_POUNDdefine] = ACTIONS(139),
-    [anon_sym_POUNDimport] = ACTIONS(139),
-    [anon_sym_POUNDquestion] = ACTIONS(139),
-    [anon_sym_COLON] = ACTIONS(209),
-    [anon_sym_EQ] = ACTIONS(205),
-    [anon_sym_const] = ACTIONS(141),
-    [anon_sym_if] = ACTIONS(141),
-    [anon_sym_for] = ACTIONS(141),
-    [anon_sym_repeat] = ACTIONS(141),
-    [anon_sym_menu] = ACTIONS(141),
-    [anon_sym_item] = ACTIONS(141),
-    [anon_sym_LBRACE] = ACTIONS(139),
-    [anon_sym_RBRACE] = ACTIONS(139),
-    [anon_sym_true] = ACTIONS(141),
-    [anon_sym_false] = ACTIONS(141),
-    [anon_sym_name] = ACTIONS(141),
-    [anon_sym_glyph] = ACTIONS(141),
-    [anon_sym_from] = ACTIONS(141),
-    [anon_sym_mac] = ACTIONS(141),
-    [anon_sym_inputs] = ACTIONS(141),
-    [anon_sym_noinput] = ACTIONS(141),
-    [anon_sym_askfor] = ACTIONS(141),
-    [anon_sym_getclipboard] = ACTIONS(141),
-    [anon_sym_list] = ACTIONS(141),
-    [anon_sym_nil] = ACTIONS(141),
-    [anon_sym_action] = ACTIONS(141),
-    [anon_sym_stop] = ACTIONS(141),
-    [anon_sym_makeVCard] = ACTIONS(141),
-    [anon_sym_rawAction] = ACTIONS(141),
-    [anon_sym_embedFile] = ACTIONS(141),
-    [anon_sym_nothing] = ACTIONS(141),
-    [anon_sym_CurrentDate] = ACTIONS(141),
-    [anon_sym_Device] = ACTIONS(141),
-    [anon_sym_RepeatIndex] = ACTIONS(141),
-    [anon_sym_RepeatItem] = ACTIONS(141),
-    [anon_sym_ShortcutInput] = ACTIONS(141),
-    [anon_sym_Ask] = ACTIONS(141),
-    [anon_sym_text] = ACTIONS(141),
-    [anon_sym_number] = ACTIONS(141),
-    [anon_sym_bool] = ACTIONS(141),
-    [anon_sym_dictionary] = ACTIONS(141),
-    [anon_sym_array] = ACTIONS(141),
-    [anon_sym_variable] = ACTIONS(141),
-    [anon_sym_color] = ACTIONS(141),
-    [anon_sym_float] = ACTIONS(141),
-    [anon_sym_LPAREN] = ACTIONS(175),
-    [anon_sym_STAR] = ACTIONS(139),
-    [anon_sym_SLASH] = ACTIONS(141),
-    [anon_sym_PLUS] = ACTIONS(139),
-    [anon_sym_DASH] = ACTIONS(139),
-    [anon_sym_EQ_EQ] = ACTIONS(139),
-    [anon_sym_BANG_EQ] = ACTIONS(139),
-    [anon_sym_LT] = ACTIONS(141),
-    [anon_sym_GT] = ACTIONS(141),
-    [anon_sym_LT_EQ] = ACTIONS(139),
-    [anon_sym_GT_EQ] = ACTIONS(139),
-    [sym_at_variable] = ACTIONS(139),
-    [anon_sym_DQUOTE] = ACTIONS(139),
-    [anon_sym_SQUOTE] = ACTIONS(139),
-    [sym_number] = ACTIONS(139),
+    [ts_builtin_sym_end] = ACTIONS(166),
+    [sym_identifier] = ACTIONS(168),
+    [anon_sym_POUNDinclude] = ACTIONS(166),
+    [anon_sym_POUNDdefine] = ACTIONS(166),
+    [anon_sym_POUNDimport] = ACTIONS(166),
+    [anon_sym_POUNDquestion] = ACTIONS(166),
+    [anon_sym_const] = ACTIONS(168),
+    [anon_sym_if] = ACTIONS(168),
+    [anon_sym_else] = ACTIONS(168),
+    [anon_sym_for] = ACTIONS(168),
+    [anon_sym_repeat] = ACTIONS(168),
+    [anon_sym_menu] = ACTIONS(168),
+    [anon_sym_item] = ACTIONS(168),
+    [anon_sym_action] = ACTIONS(168),
+    [anon_sym_LPAREN] = ACTIONS(166),
+    [anon_sym_LBRACE] = ACTIONS(166),
+    [anon_sym_RBRACE] = ACTIONS(166),
+    [anon_sym_true] = ACTIONS(168),
+    [anon_sym_false] = ACTIONS(168),
+    [anon_sym_name] = ACTIONS(168),
+    [anon_sym_glyph] = ACTIONS(168),
+    [anon_sym_from] = ACTIONS(168),
+    [anon_sym_mac] = ACTIONS(168),
+    [anon_sym_inputs] = ACTIONS(168),
+    [anon_sym_noinput] = ACTIONS(168),
+    [anon_sym_askfor] = ACTIONS(168),
+    [anon_sym_getclipboard] = ACTIONS(168),
+    [anon_sym_list] = ACTIONS(168),
+    [anon_sym_nil] = ACTIONS(168),
+    [anon_sym_stop] = ACTIONS(168),
+    [anon_sym_makeVCard] = ACTIONS(168),
+    [anon_sym_rawAction] = ACTIONS(168),
+    [anon_sym_embedFile] = ACTIONS(168),
+    [anon_sym_nothing] = ACTIONS(168),
+    [anon_sym_CurrentDate] = ACTIONS(168),
+    [anon_sym_Device] = ACTIONS(168),
+    [anon_sym_RepeatIndex] = ACTIONS(168),
+    [anon_sym_RepeatItem] = ACTIONS(168),
+    [anon_sym_ShortcutInput] = ACTIONS(168),
+    [anon_sym_Ask] = ACTIONS(168),
+    [anon_sym_text] = ACTIONS(168),
+    [anon_sym_number] = ACTIONS(168),
+    [anon_sym_bool] = ACTIONS(168),
+    [anon_sym_dictionary] = ACTIONS(168),
+    [anon_sym_array] = ACTIONS(168),
+    [anon_sym_variable] = ACTIONS(168),
+    [anon_sym_color] = ACTIONS(168),
+    [anon_sym_float] = ACTIONS(168),
+    [anon_sym_STAR] = ACTIONS(166),
+    [anon_sym_SLASH] = ACTIONS(168),
+    [anon_sym_PLUS] = ACTIONS(166),
+    [anon_sym_DASH] = ACTIONS(166),
+    [anon_sym_EQ_EQ] = ACTIONS(166),
+    [anon_sym_BANG_EQ] = ACTIONS(166),
+    [anon_sym_LT] = ACTIONS(168),
+    [anon_sym_GT] = ACTIONS(168),
+    [anon_sym_LT_EQ] = ACTIONS(166),
+    [anon_sym_GT_EQ] = ACTIONS(166),
+    [sym_at_variable] = ACTIONS(166),
+    [anon_sym_DQUOTE] = ACTIONS(166),
+    [anon_sym_SQUOTE] = ACTIONS(166),
+    [sym_number] = ACTIONS(166),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(42)] = {
     [sym_comment] = STATE(42),
-    [ts_builtin_sym_end] = ACTIONS(211),
-    [sym_identifier] = ACTIONS(213),
-    [anon_sym_POUNDinclude] = ACTIONS(211),
-    [anon_sym_POUNDdefine] = ACTIONS(211),
-    [anon_sym_POUNDimport] = ACTIONS(211),
-    [anon_sym_POUNDquestion] = ACTIONS(211),
-    [anon_sym_const] = ACTIONS(213),
-    [anon_sym_if] = ACTIONS(213),
-    [anon_sym_else] = ACTIONS(213),
-    [anon_sym_for] = ACTIONS(213),
-    [anon_sym_repeat] = ACTIONS(213),
-    [anon_sym_menu] = ACTIONS(213),
-    [anon_sym_item] = ACTIONS(213),
-    [anon_sym_LBRACE] = ACTIONS(211),
-    [anon_sym_RBRACE] = ACTIONS(211),
-    [anon_sym_true] = ACTIONS(213),
-    [anon_sym_false] = ACTIONS(213),
-    [anon_sym_name] = ACTIONS(213),
-    [anon_sym_glyph] = ACTIONS(213),
-    [anon_sym_from] = ACTIONS(213),
-    [anon_sym_mac] = ACTIONS(213),
-    [anon_sym_inputs] = ACTIONS(213),
-    [anon_sym_noinput] = ACTIONS(213),
-    [anon_sym_askfor] = ACTIONS(213),
-    [anon_sym_getclipboard] = ACTIONS(213),
-    [anon_sym_list] = ACTIONS(213),
-    [anon_sym_nil] = ACTIONS(213),
-    [anon_sym_action] = ACTIONS(213),
-    [anon_sym_stop] = ACTIONS(213),
-    [anon_sym_makeVCard] = ACTIONS(213),
-    [anon_sym_rawAction] = ACTIONS(213),
-    [anon_sym_embedFile] = ACTIONS(213),
-    [anon_sym_nothing] = ACTIONS(213),
-    [anon_sym_CurrentDate] = ACTIONS(213),
-    [anon_sym_Device] = ACTIONS(213),
-    [anon_sym_RepeatIndex] = ACTIONS(213),
-    [anon_sym_RepeatItem] = ACTIONS(213),
-    [anon_sym_ShortcutInput] = ACTIONS(213),
-    [anon_sym_Ask] = ACTIONS(213),
-    [anon_sym_text] = ACTIONS(213),
-    [anon_sym_number] = ACTIONS(213),
-    [anon_sym_bool] = ACTIONS(213),
-    [anon_sym_dictionary] = ACTIONS(213),
-    [anon_sym_array] = ACTIONS(213),
-    [anon_sym_variable] = ACTIONS(213),
-    [anon_sym_color] = ACTIONS(213),
-    [anon_sym_float] = ACTIONS(213),
-    [anon_sym_LPAREN] = ACTIONS(211),
-    [anon_sym_STAR] = ACTIONS(49),
-    [anon_sym_SLASH] = ACTIONS(51),
-    [anon_sym_PLUS] = ACTIONS(53),
-    [anon_sym_DASH] = ACTIONS(53),
-    [anon_sym_EQ_EQ] = ACTIONS(55),
-    [anon_sym_BANG_EQ] = ACTIONS(55),
-    [anon_sym_LT] = ACTIONS(57),
-    [anon_sym_GT] = ACTIONS(57),
-    [anon_sym_LT_EQ] = ACTIONS(59),
-    [anon_sym_GT_EQ] = ACTIONS(59),
-    [sym_at_variable] = ACTIONS(211),
-    [anon_sym_DQUOTE] = ACTIONS(211),
-    [anon_sym_SQUOTE] = ACTIONS(211),
-    [sym_number] = ACTIONS(211),
+    [sym_identifier] = ACTIONS(154),
+    [anon_sym_POUNDinclude] = ACTIONS(152),
+    [anon_sym_POUNDdefine] = ACTIONS(152),
+    [anon_sym_POUNDimport] = ACTIONS(152),
+    [anon_sym_POUNDquestion] = ACTIONS(152),
+    [anon_sym_COLON] = ACTIONS(216),
+    [anon_sym_EQ] = ACTIONS(210),
+    [anon_sym_const] = ACTIONS(154),
+    [anon_sym_if] = ACTIONS(154),
+    [anon_sym_for] = ACTIONS(154),
+    [anon_sym_repeat] = ACTIONS(154),
+    [anon_sym_menu] = ACTIONS(154),
+    [anon_sym_item] = ACTIONS(154),
+    [anon_sym_action] = ACTIONS(154),
+    [anon_sym_LPAREN] = ACTIONS(156),
+    [anon_sym_LBRACE] = ACTIONS(152),
+    [anon_sym_RBRACE] = ACTIONS(152),
+    [anon_sym_true] = ACTIONS(154),
+    [anon_sym_false] = ACTIONS(154),
+    [anon_sym_name] = ACTIONS(154),
+    [anon_sym_glyph] = ACTIONS(154),
+    [anon_sym_from] = ACTIONS(154),
+    [anon_sym_mac] = ACTIONS(154),
+    [anon_sym_inputs] = ACTIONS(154),
+    [anon_sym_noinput] = ACTIONS(154),
+    [anon_sym_askfor] = ACTIONS(154),
+    [anon_sym_getclipboard] = ACTIONS(154),
+    [anon_sym_list] = ACTIONS(154),
+    [anon_sym_nil] = ACTIONS(154),
+    [anon_sym_stop] = ACTIONS(154),
+    [anon_sym_makeVCard] = ACTIONS(154),
+    [anon_sym_rawAction] = ACTIONS(154),
+    [anon_sym_embedFile] = ACTIONS(154),
+    [anon_sym_nothing] = ACTIONS(154),
+    [anon_sym_CurrentDate] = ACTIONS(154),
+    [anon_sym_Device] = ACTIONS(154),
+    [anon_sym_RepeatIndex] = ACTIONS(154),
+    [anon_sym_RepeatItem] = ACTIONS(154),
+    [anon_sym_ShortcutInput] = ACTIONS(154),
+    [anon_sym_Ask] = ACTIONS(154),
+    [anon_sym_text] = ACTIONS(154),
+    [anon_sym_number] = ACTIONS(154),
+    [anon_sym_bool] = ACTIONS(154),
+    [anon_sym_dictionary] = ACTIONS(154),
+    [anon_sym_array] = ACTIONS(154),
+    [anon_sym_variable] = ACTIONS(154),
+    [anon_sym_color] = ACTIONS(154),
+    [anon_sym_float] = ACTIONS(154),
+    [anon_sym_STAR] = ACTIONS(152),
+    [anon_sym_SLASH] = ACTIONS(154),
+    [anon_sym_PLUS] = ACTIONS(152),
+    [anon_sym_DASH] = ACTIONS(152),
+    [anon_sym_EQ_EQ] = ACTIONS(152),
+    [anon_sym_BANG_EQ] = ACTIONS(152),
+    [anon_sym_LT] = ACTIONS(154),
+    [anon_sym_GT] = ACTIONS(154),
+    [anon_sym_LT_EQ] = ACTIONS(152),
+    [anon_sym_GT_EQ] = ACTIONS(152),
+    [sym_at_variable] = ACTIONS(152),
+    [anon_sym_DQUOTE] = ACTIONS(152),
+    [anon_sym_SQUOTE] = ACTIONS(152),
+    [sym_number] = ACTIONS(152),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(43)] = {
     [sym_comment] = STATE(43),
-    [ts_builtin_sym_end] = ACTIONS(215),
-    [sym_identifier] = ACTIONS(217),
-    [anon_sym_POUNDinclude] = ACTIONS(215),
-    [anon_sym_POUNDdefine] = ACTIONS(215),
-    [anon_sym_POUNDimport] = ACTIONS(215),
-    [anon_sym_POUNDquestion] = ACTIONS(215),
-    [anon_sym_const] = ACTIONS(217),
-    [anon_sym_if] = ACTIONS(217),
-    [anon_sym_else] = ACTIONS(217),
-    [anon_sym_for] = ACTIONS(217),
-    [anon_sym_repeat] = ACTIONS(217),
-    [anon_sym_menu] = ACTIONS(217),
-    [anon_sym_item] = ACTIONS(217),
-    [anon_sym_LBRACE] = ACTIONS(215),
-    [anon_sym_RBRACE] = ACTIONS(215),
-    [anon_sym_true] = ACTIONS(217),
-    [anon_sym_false] = ACTIONS(217),
-    [anon_sym_name] = ACTIONS(217),
-    [anon_sym_glyph] = ACTIONS(217),
-    [anon_sym_from] = ACTIONS(217),
-    [anon_sym_mac] = ACTIONS(217),
-    [anon_sym_inputs] = ACTIONS(217),
-    [anon_sym_noinput] = ACTIONS(217),
-    [anon_sym_askfor] = ACTIONS(217),
-    [anon_sym_getclipboard] = ACTIONS(217),
-    [anon_sym_list] = ACTIONS(217),
-    [anon_sym_nil] = ACTIONS(217),
-    [anon_sym_action] = ACTIONS(217),
-    [anon_sym_stop] = ACTIONS(217),
-    [anon_sym_makeVCard] = ACTIONS(217),
-    [anon_sym_rawAction] = ACTIONS(217),
-    [anon_sym_embedFile] = ACTIONS(217),
-    [anon_sym_nothing] = ACTIONS(217),
-    [anon_sym_CurrentDate] = ACTIONS(217),
-    [anon_sym_Device] = ACTIONS(217),
-    [anon_sym_RepeatIndex] = ACTIONS(217),
-    [anon_sym_RepeatItem] = ACTIONS(217),
-    [anon_sym_ShortcutInput] = ACTIONS(217),
-    [anon_sym_Ask] = ACTIONS(217),
-    [anon_sym_text] = ACTIONS(217),
-    [anon_sym_number] = ACTIONS(217),
-    [anon_sym_bool] = ACTIONS(217),
-    [anon_sym_dictionary] = ACTIONS(217),
-    [anon_sym_array] = ACTIONS(217),
-    [anon_sym_variable] = ACTIONS(217),
-    [anon_sym_color] = ACTIONS(217),
-    [anon_sym_float] = ACTIONS(217),
-    [anon_sym_LPAREN] = ACTIONS(215),
-    [anon_sym_STAR] = ACTIONS(49),
-    [anon_sym_SLASH] = ACTIONS(51),
-    [anon_sym_PLUS] = ACTIONS(53),
-    [anon_sym_DASH] = ACTIONS(53),
-    [anon_sym_EQ_EQ] = ACTIONS(55),
-    [anon_sym_BANG_EQ] = ACTIONS(55),
-    [anon_sym_LT] = ACTIONS(57),
-    [anon_sym_GT] = ACTIONS(57),
-    [anon_sym_LT_EQ] = ACTIONS(59),
-    [anon_sym_GT_EQ] = ACTIONS(59),
-    [sym_at_variable] = ACTIONS(215),
-    [anon_sym_DQUOTE] = ACTIONS(215),
-    [anon_sym_SQUOTE] = ACTIONS(215),
-    [sym_number] = ACTIONS(215),
+    [ts_builtin_sym_end] = ACTIONS(218),
+    [sym_identifier] = ACTIONS(220),
+    [anon_sym_POUNDinclude] = ACTIONS(218),
+    [anon_sym_POUNDdefine] = ACTIONS(218),
+    [anon_sym_POUNDimport] = ACTIONS(218),
+    [anon_sym_POUNDquestion] = ACTIONS(218),
+    [anon_sym_const] = ACTIONS(220),
+    [anon_sym_if] = ACTIONS(220),
+    [anon_sym_else] = ACTIONS(220),
+    [anon_sym_for] = ACTIONS(220),
+    [anon_sym_repeat] = ACTIONS(220),
+    [anon_sym_menu] = ACTIONS(220),
+    [anon_sym_item] = ACTIONS(220),
+    [anon_sym_action] = ACTIONS(220),
+    [anon_sym_LPAREN] = ACTIONS(218),
+    [anon_sym_LBRACE] = ACTIONS(218),
+    [anon_sym_RBRACE] = ACTIONS(218),
+    [anon_sym_true] = ACTIONS(220),
+    [anon_sym_false] = ACTIONS(220),
+    [anon_sym_name] = ACTIONS(220),
+    [anon_sym_glyph] = ACTIONS(220),
+    [anon_sym_from] = ACTIONS(220),
+    [anon_sym_mac] = ACTIONS(220),
+    [anon_sym_inputs] = ACTIONS(220),
+    [anon_sym_noinput] = ACTIONS(220),
+    [anon_sym_askfor] = ACTIONS(220),
+    [anon_sym_getclipboard] = ACTIONS(220),
+    [anon_sym_list] = ACTIONS(220),
+    [anon_sym_nil] = ACTIONS(220),
+    [anon_sym_stop] = ACTIONS(220),
+    [anon_sym_makeVCard] = ACTIONS(220),
+    [anon_sym_rawAction] = ACTIONS(220),
+    [anon_sym_embedFile] = ACTIONS(220),
+    [anon_sym_nothing] = ACTIONS(220),
+    [anon_sym_CurrentDate] = ACTIONS(220),
+    [anon_sym_Device] = ACTIONS(220),
+    [anon_sym_RepeatIndex] = ACTIONS(220),
+    [anon_sym_RepeatItem] = ACTIONS(220),
+    [anon_sym_ShortcutInput] = ACTIONS(220),
+    [anon_sym_Ask] = ACTIONS(220),
+    [anon_sym_text] = ACTIONS(220),
+    [anon_sym_number] = ACTIONS(220),
+    [anon_sym_bool] = ACTIONS(220),
+    [anon_sym_dictionary] = ACTIONS(220),
+    [anon_sym_array] = ACTIONS(220),
+    [anon_sym_variable] = ACTIONS(220),
+    [anon_sym_color] = ACTIONS(220),
+    [anon_sym_float] = ACTIONS(220),
+    [anon_sym_STAR] = ACTIONS(51),
+    [anon_sym_SLASH] = ACTIONS(53),
+    [anon_sym_PLUS] = ACTIONS(55),
+    [anon_sym_DASH] = ACTIONS(55),
+    [anon_sym_EQ_EQ] = ACTIONS(57),
+    [anon_sym_BANG_EQ] = ACTIONS(57),
+    [anon_sym_LT] = ACTIONS(59),
+    [anon_sym_GT] = ACTIONS(59),
+    [anon_sym_LT_EQ] = ACTIONS(61),
+    [anon_sym_GT_EQ] = ACTIONS(61),
+    [sym_at_variable] = ACTIONS(218),
+    [anon_sym_DQUOTE] = ACTIONS(218),
+    [anon_sym_SQUOTE] = ACTIONS(218),
+    [sym_number] = ACTIONS(218),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(44)] = {
     [sym_comment] = STATE(44),
-    [ts_builtin_sym_end] = ACTIONS(219),
-    [sym_identifier] = ACTIONS(221),
-    [anon_sym_POUNDinclude] = ACTIONS(219),
-    [anon_sym_POUNDdefine] = ACTIONS(219),
-    [anon_sym_POUNDimport] = ACTIONS(219),
-    [anon_sym_POUNDquestion] = ACTIONS(219),
-    [anon_sym_const] = ACTIONS(221),
-    [anon_sym_if] = ACTIONS(221),
-    [anon_sym_else] = ACTIONS(221),
-    [anon_sym_for] = ACTIONS(221),
-    [anon_sym_repeat] = ACTIONS(221),
-    [anon_sym_menu] = ACTIONS(221),
-    [anon_sym_item] = ACTIONS(221),
-    [anon_sym_LBRACE] = ACTIONS(219),
-    [anon_sym_RBRACE] = ACTIONS(219),
-    [anon_sym_true] = ACTIONS(221),
-    [anon_sym_false] = ACTIONS(221),
-    [anon_sym_name] = ACTIONS(221),
-    [anon_sym_glyph] = ACTIONS(221),
-    [anon_sym_from] = ACTIONS(221),
-    [anon_sym_mac] = ACTIONS(221),
-    [anon_sym_inputs] = ACTIONS(221),
-    [anon_sym_noinput] = ACTIONS(221),
-    [anon_sym_askfor] = ACTIONS(221),
-    [anon_sym_getclipboard] = ACTIONS(221),
-    [anon_sym_list] = ACTIONS(221),
-    [anon_sym_nil] = ACTIONS(221),
-    [anon_sym_action] = ACTIONS(221),
-    [anon_sym_stop] = ACTIONS(221),
-    [anon_sym_makeVCard] = ACTIONS(221),
-    [anon_sym_rawAction] = ACTIONS(221),
-    [anon_sym_embedFile] = ACTIONS(221),
-    [anon_sym_nothing] = ACTIONS(221),
-    [anon_sym_CurrentDate] = ACTIONS(221),
-    [anon_sym_Device] = ACTIONS(221),
-    [anon_sym_RepeatIndex] = ACTIONS(221),
-    [anon_sym_RepeatItem] = ACTIONS(221),
-    [anon_sym_ShortcutInput] = ACTIONS(221),
-    [anon_sym_Ask] = ACTIONS(221),
-    [anon_sym_text] = ACTIONS(221),
-    [anon_sym_number] = ACTIONS(221),
-    [anon_sym_bool] = ACTIONS(221),
-    [anon_sym_dictionary] = ACTIONS(221),
-    [anon_sym_array] = ACTIONS(221),
-    [anon_sym_variable] = ACTIONS(221),
-    [anon_sym_color] = ACTIONS(221),
-    [anon_sym_float] = ACTIONS(221),
-    [anon_sym_LPAREN] = ACTIONS(219),
-    [anon_sym_STAR] = ACTIONS(49),
-    [anon_sym_SLASH] = ACTIONS(51),
-    [anon_sym_PLUS] = ACTIONS(53),
-    [anon_sym_DASH] = ACTIONS(53),
-    [anon_sym_EQ_EQ] = ACTIONS(55),
-    [anon_sym_BANG_EQ] = ACTIONS(55),
-    [anon_sym_LT] = ACTIONS(57),
-    [anon_sym_GT] = ACTIONS(57),
-    [anon_sym_LT_EQ] = ACTIONS(59),
-    [anon_sym_GT_EQ] = ACTIONS(59),
-    [sym_at_variable] = ACTIONS(219),
-    [anon_sym_DQUOTE] = ACTIONS(219),
-    [anon_sym_SQUOTE] = ACTIONS(219),
-    [sym_number] = ACTIONS(219),
+    [ts_builtin_sym_end] = ACTIONS(222),
+    [sym_identifier] = ACTIONS(224),
+    [anon_sym_POUNDinclude] = ACTIONS(222),
+    [anon_sym_POUNDdefine] = ACTIONS(222),
+    [anon_sym_POUNDimport] = ACTIONS(222),
+    [anon_sym_POUNDquestion] = ACTIONS(222),
+    [anon_sym_const] = ACTIONS(224),
+    [anon_sym_if] = ACTIONS(224),
+    [anon_sym_else] = ACTIONS(224),
+    [anon_sym_for] = ACTIONS(224),
+    [anon_sym_repeat] = ACTIONS(224),
+    [anon_sym_menu] = ACTIONS(224),
+    [anon_sym_item] = ACTIONS(224),
+    [anon_sym_action] = ACTIONS(224),
+    [anon_sym_LPAREN] = ACTIONS(222),
+    [anon_sym_LBRACE] = ACTIONS(222),
+    [anon_sym_RBRACE] = ACTIONS(222),
+    [anon_sym_true] = ACTIONS(224),
+    [anon_sym_false] = ACTIONS(224),
+    [anon_sym_name] = ACTIONS(224),
+    [anon_sym_glyph] = ACTIONS(224),
+    [anon_sym_from] = ACTIONS(224),
+    [anon_sym_mac] = ACTIONS(224),
+    [anon_sym_inputs] = ACTIONS(224),
+    [anon_sym_noinput] = ACTIONS(224),
+    [anon_sym_askfor] = ACTIONS(224),
+    [anon_sym_getclipboard] = ACTIONS(224),
+    [anon_sym_list] = ACTIONS(224),
+    [anon_sym_nil] = ACTIONS(224),
+    [anon_sym_stop] = ACTIONS(224),
+    [anon_sym_makeVCard] = ACTIONS(224),
+    [anon_sym_rawAction] = ACTIONS(224),
+    [anon_sym_embedFile] = ACTIONS(224),
+    [anon_sym_nothing] = ACTIONS(224),
+    [anon_sym_CurrentDate] = ACTIONS(224),
+    [anon_sym_Device] = ACTIONS(224),
+    [anon_sym_RepeatIndex] = ACTIONS(224),
+    [anon_sym_RepeatItem] = ACTIONS(224),
+    [anon_sym_ShortcutInput] = ACTIONS(224),
+    [anon_sym_Ask] = ACTIONS(224),
+    [anon_sym_text] = ACTIONS(224),
+    [anon_sym_number] = ACTIONS(224),
+    [anon_sym_bool] = ACTIONS(224),
+    [anon_sym_dictionary] = ACTIONS(224),
+    [anon_sym_array] = ACTIONS(224),
+    [anon_sym_variable] = ACTIONS(224),
+    [anon_sym_color] = ACTIONS(224),
+    [anon_sym_float] = ACTIONS(224),
+    [anon_sym_STAR] = ACTIONS(51),
+    [anon_sym_SLASH] = ACTIONS(53),
+    [anon_sym_PLUS] = ACTIONS(55),
+    [anon_sym_DASH] = ACTIONS(55),
+    [anon_sym_EQ_EQ] = ACTIONS(57),
+    [anon_sym_BANG_EQ] = ACTIONS(57),
+    [anon_sym_LT] = ACTIONS(59),
+    [anon_sym_GT] = ACTIONS(59),
+    [anon_sym_LT_EQ] = ACTIONS(61),
+    [anon_sym_GT_EQ] = ACTIONS(61),
+    [sym_at_variable] = ACTIONS(222),
+    [anon_sym_DQUOTE] = ACTIONS(222),
+    [anon_sym_SQUOTE] = ACTIONS(222),
+    [sym_number] = ACTIONS(222),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(45)] = {
     [sym_comment] = STATE(45),
-    [ts_builtin_sym_end] = ACTIONS(223),
-    [sym_identifier] = ACTIONS(225),
-    [anon_sym_POUNDinclude] = ACTIONS(223),
-    [anon_sym_POUNDdefine] = ACTIONS(223),
-    [anon_sym_POUNDimport] = ACTIONS(223),
-    [anon_sym_POUNDquestion] = ACTIONS(223),
-    [anon_sym_const] = ACTIONS(225),
-    [anon_sym_if] = ACTIONS(225),
-    [anon_sym_else] = ACTIONS(225),
-    [anon_sym_for] = ACTIONS(225),
-    [anon_sym_repeat] = ACTIONS(225),
-    [anon_sym_menu] = ACTIONS(225),
-    [anon_sym_item] = ACTIONS(225),
-    [anon_sym_LBRACE] = ACTIONS(159),
-    [anon_sym_RBRACE] = ACTIONS(223),
-    [anon_sym_true] = ACTIONS(225),
-    [anon_sym_false] = ACTIONS(225),
-    [anon_sym_name] = ACTIONS(225),
-    [anon_sym_glyph] = ACTIONS(225),
-    [anon_sym_from] = ACTIONS(225),
-    [anon_sym_mac] = ACTIONS(225),
-    [anon_sym_inputs] = ACTIONS(225),
-    [anon_sym_noinput] = ACTIONS(225),
-    [anon_sym_askfor] = ACTIONS(225),
-    [anon_sym_getclipboard] = ACTIONS(225),
-    [anon_sym_list] = ACTIONS(225),
-    [anon_sym_nil] = ACTIONS(225),
-    [anon_sym_action] = ACTIONS(225),
-    [anon_sym_stop] = ACTIONS(225),
-    [anon_sym_makeVCard] = ACTIONS(225),
-    [anon_sym_rawAction] = ACTIONS(225),
-    [anon_sym_embedFile] = ACTIONS(225),
-    [anon_sym_nothing] = ACTIONS(225),
-    [anon_sym_CurrentDate] = ACTIONS(225),
-    [anon_sym_Device] = ACTIONS(225),
-    [anon_sym_RepeatIndex] = ACTIONS(225),
-    [anon_sym_RepeatItem] = ACTIONS(225),
-    [anon_sym_ShortcutInput] = ACTIONS(225),
-    [anon_sym_Ask] = ACTIONS(225),
-    [anon_sym_text] = ACTIONS(225),
-    [anon_sym_number] = ACTIONS(225),
-    [anon_sym_bool] = ACTIONS(225),
-    [anon_sym_dictionary] = ACTIONS(225),
-    [anon_sym_array] = ACTIONS(225),
-    [anon_sym_variable] = ACTIONS(225),
-    [anon_sym_color] = ACTIONS(225),
-    [anon_sym_float] = ACTIONS(225),
-    [anon_sym_LPAREN] = ACTIONS(223),
-    [anon_sym_STAR] = ACTIONS(159),
-    [anon_sym_SLASH] = ACTIONS(161),
-    [anon_sym_PLUS] = ACTIONS(159),
-    [anon_sym_DASH] = ACTIONS(159),
-    [anon_sym_EQ_EQ] = ACTIONS(159),
-    [anon_sym_BANG_EQ] = ACTIONS(159),
-    [anon_sym_LT] = ACTIONS(161),
-    [anon_sym_GT] = ACTIONS(161),
-    [anon_sym_LT_EQ] = ACTIONS(159),
-    [anon_sym_GT_EQ] = ACTIONS(159),
-    [sym_at_variable] = ACTIONS(223),
-    [anon_sym_DQUOTE] = ACTIONS(223),
-    [anon_sym_SQUOTE] = ACTIONS(223),
-    [sym_number] = ACTIONS(223),
+    [ts_builtin_sym_end] = ACTIONS(226),
+    [sym_identifier] = ACTIONS(228),
+    [anon_sym_POUNDinclude] = ACTIONS(226),
+    [anon_sym_POUNDdefine] = ACTIONS(226),
+    [anon_sym_POUNDimport] = ACTIONS(226),
+    [anon_sym_POUNDquestion] = ACTIONS(226),
+    [anon_sym_const] = ACTIONS(228),
+    [anon_sym_if] = ACTIONS(228),
+    [anon_sym_else] = ACTIONS(228),
+    [anon_sym_for] = ACTIONS(228),
+    [anon_sym_repeat] = ACTIONS(228),
+    [anon_sym_menu] = ACTIONS(228),
+    [anon_sym_item] = ACTIONS(228),
+    [anon_sym_action] = ACTIONS(228),
+    [anon_sym_LPAREN] = ACTIONS(226),
+    [anon_sym_LBRACE] = ACTIONS(226),
+    [anon_sym_RBRACE] = ACTIONS(226),
+    [anon_sym_true] = ACTIONS(228),
+    [anon_sym_false] = ACTIONS(228),
+    [anon_sym_name] = ACTIONS(228),
+    [anon_sym_glyph] = ACTIONS(228),
+    [anon_sym_from] = ACTIONS(228),
+    [anon_sym_mac] = ACTIONS(228),
+    [anon_sym_inputs] = ACTIONS(228),
+    [anon_sym_noinput] = ACTIONS(228),
+    [anon_sym_askfor] = ACTIONS(228),
+    [anon_sym_getclipboard] = ACTIONS(228),
+    [anon_sym_list] = ACTIONS(228),
+    [anon_sym_nil] = ACTIONS(228),
+    [anon_sym_stop] = ACTIONS(228),
+    [anon_sym_makeVCard] = ACTIONS(228),
+    [anon_sym_rawAction] = ACTIONS(228),
+    [anon_sym_embedFile] = ACTIONS(228),
+    [anon_sym_nothing] = ACTIONS(228),
+    [anon_sym_CurrentDate] = ACTIONS(228),
+    [anon_sym_Device] = ACTIONS(228),
+    [anon_sym_RepeatIndex] = ACTIONS(228),
+    [anon_sym_RepeatItem] = ACTIONS(228),
+    [anon_sym_ShortcutInput] = ACTIONS(228),
+    [anon_sym_Ask] = ACTIONS(228),
+    [anon_sym_text] = ACTIONS(228),
+    [anon_sym_number] = ACTIONS(228),
+    [anon_sym_bool] = ACTIONS(228),
+    [anon_sym_dictionary] = ACTIONS(228),
+    [anon_sym_array] = ACTIONS(228),
+    [anon_sym_variable] = ACTIONS(228),
+    [anon_sym_color] = ACTIONS(228),
+    [anon_sym_float] = ACTIONS(228),
+    [anon_sym_STAR] = ACTIONS(51),
+    [anon_sym_SLASH] = ACTIONS(53),
+    [anon_sym_PLUS] = ACTIONS(55),
+    [anon_sym_DASH] = ACTIONS(55),
+    [anon_sym_EQ_EQ] = ACTIONS(57),
+    [anon_sym_BANG_EQ] = ACTIONS(57),
+    [anon_sym_LT] = ACTIONS(59),
+    [anon_sym_GT] = ACTIONS(59),
+    [anon_sym_LT_EQ] = ACTIONS(61),
+    [anon_sym_GT_EQ] = ACTIONS(61),
+    [sym_at_variable] = ACTIONS(226),
+    [anon_sym_DQUOTE] = ACTIONS(226),
+    [anon_sym_SQUOTE] = ACTIONS(226),
+    [sym_number] = ACTIONS(226),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
   [STATE(46)] = {
     [sym_comment] = STATE(46),
-    [sym_identifier] = ACTIONS(141),
-    [anon_sym_POUNDinclude] = ACTIONS(139),
-    [anon_sym_POUNDdefine] = ACTIONS(139),
-    [anon_sym_POUNDimport] = ACTIONS(139),
-    [anon_sym_POUNDquestion] = ACTIONS(139),
-    [anon_sym_COLON] = ACTIONS(209),
-    [anon_sym_const] = ACTIONS(141),
-    [anon_sym_if] = ACTIONS(141),
-    [anon_sym_for] = ACTIONS(141),
-    [anon_sym_repeat] = ACTIONS(141),
-    [anon_sym_menu] = ACTIONS(141),
-    [anon_sym_item] = ACTIONS(141),
-    [anon_sym_LBRACE] = ACTIONS(139),
-    [anon_sym_RBRACE] = ACTIONS(139),
-    [anon_sym_true] = ACTIONS(141),
-    [anon_sym_false] = ACTIONS(141),
-    [anon_sym_name] = ACTIONS(141),
-    [anon_sym_glyph] = ACTIONS(141),
-    [anon_sym_from] = ACTIONS(141),
-    [anon_sym_mac] = ACTIONS(141),
-    [anon_sym_inputs] = ACTIONS(141),
-    [anon_sym_noinput] = ACTIONS(141),
-    [anon_sym_askfor] = ACTIONS(141),
-    [anon_sym_getclipboard] = ACTIONS(141),
-    [anon_sym_list] = ACTIONS(141),
-    [anon_sym_nil] = ACTIONS(141),
-    [anon_sym_action] = ACTIONS(141),
-    [anon_sym_stop] = ACTIONS(141),
-    [anon_sym_makeVCard] = ACTIONS(141),
-    [anon_sym_rawAction] = ACTIONS(141),
-    [anon_sym_embedFile] = ACTIONS(141),
-    [anon_sym_nothing] = ACTIONS(141),
-    [anon_sym_CurrentDate] = ACTIONS(141),
-    [anon_sym_Device] = ACTIONS(141),
-    [anon_sym_RepeatIndex] = ACTIONS(141),
-    [anon_sym_RepeatItem] = ACTIONS(141),
-    [anon_sym_ShortcutInput] = ACTIONS(141),
-    [anon_sym_Ask] = ACTIONS(141),
-    [anon_sym_text] = ACTIONS(141),
-    [anon_sym_number] = ACTIONS(141),
-    [anon_sym_bool] = ACTIONS(141),
-    [anon_sym_dictionary] = ACTIONS(141),
-    [anon_sym_array] = ACTIONS(141),
-    [anon_sym_variable] = ACTIONS(141),
-    [anon_sym_color] = ACTIONS(141),
-    [anon_sym_float] = ACTIONS(141),
-    [anon_sym_LPAREN] = ACTIONS(139),
-    [anon_sym_STAR] = ACTIONS(139),
-    [anon_sym_SLASH] = ACTIONS(141),
-    [anon_sym_PLUS] = ACTIONS(139),
-    [anon_sym_DASH] = ACTIONS(139),
-    [anon_sym_EQ_EQ] = ACTIONS(139),
-    [anon_sym_BANG_EQ] = ACTIONS(139),
-    [anon_sym_LT] = ACTIONS(141),
-    [anon_sym_GT] = ACTIONS(141),
-    [anon_sym_LT_EQ] = ACTIONS(139),
-    [anon_sym_GT_EQ] = ACTIONS(139),
-    [sym_at_variable] = ACTIONS(139),
-    [anon_sym_DQUOTE] = ACTIONS(139),
-    [anon_sym_SQUOTE] = ACTIONS(139),
-    [sym_number] = ACTIONS(139),
+    [ts_builtin_sym_end] = ACTIONS(230),
+    [sym_identifier] = ACTIONS(232),
+    [anon_sym_POUNDinclude] = ACTIONS(230),
+    [anon_sym_POUNDdefine] = ACTIONS(230),
+    [anon_sym_POUNDimport] = ACTIONS(230),
+    [anon_sym_POUNDquestion] = ACTIONS(230),
+    [anon_sym_const] = ACTIONS(232),
+    [anon_sym_if] = ACTIONS(232),
+    [anon_sym_else] = ACTIONS(232),
+    [anon_sym_for] = ACTIONS(232),
+    [anon_sym_repeat] = ACTIONS(232),
+    [anon_sym_menu] = ACTIONS(232),
+    [anon_sym_item] = ACTIONS(232),
+    [anon_sym_action] = ACTIONS(232),
+    [anon_sym_LPAREN] = ACTIONS(230),
+    [anon_sym_LBRACE] = ACTIONS(166),
+    [anon_sym_RBRACE] = ACTIONS(230),
+    [anon_sym_true] = ACTIONS(232),
+    [anon_sym_false] = ACTIONS(232),
+    [anon_sym_name] = ACTIONS(232),
+    [anon_sym_glyph] = ACTIONS(232),
+    [anon_sym_from] = ACTIONS(232),
+    [anon_sym_mac] = ACTIONS(232),
+    [anon_sym_inputs] = ACTIONS(232),
+    [anon_sym_noinput] = ACTIONS(232),
+    [anon_sym_askfor] = ACTIONS(232),
+    [anon_sym_getclipboard] = ACTIONS(232),
+    [anon_sym_list] = ACTIONS(232),
+    [anon_sym_nil] = ACTIONS(232),
+    [anon_sym_stop] = ACTIONS(232),
+    [anon_sym_makeVCard] = ACTIONS(232),
+    [anon_sym_rawAction] = ACTIONS(232),
+    [anon_sym_embedFile] = ACTIONS(232),
+    [anon_sym_nothing] = ACTIONS(232),
+    [anon_sym_CurrentDate] = ACTIONS(232),
+    [anon_sym_Device] = ACTIONS(232),
+    [anon_sym_RepeatIndex] = ACTIONS(232),
+    [anon_sym_RepeatItem] = ACTIONS(232),
+    [anon_sym_ShortcutInput] = ACTIONS(232),
+    [anon_sym_Ask] = ACTIONS(232),
+    [anon_sym_text] = ACTIONS(232),
+    [anon_sym_number] = ACTIONS(232),
+    [anon_sym_bool] = ACTIONS(232),
+    [anon_sym_dictionary] = ACTIONS(232),
+    [anon_sym_array] = ACTIONS(232),
+    [anon_sym_variable] = ACTIONS(232),
+    [anon_sym_color] = ACTIONS(232),
+    [anon_sym_float] = ACTIONS(232),
+    [anon_sym_STAR] = ACTIONS(166),
+    [anon_sym_SLASH] = ACTIONS(168),
+    [anon_sym_PLUS] = ACTIONS(166),
+    [anon_sym_DASH] = ACTIONS(166),
+    [anon_sym_EQ_EQ] = ACTIONS(166),
+    [anon_sym_BANG_EQ] = ACTIONS(166),
+    [anon_sym_LT] = ACTIONS(168),
+    [anon_sym_GT] = ACTIONS(168),
+    [anon_sym_LT_EQ] = ACTIONS(166),
+    [anon_sym_GT_EQ] = ACTIONS(166),
+    [sym_at_variable] = ACTIONS(230),
+    [anon_sym_DQUOTE] = ACTIONS(230),
+    [anon_sym_SQUOTE] = ACTIONS(230),
+    [sym_number] = ACTIONS(230),
+    [anon_sym_SLASH_SLASH] = ACTIONS(3),
+    [anon_sym_SLASH_STAR] = ACTIONS(5),
+  },
+  [STATE(47)] = {
+    [sym_comment] = STATE(47),
+    [sym_identifier] = ACTIONS(154),
+    [anon_sym_POUNDinclude] = ACTIONS(152),
+    [anon_sym_POUNDdefine] = ACTIONS(152),
+    [anon_sym_POUNDimport] = ACTIONS(152),
+    [anon_sym_POUNDquestion] = ACTIONS(152),
+    [anon_sym_COLON] = ACTIONS(216),
+    [anon_sym_const] = ACTIONS(154),
+    [anon_sym_if] = ACTIONS(154),
+    [anon_sym_for] = ACTIONS(154),
+    [anon_sym_repeat] = ACTIONS(154),
+    [anon_sym_menu] = ACTIONS(154),
+    [anon_sym_item] = ACTIONS(154),
+    [anon_sym_action] = ACTIONS(154),
+    [anon_sym_LPAREN] = ACTIONS(152),
+    [anon_sym_LBRACE] = ACTIONS(152),
+    [anon_sym_RBRACE] = ACTIONS(152),
+    [anon_sym_true] = ACTIONS(154),
+    [anon_sym_false] = ACTIONS(154),
+    [anon_sym_name] = ACTIONS(154),
+    [anon_sym_glyph] = ACTIONS(154),
+    [anon_sym_from] = ACTIONS(154),
+    [anon_sym_mac] = ACTIONS(154),
+    [anon_sym_inputs] = ACTIONS(154),
+    [anon_sym_noinput] = ACTIONS(154),
+    [anon_sym_askfor] = ACTIONS(154),
+    [anon_sym_getclipboard] = ACTIONS(154),
+    [anon_sym_list] = ACTIONS(154),
+    [anon_sym_nil] = ACTIONS(154),
+    [anon_sym_stop] = ACTIONS(154),
+    [anon_sym_makeVCard] = ACTIONS(154),
+    [anon_sym_rawAction] = ACTIONS(154),
+    [anon_sym_embedFile] = ACTIONS(154),
+    [anon_sym_nothing] = ACTIONS(154),
+    [anon_sym_CurrentDate] = ACTIONS(154),
+    [anon_sym_Device] = ACTIONS(154),
+    [anon_sym_RepeatIndex] = ACTIONS(154),
+    [anon_sym_RepeatItem] = ACTIONS(154),
+    [anon_sym_ShortcutInput] = ACTIONS(154),
+    [anon_sym_Ask] = ACTIONS(154),
+    [anon_sym_text] = ACTIONS(154),
+    [anon_sym_number] = ACTIONS(154),
+    [anon_sym_bool] = ACTIONS(154),
+    [anon_sym_dictionary] = ACTIONS(154),
+    [anon_sym_array] = ACTIONS(154),
+    [anon_sym_variable] = ACTIONS(154),
+    [anon_sym_color] = ACTIONS(154),
+    [anon_sym_float] = ACTIONS(154),
+    [anon_sym_STAR] = ACTIONS(152),
+    [anon_sym_SLASH] = ACTIONS(154),
+    [anon_sym_PLUS] = ACTIONS(152),
+    [anon_sym_DASH] = ACTIONS(152),
+    [anon_sym_EQ_EQ] = ACTIONS(152),
+    [anon_sym_BANG_EQ] = ACTIONS(152),
+    [anon_sym_LT] = ACTIONS(154),
+    [anon_sym_GT] = ACTIONS(154),
+    [anon_sym_LT_EQ] = ACTIONS(152),
+    [anon_sym_GT_EQ] = ACTIONS(152),
+    [sym_at_variable] = ACTIONS(152),
+    [anon_sym_DQUOTE] = ACTIONS(152),
+    [anon_sym_SQUOTE] = ACTIONS(152),
+    [sym_number] = ACTIONS(152),
     [anon_sym_SLASH_SLASH] = ACTIONS(3),
     [anon_sym_SLASH_STAR] = ACTIONS(5),
   },
 };
 
 static const uint16_t ts_small_parse_table[] = {
-  [0] = 6,
+  [0] = 5,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(231), 1,
-      anon_sym_else,
-    STATE(47), 1,
+    STATE(48), 1,
       sym_comment,
-    ACTIONS(227), 12,
+    ACTIONS(47), 12,
       ts_builtin_sym_end,
       anon_sym_POUNDinclude,
       anon_sym_POUNDdefine,
       anon_sym_POUNDimport,
       anon_sym_POUNDquestion,
+      anon_sym_LPAREN,
       anon_sym_LBRACE,
       anon_sym_RBRACE,
-      anon_sym_LPAREN,
       sym_at_variable,
       anon_sym_DQUOTE,
       anon_sym_SQUOTE,
       sym_number,
-    ACTIONS(229), 39,
+    ACTIONS(49), 40,
       anon_sym_const,
       anon_sym_if,
+      anon_sym_else,
       anon_sym_for,
       anon_sym_repeat,
       anon_sym_menu,
       anon_sym_item,
+      anon_sym_action,
       anon_sym_true,
       anon_sym_false,
       anon_sym_name,
@@ -5816,7 +5980,6 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
@@ -5837,27 +6000,27 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_color,
       anon_sym_float,
       sym_identifier,
-  [68] = 5,
+  [66] = 5,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    STATE(48), 1,
+    STATE(49), 1,
       sym_comment,
-    ACTIONS(233), 12,
+    ACTIONS(234), 12,
       ts_builtin_sym_end,
       anon_sym_POUNDinclude,
       anon_sym_POUNDdefine,
       anon_sym_POUNDimport,
       anon_sym_POUNDquestion,
+      anon_sym_LPAREN,
       anon_sym_LBRACE,
       anon_sym_RBRACE,
-      anon_sym_LPAREN,
       sym_at_variable,
       anon_sym_DQUOTE,
       anon_sym_SQUOTE,
       sym_number,
-    ACTIONS(235), 40,
+    ACTIONS(236), 40,
       anon_sym_const,
       anon_sym_if,
       anon_sym_else,
@@ -5865,6 +6028,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_repeat,
       anon_sym_menu,
       anon_sym_item,
+      anon_sym_action,
       anon_sym_true,
       anon_sym_false,
       anon_sym_name,
@@ -5877,7 +6041,6 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
@@ -5898,27 +6061,27 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_color,
       anon_sym_float,
       sym_identifier,
-  [134] = 5,
+  [132] = 5,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    STATE(49), 1,
+    STATE(50), 1,
       sym_comment,
-    ACTIONS(237), 12,
+    ACTIONS(234), 12,
       ts_builtin_sym_end,
       anon_sym_POUNDinclude,
       anon_sym_POUNDdefine,
       anon_sym_POUNDimport,
       anon_sym_POUNDquestion,
+      anon_sym_LPAREN,
       anon_sym_LBRACE,
       anon_sym_RBRACE,
-      anon_sym_LPAREN,
       sym_at_variable,
       anon_sym_DQUOTE,
       anon_sym_SQUOTE,
       sym_number,
-    ACTIONS(239), 40,
+    ACTIONS(236), 40,
       anon_sym_const,
       anon_sym_if,
       anon_sym_else,
@@ -5926,6 +6089,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_repeat,
       anon_sym_menu,
       anon_sym_item,
+      anon_sym_action,
       anon_sym_true,
       anon_sym_false,
       anon_sym_name,
@@ -5938,7 +6102,6 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
@@ -5959,27 +6122,27 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_color,
       anon_sym_float,
       sym_identifier,
-  [200] = 5,
+  [198] = 5,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    STATE(50), 1,
+    STATE(51), 1,
       sym_comment,
-    ACTIONS(241), 12,
+    ACTIONS(238), 12,
       ts_builtin_sym_end,
       anon_sym_POUNDinclude,
       anon_sym_POUNDdefine,
       anon_sym_POUNDimport,
       anon_sym_POUNDquestion,
+      anon_sym_LPAREN,
       anon_sym_LBRACE,
       anon_sym_RBRACE,
-      anon_sym_LPAREN,
       sym_at_variable,
       anon_sym_DQUOTE,
       anon_sym_SQUOTE,
       sym_number,
-    ACTIONS(243), 40,
+    ACTIONS(240), 40,
       anon_sym_const,
       anon_sym_if,
       anon_sym_else,
@@ -5987,6 +6150,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_repeat,
       anon_sym_menu,
       anon_sym_item,
+      anon_sym_action,
       anon_sym_true,
       anon_sym_false,
       anon_sym_name,
@@ -5999,7 +6163,6 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
@@ -6020,27 +6183,27 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_color,
       anon_sym_float,
       sym_identifier,
-  [266] = 5,
+  [264] = 5,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    STATE(51), 1,
+    STATE(52), 1,
       sym_comment,
-    ACTIONS(245), 12,
+    ACTIONS(242), 12,
       ts_builtin_sym_end,
       anon_sym_POUNDinclude,
       anon_sym_POUNDdefine,
       anon_sym_POUNDimport,
       anon_sym_POUNDquestion,
+      anon_sym_LPAREN,
       anon_sym_LBRACE,
       anon_sym_RBRACE,
-      anon_sym_LPAREN,
       sym_at_variable,
       anon_sym_DQUOTE,
       anon_sym_SQUOTE,
       sym_number,
-    ACTIONS(247), 40,
+    ACTIONS(244), 40,
       anon_sym_const,
       anon_sym_if,
       anon_sym_else,
@@ -6048,6 +6211,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_repeat,
       anon_sym_menu,
       anon_sym_item,
+      anon_sym_action,
       anon_sym_true,
       anon_sym_false,
       anon_sym_name,
@@ -6060,7 +6224,6 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
@@ -6081,34 +6244,36 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_color,
       anon_sym_float,
       sym_identifier,
-  [332] = 5,
+  [330] = 6,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    STATE(52), 1,
+    ACTIONS(250), 1,
+      anon_sym_else,
+    STATE(53), 1,
       sym_comment,
-    ACTIONS(45), 12,
+    ACTIONS(246), 12,
       ts_builtin_sym_end,
       anon_sym_POUNDinclude,
       anon_sym_POUNDdefine,
       anon_sym_POUNDimport,
       anon_sym_POUNDquestion,
+      anon_sym_LPAREN,
       anon_sym_LBRACE,
       anon_sym_RBRACE,
-      anon_sym_LPAREN,
       sym_at_variable,
       anon_sym_DQUOTE,
       anon_sym_SQUOTE,
       sym_number,
-    ACTIONS(47), 40,
+    ACTIONS(248), 39,
       anon_sym_const,
       anon_sym_if,
-      anon_sym_else,
       anon_sym_for,
       anon_sym_repeat,
       anon_sym_menu,
       anon_sym_item,
+      anon_sym_action,
       anon_sym_true,
       anon_sym_false,
       anon_sym_name,
@@ -6121,7 +6286,6 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
@@ -6147,30 +6311,31 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(231), 1,
+    ACTIONS(250), 1,
       anon_sym_else,
-    STATE(53), 1,
+    STATE(54), 1,
       sym_comment,
-    ACTIONS(227), 12,
+    ACTIONS(246), 12,
       ts_builtin_sym_end,
       anon_sym_POUNDinclude,
       anon_sym_POUNDdefine,
       anon_sym_POUNDimport,
       anon_sym_POUNDquestion,
+      anon_sym_LPAREN,
       anon_sym_LBRACE,
       anon_sym_RBRACE,
-      anon_sym_LPAREN,
       sym_at_variable,
       anon_sym_DQUOTE,
       anon_sym_SQUOTE,
       sym_number,
-    ACTIONS(229), 39,
+    ACTIONS(248), 39,
       anon_sym_const,
       anon_sym_if,
       anon_sym_for,
       anon_sym_repeat,
       anon_sym_menu,
       anon_sym_item,
+      anon_sym_action,
       anon_sym_true,
       anon_sym_false,
       anon_sym_name,
@@ -6183,7 +6348,6 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
@@ -6209,22 +6373,22 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    STATE(54), 1,
+    STATE(55), 1,
       sym_comment,
-    ACTIONS(237), 12,
+    ACTIONS(252), 12,
       ts_builtin_sym_end,
       anon_sym_POUNDinclude,
       anon_sym_POUNDdefine,
       anon_sym_POUNDimport,
       anon_sym_POUNDquestion,
+      anon_sym_LPAREN,
       anon_sym_LBRACE,
       anon_sym_RBRACE,
-      anon_sym_LPAREN,
       sym_at_variable,
       anon_sym_DQUOTE,
       anon_sym_SQUOTE,
       sym_number,
-    ACTIONS(239), 40,
+    ACTIONS(254), 40,
       anon_sym_const,
       anon_sym_if,
       anon_sym_else,
@@ -6232,6 +6396,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_repeat,
       anon_sym_menu,
       anon_sym_item,
+      anon_sym_action,
       anon_sym_true,
       anon_sym_false,
       anon_sym_name,
@@ -6244,7 +6409,6 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
@@ -6270,22 +6434,22 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    STATE(55), 1,
+    STATE(56), 1,
       sym_comment,
-    ACTIONS(249), 12,
+    ACTIONS(252), 12,
       ts_builtin_sym_end,
       anon_sym_POUNDinclude,
       anon_sym_POUNDdefine,
       anon_sym_POUNDimport,
       anon_sym_POUNDquestion,
+      anon_sym_LPAREN,
       anon_sym_LBRACE,
       anon_sym_RBRACE,
-      anon_sym_LPAREN,
       sym_at_variable,
       anon_sym_DQUOTE,
       anon_sym_SQUOTE,
       sym_number,
-    ACTIONS(251), 40,
+    ACTIONS(254), 40,
       anon_sym_const,
       anon_sym_if,
       anon_sym_else,
@@ -6293,6 +6457,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_repeat,
       anon_sym_menu,
       anon_sym_item,
+      anon_sym_action,
       anon_sym_true,
       anon_sym_false,
       anon_sym_name,
@@ -6305,7 +6470,6 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
@@ -6331,22 +6495,22 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    STATE(56), 1,
+    STATE(57), 1,
       sym_comment,
-    ACTIONS(253), 12,
+    ACTIONS(256), 12,
       ts_builtin_sym_end,
       anon_sym_POUNDinclude,
       anon_sym_POUNDdefine,
       anon_sym_POUNDimport,
       anon_sym_POUNDquestion,
+      anon_sym_LPAREN,
       anon_sym_LBRACE,
       anon_sym_RBRACE,
-      anon_sym_LPAREN,
       sym_at_variable,
       anon_sym_DQUOTE,
       anon_sym_SQUOTE,
       sym_number,
-    ACTIONS(255), 40,
+    ACTIONS(258), 40,
       anon_sym_const,
       anon_sym_if,
       anon_sym_else,
@@ -6354,6 +6518,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_repeat,
       anon_sym_menu,
       anon_sym_item,
+      anon_sym_action,
       anon_sym_true,
       anon_sym_false,
       anon_sym_name,
@@ -6366,7 +6531,6 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
@@ -6392,22 +6556,22 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    STATE(57), 1,
+    STATE(58), 1,
       sym_comment,
-    ACTIONS(257), 12,
+    ACTIONS(260), 12,
       ts_builtin_sym_end,
       anon_sym_POUNDinclude,
       anon_sym_POUNDdefine,
       anon_sym_POUNDimport,
       anon_sym_POUNDquestion,
+      anon_sym_LPAREN,
       anon_sym_LBRACE,
       anon_sym_RBRACE,
-      anon_sym_LPAREN,
       sym_at_variable,
       anon_sym_DQUOTE,
       anon_sym_SQUOTE,
       sym_number,
-    ACTIONS(259), 40,
+    ACTIONS(262), 40,
       anon_sym_const,
       anon_sym_if,
       anon_sym_else,
@@ -6415,6 +6579,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_repeat,
       anon_sym_menu,
       anon_sym_item,
+      anon_sym_action,
       anon_sym_true,
       anon_sym_false,
       anon_sym_name,
@@ -6427,7 +6592,6 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
@@ -6453,22 +6617,22 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    STATE(58), 1,
+    STATE(59), 1,
       sym_comment,
-    ACTIONS(257), 12,
+    ACTIONS(264), 12,
       ts_builtin_sym_end,
       anon_sym_POUNDinclude,
       anon_sym_POUNDdefine,
       anon_sym_POUNDimport,
       anon_sym_POUNDquestion,
+      anon_sym_LPAREN,
       anon_sym_LBRACE,
       anon_sym_RBRACE,
-      anon_sym_LPAREN,
       sym_at_variable,
       anon_sym_DQUOTE,
       anon_sym_SQUOTE,
       sym_number,
-    ACTIONS(259), 40,
+    ACTIONS(266), 40,
       anon_sym_const,
       anon_sym_if,
       anon_sym_else,
@@ -6476,6 +6640,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_repeat,
       anon_sym_menu,
       anon_sym_item,
+      anon_sym_action,
       anon_sym_true,
       anon_sym_false,
       anon_sym_name,
@@ -6488,7 +6653,6 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
@@ -6514,22 +6678,22 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    STATE(59), 1,
+    STATE(60), 1,
       sym_comment,
-    ACTIONS(261), 12,
+    ACTIONS(230), 12,
       ts_builtin_sym_end,
       anon_sym_POUNDinclude,
       anon_sym_POUNDdefine,
       anon_sym_POUNDimport,
       anon_sym_POUNDquestion,
+      anon_sym_LPAREN,
       anon_sym_LBRACE,
       anon_sym_RBRACE,
-      anon_sym_LPAREN,
       sym_at_variable,
       anon_sym_DQUOTE,
       anon_sym_SQUOTE,
       sym_number,
-    ACTIONS(263), 40,
+    ACTIONS(232), 40,
       anon_sym_const,
       anon_sym_if,
       anon_sym_else,
@@ -6537,6 +6701,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_repeat,
       anon_sym_menu,
       anon_sym_item,
+      anon_sym_action,
       anon_sym_true,
       anon_sym_false,
       anon_sym_name,
@@ -6549,7 +6714,6 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
@@ -6575,22 +6739,22 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    STATE(60), 1,
+    STATE(61), 1,
       sym_comment,
-    ACTIONS(223), 12,
+    ACTIONS(268), 12,
       ts_builtin_sym_end,
       anon_sym_POUNDinclude,
       anon_sym_POUNDdefine,
       anon_sym_POUNDimport,
       anon_sym_POUNDquestion,
+      anon_sym_LPAREN,
       anon_sym_LBRACE,
       anon_sym_RBRACE,
-      anon_sym_LPAREN,
       sym_at_variable,
       anon_sym_DQUOTE,
       anon_sym_SQUOTE,
       sym_number,
-    ACTIONS(225), 40,
+    ACTIONS(270), 40,
       anon_sym_const,
       anon_sym_if,
       anon_sym_else,
@@ -6598,6 +6762,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_repeat,
       anon_sym_menu,
       anon_sym_item,
+      anon_sym_action,
       anon_sym_true,
       anon_sym_false,
       anon_sym_name,
@@ -6610,7 +6775,6 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
@@ -6636,22 +6800,22 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    STATE(61), 1,
+    STATE(62), 1,
       sym_comment,
-    ACTIONS(265), 12,
+    ACTIONS(268), 12,
       ts_builtin_sym_end,
       anon_sym_POUNDinclude,
       anon_sym_POUNDdefine,
       anon_sym_POUNDimport,
       anon_sym_POUNDquestion,
+      anon_sym_LPAREN,
       anon_sym_LBRACE,
       anon_sym_RBRACE,
-      anon_sym_LPAREN,
       sym_at_variable,
       anon_sym_DQUOTE,
       anon_sym_SQUOTE,
       sym_number,
-    ACTIONS(267), 40,
+    ACTIONS(270), 40,
       anon_sym_const,
       anon_sym_if,
       anon_sym_else,
@@ -6659,6 +6823,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_repeat,
       anon_sym_menu,
       anon_sym_item,
+      anon_sym_action,
       anon_sym_true,
       anon_sym_false,
       anon_sym_name,
@@ -6671,7 +6836,6 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
@@ -6697,22 +6861,22 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    STATE(62), 1,
+    STATE(63), 1,
       sym_comment,
-    ACTIONS(265), 12,
+    ACTIONS(272), 12,
       ts_builtin_sym_end,
       anon_sym_POUNDinclude,
       anon_sym_POUNDdefine,
       anon_sym_POUNDimport,
       anon_sym_POUNDquestion,
+      anon_sym_LPAREN,
       anon_sym_LBRACE,
       anon_sym_RBRACE,
-      anon_sym_LPAREN,
       sym_at_variable,
       anon_sym_DQUOTE,
       anon_sym_SQUOTE,
       sym_number,
-    ACTIONS(267), 40,
+    ACTIONS(274), 40,
       anon_sym_const,
       anon_sym_if,
       anon_sym_else,
@@ -6720,6 +6884,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_repeat,
       anon_sym_menu,
       anon_sym_item,
+      anon_sym_action,
       anon_sym_true,
       anon_sym_false,
       anon_sym_name,
@@ -6732,7 +6897,6 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
@@ -6758,22 +6922,22 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    STATE(63), 1,
+    STATE(64), 1,
       sym_comment,
-    ACTIONS(269), 12,
+    ACTIONS(276), 12,
       ts_builtin_sym_end,
       anon_sym_POUNDinclude,
       anon_sym_POUNDdefine,
       anon_sym_POUNDimport,
       anon_sym_POUNDquestion,
+      anon_sym_LPAREN,
       anon_sym_LBRACE,
       anon_sym_RBRACE,
-      anon_sym_LPAREN,
       sym_at_variable,
       anon_sym_DQUOTE,
       anon_sym_SQUOTE,
       sym_number,
-    ACTIONS(271), 40,
+    ACTIONS(278), 40,
       anon_sym_const,
       anon_sym_if,
       anon_sym_else,
@@ -6781,6 +6945,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_repeat,
       anon_sym_menu,
       anon_sym_item,
+      anon_sym_action,
       anon_sym_true,
       anon_sym_false,
       anon_sym_name,
@@ -6793,7 +6958,6 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
@@ -6819,22 +6983,22 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    STATE(64), 1,
+    STATE(65), 1,
       sym_comment,
-    ACTIONS(269), 12,
+    ACTIONS(276), 12,
       ts_builtin_sym_end,
       anon_sym_POUNDinclude,
       anon_sym_POUNDdefine,
       anon_sym_POUNDimport,
       anon_sym_POUNDquestion,
+      anon_sym_LPAREN,
       anon_sym_LBRACE,
       anon_sym_RBRACE,
-      anon_sym_LPAREN,
       sym_at_variable,
       anon_sym_DQUOTE,
       anon_sym_SQUOTE,
       sym_number,
-    ACTIONS(271), 40,
+    ACTIONS(278), 40,
       anon_sym_const,
       anon_sym_if,
       anon_sym_else,
@@ -6842,6 +7006,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_repeat,
       anon_sym_menu,
       anon_sym_item,
+      anon_sym_action,
       anon_sym_true,
       anon_sym_false,
       anon_sym_name,
@@ -6854,7 +7019,6 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
@@ -6880,22 +7044,22 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    STATE(65), 1,
+    STATE(66), 1,
       sym_comment,
-    ACTIONS(273), 12,
+    ACTIONS(280), 12,
       ts_builtin_sym_end,
       anon_sym_POUNDinclude,
       anon_sym_POUNDdefine,
       anon_sym_POUNDimport,
       anon_sym_POUNDquestion,
+      anon_sym_LPAREN,
       anon_sym_LBRACE,
       anon_sym_RBRACE,
-      anon_sym_LPAREN,
       sym_at_variable,
       anon_sym_DQUOTE,
       anon_sym_SQUOTE,
       sym_number,
-    ACTIONS(275), 40,
+    ACTIONS(282), 40,
       anon_sym_const,
       anon_sym_if,
       anon_sym_else,
@@ -6903,6 +7067,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_repeat,
       anon_sym_menu,
       anon_sym_item,
+      anon_sym_action,
       anon_sym_true,
       anon_sym_false,
       anon_sym_name,
@@ -6915,7 +7080,6 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
@@ -6941,22 +7105,22 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    STATE(66), 1,
+    STATE(67), 1,
       sym_comment,
-    ACTIONS(273), 12,
+    ACTIONS(280), 12,
       ts_builtin_sym_end,
       anon_sym_POUNDinclude,
       anon_sym_POUNDdefine,
       anon_sym_POUNDimport,
       anon_sym_POUNDquestion,
+      anon_sym_LPAREN,
       anon_sym_LBRACE,
       anon_sym_RBRACE,
-      anon_sym_LPAREN,
       sym_at_variable,
       anon_sym_DQUOTE,
       anon_sym_SQUOTE,
       sym_number,
-    ACTIONS(275), 40,
+    ACTIONS(282), 40,
       anon_sym_const,
       anon_sym_if,
       anon_sym_else,
@@ -6964,6 +7128,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_repeat,
       anon_sym_menu,
       anon_sym_item,
+      anon_sym_action,
       anon_sym_true,
       anon_sym_false,
       anon_sym_name,
@@ -6976,7 +7141,6 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
@@ -7002,22 +7166,22 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    STATE(67), 1,
+    STATE(68), 1,
       sym_comment,
-    ACTIONS(233), 12,
+    ACTIONS(284), 12,
       ts_builtin_sym_end,
       anon_sym_POUNDinclude,
       anon_sym_POUNDdefine,
       anon_sym_POUNDimport,
       anon_sym_POUNDquestion,
+      anon_sym_LPAREN,
       anon_sym_LBRACE,
       anon_sym_RBRACE,
-      anon_sym_LPAREN,
       sym_at_variable,
       anon_sym_DQUOTE,
       anon_sym_SQUOTE,
       sym_number,
-    ACTIONS(235), 40,
+    ACTIONS(286), 40,
       anon_sym_const,
       anon_sym_if,
       anon_sym_else,
@@ -7025,6 +7189,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_repeat,
       anon_sym_menu,
       anon_sym_item,
+      anon_sym_action,
       anon_sym_true,
       anon_sym_false,
       anon_sym_name,
@@ -7037,7 +7202,67 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
+      anon_sym_stop,
+      anon_sym_makeVCard,
+      anon_sym_rawAction,
+      anon_sym_embedFile,
+      anon_sym_nothing,
+      anon_sym_CurrentDate,
+      anon_sym_Device,
+      anon_sym_RepeatIndex,
+      anon_sym_RepeatItem,
+      anon_sym_ShortcutInput,
+      anon_sym_Ask,
+      anon_sym_text,
+      anon_sym_number,
+      anon_sym_bool,
+      anon_sym_dictionary,
+      anon_sym_array,
+      anon_sym_variable,
+      anon_sym_color,
+      anon_sym_float,
+      sym_identifier,
+  [1390] = 5,
+    ACTIONS(3), 1,
+      anon_sym_SLASH_SLASH,
+    ACTIONS(5), 1,
+      anon_sym_SLASH_STAR,
+    STATE(69), 1,
+      sym_comment,
+    ACTIONS(284), 12,
+      ts_builtin_sym_end,
+      anon_sym_POUNDinclude,
+      anon_sym_POUNDdefine,
+      anon_sym_POUNDimport,
+      anon_sym_POUNDquestion,
+      anon_sym_LPAREN,
+      anon_sym_LBRACE,
+      anon_sym_RBRACE,
+      sym_at_variable,
+      anon_sym_DQUOTE,
+      anon_sym_SQUOTE,
+      sym_number,
+    ACTIONS(286), 40,
+      anon_sym_const,
+      anon_sym_if,
+      anon_sym_else,
+      anon_sym_for,
+      anon_sym_repeat,
+      anon_sym_menu,
+      anon_sym_item,
       anon_sym_action,
+      anon_sym_true,
+      anon_sym_false,
+      anon_sym_name,
+      anon_sym_glyph,
+      anon_sym_from,
+      anon_sym_mac,
+      anon_sym_inputs,
+      anon_sym_noinput,
+      anon_sym_askfor,
+      anon_sym_getclipboard,
+      anon_sym_list,
+      anon_sym_nil,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
@@ -7058,44 +7283,105 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_color,
       anon_sym_float,
       sym_identifier,
-  [1390] = 17,
+  [1456] = 5,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(35), 1,
+    STATE(70), 1,
+      sym_comment,
+    ACTIONS(288), 12,
+      ts_builtin_sym_end,
+      anon_sym_POUNDinclude,
+      anon_sym_POUNDdefine,
+      anon_sym_POUNDimport,
+      anon_sym_POUNDquestion,
       anon_sym_LPAREN,
-    ACTIONS(39), 1,
+      anon_sym_LBRACE,
+      anon_sym_RBRACE,
+      sym_at_variable,
       anon_sym_DQUOTE,
-    ACTIONS(41), 1,
       anon_sym_SQUOTE,
-    ACTIONS(277), 1,
+      sym_number,
+    ACTIONS(290), 40,
+      anon_sym_const,
+      anon_sym_if,
+      anon_sym_else,
+      anon_sym_for,
+      anon_sym_repeat,
+      anon_sym_menu,
+      anon_sym_item,
+      anon_sym_action,
+      anon_sym_true,
+      anon_sym_false,
+      anon_sym_name,
+      anon_sym_glyph,
+      anon_sym_from,
+      anon_sym_mac,
+      anon_sym_inputs,
+      anon_sym_noinput,
+      anon_sym_askfor,
+      anon_sym_getclipboard,
+      anon_sym_list,
+      anon_sym_nil,
+      anon_sym_stop,
+      anon_sym_makeVCard,
+      anon_sym_rawAction,
+      anon_sym_embedFile,
+      anon_sym_nothing,
+      anon_sym_CurrentDate,
+      anon_sym_Device,
+      anon_sym_RepeatIndex,
+      anon_sym_RepeatItem,
+      anon_sym_ShortcutInput,
+      anon_sym_Ask,
+      anon_sym_text,
+      anon_sym_number,
+      anon_sym_bool,
+      anon_sym_dictionary,
+      anon_sym_array,
+      anon_sym_variable,
+      anon_sym_color,
+      anon_sym_float,
       sym_identifier,
-    ACTIONS(279), 1,
+  [1522] = 17,
+    ACTIONS(3), 1,
+      anon_sym_SLASH_SLASH,
+    ACTIONS(5), 1,
+      anon_sym_SLASH_STAR,
+    ACTIONS(27), 1,
+      anon_sym_LPAREN,
+    ACTIONS(41), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(43), 1,
+      anon_sym_SQUOTE,
+    ACTIONS(292), 1,
       anon_sym_LBRACE,
-    ACTIONS(281), 1,
-      anon_sym_RPAREN,
-    STATE(68), 1,
+    ACTIONS(294), 1,
+      sym_identifier,
+    STATE(51), 1,
+      sym_block,
+    STATE(71), 1,
       sym_comment,
-    STATE(87), 1,
+    STATE(91), 1,
       sym__expression,
-    ACTIONS(27), 2,
+    ACTIONS(31), 2,
       anon_sym_true,
       anon_sym_false,
-    ACTIONS(43), 2,
+    ACTIONS(45), 2,
       sym_at_variable,
       sym_number,
-    STATE(28), 2,
+    STATE(20), 2,
       sym_builtin_keyword,
       sym_type_keyword,
-    ACTIONS(31), 6,
+    ACTIONS(35), 6,
       anon_sym_CurrentDate,
       anon_sym_Device,
       anon_sym_RepeatIndex,
       anon_sym_RepeatItem,
       anon_sym_ShortcutInput,
       anon_sym_Ask,
-    ACTIONS(33), 8,
+    ACTIONS(37), 8,
       anon_sym_text,
       anon_sym_number,
       anon_sym_bool,
@@ -7104,7 +7390,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_variable,
       anon_sym_color,
       anon_sym_float,
-    STATE(17), 8,
+    STATE(19), 8,
       sym_dictionary,
       sym_boolean,
       sym_builtin_constant,
@@ -7113,7 +7399,8 @@ static const uint16_t ts_small_parse_table[] = {
       sym_call,
       sym_string,
       sym_single_quoted_string,
-    ACTIONS(29), 16,
+    ACTIONS(33), 16,
+      anon_sym_action,
       anon_sym_name,
       anon_sym_glyph,
       anon_sym_from,
@@ -7124,39 +7411,39 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
       anon_sym_embedFile,
       anon_sym_nothing,
-  [1479] = 5,
+  [1611] = 5,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    STATE(69), 1,
+    STATE(72), 1,
       sym_comment,
-    ACTIONS(283), 12,
+    ACTIONS(296), 12,
       ts_builtin_sym_end,
       anon_sym_POUNDinclude,
       anon_sym_POUNDdefine,
       anon_sym_POUNDimport,
       anon_sym_POUNDquestion,
+      anon_sym_LPAREN,
       anon_sym_LBRACE,
       anon_sym_RBRACE,
-      anon_sym_LPAREN,
       sym_at_variable,
       anon_sym_DQUOTE,
       anon_sym_SQUOTE,
       sym_number,
-    ACTIONS(285), 39,
+    ACTIONS(298), 39,
       anon_sym_const,
       anon_sym_if,
       anon_sym_for,
       anon_sym_repeat,
       anon_sym_menu,
       anon_sym_item,
+      anon_sym_action,
       anon_sym_true,
       anon_sym_false,
       anon_sym_name,
@@ -7169,7 +7456,6 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
@@ -7190,44 +7476,44 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_color,
       anon_sym_float,
       sym_identifier,
-  [1544] = 17,
+  [1676] = 17,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(35), 1,
+    ACTIONS(27), 1,
       anon_sym_LPAREN,
-    ACTIONS(39), 1,
-      anon_sym_DQUOTE,
     ACTIONS(41), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(43), 1,
       anon_sym_SQUOTE,
-    ACTIONS(277), 1,
+    ACTIONS(294), 1,
       sym_identifier,
-    ACTIONS(287), 1,
+    ACTIONS(300), 1,
+      anon_sym_RPAREN,
+    ACTIONS(302), 1,
       anon_sym_LBRACE,
-    STATE(59), 1,
-      sym_block,
-    STATE(70), 1,
+    STATE(73), 1,
       sym_comment,
-    STATE(88), 1,
+    STATE(90), 1,
       sym__expression,
-    ACTIONS(27), 2,
+    ACTIONS(31), 2,
       anon_sym_true,
       anon_sym_false,
-    ACTIONS(43), 2,
+    ACTIONS(45), 2,
       sym_at_variable,
       sym_number,
-    STATE(28), 2,
+    STATE(20), 2,
       sym_builtin_keyword,
       sym_type_keyword,
-    ACTIONS(31), 6,
+    ACTIONS(35), 6,
       anon_sym_CurrentDate,
       anon_sym_Device,
       anon_sym_RepeatIndex,
       anon_sym_RepeatItem,
       anon_sym_ShortcutInput,
       anon_sym_Ask,
-    ACTIONS(33), 8,
+    ACTIONS(37), 8,
       anon_sym_text,
       anon_sym_number,
       anon_sym_bool,
@@ -7236,7 +7522,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_variable,
       anon_sym_color,
       anon_sym_float,
-    STATE(17), 8,
+    STATE(19), 8,
       sym_dictionary,
       sym_boolean,
       sym_builtin_constant,
@@ -7245,7 +7531,8 @@ static const uint16_t ts_small_parse_table[] = {
       sym_call,
       sym_string,
       sym_single_quoted_string,
-    ACTIONS(29), 16,
+    ACTIONS(33), 16,
+      anon_sym_action,
       anon_sym_name,
       anon_sym_glyph,
       anon_sym_from,
@@ -7256,48 +7543,47 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
       anon_sym_embedFile,
       anon_sym_nothing,
-  [1633] = 16,
+  [1765] = 16,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(35), 1,
+    ACTIONS(27), 1,
       anon_sym_LPAREN,
-    ACTIONS(39), 1,
-      anon_sym_DQUOTE,
     ACTIONS(41), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(43), 1,
       anon_sym_SQUOTE,
-    ACTIONS(277), 1,
+    ACTIONS(294), 1,
       sym_identifier,
-    ACTIONS(279), 1,
+    ACTIONS(302), 1,
       anon_sym_LBRACE,
-    STATE(71), 1,
-      sym_comment,
-    STATE(90), 1,
+    STATE(3), 1,
       sym__expression,
-    ACTIONS(27), 2,
+    STATE(74), 1,
+      sym_comment,
+    ACTIONS(31), 2,
       anon_sym_true,
       anon_sym_false,
-    ACTIONS(43), 2,
+    ACTIONS(45), 2,
       sym_at_variable,
       sym_number,
-    STATE(28), 2,
+    STATE(20), 2,
       sym_builtin_keyword,
       sym_type_keyword,
-    ACTIONS(31), 6,
+    ACTIONS(35), 6,
       anon_sym_CurrentDate,
       anon_sym_Device,
       anon_sym_RepeatIndex,
       anon_sym_RepeatItem,
       anon_sym_ShortcutInput,
       anon_sym_Ask,
-    ACTIONS(33), 8,
+    ACTIONS(37), 8,
       anon_sym_text,
       anon_sym_number,
       anon_sym_bool,
@@ -7306,7 +7592,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_variable,
       anon_sym_color,
       anon_sym_float,
-    STATE(17), 8,
+    STATE(19), 8,
       sym_dictionary,
       sym_boolean,
       sym_builtin_constant,
@@ -7315,7 +7601,8 @@ static const uint16_t ts_small_parse_table[] = {
       sym_call,
       sym_string,
       sym_single_quoted_string,
-    ACTIONS(29), 16,
+    ACTIONS(33), 16,
+      anon_sym_action,
       anon_sym_name,
       anon_sym_glyph,
       anon_sym_from,
@@ -7326,48 +7613,47 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
       anon_sym_embedFile,
       anon_sym_nothing,
-  [1719] = 16,
+  [1851] = 16,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(35), 1,
+    ACTIONS(27), 1,
       anon_sym_LPAREN,
-    ACTIONS(39), 1,
-      anon_sym_DQUOTE,
     ACTIONS(41), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(43), 1,
       anon_sym_SQUOTE,
-    ACTIONS(277), 1,
+    ACTIONS(294), 1,
       sym_identifier,
-    ACTIONS(279), 1,
+    ACTIONS(302), 1,
       anon_sym_LBRACE,
-    STATE(42), 1,
-      sym__expression,
-    STATE(72), 1,
+    STATE(75), 1,
       sym_comment,
-    ACTIONS(27), 2,
+    STATE(95), 1,
+      sym__expression,
+    ACTIONS(31), 2,
       anon_sym_true,
       anon_sym_false,
-    ACTIONS(43), 2,
+    ACTIONS(45), 2,
       sym_at_variable,
       sym_number,
-    STATE(28), 2,
+    STATE(20), 2,
       sym_builtin_keyword,
       sym_type_keyword,
-    ACTIONS(31), 6,
+    ACTIONS(35), 6,
       anon_sym_CurrentDate,
       anon_sym_Device,
       anon_sym_RepeatIndex,
       anon_sym_RepeatItem,
       anon_sym_ShortcutInput,
       anon_sym_Ask,
-    ACTIONS(33), 8,
+    ACTIONS(37), 8,
       anon_sym_text,
       anon_sym_number,
       anon_sym_bool,
@@ -7376,7 +7662,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_variable,
       anon_sym_color,
       anon_sym_float,
-    STATE(17), 8,
+    STATE(19), 8,
       sym_dictionary,
       sym_boolean,
       sym_builtin_constant,
@@ -7385,7 +7671,8 @@ static const uint16_t ts_small_parse_table[] = {
       sym_call,
       sym_string,
       sym_single_quoted_string,
-    ACTIONS(29), 16,
+    ACTIONS(33), 16,
+      anon_sym_action,
       anon_sym_name,
       anon_sym_glyph,
       anon_sym_from,
@@ -7396,48 +7683,47 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
       anon_sym_embedFile,
       anon_sym_nothing,
-  [1805] = 16,
+  [1937] = 16,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(35), 1,
+    ACTIONS(27), 1,
       anon_sym_LPAREN,
-    ACTIONS(39), 1,
-      anon_sym_DQUOTE,
     ACTIONS(41), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(43), 1,
       anon_sym_SQUOTE,
-    ACTIONS(277), 1,
+    ACTIONS(294), 1,
       sym_identifier,
-    ACTIONS(279), 1,
+    ACTIONS(302), 1,
       anon_sym_LBRACE,
-    STATE(3), 1,
-      sym__expression,
-    STATE(73), 1,
+    STATE(76), 1,
       sym_comment,
-    ACTIONS(27), 2,
+    STATE(96), 1,
+      sym__expression,
+    ACTIONS(31), 2,
       anon_sym_true,
       anon_sym_false,
-    ACTIONS(43), 2,
+    ACTIONS(45), 2,
       sym_at_variable,
       sym_number,
-    STATE(28), 2,
+    STATE(20), 2,
       sym_builtin_keyword,
       sym_type_keyword,
-    ACTIONS(31), 6,
+    ACTIONS(35), 6,
       anon_sym_CurrentDate,
       anon_sym_Device,
       anon_sym_RepeatIndex,
       anon_sym_RepeatItem,
       anon_sym_ShortcutInput,
       anon_sym_Ask,
-    ACTIONS(33), 8,
+    ACTIONS(37), 8,
       anon_sym_text,
       anon_sym_number,
       anon_sym_bool,
@@ -7446,7 +7732,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_variable,
       anon_sym_color,
       anon_sym_float,
-    STATE(17), 8,
+    STATE(19), 8,
       sym_dictionary,
       sym_boolean,
       sym_builtin_constant,
@@ -7455,7 +7741,8 @@ static const uint16_t ts_small_parse_table[] = {
       sym_call,
       sym_string,
       sym_single_quoted_string,
-    ACTIONS(29), 16,
+    ACTIONS(33), 16,
+      anon_sym_action,
       anon_sym_name,
       anon_sym_glyph,
       anon_sym_from,
@@ -7466,48 +7753,47 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
       anon_sym_embedFile,
       anon_sym_nothing,
-  [1891] = 16,
+  [2023] = 16,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(35), 1,
+    ACTIONS(27), 1,
       anon_sym_LPAREN,
-    ACTIONS(39), 1,
-      anon_sym_DQUOTE,
     ACTIONS(41), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(43), 1,
       anon_sym_SQUOTE,
-    ACTIONS(277), 1,
+    ACTIONS(294), 1,
       sym_identifier,
-    ACTIONS(279), 1,
+    ACTIONS(302), 1,
       anon_sym_LBRACE,
-    STATE(44), 1,
+    STATE(43), 1,
       sym__expression,
-    STATE(74), 1,
+    STATE(77), 1,
       sym_comment,
-    ACTIONS(27), 2,
+    ACTIONS(31), 2,
       anon_sym_true,
       anon_sym_false,
-    ACTIONS(43), 2,
+    ACTIONS(45), 2,
       sym_at_variable,
       sym_number,
-    STATE(28), 2,
+    STATE(20), 2,
       sym_builtin_keyword,
       sym_type_keyword,
-    ACTIONS(31), 6,
+    ACTIONS(35), 6,
       anon_sym_CurrentDate,
       anon_sym_Device,
       anon_sym_RepeatIndex,
       anon_sym_RepeatItem,
       anon_sym_ShortcutInput,
       anon_sym_Ask,
-    ACTIONS(33), 8,
+    ACTIONS(37), 8,
       anon_sym_text,
       anon_sym_number,
       anon_sym_bool,
@@ -7516,7 +7802,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_variable,
       anon_sym_color,
       anon_sym_float,
-    STATE(17), 8,
+    STATE(19), 8,
       sym_dictionary,
       sym_boolean,
       sym_builtin_constant,
@@ -7525,7 +7811,8 @@ static const uint16_t ts_small_parse_table[] = {
       sym_call,
       sym_string,
       sym_single_quoted_string,
-    ACTIONS(29), 16,
+    ACTIONS(33), 16,
+      anon_sym_action,
       anon_sym_name,
       anon_sym_glyph,
       anon_sym_from,
@@ -7536,48 +7823,47 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
       anon_sym_embedFile,
       anon_sym_nothing,
-  [1977] = 16,
+  [2109] = 16,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(35), 1,
+    ACTIONS(27), 1,
       anon_sym_LPAREN,
-    ACTIONS(39), 1,
-      anon_sym_DQUOTE,
     ACTIONS(41), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(43), 1,
       anon_sym_SQUOTE,
-    ACTIONS(277), 1,
+    ACTIONS(294), 1,
       sym_identifier,
-    ACTIONS(279), 1,
+    ACTIONS(302), 1,
       anon_sym_LBRACE,
-    STATE(27), 1,
+    STATE(44), 1,
       sym__expression,
-    STATE(75), 1,
+    STATE(78), 1,
       sym_comment,
-    ACTIONS(27), 2,
+    ACTIONS(31), 2,
       anon_sym_true,
       anon_sym_false,
-    ACTIONS(43), 2,
+    ACTIONS(45), 2,
       sym_at_variable,
       sym_number,
-    STATE(28), 2,
+    STATE(20), 2,
       sym_builtin_keyword,
       sym_type_keyword,
-    ACTIONS(31), 6,
+    ACTIONS(35), 6,
       anon_sym_CurrentDate,
       anon_sym_Device,
       anon_sym_RepeatIndex,
       anon_sym_RepeatItem,
       anon_sym_ShortcutInput,
       anon_sym_Ask,
-    ACTIONS(33), 8,
+    ACTIONS(37), 8,
       anon_sym_text,
       anon_sym_number,
       anon_sym_bool,
@@ -7586,7 +7872,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_variable,
       anon_sym_color,
       anon_sym_float,
-    STATE(17), 8,
+    STATE(19), 8,
       sym_dictionary,
       sym_boolean,
       sym_builtin_constant,
@@ -7595,7 +7881,8 @@ static const uint16_t ts_small_parse_table[] = {
       sym_call,
       sym_string,
       sym_single_quoted_string,
-    ACTIONS(29), 16,
+    ACTIONS(33), 16,
+      anon_sym_action,
       anon_sym_name,
       anon_sym_glyph,
       anon_sym_from,
@@ -7606,48 +7893,47 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
       anon_sym_embedFile,
       anon_sym_nothing,
-  [2063] = 16,
+  [2195] = 16,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(35), 1,
+    ACTIONS(27), 1,
       anon_sym_LPAREN,
-    ACTIONS(39), 1,
-      anon_sym_DQUOTE,
     ACTIONS(41), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(43), 1,
       anon_sym_SQUOTE,
-    ACTIONS(277), 1,
+    ACTIONS(294), 1,
       sym_identifier,
-    ACTIONS(279), 1,
+    ACTIONS(302), 1,
       anon_sym_LBRACE,
-    STATE(76), 1,
-      sym_comment,
-    STATE(91), 1,
+    STATE(29), 1,
       sym__expression,
-    ACTIONS(27), 2,
+    STATE(79), 1,
+      sym_comment,
+    ACTIONS(31), 2,
       anon_sym_true,
       anon_sym_false,
-    ACTIONS(43), 2,
+    ACTIONS(45), 2,
       sym_at_variable,
       sym_number,
-    STATE(28), 2,
+    STATE(20), 2,
       sym_builtin_keyword,
       sym_type_keyword,
-    ACTIONS(31), 6,
+    ACTIONS(35), 6,
       anon_sym_CurrentDate,
       anon_sym_Device,
       anon_sym_RepeatIndex,
       anon_sym_RepeatItem,
       anon_sym_ShortcutInput,
       anon_sym_Ask,
-    ACTIONS(33), 8,
+    ACTIONS(37), 8,
       anon_sym_text,
       anon_sym_number,
       anon_sym_bool,
@@ -7656,7 +7942,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_variable,
       anon_sym_color,
       anon_sym_float,
-    STATE(17), 8,
+    STATE(19), 8,
       sym_dictionary,
       sym_boolean,
       sym_builtin_constant,
@@ -7665,7 +7951,8 @@ static const uint16_t ts_small_parse_table[] = {
       sym_call,
       sym_string,
       sym_single_quoted_string,
-    ACTIONS(29), 16,
+    ACTIONS(33), 16,
+      anon_sym_action,
       anon_sym_name,
       anon_sym_glyph,
       anon_sym_from,
@@ -7676,48 +7963,47 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
       anon_sym_embedFile,
       anon_sym_nothing,
-  [2149] = 16,
+  [2281] = 16,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(35), 1,
+    ACTIONS(27), 1,
       anon_sym_LPAREN,
-    ACTIONS(39), 1,
-      anon_sym_DQUOTE,
     ACTIONS(41), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(43), 1,
       anon_sym_SQUOTE,
-    ACTIONS(277), 1,
+    ACTIONS(294), 1,
       sym_identifier,
-    ACTIONS(279), 1,
+    ACTIONS(302), 1,
       anon_sym_LBRACE,
-    STATE(5), 1,
+    STATE(30), 1,
       sym__expression,
-    STATE(77), 1,
+    STATE(80), 1,
       sym_comment,
-    ACTIONS(27), 2,
+    ACTIONS(31), 2,
       anon_sym_true,
       anon_sym_false,
-    ACTIONS(43), 2,
+    ACTIONS(45), 2,
       sym_at_variable,
       sym_number,
-    STATE(28), 2,
+    STATE(20), 2,
       sym_builtin_keyword,
       sym_type_keyword,
-    ACTIONS(31), 6,
+    ACTIONS(35), 6,
       anon_sym_CurrentDate,
       anon_sym_Device,
       anon_sym_RepeatIndex,
       anon_sym_RepeatItem,
       anon_sym_ShortcutInput,
       anon_sym_Ask,
-    ACTIONS(33), 8,
+    ACTIONS(37), 8,
       anon_sym_text,
       anon_sym_number,
       anon_sym_bool,
@@ -7726,7 +8012,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_variable,
       anon_sym_color,
       anon_sym_float,
-    STATE(17), 8,
+    STATE(19), 8,
       sym_dictionary,
       sym_boolean,
       sym_builtin_constant,
@@ -7735,7 +8021,8 @@ static const uint16_t ts_small_parse_table[] = {
       sym_call,
       sym_string,
       sym_single_quoted_string,
-    ACTIONS(29), 16,
+    ACTIONS(33), 16,
+      anon_sym_action,
       anon_sym_name,
       anon_sym_glyph,
       anon_sym_from,
@@ -7746,48 +8033,47 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
       anon_sym_embedFile,
       anon_sym_nothing,
-  [2235] = 16,
+  [2367] = 16,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(35), 1,
+    ACTIONS(27), 1,
       anon_sym_LPAREN,
-    ACTIONS(39), 1,
-      anon_sym_DQUOTE,
     ACTIONS(41), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(43), 1,
       anon_sym_SQUOTE,
-    ACTIONS(277), 1,
+    ACTIONS(294), 1,
       sym_identifier,
-    ACTIONS(279), 1,
+    ACTIONS(302), 1,
       anon_sym_LBRACE,
-    STATE(15), 1,
+    STATE(31), 1,
       sym__expression,
-    STATE(78), 1,
+    STATE(81), 1,
       sym_comment,
-    ACTIONS(27), 2,
+    ACTIONS(31), 2,
       anon_sym_true,
       anon_sym_false,
-    ACTIONS(43), 2,
+    ACTIONS(45), 2,
       sym_at_variable,
       sym_number,
-    STATE(28), 2,
+    STATE(20), 2,
       sym_builtin_keyword,
       sym_type_keyword,
-    ACTIONS(31), 6,
+    ACTIONS(35), 6,
       anon_sym_CurrentDate,
       anon_sym_Device,
       anon_sym_RepeatIndex,
       anon_sym_RepeatItem,
       anon_sym_ShortcutInput,
       anon_sym_Ask,
-    ACTIONS(33), 8,
+    ACTIONS(37), 8,
       anon_sym_text,
       anon_sym_number,
       anon_sym_bool,
@@ -7796,7 +8082,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_variable,
       anon_sym_color,
       anon_sym_float,
-    STATE(17), 8,
+    STATE(19), 8,
       sym_dictionary,
       sym_boolean,
       sym_builtin_constant,
@@ -7805,7 +8091,8 @@ static const uint16_t ts_small_parse_table[] = {
       sym_call,
       sym_string,
       sym_single_quoted_string,
-    ACTIONS(29), 16,
+    ACTIONS(33), 16,
+      anon_sym_action,
       anon_sym_name,
       anon_sym_glyph,
       anon_sym_from,
@@ -7816,48 +8103,47 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
       anon_sym_embedFile,
       anon_sym_nothing,
-  [2321] = 16,
+  [2453] = 16,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(35), 1,
+    ACTIONS(27), 1,
       anon_sym_LPAREN,
-    ACTIONS(39), 1,
-      anon_sym_DQUOTE,
     ACTIONS(41), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(43), 1,
       anon_sym_SQUOTE,
-    ACTIONS(277), 1,
+    ACTIONS(294), 1,
       sym_identifier,
-    ACTIONS(279), 1,
+    ACTIONS(302), 1,
       anon_sym_LBRACE,
-    STATE(43), 1,
+    STATE(32), 1,
       sym__expression,
-    STATE(79), 1,
+    STATE(82), 1,
       sym_comment,
-    ACTIONS(27), 2,
+    ACTIONS(31), 2,
       anon_sym_true,
       anon_sym_false,
-    ACTIONS(43), 2,
+    ACTIONS(45), 2,
       sym_at_variable,
       sym_number,
-    STATE(28), 2,
+    STATE(20), 2,
       sym_builtin_keyword,
       sym_type_keyword,
-    ACTIONS(31), 6,
+    ACTIONS(35), 6,
       anon_sym_CurrentDate,
       anon_sym_Device,
       anon_sym_RepeatIndex,
       anon_sym_RepeatItem,
       anon_sym_ShortcutInput,
       anon_sym_Ask,
-    ACTIONS(33), 8,
+    ACTIONS(37), 8,
       anon_sym_text,
       anon_sym_number,
       anon_sym_bool,
@@ -7866,7 +8152,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_variable,
       anon_sym_color,
       anon_sym_float,
-    STATE(17), 8,
+    STATE(19), 8,
       sym_dictionary,
       sym_boolean,
       sym_builtin_constant,
@@ -7875,7 +8161,8 @@ static const uint16_t ts_small_parse_table[] = {
       sym_call,
       sym_string,
       sym_single_quoted_string,
-    ACTIONS(29), 16,
+    ACTIONS(33), 16,
+      anon_sym_action,
       anon_sym_name,
       anon_sym_glyph,
       anon_sym_from,
@@ -7886,48 +8173,47 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
       anon_sym_embedFile,
       anon_sym_nothing,
-  [2407] = 16,
+  [2539] = 16,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(35), 1,
+    ACTIONS(27), 1,
       anon_sym_LPAREN,
-    ACTIONS(39), 1,
-      anon_sym_DQUOTE,
     ACTIONS(41), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(43), 1,
       anon_sym_SQUOTE,
-    ACTIONS(277), 1,
+    ACTIONS(294), 1,
       sym_identifier,
-    ACTIONS(279), 1,
+    ACTIONS(302), 1,
       anon_sym_LBRACE,
-    STATE(29), 1,
+    STATE(45), 1,
       sym__expression,
-    STATE(80), 1,
+    STATE(83), 1,
       sym_comment,
-    ACTIONS(27), 2,
+    ACTIONS(31), 2,
       anon_sym_true,
       anon_sym_false,
-    ACTIONS(43), 2,
+    ACTIONS(45), 2,
       sym_at_variable,
       sym_number,
-    STATE(28), 2,
+    STATE(20), 2,
       sym_builtin_keyword,
       sym_type_keyword,
-    ACTIONS(31), 6,
+    ACTIONS(35), 6,
       anon_sym_CurrentDate,
       anon_sym_Device,
       anon_sym_RepeatIndex,
       anon_sym_RepeatItem,
       anon_sym_ShortcutInput,
       anon_sym_Ask,
-    ACTIONS(33), 8,
+    ACTIONS(37), 8,
       anon_sym_text,
       anon_sym_number,
       anon_sym_bool,
@@ -7936,7 +8222,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_variable,
       anon_sym_color,
       anon_sym_float,
-    STATE(17), 8,
+    STATE(19), 8,
       sym_dictionary,
       sym_boolean,
       sym_builtin_constant,
@@ -7945,7 +8231,8 @@ static const uint16_t ts_small_parse_table[] = {
       sym_call,
       sym_string,
       sym_single_quoted_string,
-    ACTIONS(29), 16,
+    ACTIONS(33), 16,
+      anon_sym_action,
       anon_sym_name,
       anon_sym_glyph,
       anon_sym_from,
@@ -7956,48 +8243,47 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
       anon_sym_embedFile,
       anon_sym_nothing,
-  [2493] = 16,
+  [2625] = 16,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(35), 1,
+    ACTIONS(27), 1,
       anon_sym_LPAREN,
-    ACTIONS(39), 1,
-      anon_sym_DQUOTE,
     ACTIONS(41), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(43), 1,
       anon_sym_SQUOTE,
-    ACTIONS(277), 1,
+    ACTIONS(294), 1,
       sym_identifier,
-    ACTIONS(279), 1,
+    ACTIONS(302), 1,
       anon_sym_LBRACE,
-    STATE(25), 1,
+    STATE(4), 1,
       sym__expression,
-    STATE(81), 1,
+    STATE(84), 1,
       sym_comment,
-    ACTIONS(27), 2,
+    ACTIONS(31), 2,
       anon_sym_true,
       anon_sym_false,
-    ACTIONS(43), 2,
+    ACTIONS(45), 2,
       sym_at_variable,
       sym_number,
-    STATE(28), 2,
+    STATE(20), 2,
       sym_builtin_keyword,
       sym_type_keyword,
-    ACTIONS(31), 6,
+    ACTIONS(35), 6,
       anon_sym_CurrentDate,
       anon_sym_Device,
       anon_sym_RepeatIndex,
       anon_sym_RepeatItem,
       anon_sym_ShortcutInput,
       anon_sym_Ask,
-    ACTIONS(33), 8,
+    ACTIONS(37), 8,
       anon_sym_text,
       anon_sym_number,
       anon_sym_bool,
@@ -8006,7 +8292,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_variable,
       anon_sym_color,
       anon_sym_float,
-    STATE(17), 8,
+    STATE(19), 8,
       sym_dictionary,
       sym_boolean,
       sym_builtin_constant,
@@ -8015,7 +8301,8 @@ static const uint16_t ts_small_parse_table[] = {
       sym_call,
       sym_string,
       sym_single_quoted_string,
-    ACTIONS(29), 16,
+    ACTIONS(33), 16,
+      anon_sym_action,
       anon_sym_name,
       anon_sym_glyph,
       anon_sym_from,
@@ -8026,48 +8313,47 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
       anon_sym_embedFile,
       anon_sym_nothing,
-  [2579] = 16,
+  [2711] = 16,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(35), 1,
+    ACTIONS(27), 1,
       anon_sym_LPAREN,
-    ACTIONS(39), 1,
-      anon_sym_DQUOTE,
     ACTIONS(41), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(43), 1,
       anon_sym_SQUOTE,
-    ACTIONS(277), 1,
+    ACTIONS(294), 1,
       sym_identifier,
-    ACTIONS(279), 1,
+    ACTIONS(302), 1,
       anon_sym_LBRACE,
-    STATE(4), 1,
+    STATE(5), 1,
       sym__expression,
-    STATE(82), 1,
+    STATE(85), 1,
       sym_comment,
-    ACTIONS(27), 2,
+    ACTIONS(31), 2,
       anon_sym_true,
       anon_sym_false,
-    ACTIONS(43), 2,
+    ACTIONS(45), 2,
       sym_at_variable,
       sym_number,
-    STATE(28), 2,
+    STATE(20), 2,
       sym_builtin_keyword,
       sym_type_keyword,
-    ACTIONS(31), 6,
+    ACTIONS(35), 6,
       anon_sym_CurrentDate,
       anon_sym_Device,
       anon_sym_RepeatIndex,
       anon_sym_RepeatItem,
       anon_sym_ShortcutInput,
       anon_sym_Ask,
-    ACTIONS(33), 8,
+    ACTIONS(37), 8,
       anon_sym_text,
       anon_sym_number,
       anon_sym_bool,
@@ -8076,7 +8362,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_variable,
       anon_sym_color,
       anon_sym_float,
-    STATE(17), 8,
+    STATE(19), 8,
       sym_dictionary,
       sym_boolean,
       sym_builtin_constant,
@@ -8085,7 +8371,8 @@ static const uint16_t ts_small_parse_table[] = {
       sym_call,
       sym_string,
       sym_single_quoted_string,
-    ACTIONS(29), 16,
+    ACTIONS(33), 16,
+      anon_sym_action,
       anon_sym_name,
       anon_sym_glyph,
       anon_sym_from,
@@ -8096,48 +8383,47 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
       anon_sym_embedFile,
       anon_sym_nothing,
-  [2665] = 16,
+  [2797] = 16,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(35), 1,
+    ACTIONS(27), 1,
       anon_sym_LPAREN,
-    ACTIONS(39), 1,
-      anon_sym_DQUOTE,
     ACTIONS(41), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(43), 1,
       anon_sym_SQUOTE,
-    ACTIONS(277), 1,
+    ACTIONS(294), 1,
       sym_identifier,
-    ACTIONS(279), 1,
+    ACTIONS(302), 1,
       anon_sym_LBRACE,
-    STATE(83), 1,
+    STATE(86), 1,
       sym_comment,
-    STATE(89), 1,
+    STATE(93), 1,
       sym__expression,
-    ACTIONS(27), 2,
+    ACTIONS(31), 2,
       anon_sym_true,
       anon_sym_false,
-    ACTIONS(43), 2,
+    ACTIONS(45), 2,
       sym_at_variable,
       sym_number,
-    STATE(28), 2,
+    STATE(20), 2,
       sym_builtin_keyword,
       sym_type_keyword,
-    ACTIONS(31), 6,
+    ACTIONS(35), 6,
       anon_sym_CurrentDate,
       anon_sym_Device,
       anon_sym_RepeatIndex,
       anon_sym_RepeatItem,
       anon_sym_ShortcutInput,
       anon_sym_Ask,
-    ACTIONS(33), 8,
+    ACTIONS(37), 8,
       anon_sym_text,
       anon_sym_number,
       anon_sym_bool,
@@ -8146,7 +8432,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_variable,
       anon_sym_color,
       anon_sym_float,
-    STATE(17), 8,
+    STATE(19), 8,
       sym_dictionary,
       sym_boolean,
       sym_builtin_constant,
@@ -8155,7 +8441,8 @@ static const uint16_t ts_small_parse_table[] = {
       sym_call,
       sym_string,
       sym_single_quoted_string,
-    ACTIONS(29), 16,
+    ACTIONS(33), 16,
+      anon_sym_action,
       anon_sym_name,
       anon_sym_glyph,
       anon_sym_from,
@@ -8166,48 +8453,47 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
       anon_sym_embedFile,
       anon_sym_nothing,
-  [2751] = 16,
+  [2883] = 16,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(35), 1,
+    ACTIONS(27), 1,
       anon_sym_LPAREN,
-    ACTIONS(39), 1,
-      anon_sym_DQUOTE,
     ACTIONS(41), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(43), 1,
       anon_sym_SQUOTE,
-    ACTIONS(277), 1,
+    ACTIONS(294), 1,
       sym_identifier,
-    ACTIONS(279), 1,
+    ACTIONS(302), 1,
       anon_sym_LBRACE,
-    STATE(84), 1,
+    STATE(87), 1,
       sym_comment,
-    STATE(92), 1,
+    STATE(94), 1,
       sym__expression,
-    ACTIONS(27), 2,
+    ACTIONS(31), 2,
       anon_sym_true,
       anon_sym_false,
-    ACTIONS(43), 2,
+    ACTIONS(45), 2,
       sym_at_variable,
       sym_number,
-    STATE(28), 2,
+    STATE(20), 2,
       sym_builtin_keyword,
       sym_type_keyword,
-    ACTIONS(31), 6,
+    ACTIONS(35), 6,
       anon_sym_CurrentDate,
       anon_sym_Device,
       anon_sym_RepeatIndex,
       anon_sym_RepeatItem,
       anon_sym_ShortcutInput,
       anon_sym_Ask,
-    ACTIONS(33), 8,
+    ACTIONS(37), 8,
       anon_sym_text,
       anon_sym_number,
       anon_sym_bool,
@@ -8216,7 +8502,7 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_variable,
       anon_sym_color,
       anon_sym_float,
-    STATE(17), 8,
+    STATE(19), 8,
       sym_dictionary,
       sym_boolean,
       sym_builtin_constant,
@@ -8225,7 +8511,8 @@ static const uint16_t ts_small_parse_table[] = {
       sym_call,
       sym_string,
       sym_single_quoted_string,
-    ACTIONS(29), 16,
+    ACTIONS(33), 16,
+      anon_sym_action,
       anon_sym_name,
       anon_sym_glyph,
       anon_sym_from,
@@ -8236,31 +8523,30 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
       anon_sym_embedFile,
       anon_sym_nothing,
-  [2837] = 9,
+  [2969] = 9,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(39), 1,
-      anon_sym_DQUOTE,
     ACTIONS(41), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(43), 1,
       anon_sym_SQUOTE,
-    ACTIONS(289), 1,
+    ACTIONS(304), 1,
       sym_identifier,
-    STATE(85), 1,
+    STATE(88), 1,
       sym_comment,
-    STATE(50), 4,
+    STATE(52), 4,
       sym_builtin_keyword,
       sym_type_keyword,
       sym_string,
       sym_single_quoted_string,
-    ACTIONS(33), 8,
+    ACTIONS(37), 8,
       anon_sym_text,
       anon_sym_number,
       anon_sym_bool,
@@ -8269,7 +8555,8 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_variable,
       anon_sym_color,
       anon_sym_float,
-    ACTIONS(29), 16,
+    ACTIONS(33), 16,
+      anon_sym_action,
       anon_sym_name,
       anon_sym_glyph,
       anon_sym_from,
@@ -8280,23 +8567,23 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
       anon_sym_embedFile,
       anon_sym_nothing,
-  [2890] = 5,
+  [3022] = 5,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    STATE(86), 1,
+    STATE(89), 1,
       sym_comment,
-    ACTIONS(293), 2,
+    ACTIONS(308), 2,
       anon_sym_DQUOTE,
       anon_sym_SQUOTE,
-    ACTIONS(291), 25,
+    ACTIONS(306), 25,
+      anon_sym_action,
       anon_sym_name,
       anon_sym_glyph,
       anon_sym_from,
@@ -8307,7 +8594,6 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_getclipboard,
       anon_sym_list,
       anon_sym_nil,
-      anon_sym_action,
       anon_sym_stop,
       anon_sym_makeVCard,
       anon_sym_rawAction,
@@ -8322,176 +8608,222 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_color,
       anon_sym_float,
       sym_identifier,
-  [2931] = 12,
+  [3063] = 12,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(49), 1,
-      anon_sym_STAR,
     ACTIONS(51), 1,
+      anon_sym_STAR,
+    ACTIONS(53), 1,
       anon_sym_SLASH,
-    ACTIONS(295), 1,
+    ACTIONS(310), 1,
       anon_sym_COMMA,
-    ACTIONS(297), 1,
+    ACTIONS(312), 1,
       anon_sym_RPAREN,
-    STATE(87), 1,
+    STATE(90), 1,
       sym_comment,
-    STATE(115), 1,
+    STATE(124), 1,
       aux_sym_call_repeat1,
-    ACTIONS(53), 2,
+    ACTIONS(55), 2,
       anon_sym_PLUS,
       anon_sym_DASH,
-    ACTIONS(55), 2,
+    ACTIONS(57), 2,
       anon_sym_EQ_EQ,
       anon_sym_BANG_EQ,
-    ACTIONS(57), 2,
+    ACTIONS(59), 2,
       anon_sym_LT,
       anon_sym_GT,
-    ACTIONS(59), 2,
+    ACTIONS(61), 2,
       anon_sym_LT_EQ,
       anon_sym_GT_EQ,
-  [2972] = 11,
+  [3104] = 11,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(49), 1,
-      anon_sym_STAR,
     ACTIONS(51), 1,
+      anon_sym_STAR,
+    ACTIONS(53), 1,
       anon_sym_SLASH,
-    ACTIONS(299), 1,
+    ACTIONS(314), 1,
       anon_sym_LBRACE,
-    STATE(51), 1,
+    STATE(57), 1,
       sym_block,
-    STATE(88), 1,
+    STATE(91), 1,
       sym_comment,
-    ACTIONS(53), 2,
+    ACTIONS(55), 2,
       anon_sym_PLUS,
       anon_sym_DASH,
-    ACTIONS(55), 2,
+    ACTIONS(57), 2,
       anon_sym_EQ_EQ,
       anon_sym_BANG_EQ,
-    ACTIONS(57), 2,
+    ACTIONS(59), 2,
       anon_sym_LT,
       anon_sym_GT,
-    ACTIONS(59), 2,
+    ACTIONS(61), 2,
       anon_sym_LT_EQ,
       anon_sym_GT_EQ,
-  [3010] = 10,
+  [3142] = 8,
+    ACTIONS(3), 1,
+      anon_sym_SLASH_SLASH,
+    ACTIONS(5), 1,
+      anon_sym_SLASH_STAR,
+    ACTIONS(316), 1,
+      anon_sym_RPAREN,
+    ACTIONS(318), 1,
+      sym_identifier,
+    STATE(92), 1,
+      sym_comment,
+    STATE(121), 1,
+      sym_parameter,
+    STATE(144), 1,
+      sym_type_keyword,
+    ACTIONS(37), 8,
+      anon_sym_text,
+      anon_sym_number,
+      anon_sym_bool,
+      anon_sym_dictionary,
+      anon_sym_array,
+      anon_sym_variable,
+      anon_sym_color,
+      anon_sym_float,
+  [3174] = 10,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(49), 1,
-      anon_sym_STAR,
     ACTIONS(51), 1,
+      anon_sym_STAR,
+    ACTIONS(53), 1,
       anon_sym_SLASH,
-    STATE(89), 1,
+    STATE(93), 1,
       sym_comment,
-    ACTIONS(53), 2,
+    ACTIONS(55), 2,
       anon_sym_PLUS,
       anon_sym_DASH,
-    ACTIONS(55), 2,
+    ACTIONS(57), 2,
       anon_sym_EQ_EQ,
       anon_sym_BANG_EQ,
-    ACTIONS(57), 2,
+    ACTIONS(59), 2,
       anon_sym_LT,
       anon_sym_GT,
-    ACTIONS(59), 2,
+    ACTIONS(61), 2,
       anon_sym_LT_EQ,
       anon_sym_GT_EQ,
-    ACTIONS(301), 2,
-      anon_sym_RBRACE,
+    ACTIONS(320), 2,
       anon_sym_COMMA,
-  [3046] = 10,
+      anon_sym_RBRACE,
+  [3210] = 10,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(49), 1,
-      anon_sym_STAR,
     ACTIONS(51), 1,
+      anon_sym_STAR,
+    ACTIONS(53), 1,
       anon_sym_SLASH,
-    STATE(90), 1,
+    STATE(94), 1,
       sym_comment,
-    ACTIONS(53), 2,
+    ACTIONS(55), 2,
       anon_sym_PLUS,
       anon_sym_DASH,
-    ACTIONS(55), 2,
+    ACTIONS(57), 2,
       anon_sym_EQ_EQ,
       anon_sym_BANG_EQ,
-    ACTIONS(57), 2,
+    ACTIONS(59), 2,
       anon_sym_LT,
       anon_sym_GT,
-    ACTIONS(59), 2,
+    ACTIONS(61), 2,
       anon_sym_LT_EQ,
       anon_sym_GT_EQ,
-    ACTIONS(303), 2,
+    ACTIONS(322), 2,
       anon_sym_COMMA,
       anon_sym_RPAREN,
-  [3082] = 10,
+  [3246] = 10,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(49), 1,
-      anon_sym_STAR,
     ACTIONS(51), 1,
+      anon_sym_STAR,
+    ACTIONS(53), 1,
       anon_sym_SLASH,
-    ACTIONS(305), 1,
-      anon_sym_RPAREN,
-    STATE(91), 1,
+    ACTIONS(324), 1,
+      anon_sym_COLON,
+    STATE(95), 1,
       sym_comment,
-    ACTIONS(53), 2,
+    ACTIONS(55), 2,
       anon_sym_PLUS,
       anon_sym_DASH,
-    ACTIONS(55), 2,
+    ACTIONS(57), 2,
       anon_sym_EQ_EQ,
       anon_sym_BANG_EQ,
-    ACTIONS(57), 2,
+    ACTIONS(59), 2,
       anon_sym_LT,
       anon_sym_GT,
-    ACTIONS(59), 2,
+    ACTIONS(61), 2,
       anon_sym_LT_EQ,
       anon_sym_GT_EQ,
-  [3117] = 10,
+  [3281] = 10,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(49), 1,
-      anon_sym_STAR,
     ACTIONS(51), 1,
+      anon_sym_STAR,
+    ACTIONS(53), 1,
       anon_sym_SLASH,
-    ACTIONS(307), 1,
-      anon_sym_COLON,
-    STATE(92), 1,
+    ACTIONS(326), 1,
+      anon_sym_RPAREN,
+    STATE(96), 1,
       sym_comment,
-    ACTIONS(53), 2,
+    ACTIONS(55), 2,
       anon_sym_PLUS,
       anon_sym_DASH,
-    ACTIONS(55), 2,
+    ACTIONS(57), 2,
       anon_sym_EQ_EQ,
       anon_sym_BANG_EQ,
-    ACTIONS(57), 2,
+    ACTIONS(59), 2,
       anon_sym_LT,
       anon_sym_GT,
-    ACTIONS(59), 2,
+    ACTIONS(61), 2,
       anon_sym_LT_EQ,
       anon_sym_GT_EQ,
-  [3152] = 6,
+  [3316] = 7,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(309), 1,
+    ACTIONS(318), 1,
       sym_identifier,
-    STATE(56), 1,
+    STATE(97), 1,
+      sym_comment,
+    STATE(135), 1,
+      sym_parameter,
+    STATE(144), 1,
       sym_type_keyword,
-    STATE(93), 1,
+    ACTIONS(37), 8,
+      anon_sym_text,
+      anon_sym_number,
+      anon_sym_bool,
+      anon_sym_dictionary,
+      anon_sym_array,
+      anon_sym_variable,
+      anon_sym_color,
+      anon_sym_float,
+  [3345] = 6,
+    ACTIONS(3), 1,
+      anon_sym_SLASH_SLASH,
+    ACTIONS(5), 1,
+      anon_sym_SLASH_STAR,
+    ACTIONS(328), 1,
+      sym_identifier,
+    STATE(59), 1,
+      sym_type_keyword,
+    STATE(98), 1,
       sym_comment,
-    ACTIONS(33), 8,
+    ACTIONS(37), 8,
       anon_sym_text,
       anon_sym_number,
       anon_sym_bool,
@@ -8500,710 +8832,901 @@ static const uint16_t ts_small_parse_table[] = {
       anon_sym_variable,
       anon_sym_color,
       anon_sym_float,
-  [3178] = 7,
-    ACTIONS(311), 1,
+  [3371] = 6,
+    ACTIONS(3), 1,
+      anon_sym_SLASH_SLASH,
+    ACTIONS(5), 1,
+      anon_sym_SLASH_STAR,
+    ACTIONS(330), 1,
+      sym_identifier,
+    STATE(99), 1,
+      sym_comment,
+    STATE(134), 1,
+      sym_type_keyword,
+    ACTIONS(37), 8,
+      anon_sym_text,
+      anon_sym_number,
+      anon_sym_bool,
+      anon_sym_dictionary,
+      anon_sym_array,
+      anon_sym_variable,
+      anon_sym_color,
+      anon_sym_float,
+  [3397] = 8,
+    ACTIONS(332), 1,
       anon_sym_LBRACE,
-    ACTIONS(314), 1,
+    ACTIONS(334), 1,
       anon_sym_DQUOTE,
-    ACTIONS(319), 1,
+    ACTIONS(338), 1,
       anon_sym_SLASH_SLASH,
-    ACTIONS(321), 1,
+    ACTIONS(340), 1,
       anon_sym_SLASH_STAR,
+    STATE(100), 1,
+      sym_comment,
     STATE(101), 1,
+      aux_sym_string_repeat1,
+    STATE(107), 1,
       sym_interpolation,
-    ACTIONS(316), 2,
+    ACTIONS(336), 2,
       sym_string_content,
       sym_escape_sequence,
-    STATE(94), 2,
-      sym_comment,
-      aux_sym_string_repeat1,
-  [3202] = 8,
-    ACTIONS(319), 1,
+  [3423] = 8,
+    ACTIONS(332), 1,
+      anon_sym_LBRACE,
+    ACTIONS(338), 1,
       anon_sym_SLASH_SLASH,
-    ACTIONS(321), 1,
+    ACTIONS(340), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(323), 1,
-      anon_sym_LBRACE,
-    ACTIONS(325), 1,
+    ACTIONS(342), 1,
       anon_sym_DQUOTE,
-    STATE(94), 1,
-      aux_sym_string_repeat1,
-    STATE(95), 1,
-      sym_comment,
     STATE(101), 1,
+      sym_comment,
+    STATE(102), 1,
+      aux_sym_string_repeat1,
+    STATE(107), 1,
       sym_interpolation,
-    ACTIONS(327), 2,
+    ACTIONS(336), 2,
       sym_string_content,
       sym_escape_sequence,
-  [3228] = 8,
-    ACTIONS(319), 1,
+  [3449] = 7,
+    ACTIONS(338), 1,
       anon_sym_SLASH_SLASH,
-    ACTIONS(321), 1,
+    ACTIONS(340), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(323), 1,
+    ACTIONS(344), 1,
       anon_sym_LBRACE,
-    ACTIONS(329), 1,
+    ACTIONS(347), 1,
       anon_sym_DQUOTE,
-    STATE(95), 1,
-      aux_sym_string_repeat1,
-    STATE(96), 1,
-      sym_comment,
-    STATE(101), 1,
+    STATE(107), 1,
       sym_interpolation,
-    ACTIONS(327), 2,
+    ACTIONS(349), 2,
       sym_string_content,
       sym_escape_sequence,
-  [3254] = 8,
-    ACTIONS(3), 1,
-      anon_sym_SLASH_SLASH,
-    ACTIONS(5), 1,
-      anon_sym_SLASH_STAR,
-    ACTIONS(39), 1,
-      anon_sym_DQUOTE,
-    ACTIONS(331), 1,
-      sym_identifier,
-    ACTIONS(333), 1,
-      anon_sym_RBRACE,
-    STATE(97), 1,
+    STATE(102), 2,
       sym_comment,
-    STATE(112), 1,
-      sym_dictionary_pair,
-    STATE(122), 1,
-      sym_string,
-  [3279] = 7,
+      aux_sym_string_repeat1,
+  [3473] = 8,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(39), 1,
+    ACTIONS(41), 1,
       anon_sym_DQUOTE,
-    ACTIONS(331), 1,
+    ACTIONS(352), 1,
+      anon_sym_RBRACE,
+    ACTIONS(354), 1,
       sym_identifier,
-    STATE(98), 1,
+    STATE(103), 1,
       sym_comment,
-    STATE(118), 1,
+    STATE(115), 1,
       sym_dictionary_pair,
-    STATE(122), 1,
+    STATE(143), 1,
       sym_string,
-  [3301] = 5,
-    ACTIONS(319), 1,
-      anon_sym_SLASH_SLASH,
-    ACTIONS(321), 1,
-      anon_sym_SLASH_STAR,
-    ACTIONS(335), 1,
-      anon_sym_SQUOTE,
-    ACTIONS(337), 2,
-      aux_sym_single_quoted_string_token1,
-      sym_escape_sequence,
-    STATE(99), 2,
-      sym_comment,
-      aux_sym_single_quoted_string_repeat1,
-  [3319] = 5,
-    ACTIONS(319), 1,
+  [3498] = 6,
+    ACTIONS(338), 1,
       anon_sym_SLASH_SLASH,
-    ACTIONS(321), 1,
+    ACTIONS(340), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(343), 1,
+    ACTIONS(358), 1,
       anon_sym_STAR_SLASH,
-    ACTIONS(340), 2,
-      aux_sym_comment_token2,
-      aux_sym_comment_token3,
-    STATE(100), 2,
+    STATE(104), 1,
       sym_comment,
+    STATE(106), 1,
       aux_sym_comment_repeat1,
-  [3337] = 5,
-    ACTIONS(319), 1,
+    ACTIONS(356), 2,
+      aux_sym_comment_token2,
+      aux_sym_comment_token3,
+  [3518] = 6,
+    ACTIONS(338), 1,
       anon_sym_SLASH_SLASH,
-    ACTIONS(321), 1,
+    ACTIONS(340), 1,
       anon_sym_SLASH_STAR,
-    STATE(101), 1,
+    ACTIONS(360), 1,
+      anon_sym_SQUOTE,
+    STATE(105), 1,
       sym_comment,
-    ACTIONS(345), 2,
-      anon_sym_LBRACE,
-      anon_sym_DQUOTE,
-    ACTIONS(347), 2,
-      sym_string_content,
+    STATE(108), 1,
+      aux_sym_single_quoted_string_repeat1,
+    ACTIONS(362), 2,
+      aux_sym_single_quoted_string_token1,
       sym_escape_sequence,
-  [3355] = 6,
-    ACTIONS(319), 1,
+  [3538] = 6,
+    ACTIONS(338), 1,
       anon_sym_SLASH_SLASH,
-    ACTIONS(321), 1,
+    ACTIONS(340), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(351), 1,
+    ACTIONS(364), 1,
       anon_sym_STAR_SLASH,
-    STATE(102), 1,
+    STATE(106), 1,
       sym_comment,
-    STATE(105), 1,
+    STATE(109), 1,
       aux_sym_comment_repeat1,
-    ACTIONS(349), 2,
+    ACTIONS(356), 2,
       aux_sym_comment_token2,
       aux_sym_comment_token3,
-  [3375] = 5,
-    ACTIONS(319), 1,
+  [3558] = 5,
+    ACTIONS(338), 1,
       anon_sym_SLASH_SLASH,
-    ACTIONS(321), 1,
+    ACTIONS(340), 1,
       anon_sym_SLASH_STAR,
-    STATE(103), 1,
+    STATE(107), 1,
       sym_comment,
-    ACTIONS(353), 2,
+    ACTIONS(366), 2,
       anon_sym_LBRACE,
       anon_sym_DQUOTE,
-    ACTIONS(355), 2,
+    ACTIONS(368), 2,
       sym_string_content,
       sym_escape_sequence,
-  [3393] = 6,
-    ACTIONS(319), 1,
+  [3576] = 6,
+    ACTIONS(338), 1,
       anon_sym_SLASH_SLASH,
-    ACTIONS(321), 1,
+    ACTIONS(340), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(357), 1,
+    ACTIONS(370), 1,
       anon_sym_SQUOTE,
-    STATE(99), 1,
-      aux_sym_single_quoted_string_repeat1,
-    STATE(104), 1,
+    STATE(108), 1,
       sym_comment,
-    ACTIONS(359), 2,
+    STATE(112), 1,
+      aux_sym_single_quoted_string_repeat1,
+    ACTIONS(362), 2,
       aux_sym_single_quoted_string_token1,
       sym_escape_sequence,
-  [3413] = 6,
-    ACTIONS(319), 1,
+  [3596] = 5,
+    ACTIONS(338), 1,
       anon_sym_SLASH_SLASH,
-    ACTIONS(321), 1,
+    ACTIONS(340), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(361), 1,
+    ACTIONS(375), 1,
       anon_sym_STAR_SLASH,
-    STATE(100), 1,
-      aux_sym_comment_repeat1,
-    STATE(105), 1,
-      sym_comment,
-    ACTIONS(349), 2,
+    ACTIONS(372), 2,
       aux_sym_comment_token2,
       aux_sym_comment_token3,
-  [3433] = 5,
-    ACTIONS(319), 1,
+    STATE(109), 2,
+      sym_comment,
+      aux_sym_comment_repeat1,
+  [3614] = 7,
+    ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
-    ACTIONS(321), 1,
+    ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    STATE(106), 1,
+    ACTIONS(41), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(354), 1,
+      sym_identifier,
+    STATE(110), 1,
       sym_comment,
-    ACTIONS(363), 2,
+    STATE(131), 1,
+      sym_dictionary_pair,
+    STATE(143), 1,
+      sym_string,
+  [3636] = 5,
+    ACTIONS(338), 1,
+      anon_sym_SLASH_SLASH,
+    ACTIONS(340), 1,
+      anon_sym_SLASH_STAR,
+    STATE(111), 1,
+      sym_comment,
+    ACTIONS(377), 2,
       anon_sym_LBRACE,
       anon_sym_DQUOTE,
-    ACTIONS(365), 2,
+    ACTIONS(379), 2,
       sym_string_content,
       sym_escape_sequence,
-  [3451] = 6,
-    ACTIONS(319), 1,
+  [3654] = 5,
+    ACTIONS(338), 1,
       anon_sym_SLASH_SLASH,
-    ACTIONS(321), 1,
+    ACTIONS(340), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(367), 1,
+    ACTIONS(381), 1,
       anon_sym_SQUOTE,
-    STATE(104), 1,
+    ACTIONS(383), 2,
+      aux_sym_single_quoted_string_token1,
+      sym_escape_sequence,
+    STATE(112), 2,
+      sym_comment,
       aux_sym_single_quoted_string_repeat1,
-    STATE(107), 1,
+  [3672] = 5,
+    ACTIONS(338), 1,
+      anon_sym_SLASH_SLASH,
+    ACTIONS(340), 1,
+      anon_sym_SLASH_STAR,
+    STATE(113), 1,
       sym_comment,
-    ACTIONS(359), 2,
-      aux_sym_single_quoted_string_token1,
+    ACTIONS(386), 2,
+      anon_sym_LBRACE,
+      anon_sym_DQUOTE,
+    ACTIONS(388), 2,
+      sym_string_content,
       sym_escape_sequence,
-  [3471] = 6,
+  [3690] = 4,
+    ACTIONS(338), 1,
+      anon_sym_SLASH_SLASH,
+    ACTIONS(340), 1,
+      anon_sym_SLASH_STAR,
+    STATE(114), 1,
+      sym_comment,
+    ACTIONS(390), 3,
+      aux_sym_comment_token2,
+      aux_sym_comment_token3,
+      anon_sym_STAR_SLASH,
+  [3705] = 6,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(369), 1,
-      anon_sym_RBRACE,
-    ACTIONS(371), 1,
+    ACTIONS(392), 1,
       anon_sym_COMMA,
-    STATE(108), 1,
+    ACTIONS(394), 1,
+      anon_sym_RBRACE,
+    STATE(115), 1,
       sym_comment,
-    STATE(110), 1,
+    STATE(119), 1,
       aux_sym_dictionary_repeat1,
-  [3490] = 6,
-    ACTIONS(319), 1,
+  [3724] = 6,
+    ACTIONS(338), 1,
       anon_sym_SLASH_SLASH,
-    ACTIONS(321), 1,
+    ACTIONS(340), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(373), 1,
+    ACTIONS(396), 1,
       anon_sym_RBRACE,
-    ACTIONS(375), 1,
+    ACTIONS(398), 1,
       aux_sym_interpolation_token1,
-    STATE(109), 1,
+    STATE(116), 1,
       sym_comment,
-    STATE(111), 1,
+    STATE(120), 1,
       aux_sym_interpolation_repeat1,
-  [3509] = 5,
+  [3743] = 5,
+    ACTIONS(338), 1,
+      anon_sym_SLASH_SLASH,
+    ACTIONS(340), 1,
+      anon_sym_SLASH_STAR,
+    ACTIONS(400), 1,
+      anon_sym_SQUOTE,
+    STATE(117), 1,
+      sym_comment,
+    ACTIONS(402), 2,
+      aux_sym_single_quoted_string_token1,
+      sym_escape_sequence,
+  [3760] = 6,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(377), 1,
-      anon_sym_RBRACE,
-    ACTIONS(379), 1,
+    ACTIONS(314), 1,
+      anon_sym_LBRACE,
+    ACTIONS(404), 1,
+      anon_sym_COLON,
+    STATE(63), 1,
+      sym_block,
+    STATE(118), 1,
+      sym_comment,
+  [3779] = 6,
+    ACTIONS(3), 1,
+      anon_sym_SLASH_SLASH,
+    ACTIONS(5), 1,
+      anon_sym_SLASH_STAR,
+    ACTIONS(392), 1,
       anon_sym_COMMA,
-    STATE(110), 2,
+    ACTIONS(406), 1,
+      anon_sym_RBRACE,
+    STATE(119), 1,
       sym_comment,
+    STATE(122), 1,
       aux_sym_dictionary_repeat1,
-  [3526] = 6,
-    ACTIONS(319), 1,
+  [3798] = 6,
+    ACTIONS(338), 1,
       anon_sym_SLASH_SLASH,
-    ACTIONS(321), 1,
+    ACTIONS(340), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(375), 1,
+    ACTIONS(398), 1,
       aux_sym_interpolation_token1,
-    ACTIONS(382), 1,
+    ACTIONS(408), 1,
       anon_sym_RBRACE,
-    STATE(111), 1,
+    STATE(120), 1,
       sym_comment,
-    STATE(113), 1,
+    STATE(123), 1,
       aux_sym_interpolation_repeat1,
-  [3545] = 6,
+  [3817] = 6,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(371), 1,
+    ACTIONS(410), 1,
       anon_sym_COMMA,
-    ACTIONS(384), 1,
-      anon_sym_RBRACE,
-    STATE(108), 1,
-      aux_sym_dictionary_repeat1,
-    STATE(112), 1,
+    ACTIONS(412), 1,
+      anon_sym_RPAREN,
+    STATE(121), 1,
       sym_comment,
-  [3564] = 5,
-    ACTIONS(319), 1,
+    STATE(125), 1,
+      aux_sym_parameters_repeat1,
+  [3836] = 5,
+    ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
-    ACTIONS(321), 1,
+    ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(386), 1,
+    ACTIONS(414), 1,
+      anon_sym_COMMA,
+    ACTIONS(417), 1,
       anon_sym_RBRACE,
-    ACTIONS(388), 1,
-      aux_sym_interpolation_token1,
-    STATE(113), 2,
+    STATE(122), 2,
       sym_comment,
-      aux_sym_interpolation_repeat1,
-  [3581] = 4,
-    ACTIONS(319), 1,
+      aux_sym_dictionary_repeat1,
+  [3853] = 5,
+    ACTIONS(338), 1,
       anon_sym_SLASH_SLASH,
-    ACTIONS(321), 1,
+    ACTIONS(340), 1,
       anon_sym_SLASH_STAR,
-    STATE(114), 1,
+    ACTIONS(419), 1,
+      anon_sym_RBRACE,
+    ACTIONS(421), 1,
+      aux_sym_interpolation_token1,
+    STATE(123), 2,
       sym_comment,
-    ACTIONS(391), 3,
-      aux_sym_comment_token2,
-      aux_sym_comment_token3,
-      anon_sym_STAR_SLASH,
-  [3596] = 6,
+      aux_sym_interpolation_repeat1,
+  [3870] = 6,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(295), 1,
+    ACTIONS(310), 1,
       anon_sym_COMMA,
-    ACTIONS(393), 1,
+    ACTIONS(424), 1,
       anon_sym_RPAREN,
-    STATE(115), 1,
+    STATE(124), 1,
       sym_comment,
-    STATE(117), 1,
+    STATE(126), 1,
       aux_sym_call_repeat1,
-  [3615] = 5,
-    ACTIONS(319), 1,
+  [3889] = 6,
+    ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
-    ACTIONS(321), 1,
+    ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(395), 1,
-      anon_sym_SQUOTE,
-    STATE(116), 1,
+    ACTIONS(410), 1,
+      anon_sym_COMMA,
+    ACTIONS(426), 1,
+      anon_sym_RPAREN,
+    STATE(125), 1,
       sym_comment,
-    ACTIONS(397), 2,
-      aux_sym_single_quoted_string_token1,
-      sym_escape_sequence,
-  [3632] = 5,
+    STATE(127), 1,
+      aux_sym_parameters_repeat1,
+  [3908] = 5,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(303), 1,
+    ACTIONS(322), 1,
       anon_sym_RPAREN,
-    ACTIONS(399), 1,
+    ACTIONS(428), 1,
       anon_sym_COMMA,
-    STATE(117), 2,
+    STATE(126), 2,
       sym_comment,
       aux_sym_call_repeat1,
-  [3649] = 4,
+  [3925] = 5,
+    ACTIONS(3), 1,
+      anon_sym_SLASH_SLASH,
+    ACTIONS(5), 1,
+      anon_sym_SLASH_STAR,
+    ACTIONS(431), 1,
+      anon_sym_COMMA,
+    ACTIONS(434), 1,
+      anon_sym_RPAREN,
+    STATE(127), 2,
+      sym_comment,
+      aux_sym_parameters_repeat1,
+  [3942] = 5,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
+    ACTIONS(436), 1,
+      anon_sym_LPAREN,
     STATE(118), 1,
+      sym_parameters,
+    STATE(128), 1,
       sym_comment,
-    ACTIONS(377), 2,
-      anon_sym_RBRACE,
-      anon_sym_COMMA,
-  [3663] = 4,
-    ACTIONS(319), 1,
+  [3958] = 4,
+    ACTIONS(338), 1,
       anon_sym_SLASH_SLASH,
-    ACTIONS(321), 1,
+    ACTIONS(340), 1,
       anon_sym_SLASH_STAR,
-    STATE(119), 1,
+    STATE(129), 1,
       sym_comment,
-    ACTIONS(402), 2,
+    ACTIONS(438), 2,
       anon_sym_RBRACE,
       aux_sym_interpolation_token1,
-  [3677] = 4,
+  [3972] = 4,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(404), 1,
-      anon_sym_EQ,
-    STATE(120), 1,
+    STATE(130), 1,
       sym_comment,
-  [3690] = 4,
+    ACTIONS(440), 2,
+      anon_sym_COLON,
+      anon_sym_LBRACE,
+  [3986] = 4,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(406), 1,
-      sym_identifier,
-    STATE(121), 1,
+    STATE(131), 1,
       sym_comment,
-  [3703] = 4,
+    ACTIONS(417), 2,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+  [4000] = 4,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(209), 1,
+    STATE(132), 1,
+      sym_comment,
+    ACTIONS(442), 2,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+  [4014] = 4,
+    ACTIONS(3), 1,
+      anon_sym_SLASH_SLASH,
+    ACTIONS(5), 1,
+      anon_sym_SLASH_STAR,
+    STATE(133), 1,
+      sym_comment,
+    ACTIONS(444), 2,
       anon_sym_COLON,
-    STATE(122), 1,
+      anon_sym_LBRACE,
+  [4028] = 5,
+    ACTIONS(3), 1,
+      anon_sym_SLASH_SLASH,
+    ACTIONS(5), 1,
+      anon_sym_SLASH_STAR,
+    ACTIONS(314), 1,
+      anon_sym_LBRACE,
+    STATE(70), 1,
+      sym_block,
+    STATE(134), 1,
       sym_comment,
-  [3716] = 4,
-    ACTIONS(319), 1,
+  [4044] = 4,
+    ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
-    ACTIONS(321), 1,
+    ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(351), 1,
+    STATE(135), 1,
+      sym_comment,
+    ACTIONS(434), 2,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+  [4058] = 4,
+    ACTIONS(3), 1,
+      anon_sym_SLASH_SLASH,
+    ACTIONS(5), 1,
+      anon_sym_SLASH_STAR,
+    STATE(136), 1,
+      sym_comment,
+    ACTIONS(446), 2,
+      anon_sym_COLON,
+      anon_sym_LBRACE,
+  [4072] = 4,
+    ACTIONS(338), 1,
+      anon_sym_SLASH_SLASH,
+    ACTIONS(340), 1,
+      anon_sym_SLASH_STAR,
+    ACTIONS(358), 1,
       aux_sym_comment_token1,
-    STATE(123), 1,
+    STATE(137), 1,
       sym_comment,
-  [3729] = 4,
+  [4085] = 4,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(408), 1,
+    ACTIONS(448), 1,
+      sym_identifier,
+    STATE(138), 1,
+      sym_comment,
+  [4098] = 4,
+    ACTIONS(3), 1,
+      anon_sym_SLASH_SLASH,
+    ACTIONS(5), 1,
+      anon_sym_SLASH_STAR,
+    ACTIONS(450), 1,
+      sym_identifier,
+    STATE(139), 1,
+      sym_comment,
+  [4111] = 4,
+    ACTIONS(3), 1,
+      anon_sym_SLASH_SLASH,
+    ACTIONS(5), 1,
+      anon_sym_SLASH_STAR,
+    ACTIONS(452), 1,
       ts_builtin_sym_end,
-    STATE(124), 1,
+    STATE(140), 1,
       sym_comment,
-  [3742] = 4,
+  [4124] = 4,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(410), 1,
+    ACTIONS(454), 1,
+      anon_sym_EQ,
+    STATE(141), 1,
+      sym_comment,
+  [4137] = 4,
+    ACTIONS(3), 1,
+      anon_sym_SLASH_SLASH,
+    ACTIONS(5), 1,
+      anon_sym_SLASH_STAR,
+    ACTIONS(456), 1,
       anon_sym_in,
-    STATE(125), 1,
+    STATE(142), 1,
       sym_comment,
-  [3755] = 4,
+  [4150] = 4,
     ACTIONS(3), 1,
       anon_sym_SLASH_SLASH,
     ACTIONS(5), 1,
       anon_sym_SLASH_STAR,
-    ACTIONS(412), 1,
+    ACTIONS(216), 1,
+      anon_sym_COLON,
+    STATE(143), 1,
+      sym_comment,
+  [4163] = 4,
+    ACTIONS(3), 1,
+      anon_sym_SLASH_SLASH,
+    ACTIONS(5), 1,
+      anon_sym_SLASH_STAR,
+    ACTIONS(458), 1,
       sym_identifier,
-    STATE(126), 1,
+    STATE(144), 1,
       sym_comment,
-  [3768] = 1,
-    ACTIONS(414), 1,
+  [4176] = 1,
+    ACTIONS(460), 1,
       ts_builtin_sym_end,
-  [3772] = 1,
-    ACTIONS(416), 1,
+  [4180] = 1,
+    ACTIONS(462), 1,
       ts_builtin_sym_end,
 };
 
 static const uint32_t ts_small_parse_table_map[] = {
-  [SMALL_STATE(47)] = 0,
-  [SMALL_STATE(48)] = 68,
-  [SMALL_STATE(49)] = 134,
-  [SMALL_STATE(50)] = 200,
-  [SMALL_STATE(51)] = 266,
-  [SMALL_STATE(52)] = 332,
-  [SMALL_STATE(53)] = 398,
-  [SMALL_STATE(54)] = 466,
-  [SMALL_STATE(55)] = 532,
-  [SMALL_STATE(56)] = 598,
-  [SMALL_STATE(57)] = 664,
-  [SMALL_STATE(58)] = 730,
-  [SMALL_STATE(59)] = 796,
-  [SMALL_STATE(60)] = 862,
-  [SMALL_STATE(61)] = 928,
-  [SMALL_STATE(62)] = 994,
-  [SMALL_STATE(63)] = 1060,
-  [SMALL_STATE(64)] = 1126,
-  [SMALL_STATE(65)] = 1192,
-  [SMALL_STATE(66)] = 1258,
-  [SMALL_STATE(67)] = 1324,
-  [SMALL_STATE(68)] = 1390,
-  [SMALL_STATE(69)] = 1479,
-  [SMALL_STATE(70)] = 1544,
-  [SMALL_STATE(71)] = 1633,
-  [SMALL_STATE(72)] = 1719,
-  [SMALL_STATE(73)] = 1805,
-  [SMALL_STATE(74)] = 1891,
-  [SMALL_STATE(75)] = 1977,
-  [SMALL_STATE(76)] = 2063,
-  [SMALL_STATE(77)] = 2149,
-  [SMALL_STATE(78)] = 2235,
-  [SMALL_STATE(79)] = 2321,
-  [SMALL_STATE(80)] = 2407,
-  [SMALL_STATE(81)] = 2493,
-  [SMALL_STATE(82)] = 2579,
-  [SMALL_STATE(83)] = 2665,
-  [SMALL_STATE(84)] = 2751,
-  [SMALL_STATE(85)] = 2837,
-  [SMALL_STATE(86)] = 2890,
-  [SMALL_STATE(87)] = 2931,
-  [SMALL_STATE(88)] = 2972,
-  [SMALL_STATE(89)] = 3010,
-  [SMALL_STATE(90)] = 3046,
-  [SMALL_STATE(91)] = 3082,
-  [SMALL_STATE(92)] = 3117,
-  [SMALL_STATE(93)] = 3152,
-  [SMALL_STATE(94)] = 3178,
-  [SMALL_STATE(95)] = 3202,
-  [SMALL_STATE(96)] = 3228,
-  [SMALL_STATE(97)] = 3254,
-  [SMALL_STATE(98)] = 3279,
-  [SMALL_STATE(99)] = 3301,
-  [SMALL_STATE(100)] = 3319,
-  [SMALL_STATE(101)] = 3337,
-  [SMALL_STATE(102)] = 3355,
-  [SMALL_STATE(103)] = 3375,
-  [SMALL_STATE(104)] = 3393,
-  [SMALL_STATE(105)] = 3413,
-  [SMALL_STATE(106)] = 3433,
-  [SMALL_STATE(107)] = 3451,
-  [SMALL_STATE(108)] = 3471,
-  [SMALL_STATE(109)] = 3490,
-  [SMALL_STATE(110)] = 3509,
-  [SMALL_STATE(111)] = 3526,
-  [SMALL_STATE(112)] = 3545,
-  [SMALL_STATE(113)] = 3564,
-  [SMALL_STATE(114)] = 3581,
-  [SMALL_STATE(115)] = 3596,
-  [SMALL_STATE(116)] = 3615,
-  [SMALL_STATE(117)] = 3632,
-  [SMALL_STATE(118)] = 3649,
-  [SMALL_STATE(119)] = 3663,
-  [SMALL_STATE(120)] = 3677,
-  [SMALL_STATE(121)] = 3690,
-  [SMALL_STATE(122)] = 3703,
-  [SMALL_STATE(123)] = 3716,
-  [SMALL_STATE(124)] = 3729,
-  [SMALL_STATE(125)] = 3742,
-  [SMALL_STATE(126)] = 3755,
-  [SMALL_STATE(127)] = 3768,
-  [SMALL_STATE(128)] = 3772,
+  [SMALL_STATE(48)] = 0,
+  [SMALL_STATE(49)] = 66,
+  [SMALL_STATE(50)] = 132,
+  [SMALL_STATE(51)] = 198,
+  [SMALL_STATE(52)] = 264,
+  [SMALL_STATE(53)] = 330,
+  [SMALL_STATE(54)] = 398,
+  [SMALL_STATE(55)] = 466,
+  [SMALL_STATE(56)] = 532,
+  [SMALL_STATE(57)] = 598,
+  [SMALL_STATE(58)] = 664,
+  [SMALL_STATE(59)] = 730,
+  [SMALL_STATE(60)] = 796,
+  [SMALL_STATE(61)] = 862,
+  [SMALL_STATE(62)] = 928,
+  [SMALL_STATE(63)] = 994,
+  [SMALL_STATE(64)] = 1060,
+  [SMALL_STATE(65)] = 1126,
+  [SMALL_STATE(66)] = 1192,
+  [SMALL_STATE(67)] = 1258,
+  [SMALL_STATE(68)] = 1324,
+  [SMALL_STATE(69)] = 1390,
+  [SMALL_STATE(70)] = 1456,
+  [SMALL_STATE(71)] = 1522,
+  [SMALL_STATE(72)] = 1611,
+  [SMALL_STATE(73)] = 1676,
+  [SMALL_STATE(74)] = 1765,
+  [SMALL_STATE(75)] = 1851,
+  [SMALL_STATE(76)] = 1937,
+  [SMALL_STATE(77)] = 2023,
+  [SMALL_STATE(78)] = 2109,
+  [SMALL_STATE(79)] = 2195,
+  [SMALL_STATE(80)] = 2281,
+  [SMALL_STATE(81)] = 2367,
+  [SMALL_STATE(82)] = 2453,
+  [SMALL_STATE(83)] = 2539,
+  [SMALL_STATE(84)] = 2625,
+  [SMALL_STATE(85)] = 2711,
+  [SMALL_STATE(86)] = 2797,
+  [SMALL_STATE(87)] = 2883,
+  [SMALL_STATE(88)] = 2969,
+  [SMALL_STATE(89)] = 3022,
+  [SMALL_STATE(90)] = 3063,
+  [SMALL_STATE(91)] = 3104,
+  [SMALL_STATE(92)] = 3142,
+  [SMALL_STATE(93)] = 3174,
+  [SMALL_STATE(94)] = 3210,
+  [SMALL_STATE(95)] = 3246,
+  [SMALL_STATE(96)] = 3281,
+  [SMALL_STATE(97)] = 3316,
+  [SMALL_STATE(98)] = 3345,
+  [SMALL_STATE(99)] = 3371,
+  [SMALL_STATE(100)] = 3397,
+  [SMALL_STATE(101)] = 3423,
+  [SMALL_STATE(102)] = 3449,
+  [SMALL_STATE(103)] = 3473,
+  [SMALL_STATE(104)] = 3498,
+  [SMALL_STATE(105)] = 3518,
+  [SMALL_STATE(106)] = 3538,
+  [SMALL_STATE(107)] = 3558,
+  [SMALL_STATE(108)] = 3576,
+  [SMALL_STATE(109)] = 3596,
+  [SMALL_STATE(110)] = 3614,
+  [SMALL_STATE(111)] = 3636,
+  [SMALL_STATE(112)] = 3654,
+  [SMALL_STATE(113)] = 3672,
+  [SMALL_STATE(114)] = 3690,
+  [SMALL_STATE(115)] = 3705,
+  [SMALL_STATE(116)] = 3724,
+  [SMALL_STATE(117)] = 3743,
+  [SMALL_STATE(118)] = 3760,
+  [SMALL_STATE(119)] = 3779,
+  [SMALL_STATE(120)] = 3798,
+  [SMALL_STATE(121)] = 3817,
+  [SMALL_STATE(122)] = 3836,
+  [SMALL_STATE(123)] = 3853,
+  [SMALL_STATE(124)] = 3870,
+  [SMALL_STATE(125)] = 3889,
+  [SMALL_STATE(126)] = 3908,
+  [SMALL_STATE(127)] = 3925,
+  [SMALL_STATE(128)] = 3942,
+  [SMALL_STATE(129)] = 3958,
+  [SMALL_STATE(130)] = 3972,
+  [SMALL_STATE(131)] = 3986,
+  [SMALL_STATE(132)] = 4000,
+  [SMALL_STATE(133)] = 4014,
+  [SMALL_STATE(134)] = 4028,
+  [SMALL_STATE(135)] = 4044,
+  [SMALL_STATE(136)] = 4058,
+  [SMALL_STATE(137)] = 4072,
+  [SMALL_STATE(138)] = 4085,
+  [SMALL_STATE(139)] = 4098,
+  [SMALL_STATE(140)] = 4111,
+  [SMALL_STATE(141)] = 4124,
+  [SMALL_STATE(142)] = 4137,
+  [SMALL_STATE(143)] = 4150,
+  [SMALL_STATE(144)] = 4163,
+  [SMALL_STATE(145)] = 4176,
+  [SMALL_STATE(146)] = 4180,
 };
 
 static const TSParseActionEntry ts_parse_actions[] = {
   [0] = {.entry = {.count = 0, .reusable = false}},
   [1] = {.entry = {.count = 1, .reusable = false}}, RECOVER(),
-  [3] = {.entry = {.count = 1, .reusable = true}}, SHIFT(123),
-  [5] = {.entry = {.count = 1, .reusable = true}}, SHIFT(102),
+  [3] = {.entry = {.count = 1, .reusable = true}}, SHIFT(137),
+  [5] = {.entry = {.count = 1, .reusable = true}}, SHIFT(104),
   [7] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_source_file, 0, 0, 0),
   [9] = {.entry = {.count = 1, .reusable = false}}, SHIFT(37),
-  [11] = {.entry = {.count = 1, .reusable = true}}, SHIFT(86),
-  [13] = {.entry = {.count = 1, .reusable = false}}, SHIFT(121),
-  [15] = {.entry = {.count = 1, .reusable = false}}, SHIFT(82),
-  [17] = {.entry = {.count = 1, .reusable = false}}, SHIFT(126),
-  [19] = {.entry = {.count = 1, .reusable = false}}, SHIFT(14),
-  [21] = {.entry = {.count = 1, .reusable = false}}, SHIFT(70),
-  [23] = {.entry = {.count = 1, .reusable = false}}, SHIFT(84),
-  [25] = {.entry = {.count = 1, .reusable = true}}, SHIFT(7),
-  [27] = {.entry = {.count = 1, .reusable = false}}, SHIFT(32),
-  [29] = {.entry = {.count = 1, .reusable = false}}, SHIFT(31),
-  [31] = {.entry = {.count = 1, .reusable = false}}, SHIFT(35),
-  [33] = {.entry = {.count = 1, .reusable = false}}, SHIFT(18),
-  [35] = {.entry = {.count = 1, .reusable = true}}, SHIFT(76),
-  [37] = {.entry = {.count = 1, .reusable = true}}, SHIFT(36),
-  [39] = {.entry = {.count = 1, .reusable = true}}, SHIFT(96),
-  [41] = {.entry = {.count = 1, .reusable = true}}, SHIFT(107),
-  [43] = {.entry = {.count = 1, .reusable = true}}, SHIFT(17),
-  [45] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__statement, 1, 0, 0),
-  [47] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym__statement, 1, 0, 0),
-  [49] = {.entry = {.count = 1, .reusable = true}}, SHIFT(75),
-  [51] = {.entry = {.count = 1, .reusable = false}}, SHIFT(75),
-  [53] = {.entry = {.count = 1, .reusable = true}}, SHIFT(78),
+  [11] = {.entry = {.count = 1, .reusable = true}}, SHIFT(89),
+  [13] = {.entry = {.count = 1, .reusable = false}}, SHIFT(138),
+  [15] = {.entry = {.count = 1, .reusable = false}}, SHIFT(74),
+  [17] = {.entry = {.count = 1, .reusable = false}}, SHIFT(139),
+  [19] = {.entry = {.count = 1, .reusable = false}}, SHIFT(12),
+  [21] = {.entry = {.count = 1, .reusable = false}}, SHIFT(71),
+  [23] = {.entry = {.count = 1, .reusable = false}}, SHIFT(75),
+  [25] = {.entry = {.count = 1, .reusable = false}}, SHIFT(39),
+  [27] = {.entry = {.count = 1, .reusable = true}}, SHIFT(76),
+  [29] = {.entry = {.count = 1, .reusable = true}}, SHIFT(6),
+  [31] = {.entry = {.count = 1, .reusable = false}}, SHIFT(15),
+  [33] = {.entry = {.count = 1, .reusable = false}}, SHIFT(16),
+  [35] = {.entry = {.count = 1, .reusable = false}}, SHIFT(17),
+  [37] = {.entry = {.count = 1, .reusable = false}}, SHIFT(18),
+  [39] = {.entry = {.count = 1, .reusable = true}}, SHIFT(36),
+  [41] = {.entry = {.count = 1, .reusable = true}}, SHIFT(100),
+  [43] = {.entry = {.count = 1, .reusable = true}}, SHIFT(105),
+  [45] = {.entry = {.count = 1, .reusable = true}}, SHIFT(19),
+  [47] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__statement, 1, 0, 0),
+  [49] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym__statement, 1, 0, 0),
+  [51] = {.entry = {.count = 1, .reusable = true}}, SHIFT(79),
+  [53] = {.entry = {.count = 1, .reusable = false}}, SHIFT(79),
   [55] = {.entry = {.count = 1, .reusable = true}}, SHIFT(80),
-  [57] = {.entry = {.count = 1, .reusable = false}}, SHIFT(81),
-  [59] = {.entry = {.count = 1, .reusable = true}}, SHIFT(81),
-  [61] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0),
-  [63] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(37),
-  [66] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(86),
-  [69] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(121),
-  [72] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(82),
-  [75] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(126),
-  [78] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(14),
-  [81] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(70),
-  [84] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(84),
-  [87] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(7),
-  [90] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(32),
-  [93] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(31),
-  [96] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(35),
-  [99] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(18),
-  [102] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(76),
-  [105] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(36),
-  [108] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(96),
-  [111] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(107),
-  [114] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(17),
-  [117] = {.entry = {.count = 1, .reusable = false}}, SHIFT(41),
-  [119] = {.entry = {.count = 1, .reusable = true}}, SHIFT(40),
-  [121] = {.entry = {.count = 1, .reusable = true}}, SHIFT(45),
-  [123] = {.entry = {.count = 1, .reusable = true}}, SHIFT(55),
-  [125] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_source_file, 1, 0, 0),
-  [127] = {.entry = {.count = 1, .reusable = true}}, SHIFT(60),
-  [129] = {.entry = {.count = 1, .reusable = false}}, SHIFT(38),
-  [131] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_binary_expression, 3, 0, 0),
-  [133] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_binary_expression, 3, 0, 0),
-  [135] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_parenthesized_expression, 3, 0, 0),
-  [137] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_parenthesized_expression, 3, 0, 0),
-  [139] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__expression, 1, 0, 0),
-  [141] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym__expression, 1, 0, 0),
-  [143] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_keyword, 1, 0, 0),
-  [145] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_type_keyword, 1, 0, 0),
-  [147] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_dictionary, 3, 0, 0),
-  [149] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_dictionary, 3, 0, 0),
-  [151] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_string, 2, 0, 0),
-  [153] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_string, 2, 0, 0),
-  [155] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_single_quoted_string, 2, 0, 0),
-  [157] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_single_quoted_string, 2, 0, 0),
-  [159] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_dictionary, 2, 0, 0),
-  [161] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_dictionary, 2, 0, 0),
-  [163] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_string, 3, 0, 0),
-  [165] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_string, 3, 0, 0),
-  [167] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_single_quoted_string, 3, 0, 0),
-  [169] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_single_quoted_string, 3, 0, 0),
-  [171] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_call, 3, 0, 8),
-  [173] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_call, 3, 0, 8),
-  [175] = {.entry = {.count = 1, .reusable = true}}, SHIFT(68),
-  [177] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_dictionary, 4, 0, 0),
-  [179] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_dictionary, 4, 0, 0),
-  [181] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_builtin_keyword, 1, 0, 0),
-  [183] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_builtin_keyword, 1, 0, 0),
-  [185] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_boolean, 1, 0, 0),
-  [187] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_boolean, 1, 0, 0),
-  [189] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_call, 4, 0, 12),
-  [191] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_call, 4, 0, 12),
-  [193] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_call, 5, 0, 16),
-  [195] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_call, 5, 0, 16),
-  [197] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_builtin_constant, 1, 0, 0),
-  [199] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_builtin_constant, 1, 0, 0),
-  [201] = {.entry = {.count = 1, .reusable = true}}, SHIFT(93),
-  [203] = {.entry = {.count = 1, .reusable = false}}, SHIFT(72),
-  [205] = {.entry = {.count = 1, .reusable = false}}, SHIFT(74),
-  [207] = {.entry = {.count = 1, .reusable = false}}, SHIFT(77),
-  [209] = {.entry = {.count = 1, .reusable = true}}, SHIFT(83),
-  [211] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_variable_assignment, 3, 0, 7),
-  [213] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_variable_assignment, 3, 0, 7),
-  [215] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_constant_assignment, 4, 0, 9),
-  [217] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_constant_assignment, 4, 0, 9),
-  [219] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_identifier_assignment, 3, 0, 7),
-  [221] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_identifier_assignment, 3, 0, 7),
-  [223] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_block, 2, 0, 0),
-  [225] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_block, 2, 0, 0),
-  [227] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_if_statement, 3, 0, 3),
-  [229] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_if_statement, 3, 0, 3),
-  [231] = {.entry = {.count = 1, .reusable = false}}, SHIFT(13),
-  [233] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_repeat_statement, 5, 0, 15),
-  [235] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_repeat_statement, 5, 0, 15),
-  [237] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_repeat_statement, 3, 0, 4),
-  [239] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_repeat_statement, 3, 0, 4),
-  [241] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_pragma, 2, 0, 2),
-  [243] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_pragma, 2, 0, 2),
-  [245] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_menu_statement, 3, 0, 5),
-  [247] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_menu_statement, 3, 0, 5),
-  [249] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_block, 3, 0, 0),
-  [251] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_block, 3, 0, 0),
-  [253] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_declaration, 3, 0, 6),
-  [255] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_declaration, 3, 0, 6),
-  [257] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_repeat_statement, 2, 0, 1),
-  [259] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_repeat_statement, 2, 0, 1),
-  [261] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_menu_statement, 2, 0, 1),
-  [263] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_menu_statement, 2, 0, 1),
-  [265] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_item_statement, 4, 0, 10),
-  [267] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_item_statement, 4, 0, 10),
-  [269] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_if_statement, 5, 0, 13),
-  [271] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_if_statement, 5, 0, 13),
-  [273] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_for_statement, 5, 0, 14),
-  [275] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_for_statement, 5, 0, 14),
-  [277] = {.entry = {.count = 1, .reusable = false}}, SHIFT(28),
-  [279] = {.entry = {.count = 1, .reusable = true}}, SHIFT(97),
-  [281] = {.entry = {.count = 1, .reusable = true}}, SHIFT(26),
-  [283] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 1, 0, 0),
-  [285] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 1, 0, 0),
-  [287] = {.entry = {.count = 1, .reusable = true}}, SHIFT(8),
-  [289] = {.entry = {.count = 1, .reusable = false}}, SHIFT(50),
-  [291] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_pragma_directive, 1, 0, 0),
-  [293] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_pragma_directive, 1, 0, 0),
-  [295] = {.entry = {.count = 1, .reusable = true}}, SHIFT(71),
-  [297] = {.entry = {.count = 1, .reusable = true}}, SHIFT(33),
-  [299] = {.entry = {.count = 1, .reusable = true}}, SHIFT(11),
-  [301] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_dictionary_pair, 3, 0, 11),
-  [303] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_call_repeat1, 2, 0, 0),
-  [305] = {.entry = {.count = 1, .reusable = true}}, SHIFT(16),
-  [307] = {.entry = {.count = 1, .reusable = true}}, SHIFT(12),
-  [309] = {.entry = {.count = 1, .reusable = false}}, SHIFT(56),
-  [311] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_string_repeat1, 2, 0, 0), SHIFT_REPEAT(109),
-  [314] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_string_repeat1, 2, 0, 0),
-  [316] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_string_repeat1, 2, 0, 0), SHIFT_REPEAT(101),
-  [319] = {.entry = {.count = 1, .reusable = false}}, SHIFT(123),
-  [321] = {.entry = {.count = 1, .reusable = false}}, SHIFT(102),
-  [323] = {.entry = {.count = 1, .reusable = false}}, SHIFT(109),
-  [325] = {.entry = {.count = 1, .reusable = false}}, SHIFT(23),
-  [327] = {.entry = {.count = 1, .reusable = true}}, SHIFT(101),
-  [329] = {.entry = {.count = 1, .reusable = false}}, SHIFT(20),
-  [331] = {.entry = {.count = 1, .reusable = true}}, SHIFT(122),
-  [333] = {.entry = {.count = 1, .reusable = true}}, SHIFT(22),
-  [335] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_single_quoted_string_repeat1, 2, 0, 0),
-  [337] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_single_quoted_string_repeat1, 2, 0, 0), SHIFT_REPEAT(116),
-  [340] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_comment_repeat1, 2, 0, 0), SHIFT_REPEAT(114),
-  [343] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_comment_repeat1, 2, 0, 0),
-  [345] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_string_repeat1, 1, 0, 0),
-  [347] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_string_repeat1, 1, 0, 0),
-  [349] = {.entry = {.count = 1, .reusable = false}}, SHIFT(114),
-  [351] = {.entry = {.count = 1, .reusable = false}}, SHIFT(128),
-  [353] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_interpolation, 3, 0, 0),
-  [355] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_interpolation, 3, 0, 0),
-  [357] = {.entry = {.count = 1, .reusable = false}}, SHIFT(24),
-  [359] = {.entry = {.count = 1, .reusable = true}}, SHIFT(116),
-  [361] = {.entry = {.count = 1, .reusable = false}}, SHIFT(127),
-  [363] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_interpolation, 2, 0, 0),
-  [365] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_interpolation, 2, 0, 0),
-  [367] = {.entry = {.count = 1, .reusable = false}}, SHIFT(21),
-  [369] = {.entry = {.count = 1, .reusable = true}}, SHIFT(30),
-  [371] = {.entry = {.count = 1, .reusable = true}}, SHIFT(98),
-  [373] = {.entry = {.count = 1, .reusable = false}}, SHIFT(106),
-  [375] = {.entry = {.count = 1, .reusable = false}}, SHIFT(119),
-  [377] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_dictionary_repeat1, 2, 0, 0),
-  [379] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_dictionary_repeat1, 2, 0, 0), SHIFT_REPEAT(98),
-  [382] = {.entry = {.count = 1, .reusable = false}}, SHIFT(103),
-  [384] = {.entry = {.count = 1, .reusable = true}}, SHIFT(19),
-  [386] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_interpolation_repeat1, 2, 0, 0),
-  [388] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_interpolation_repeat1, 2, 0, 0), SHIFT_REPEAT(119),
-  [391] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_comment_repeat1, 1, 0, 0),
-  [393] = {.entry = {.count = 1, .reusable = true}}, SHIFT(34),
-  [395] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_single_quoted_string_repeat1, 1, 0, 0),
-  [397] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_single_quoted_string_repeat1, 1, 0, 0),
-  [399] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_call_repeat1, 2, 0, 0), SHIFT_REPEAT(71),
-  [402] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_interpolation_repeat1, 1, 0, 0),
-  [404] = {.entry = {.count = 1, .reusable = true}}, SHIFT(79),
-  [406] = {.entry = {.count = 1, .reusable = true}}, SHIFT(120),
-  [408] = {.entry = {.count = 1, .reusable = true}},  ACCEPT_INPUT(),
-  [410] = {.entry = {.count = 1, .reusable = true}}, SHIFT(73),
-  [412] = {.entry = {.count = 1, .reusable = true}}, SHIFT(125),
-  [414] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_comment, 3, 0, 0),
-  [416] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_comment, 2, 0, 0),
+  [57] = {.entry = {.count = 1, .reusable = true}}, SHIFT(81),
+  [59] = {.entry = {.count = 1, .reusable = false}}, SHIFT(82),
+  [61] = {.entry = {.count = 1, .reusable = true}}, SHIFT(82),
+  [63] = {.entry = {.count = 1, .reusable = false}}, SHIFT(42),
+  [65] = {.entry = {.count = 1, .reusable = true}}, SHIFT(41),
+  [67] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0),
+  [69] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(37),
+  [72] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(89),
+  [75] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(138),
+  [78] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(74),
+  [81] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(139),
+  [84] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(12),
+  [87] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(71),
+  [90] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(75),
+  [93] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(39),
+  [96] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(76),
+  [99] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(6),
+  [102] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(15),
+  [105] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(16),
+  [108] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(17),
+  [111] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(18),
+  [114] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(36),
+  [117] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(100),
+  [120] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(105),
+  [123] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(19),
+  [126] = {.entry = {.count = 1, .reusable = true}}, SHIFT(46),
+  [128] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_source_file, 1, 0, 0),
+  [130] = {.entry = {.count = 1, .reusable = true}}, SHIFT(58),
+  [132] = {.entry = {.count = 1, .reusable = true}}, SHIFT(60),
+  [134] = {.entry = {.count = 1, .reusable = false}}, SHIFT(38),
+  [136] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_boolean, 1, 0, 0),
+  [138] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_boolean, 1, 0, 0),
+  [140] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_builtin_keyword, 1, 0, 0),
+  [142] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_builtin_keyword, 1, 0, 0),
+  [144] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_builtin_constant, 1, 0, 0),
+  [146] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_builtin_constant, 1, 0, 0),
+  [148] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_keyword, 1, 0, 0),
+  [150] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_type_keyword, 1, 0, 0),
+  [152] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__expression, 1, 0, 0),
+  [154] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym__expression, 1, 0, 0),
+  [156] = {.entry = {.count = 1, .reusable = true}}, SHIFT(73),
+  [158] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_string, 2, 0, 0),
+  [160] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_string, 2, 0, 0),
+  [162] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_single_quoted_string, 2, 0, 0),
+  [164] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_single_quoted_string, 2, 0, 0),
+  [166] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_dictionary, 2, 0, 0),
+  [168] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_dictionary, 2, 0, 0),
+  [170] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_parenthesized_expression, 3, 0, 0),
+  [172] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_parenthesized_expression, 3, 0, 0),
+  [174] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_dictionary, 3, 0, 0),
+  [176] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_dictionary, 3, 0, 0),
+  [178] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_string, 3, 0, 0),
+  [180] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_string, 3, 0, 0),
+  [182] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_single_quoted_string, 3, 0, 0),
+  [184] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_single_quoted_string, 3, 0, 0),
+  [186] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_call, 3, 0, 8),
+  [188] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_call, 3, 0, 8),
+  [190] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_binary_expression, 3, 0, 0),
+  [192] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_binary_expression, 3, 0, 0),
+  [194] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_dictionary, 4, 0, 0),
+  [196] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_dictionary, 4, 0, 0),
+  [198] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_call, 4, 0, 13),
+  [200] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_call, 4, 0, 13),
+  [202] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_call, 5, 0, 18),
+  [204] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_call, 5, 0, 18),
+  [206] = {.entry = {.count = 1, .reusable = true}}, SHIFT(98),
+  [208] = {.entry = {.count = 1, .reusable = false}}, SHIFT(77),
+  [210] = {.entry = {.count = 1, .reusable = false}}, SHIFT(78),
+  [212] = {.entry = {.count = 1, .reusable = false}}, SHIFT(85),
+  [214] = {.entry = {.count = 1, .reusable = false}}, SHIFT(128),
+  [216] = {.entry = {.count = 1, .reusable = true}}, SHIFT(86),
+  [218] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_variable_assignment, 3, 0, 7),
+  [220] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_variable_assignment, 3, 0, 7),
+  [222] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_identifier_assignment, 3, 0, 7),
+  [224] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_identifier_assignment, 3, 0, 7),
+  [226] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_constant_assignment, 4, 0, 9),
+  [228] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_constant_assignment, 4, 0, 9),
+  [230] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_block, 2, 0, 0),
+  [232] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_block, 2, 0, 0),
+  [234] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_repeat_statement, 2, 0, 1),
+  [236] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_repeat_statement, 2, 0, 1),
+  [238] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_menu_statement, 2, 0, 1),
+  [240] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_menu_statement, 2, 0, 1),
+  [242] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_pragma, 2, 0, 2),
+  [244] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_pragma, 2, 0, 2),
+  [246] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_if_statement, 3, 0, 3),
+  [248] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_if_statement, 3, 0, 3),
+  [250] = {.entry = {.count = 1, .reusable = false}}, SHIFT(14),
+  [252] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_repeat_statement, 3, 0, 4),
+  [254] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_repeat_statement, 3, 0, 4),
+  [256] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_menu_statement, 3, 0, 5),
+  [258] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_menu_statement, 3, 0, 5),
+  [260] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_block, 3, 0, 0),
+  [262] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_block, 3, 0, 0),
+  [264] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_declaration, 3, 0, 6),
+  [266] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_declaration, 3, 0, 6),
+  [268] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_item_statement, 4, 0, 10),
+  [270] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_item_statement, 4, 0, 10),
+  [272] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_action_definition, 4, 0, 11),
+  [274] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_action_definition, 4, 0, 11),
+  [276] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_if_statement, 5, 0, 14),
+  [278] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_if_statement, 5, 0, 14),
+  [280] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_for_statement, 5, 0, 15),
+  [282] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_for_statement, 5, 0, 15),
+  [284] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_repeat_statement, 5, 0, 16),
+  [286] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_repeat_statement, 5, 0, 16),
+  [288] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_action_definition, 6, 0, 19),
+  [290] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_action_definition, 6, 0, 19),
+  [292] = {.entry = {.count = 1, .reusable = true}}, SHIFT(8),
+  [294] = {.entry = {.count = 1, .reusable = false}}, SHIFT(20),
+  [296] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 1, 0, 0),
+  [298] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 1, 0, 0),
+  [300] = {.entry = {.count = 1, .reusable = true}}, SHIFT(28),
+  [302] = {.entry = {.count = 1, .reusable = true}}, SHIFT(103),
+  [304] = {.entry = {.count = 1, .reusable = false}}, SHIFT(52),
+  [306] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_pragma_directive, 1, 0, 0),
+  [308] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_pragma_directive, 1, 0, 0),
+  [310] = {.entry = {.count = 1, .reusable = true}}, SHIFT(87),
+  [312] = {.entry = {.count = 1, .reusable = true}}, SHIFT(34),
+  [314] = {.entry = {.count = 1, .reusable = true}}, SHIFT(11),
+  [316] = {.entry = {.count = 1, .reusable = true}}, SHIFT(130),
+  [318] = {.entry = {.count = 1, .reusable = false}}, SHIFT(144),
+  [320] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_dictionary_pair, 3, 0, 12),
+  [322] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_call_repeat1, 2, 0, 0),
+  [324] = {.entry = {.count = 1, .reusable = true}}, SHIFT(13),
+  [326] = {.entry = {.count = 1, .reusable = true}}, SHIFT(24),
+  [328] = {.entry = {.count = 1, .reusable = false}}, SHIFT(59),
+  [330] = {.entry = {.count = 1, .reusable = false}}, SHIFT(134),
+  [332] = {.entry = {.count = 1, .reusable = false}}, SHIFT(116),
+  [334] = {.entry = {.count = 1, .reusable = false}}, SHIFT(21),
+  [336] = {.entry = {.count = 1, .reusable = true}}, SHIFT(107),
+  [338] = {.entry = {.count = 1, .reusable = false}}, SHIFT(137),
+  [340] = {.entry = {.count = 1, .reusable = false}}, SHIFT(104),
+  [342] = {.entry = {.count = 1, .reusable = false}}, SHIFT(26),
+  [344] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_string_repeat1, 2, 0, 0), SHIFT_REPEAT(116),
+  [347] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_string_repeat1, 2, 0, 0),
+  [349] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_string_repeat1, 2, 0, 0), SHIFT_REPEAT(107),
+  [352] = {.entry = {.count = 1, .reusable = true}}, SHIFT(23),
+  [354] = {.entry = {.count = 1, .reusable = true}}, SHIFT(143),
+  [356] = {.entry = {.count = 1, .reusable = false}}, SHIFT(114),
+  [358] = {.entry = {.count = 1, .reusable = false}}, SHIFT(145),
+  [360] = {.entry = {.count = 1, .reusable = false}}, SHIFT(22),
+  [362] = {.entry = {.count = 1, .reusable = true}}, SHIFT(117),
+  [364] = {.entry = {.count = 1, .reusable = false}}, SHIFT(146),
+  [366] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_string_repeat1, 1, 0, 0),
+  [368] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_string_repeat1, 1, 0, 0),
+  [370] = {.entry = {.count = 1, .reusable = false}}, SHIFT(27),
+  [372] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_comment_repeat1, 2, 0, 0), SHIFT_REPEAT(114),
+  [375] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_comment_repeat1, 2, 0, 0),
+  [377] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_interpolation, 2, 0, 0),
+  [379] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_interpolation, 2, 0, 0),
+  [381] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_single_quoted_string_repeat1, 2, 0, 0),
+  [383] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_single_quoted_string_repeat1, 2, 0, 0), SHIFT_REPEAT(117),
+  [386] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_interpolation, 3, 0, 0),
+  [388] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_interpolation, 3, 0, 0),
+  [390] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_comment_repeat1, 1, 0, 0),
+  [392] = {.entry = {.count = 1, .reusable = true}}, SHIFT(110),
+  [394] = {.entry = {.count = 1, .reusable = true}}, SHIFT(25),
+  [396] = {.entry = {.count = 1, .reusable = false}}, SHIFT(111),
+  [398] = {.entry = {.count = 1, .reusable = false}}, SHIFT(129),
+  [400] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_single_quoted_string_repeat1, 1, 0, 0),
+  [402] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_single_quoted_string_repeat1, 1, 0, 0),
+  [404] = {.entry = {.count = 1, .reusable = true}}, SHIFT(99),
+  [406] = {.entry = {.count = 1, .reusable = true}}, SHIFT(33),
+  [408] = {.entry = {.count = 1, .reusable = false}}, SHIFT(113),
+  [410] = {.entry = {.count = 1, .reusable = true}}, SHIFT(97),
+  [412] = {.entry = {.count = 1, .reusable = true}}, SHIFT(133),
+  [414] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_dictionary_repeat1, 2, 0, 0), SHIFT_REPEAT(110),
+  [417] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_dictionary_repeat1, 2, 0, 0),
+  [419] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_interpolation_repeat1, 2, 0, 0),
+  [421] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_interpolation_repeat1, 2, 0, 0), SHIFT_REPEAT(129),
+  [424] = {.entry = {.count = 1, .reusable = true}}, SHIFT(35),
+  [426] = {.entry = {.count = 1, .reusable = true}}, SHIFT(136),
+  [428] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_call_repeat1, 2, 0, 0), SHIFT_REPEAT(87),
+  [431] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_parameters_repeat1, 2, 0, 0), SHIFT_REPEAT(97),
+  [434] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_parameters_repeat1, 2, 0, 0),
+  [436] = {.entry = {.count = 1, .reusable = true}}, SHIFT(92),
+  [438] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_interpolation_repeat1, 1, 0, 0),
+  [440] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_parameters, 2, 0, 0),
+  [442] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_parameter, 2, 0, 17),
+  [444] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_parameters, 3, 0, 0),
+  [446] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_parameters, 4, 0, 0),
+  [448] = {.entry = {.count = 1, .reusable = true}}, SHIFT(141),
+  [450] = {.entry = {.count = 1, .reusable = true}}, SHIFT(142),
+  [452] = {.entry = {.count = 1, .reusable = true}},  ACCEPT_INPUT(),
+  [454] = {.entry = {.count = 1, .reusable = true}}, SHIFT(83),
+  [456] = {.entry = {.count = 1, .reusable = true}}, SHIFT(84),
+  [458] = {.entry = {.count = 1, .reusable = true}}, SHIFT(132),
+  [460] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_comment, 2, 0, 0),
+  [462] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_comment, 3, 0, 0),
 };
 
 #ifdef __cplusplus